@@ -1,7 +1,7 @@
 package main
 
 import (
-	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"project/internal/infra/config"
@@ -21,9 +21,15 @@ import (
 // @securityDefinitions.apikey ApiKeyAuth
 // @in header
 // @name Authorization
+// @description An API key (pck_...) or a JWT, sent as "Bearer <credential>". Required on the writes.
 // @host localhost:8085
 // @BasePath /
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		migrate(os.Args[2:])
+		return
+	}
+
 	environmentConf := config.NewBaseConfig(".env")
 	mainInstance := config.NewServerInstances(environmentConf)
 
@@ -32,7 +38,7 @@ func main() {
 
 	go func() {
 		<-sigCh
-		log.Print("Shutting down server...")
+		slog.Info("shutting down server")
 		mainInstance.Stop()
 	}()
 
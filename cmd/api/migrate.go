@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"project/internal/infra/config"
+	"project/internal/infra/sqlite"
+	"text/tabwriter"
+	"time"
+)
+
+// migrate runs the migrations embedded in the binary, without starting the server:
+//
+//	api migrate up
+//	api migrate down
+//	api migrate status
+func migrate(args []string) {
+	if len(args) != 1 {
+		log.Fatal("usage: api migrate up|down|status")
+	}
+
+	environmentConf := config.NewBaseConfig(".env")
+	db := sqlite.NewSqliteConnection(environmentConf.Sqlite)
+	defer db.DB.Close()
+
+	migrator, err := sqlite.NewMigrator(db.DB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch args[0] {
+	case "up":
+		results, err := migrator.Up()
+
+		for _, result := range results {
+			log.Printf("Applied migration %s (%s)", result.Source.Path, result.Duration)
+		}
+
+		if err != nil {
+			log.Fatalf("migrate up failed: %v", err)
+		}
+
+		if len(results) == 0 {
+			log.Print("No pending migrations")
+		}
+	case "down":
+		result, err := migrator.Down()
+		if err != nil {
+			log.Fatalf("migrate down failed: %v", err)
+		}
+
+		log.Printf("Rolled back migration %s (%s)", result.Source.Path, result.Duration)
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			log.Fatalf("migrate status failed: %v", err)
+		}
+
+		current, target, err := migrator.Versions()
+		if err != nil {
+			log.Fatalf("migrate status failed: %v", err)
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+		fmt.Fprintln(writer, "VERSION\tSTATE\tAPPLIED AT\tFILE")
+
+		for _, status := range statuses {
+			appliedAt := ""
+
+			if !status.AppliedAt.IsZero() {
+				appliedAt = status.AppliedAt.Format(time.DateTime)
+			}
+
+			fmt.Fprintf(writer, "%d\t%s\t%s\t%s\n", status.Source.Version, status.State, appliedAt, status.Source.Path)
+		}
+
+		writer.Flush()
+
+		fmt.Printf("\ndatabase version %d, latest migration %d\n", current, target)
+	default:
+		log.Fatalf("unknown migrate command %q, use up, down or status", args[0])
+	}
+}
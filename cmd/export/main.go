@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"log"
+	"os"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/catalog"
+	"project/internal/infra/config"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+)
+
+// Exports the product catalog straight from the sqlite database, reading it in
+// batches so very large catalogs are never fully loaded in memory.
+//
+//	go run ./cmd/export -format csv -output catalog.csv
+//	go run ./cmd/export -format ndjson -category 1a2b3c4d -limit 500
+func main() {
+	envFile := flag.String("env", ".env", "environment file with the sqlite settings")
+	format := flag.String("format", "", "csv, json or ndjson, defaults to the output extension or csv")
+	outputPath := flag.String("output", "", "file to write, defaults to stdout")
+	categoryPublicId := flag.String("category", "", "only export products of this category public ID")
+	skip := flag.Int64("skip", 0, "products to skip")
+	limit := flag.Int64("limit", 0, "maximum number of products, 0 exports all")
+	flag.Parse()
+
+	if *format == "" && *outputPath == "" {
+		*format = string(catalog.FormatCSV)
+	}
+
+	fileFormat, err := catalog.ParseFormat(*format, *outputPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	environmentConf := config.NewBaseConfig(*envFile)
+	db := sqlite.NewSqliteInstance(environmentConf.Sqlite)
+	defer db.DB.Close()
+
+	exportProducts := usecase.NewExportProducts(
+		repository.NewProductSqlite(db.DB),
+		repository.NewCategorySqlite(db.DB),
+		repository.NewSpecificationqlite(db.DB),
+		repository.NewProductSpecificationValueSqlite(db.DB),
+	)
+
+	result, usecaseErr := exportProducts.Execute(&dto.ExportProductsInput{
+		PaginatorInput:   &dto.PaginatorInput{Skip: *skip, Limit: *limit},
+		CategoryPublicID: types.CategoryPublicID(*categoryPublicId),
+	})
+
+	if usecaseErr != nil {
+		log.Fatal(usecaseErr.Instance().Message)
+	}
+
+	output := os.Stdout
+
+	if *outputPath != "" {
+		output, err = os.Create(*outputPath)
+		if err != nil {
+			log.Fatalf("can't create output file. error: %v", err)
+		}
+		defer output.Close()
+	}
+
+	writer := bufio.NewWriter(output)
+	defer writer.Flush()
+
+	encoder, err := catalog.NewEncoder(fileFormat, writer, result.Columns)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exported := 0
+
+	for product, usecaseErr := range result.Products {
+		if usecaseErr != nil {
+			writer.Flush()
+			log.Fatalf("export stopped after %d products: %s", exported, usecaseErr.Instance().Message)
+		}
+
+		if err := encoder.Encode(product); err != nil {
+			log.Fatalf("can't write product %s. error: %v", product.PublicID, err)
+		}
+
+		exported++
+	}
+
+	if err := encoder.Close(); err != nil {
+		log.Fatalf("can't finish export. error: %v", err)
+	}
+
+	log.Printf("%d products exported", exported)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	"project/internal/infra/catalog"
+	"project/internal/infra/config"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+
+	json "github.com/goccy/go-json"
+)
+
+// Imports a file in the fakestoreapi.com format (the /products response saved to
+// disk) straight into the sqlite database. Each product keeps a reference to its
+// Fake Store ID, so running it again with the same or a newer file updates the
+// products instead of duplicating them.
+//
+//	curl -o fakestore.json https://fakestoreapi.com/products
+//	go run ./cmd/import-fakestore -file fakestore.json
+func main() {
+	envFile := flag.String("env", ".env", "environment file with the sqlite settings")
+	filePath := flag.String("file", "", "fake store json file to import")
+	reportPath := flag.String("report", "", "write the JSON report to this file instead of stdout")
+	flag.Parse()
+
+	if *filePath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	file, err := os.Open(*filePath)
+	if err != nil {
+		log.Fatalf("can't open import file. error: %v", err)
+	}
+
+	products, err := catalog.DecodeFakeStore(file)
+	file.Close()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	environmentConf := config.NewBaseConfig(*envFile)
+	db := sqlite.NewSqliteInstance(environmentConf.Sqlite)
+	defer db.DB.Close()
+
+	importFakeStoreProducts := usecase.NewImportFakeStoreProducts(
+		repository.NewProductSqlite(db.DB),
+		repository.NewCategorySqlite(db.DB),
+		repository.NewExternalReferenceSqlite(db.DB),
+		repository.NewAuditLogSqlite(db.DB),
+	)
+
+	report, usecaseErr := importFakeStoreProducts.Execute(&dto.ImportFakeStoreProductsInput{
+		Products:  products,
+		Actor:     "import-fakestore",
+		ActorRole: constants.RoleSystem,
+	})
+
+	if usecaseErr != nil {
+		log.Fatalf("import stopped: %s", usecaseErr.Instance().Message)
+	}
+
+	log.Printf(
+		"%d products: %d created, %d updated, %d unchanged, %d failed, %d categories created",
+		report.Total, report.Created, report.Updated, report.Unchanged, report.Failed, report.CategoriesCreated,
+	)
+
+	output := os.Stdout
+
+	if *reportPath != "" {
+		output, err = os.Create(*reportPath)
+		if err != nil {
+			log.Fatalf("can't create report file. error: %v", err)
+		}
+		defer output.Close()
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(report); err != nil {
+		log.Fatalf("can't write report. error: %v", err)
+	}
+}
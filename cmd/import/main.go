@@ -0,0 +1,135 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	"project/internal/infra/catalog"
+	"project/internal/infra/config"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+
+	json "github.com/goccy/go-json"
+)
+
+// Imports a product catalog file straight into the sqlite database, one chunk per
+// transaction. When an import stops halfway, run it again with -offset set to the
+// last reported next offset to resume.
+//
+//	go run ./cmd/import -file catalog.csv -dry-run
+//	go run ./cmd/import -file catalog.ndjson -chunk-size 500 -offset 1500 -report report.json
+func main() {
+	envFile := flag.String("env", ".env", "environment file with the sqlite settings")
+	filePath := flag.String("file", "", "file to import (csv, json or ndjson)")
+	format := flag.String("format", "", "file format, defaults to the file extension")
+	dryRun := flag.Bool("dry-run", false, "validate every row without writing")
+	offset := flag.Int("offset", 0, "rows to skip, used to resume an import")
+	chunkSize := flag.Int("chunk-size", 100, "rows written per transaction (max 1000)")
+	reportPath := flag.String("report", "", "write the JSON error report to this file instead of stdout")
+	flag.Parse()
+
+	if *filePath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	fileFormat, err := catalog.ParseFormat(*format, *filePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	file, err := os.Open(*filePath)
+	if err != nil {
+		log.Fatalf("can't open import file. error: %v", err)
+	}
+
+	rows, err := catalog.Decode(fileFormat, file)
+	file.Close()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	environmentConf := config.NewBaseConfig(*envFile)
+	db := sqlite.NewSqliteInstance(environmentConf.Sqlite)
+	defer db.DB.Close()
+
+	importProducts := usecase.NewImportProducts(
+		repository.NewProductSqlite(db.DB),
+		repository.NewCategorySqlite(db.DB),
+		repository.NewSpecificationqlite(db.DB),
+		repository.NewCategorySpecificationSqlite(db.DB),
+		repository.NewAuditLogSqlite(db.DB),
+	)
+
+	report := &dto.ImportProductsOutput{
+		DryRun:   *dryRun,
+		Total:    len(rows),
+		Offset:   *offset,
+		Products: []*dto.ImportedProduct{},
+		Errors:   []*dto.ImportProductError{},
+	}
+
+	input := &dto.ImportProductsInput{
+		Rows:      rows,
+		DryRun:    *dryRun,
+		Offset:    *offset,
+		ChunkSize: *chunkSize,
+		Actor:     "import",
+		ActorRole: constants.RoleSystem,
+	}
+
+	for {
+		result, usecaseErr := importProducts.Execute(input)
+
+		if usecaseErr != nil {
+			log.Printf("import stopped: %s", usecaseErr.Instance().Message)
+			log.Fatalf("resume with -offset %d", input.Offset)
+		}
+
+		log.Printf(
+			"rows %d-%d of %d: %d valid, %d imported, %d failed, next offset %d",
+			result.Offset+1, result.NextOffset, result.Total, result.Valid, result.Imported, result.Failed, result.NextOffset,
+		)
+
+		report.ChunkSize = result.ChunkSize
+		report.Processed += result.Processed
+		report.Valid += result.Valid
+		report.Imported += result.Imported
+		report.Failed += result.Failed
+		report.NextOffset = result.NextOffset
+		report.Done = result.Done
+		report.Products = append(report.Products, result.Products...)
+		report.Errors = append(report.Errors, result.Errors...)
+
+		if result.Done {
+			break
+		}
+
+		input.Offset = result.NextOffset
+	}
+
+	output := os.Stdout
+
+	if *reportPath != "" {
+		output, err = os.Create(*reportPath)
+		if err != nil {
+			log.Fatalf("can't create report file. error: %v", err)
+		}
+		defer output.Close()
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(report); err != nil {
+		log.Fatalf("can't write report. error: %v", err)
+	}
+
+	if report.Failed > 0 {
+		log.Printf("%d of %d rows failed, see the report", report.Failed, report.Processed)
+	}
+}
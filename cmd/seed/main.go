@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	"project/internal/infra/config"
+	"project/internal/infra/seed"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"strings"
+
+	json "github.com/goccy/go-json"
+)
+
+// Seeds the sqlite database with the specification groups, the 16 built-in
+// specifications (always with IDs 1 to 16) and the sample categories and products
+// of a profile. Seeding is repeatable: rows are matched by ID and public ID and
+// updated in place.
+//
+//	go run ./cmd/seed -profile dev
+//	go run ./cmd/seed -profile demo -reset
+//	go run ./cmd/seed -dir ./my-fixtures -profile staging
+func main() {
+	envFile := flag.String("env", ".env", "environment file with the sqlite settings")
+	profile := flag.String("profile", "dev", "fixture profile: "+strings.Join(seed.Profiles, ", "))
+	dir := flag.String("dir", "", "read the profile from this directory instead of the built-in fixtures")
+	reset := flag.Bool("reset", false, "delete every catalog row before seeding")
+	flag.Parse()
+
+	fixtures := seed.Fixtures
+
+	if *dir != "" {
+		fixtures = os.DirFS(*dir)
+	}
+
+	fixture, err := seed.Load(fixtures, *profile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	environmentConf := config.NewBaseConfig(*envFile)
+	db := sqlite.NewSqliteInstance(environmentConf.Sqlite)
+	defer db.DB.Close()
+
+	seedCatalog := usecase.NewSeedCatalog(repository.NewSeedSqlite(db.DB))
+
+	if *reset {
+		log.Printf("resetting catalog of %s", environmentConf.Sqlite.Path)
+	}
+
+	result, usecaseErr := seedCatalog.Execute(&dto.SeedCatalogInput{
+		Fixture:   fixture,
+		Reset:     *reset,
+		ActorRole: constants.RoleSystem,
+	})
+
+	if usecaseErr != nil {
+		log.Fatal(usecaseErr.Instance().Message)
+	}
+
+	log.Printf(
+		"profile %s seeded: %d specification groups, %d specifications, %d categories, %d products, %d specification values",
+		*profile, result.SpecificationGroups, result.Specifications, result.Categories, result.Products, result.SpecificationValues,
+	)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(result); err != nil {
+		log.Fatalf("can't write report. error: %v", err)
+	}
+}
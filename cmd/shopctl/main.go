@@ -0,0 +1,677 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"project/internal/application/dto"
+	"project/internal/domain/constants"
+	"project/internal/domain/types"
+	"project/internal/infra/config"
+	"project/internal/infra/config/environment"
+	"project/internal/infra/shopctl"
+	"project/internal/infra/sqlite"
+)
+
+const usage = `shopctl browses and manages the catalog, straight on the sqlite database or
+through a running API (-url).
+
+usage: shopctl [-env .env | -db catalog.db | -url http://host:port [-api-key KEY]] [-output table|json|markdown] command
+
+commands:
+  products list [-category ID] [-skip N] [-limit N]
+  products search [-category ID] [-skip N] [-limit N] TERM
+  products show PRODUCT
+  compare LEFT RIGHT
+  categories list
+  categories create -name NAME [-description TEXT]
+  categories update [-name NAME] [-description TEXT] CATEGORY
+  categories delete CATEGORY
+  categories restore CATEGORY
+  specs list -group GROUP
+  specs create -title TITLE -type string|int|bool -group GROUP
+  specs update [-title TITLE] [-type TYPE] [-group GROUP] [-convert] SPEC
+  specs delete SPEC
+  groups list
+  groups create -name NAME [-description TEXT]
+  groups update [-name NAME] [-description TEXT] GROUP
+  groups delete GROUP
+  keys list
+  keys create -name NAME [-role viewer|editor|catalog-admin]
+  keys revoke KEY
+
+update commands keep the current value of every flag left out. The writes through
+-url need an API key, from -api-key or the SHOPCTL_API_KEY env. On the database the
+writes run as the system, or with the role of the -api-key when there is one. The
+keys commands only run on the database and print a new key once.
+
+global flags:
+`
+
+// Command line client for the catalog. By default it runs the use cases against the
+// sqlite database of the .env file; -db points to another database file and -url
+// sends the same commands to a running API instead.
+//
+//	go run ./cmd/shopctl products search -limit 5 notebook
+//	go run ./cmd/shopctl -output markdown compare prd00001 prd00002
+//	go run ./cmd/shopctl -url http://localhost:8080 categories list
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+
+	envFile := flag.String("env", ".env", "environment file with the sqlite settings")
+	dbPath := flag.String("db", "", "sqlite database file, instead of the one in the environment file")
+	baseURL := flag.String("url", "", "base URL of a running API, instead of the sqlite database")
+	apiKey := flag.String("api-key", os.Getenv("SHOPCTL_API_KEY"), "API key of the writes, sent to the -url API or checked on the database")
+	output := flag.String("output", string(shopctl.FormatTable), "output format: table, json or markdown")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	format, err := shopctl.ParseFormat(*output)
+	if err != nil {
+		fail(err)
+	}
+
+	var client shopctl.Client
+
+	if *baseURL != "" {
+		client = shopctl.NewHTTP(*baseURL, *apiKey)
+	} else {
+		db := sqlite.NewSqliteInstance(sqliteConfig(*envFile, *dbPath))
+		defer db.DB.Close()
+
+		client, err = shopctl.NewLocal(db.DB, *apiKey)
+		if err != nil {
+			fail(err)
+		}
+	}
+
+	cli := &cli{
+		client:   client,
+		renderer: shopctl.NewRenderer(format, os.Stdout),
+	}
+
+	if err := cli.run(flag.Args()); err != nil {
+		fail(err)
+	}
+}
+
+func sqliteConfig(envFile string, dbPath string) *environment.Sqlite {
+	if dbPath == "" {
+		return config.NewBaseConfig(envFile).Sqlite
+	}
+
+	os.Setenv("SQLITE_PATH", dbPath)
+
+	if os.Getenv("SQLITE_BUSY_TIMEOUT") == "" {
+		os.Setenv("SQLITE_BUSY_TIMEOUT", "5000")
+	}
+
+	return environment.NewSqliteConfig()
+}
+
+func fail(err error) {
+	var clientErr *shopctl.Error
+
+	if errors.As(err, &clientErr) && clientErr.StatusCode > 0 {
+		fmt.Fprintf(os.Stderr, "shopctl: %s (%d)\n", clientErr.Message, clientErr.StatusCode)
+	} else {
+		fmt.Fprintf(os.Stderr, "shopctl: %v\n", err)
+	}
+
+	os.Exit(1)
+}
+
+type cli struct {
+	client   shopctl.Client
+	renderer *shopctl.Renderer
+}
+
+func (c *cli) run(args []string) error {
+	command, args := args[0], args[1:]
+
+	if command == "compare" {
+		return c.compare(args)
+	}
+
+	if len(args) == 0 {
+		return fmt.Errorf("missing subcommand for %s, run shopctl -h for the usage", command)
+	}
+
+	subcommand, args := args[0], args[1:]
+
+	switch command + " " + subcommand {
+	case "products list":
+		return c.listProducts(args, false)
+	case "products search":
+		return c.listProducts(args, true)
+	case "products show":
+		return c.showProduct(args)
+	case "categories list":
+		return c.listCategories(args)
+	case "categories create":
+		return c.createCategory(args)
+	case "categories update":
+		return c.updateCategory(args)
+	case "categories delete":
+		return c.deleteCategory(args)
+	case "categories restore":
+		return c.restoreCategory(args)
+	case "specs list":
+		return c.listSpecifications(args)
+	case "specs create":
+		return c.createSpecification(args)
+	case "specs update":
+		return c.updateSpecification(args)
+	case "specs delete":
+		return c.deleteSpecification(args)
+	case "groups list":
+		return c.listSpecificationGroups(args)
+	case "groups create":
+		return c.createSpecificationGroup(args)
+	case "groups update":
+		return c.updateSpecificationGroup(args)
+	case "groups delete":
+		return c.deleteSpecificationGroup(args)
+	case "keys list":
+		return c.listApiKeys(args)
+	case "keys create":
+		return c.createApiKey(args)
+	case "keys revoke":
+		return c.revokeApiKey(args)
+	}
+
+	return fmt.Errorf("unknown command %q, run shopctl -h for the usage", command+" "+subcommand)
+}
+
+// parse parses the flags of a subcommand and checks the number of positional arguments.
+func parse(name string, set *flag.FlagSet, args []string, positional ...string) ([]string, error) {
+	set.Init(name, flag.ExitOnError)
+	set.Parse(args)
+
+	if set.NArg() != len(positional) {
+		if len(positional) == 0 {
+			return nil, fmt.Errorf("%s takes no arguments", name)
+		}
+
+		return nil, fmt.Errorf("usage: shopctl %s [flags] %v", name, positional)
+	}
+
+	return set.Args(), nil
+}
+
+// visited reports which flags were set on the command line.
+func visited(set *flag.FlagSet) map[string]bool {
+	flags := map[string]bool{}
+
+	set.Visit(func(f *flag.Flag) {
+		flags[f.Name] = true
+	})
+
+	return flags
+}
+
+func (c *cli) listProducts(args []string, search bool) error {
+	name := "products list"
+	positional := []string{}
+
+	if search {
+		name = "products search"
+		positional = []string{"TERM"}
+	}
+
+	set := &flag.FlagSet{}
+	category := set.String("category", "", "only products of this category public id")
+	skip := set.Int64("skip", 0, "products to skip")
+	limit := set.Int64("limit", 20, "products to list")
+
+	args, err := parse(name, set, args, positional...)
+	if err != nil {
+		return err
+	}
+
+	paginator := &dto.PaginatorInput{
+		Skip:  *skip,
+		Limit: *limit,
+	}
+
+	if search {
+		paginator.Search = args[0]
+	}
+
+	if *category != "" {
+		output, err := c.client.ListProductsByCategory(&dto.GetAllProductsByCategoryIdInput{
+			PaginatorInput:   paginator,
+			CategoryPublicID: types.CategoryPublicID(*category),
+		})
+		if err != nil {
+			return err
+		}
+
+		return c.renderer.ProductsByCategory(output)
+	}
+
+	output, err := c.client.ListProducts(&dto.GetAllProductsInput{PaginatorInput: paginator})
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Products(output)
+}
+
+func (c *cli) showProduct(args []string) error {
+	args, err := parse("products show", &flag.FlagSet{}, args, "PRODUCT")
+	if err != nil {
+		return err
+	}
+
+	publicId := types.ProductPublicID(args[0])
+
+	output, err := c.client.GetProductSpecifications(publicId)
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.ProductSpecifications(publicId, output)
+}
+
+func (c *cli) compare(args []string) error {
+	args, err := parse("compare", &flag.FlagSet{}, args, "LEFT", "RIGHT")
+	if err != nil {
+		return err
+	}
+
+	output, err := c.client.CompareProducts(&dto.CompareProductsInput{
+		LeftPublicID:  types.ProductPublicID(args[0]),
+		RightPublicID: types.ProductPublicID(args[1]),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Compare(output)
+}
+
+func (c *cli) listCategories(args []string) error {
+	if _, err := parse("categories list", &flag.FlagSet{}, args); err != nil {
+		return err
+	}
+
+	output, err := c.client.ListCategories()
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Categories(output)
+}
+
+func (c *cli) createCategory(args []string) error {
+	set := &flag.FlagSet{}
+	name := set.String("name", "", "category name")
+	description := set.String("description", "", "category description")
+
+	if _, err := parse("categories create", set, args); err != nil {
+		return err
+	}
+
+	output, err := c.client.CreateCategory(&dto.CreateOneCategoryInput{
+		Name:        *name,
+		Description: *description,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, string(output.PublicID))
+}
+
+func (c *cli) updateCategory(args []string) error {
+	set := &flag.FlagSet{}
+	name := set.String("name", "", "category name")
+	description := set.String("description", "", "category description")
+
+	args, err := parse("categories update", set, args, "CATEGORY")
+	if err != nil {
+		return err
+	}
+
+	input := &dto.UpdateOneCategoryInput{
+		PublicID:    types.CategoryPublicID(args[0]),
+		Name:        *name,
+		Description: *description,
+	}
+
+	// the use case replaces every field, so the ones left out keep their value
+	if flags := visited(set); !flags["name"] || !flags["description"] {
+		categories, err := c.client.ListCategories()
+		if err != nil {
+			return err
+		}
+
+		for _, category := range categories.Categories {
+			if category.PublicID != input.PublicID {
+				continue
+			}
+
+			if !flags["name"] {
+				input.Name = category.Name
+			}
+
+			if !flags["description"] {
+				input.Description = category.Description
+			}
+		}
+	}
+
+	output, err := c.client.UpdateCategory(input)
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, output.Message)
+}
+
+func (c *cli) deleteCategory(args []string) error {
+	args, err := parse("categories delete", &flag.FlagSet{}, args, "CATEGORY")
+	if err != nil {
+		return err
+	}
+
+	output, err := c.client.DeleteCategory(types.CategoryPublicID(args[0]))
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, output.Message)
+}
+
+func (c *cli) restoreCategory(args []string) error {
+	args, err := parse("categories restore", &flag.FlagSet{}, args, "CATEGORY")
+	if err != nil {
+		return err
+	}
+
+	output, err := c.client.RestoreCategory(types.CategoryPublicID(args[0]))
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, output.Message)
+}
+
+func (c *cli) listSpecifications(args []string) error {
+	set := &flag.FlagSet{}
+	group := set.String("group", "", "specification group public id")
+
+	if _, err := parse("specs list", set, args); err != nil {
+		return err
+	}
+
+	if *group == "" {
+		return fmt.Errorf("specs list needs -group, run shopctl groups list to see them")
+	}
+
+	output, err := c.client.ListSpecifications(&dto.GetAllSpecificationsInput{
+		SpecificationGroupPublicID: types.SpecificationGroupPublicID(*group),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Specifications(output)
+}
+
+func (c *cli) createSpecification(args []string) error {
+	set := &flag.FlagSet{}
+	title := set.String("title", "", "specification title")
+	specType := set.String("type", "", "value type: string, int or bool")
+	group := set.String("group", "", "specification group public id")
+
+	if _, err := parse("specs create", set, args); err != nil {
+		return err
+	}
+
+	output, err := c.client.CreateSpecification(&dto.CreateOneSpecificationInput{
+		Title:                      *title,
+		Type:                       types.SpecificationType(*specType),
+		SpecificationGroupPublicID: types.SpecificationGroupPublicID(*group),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, string(output.PublicID))
+}
+
+func (c *cli) updateSpecification(args []string) error {
+	set := &flag.FlagSet{}
+	title := set.String("title", "", "specification title")
+	specType := set.String("type", "", "value type: string, int or bool")
+	group := set.String("group", "", "specification group public id")
+	convert := set.Bool("convert", false, "convert the product values when the type changes")
+
+	args, err := parse("specs update", set, args, "SPEC")
+	if err != nil {
+		return err
+	}
+
+	input := &dto.UpdateOneSpecificationInput{
+		PublicID:                   types.SpecificationPublicID(args[0]),
+		Title:                      *title,
+		Type:                       types.SpecificationType(*specType),
+		SpecificationGroupPublicID: types.SpecificationGroupPublicID(*group),
+		ConvertValues:              *convert,
+	}
+
+	if flags := visited(set); !flags["title"] || !flags["type"] || !flags["group"] {
+		current, currentGroup, err := c.findSpecification(input.PublicID)
+		if err != nil {
+			return err
+		}
+
+		if current != nil {
+			if !flags["title"] {
+				input.Title = current.Title
+			}
+
+			if !flags["type"] {
+				input.Type = current.Type
+			}
+
+			if !flags["group"] {
+				input.SpecificationGroupPublicID = currentGroup
+			}
+		}
+	}
+
+	output, err := c.client.UpdateSpecification(input)
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, output.Message)
+}
+
+// findSpecification looks up a specification and its group, since the listing
+// only tells the group when filtered by it.
+func (c *cli) findSpecification(publicId types.SpecificationPublicID) (*dto.SpecificationOutput, types.SpecificationGroupPublicID, error) {
+	groups, err := c.client.ListSpecificationGroups()
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, group := range groups.Groups {
+		specifications, err := c.client.ListSpecifications(&dto.GetAllSpecificationsInput{
+			SpecificationGroupPublicID: group.PublicID,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, spec := range specifications.Specifications {
+			if spec.PublicID == publicId {
+				return spec, group.PublicID, nil
+			}
+		}
+	}
+
+	return nil, "", nil
+}
+
+func (c *cli) deleteSpecification(args []string) error {
+	args, err := parse("specs delete", &flag.FlagSet{}, args, "SPEC")
+	if err != nil {
+		return err
+	}
+
+	output, err := c.client.DeleteSpecification(types.SpecificationPublicID(args[0]))
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, output.Message)
+}
+
+func (c *cli) listSpecificationGroups(args []string) error {
+	if _, err := parse("groups list", &flag.FlagSet{}, args); err != nil {
+		return err
+	}
+
+	output, err := c.client.ListSpecificationGroups()
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.SpecificationGroups(output)
+}
+
+func (c *cli) createSpecificationGroup(args []string) error {
+	set := &flag.FlagSet{}
+	name := set.String("name", "", "group name")
+	description := set.String("description", "", "group description")
+
+	if _, err := parse("groups create", set, args); err != nil {
+		return err
+	}
+
+	output, err := c.client.CreateSpecificationGroup(&dto.CreateOneSpecificationGroupInput{
+		Name:        *name,
+		Description: *description,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, string(output.PublicID))
+}
+
+func (c *cli) updateSpecificationGroup(args []string) error {
+	set := &flag.FlagSet{}
+	name := set.String("name", "", "group name")
+	description := set.String("description", "", "group description")
+
+	args, err := parse("groups update", set, args, "GROUP")
+	if err != nil {
+		return err
+	}
+
+	input := &dto.UpdateOneSpecificationGroupInput{
+		PublicID:    types.SpecificationGroupPublicID(args[0]),
+		Name:        *name,
+		Description: *description,
+	}
+
+	if flags := visited(set); !flags["name"] || !flags["description"] {
+		groups, err := c.client.ListSpecificationGroups()
+		if err != nil {
+			return err
+		}
+
+		for _, group := range groups.Groups {
+			if group.PublicID != input.PublicID {
+				continue
+			}
+
+			if !flags["name"] {
+				input.Name = group.Name
+			}
+
+			if !flags["description"] {
+				input.Description = group.Description
+			}
+		}
+	}
+
+	output, err := c.client.UpdateSpecificationGroup(input)
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, output.Message)
+}
+
+func (c *cli) deleteSpecificationGroup(args []string) error {
+	args, err := parse("groups delete", &flag.FlagSet{}, args, "GROUP")
+	if err != nil {
+		return err
+	}
+
+	output, err := c.client.DeleteSpecificationGroup(types.SpecificationGroupPublicID(args[0]))
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, output.Message)
+}
+
+func (c *cli) listApiKeys(args []string) error {
+	if _, err := parse("keys list", &flag.FlagSet{}, args); err != nil {
+		return err
+	}
+
+	output, err := c.client.ListApiKeys()
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.ApiKeys(output)
+}
+
+// createApiKey prints the key alone, it can't be shown again.
+func (c *cli) createApiKey(args []string) error {
+	set := &flag.FlagSet{}
+	name := set.String("name", "", "who will use the key, recorded as the actor of its writes")
+	role := set.String("role", string(constants.RoleEditor), "role of the key: viewer, editor or catalog-admin")
+
+	if _, err := parse("keys create", set, args); err != nil {
+		return err
+	}
+
+	output, err := c.client.CreateApiKey(&dto.CreateOneApiKeyInput{Name: *name, Role: types.Role(*role)})
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, output.Key)
+}
+
+func (c *cli) revokeApiKey(args []string) error {
+	args, err := parse("keys revoke", &flag.FlagSet{}, args, "KEY")
+	if err != nil {
+		return err
+	}
+
+	output, err := c.client.RevokeApiKey(types.ApiKeyPublicID(args[0]))
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Result(output, output.Message)
+}
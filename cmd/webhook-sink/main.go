@@ -0,0 +1,77 @@
+package main
+
+import (
+	"crypto/hmac"
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"project/internal/infra/webhook"
+	"strings"
+	"sync/atomic"
+)
+
+// Stands in for the webhook receiver during development: logs every request and
+// answers 204, or 503 to the first -fail requests so the retries can be seen. With
+// -secret the signature of the outbox events is checked, a bad one answers 401.
+//
+//	go run ./cmd/webhook-sink -addr localhost:9090
+//	WEBHOOK_URL=http://localhost:9090/alerts go run ./cmd/api
+func main() {
+	addr := flag.String("addr", "localhost:9090", "address to listen on")
+	fail := flag.Int64("fail", 0, "answer 503 to this many requests before succeeding")
+	secret := flag.String("secret", "", "webhook subscription secret to check the signatures with")
+	flag.Parse()
+
+	var received atomic.Int64
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		count := received.Add(1)
+
+		if *secret != "" && !validSignature(r.Header.Get(webhook.SignatureHeader), *secret, body) {
+			log.Printf("#%d %s %s -> 401 (bad signature)", count, r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if count <= *fail {
+			log.Printf("#%d %s %s -> 503 (%d bytes)", count, r.Method, r.URL.Path, len(body))
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		log.Printf("#%d %s %s event %s -> 204\n%s", count, r.Method, r.URL.Path, r.Header.Get(webhook.EventIDHeader), body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	log.Printf("webhook sink listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
+
+// validSignature checks a "t=<unix time>,v1=<signature>" header against the body.
+func validSignature(header string, secret string, body []byte) bool {
+	var timestamp, signature string
+
+	for part := range strings.SplitSeq(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signature = value
+		}
+	}
+
+	if timestamp == "" || signature == "" {
+		return false
+	}
+
+	return hmac.Equal([]byte(signature), []byte(webhook.Sign(secret, timestamp, body)))
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+type AuditChangeOutput struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+type AuditEntryOutput struct {
+	Actor          types.Actor           `json:"actor"`
+	EntityType     types.AuditEntityType `json:"entity_type"`
+	EntityPublicID string                `json:"entity_public_id"`
+	Operation      types.AuditOperation  `json:"operation"`
+	Changes        []*AuditChangeOutput  `json:"changes"`
+	CreatedAt      time.Time             `json:"created_at"`
+}
+
+type GetAllAuditEntriesInput struct {
+	PaginatorInput *PaginatorInput       `mapstructure:"pagination"`
+	Actor          types.Actor           `mapstructure:"actor"`
+	EntityType     types.AuditEntityType `mapstructure:"entity_type"`
+	EntityPublicID string                `mapstructure:"entity_public_id"`
+	Operation      types.AuditOperation  `mapstructure:"operation"`
+	From           string                `mapstructure:"from"`
+	To             string                `mapstructure:"to"`
+	ActorRole      types.Role            `json:"-" mapstructure:"-"`
+}
+
+type GetAllAuditEntriesOutput struct {
+	PaginatorOutput *PaginatorOutput    `json:"paginator"`
+	Entries         []*AuditEntryOutput `json:"entries"`
+}
+
+type GetProductHistoryInput struct {
+	PaginatorInput *PaginatorInput       `mapstructure:"pagination"`
+	PublicID       types.ProductPublicID `mapstructure:"public_id"`
+}
+
+type GetProductHistoryOutput struct {
+	PaginatorOutput *PaginatorOutput    `json:"paginator"`
+	Entries         []*AuditEntryOutput `json:"entries"`
+}
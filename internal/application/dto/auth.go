@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+// AuthenticateInput takes the value of the Authorization header, an API key or a
+// JWT, with or without the "Bearer " scheme.
+type AuthenticateInput struct {
+	Credential string
+}
+
+type PrincipalOutput struct {
+	Subject        types.Actor          `json:"subject"`
+	Method         types.AuthMethod     `json:"method"`
+	Role           types.Role           `json:"role"`
+	ApiKeyPublicID types.ApiKeyPublicID `json:"api_key_public_id,omitempty"`
+}
+
+type ApiKeyOutput struct {
+	PublicID   types.ApiKeyPublicID `json:"public_id"`
+	Name       string               `json:"name"`
+	Role       types.Role           `json:"role"`
+	CreatedAt  time.Time            `json:"created_at"`
+	LastUsedAt *time.Time           `json:"last_used_at"`
+	RevokedAt  *time.Time           `json:"revoked_at"`
+}
+
+type CreateOneApiKeyInput struct {
+	Name      string     `json:"name" mapstructure:"name"`
+	Role      types.Role `json:"role" mapstructure:"role"`
+	ActorRole types.Role `json:"-" mapstructure:"-"`
+}
+
+// CreateOneApiKeyOutput is the only time the key is shown, only its hash is kept.
+type CreateOneApiKeyOutput struct {
+	PublicID types.ApiKeyPublicID `json:"public_id"`
+	Name     string               `json:"name"`
+	Key      string               `json:"key"`
+}
+
+type GetAllApiKeysInput struct {
+	ActorRole types.Role `json:"-" mapstructure:"-"`
+}
+
+type GetAllApiKeysOutput struct {
+	ApiKeys []*ApiKeyOutput `json:"api_keys"`
+}
+
+type RevokeOneApiKeyInput struct {
+	PublicID  types.ApiKeyPublicID `mapstructure:"public_id"`
+	ActorRole types.Role           `json:"-" mapstructure:"-"`
+}
+
+type RevokeOneApiKeyOutput struct {
+	Revoked bool   `json:"revoked"`
+	Message string `json:"message"`
+}
+
+// PermissionDeniedOutput is the data of a 403, what the role of the caller lacked
+// for the operation.
+type PermissionDeniedOutput struct {
+	Operation  string           `json:"operation"`
+	Permission types.Permission `json:"permission"`
+	Role       types.Role       `json:"role"`
+}
@@ -11,3 +11,49 @@ type CategoryOutput struct {
 	Name        string                 `json:"name"`
 	Description string                 `json:"description"`
 }
+
+type CreateOneCategoryInput struct {
+	Name        string      `json:"name" mapstructure:"name"`
+	Description string      `json:"description" mapstructure:"description"`
+	Actor       types.Actor `json:"-" mapstructure:"-"`
+	ActorRole   types.Role  `json:"-" mapstructure:"-"`
+}
+
+type CreateOneCategoryOutput struct {
+	PublicID types.CategoryPublicID `json:"public_id"`
+}
+
+type UpdateOneCategoryInput struct {
+	PublicID    types.CategoryPublicID `mapstructure:"public_id"`
+	Name        string                 `json:"name" mapstructure:"name"`
+	Description string                 `json:"description" mapstructure:"description"`
+	Actor       types.Actor            `json:"-" mapstructure:"-"`
+	ActorRole   types.Role             `json:"-" mapstructure:"-"`
+}
+
+type UpdateOneCategoryOutput struct {
+	Updated bool   `json:"updated"`
+	Message string `json:"message"`
+}
+
+type DeleteOneCategoryInput struct {
+	PublicID  types.CategoryPublicID `mapstructure:"public_id"`
+	Actor     types.Actor            `json:"-" mapstructure:"-"`
+	ActorRole types.Role             `json:"-" mapstructure:"-"`
+}
+
+type DeleteOneCategoryOutput struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message"`
+}
+
+type RestoreOneCategoryInput struct {
+	PublicID  types.CategoryPublicID `mapstructure:"public_id"`
+	Actor     types.Actor            `json:"-" mapstructure:"-"`
+	ActorRole types.Role             `json:"-" mapstructure:"-"`
+}
+
+type RestoreOneCategoryOutput struct {
+	Restored bool   `json:"restored"`
+	Message  string `json:"message"`
+}
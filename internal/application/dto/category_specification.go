@@ -0,0 +1,63 @@
+package dto
+
+import "project/internal/domain/types"
+
+type GetCategorySpecificationTemplateInput struct {
+	CategoryPublicID types.CategoryPublicID `mapstructure:"public_id"`
+}
+
+type GetCategorySpecificationTemplateOutput struct {
+	CategoryPublicID types.CategoryPublicID         `json:"category_public_id"`
+	Specifications   []*CategorySpecificationOutput `json:"specifications"`
+}
+
+type CategorySpecificationOutput struct {
+	SpecificationPublicID types.SpecificationPublicID    `json:"specification_public_id"`
+	Title                 string                         `json:"title"`
+	Type                  types.SpecificationType        `json:"type"`
+	Requirement           types.SpecificationRequirement `json:"requirement"`
+	DisplayOrder          int64                          `json:"display_order"`
+}
+
+type ReplaceCategorySpecificationTemplateInput struct {
+	CategoryPublicID types.CategoryPublicID        `mapstructure:"public_id"`
+	Specifications   []*CategorySpecificationInput `json:"specifications" mapstructure:"specifications"`
+	Actor            types.Actor                   `json:"-" mapstructure:"-"`
+	ActorRole        types.Role                    `json:"-" mapstructure:"-"`
+}
+
+type CategorySpecificationInput struct {
+	SpecificationPublicID types.SpecificationPublicID    `json:"specification_public_id" mapstructure:"specification_public_id"`
+	Requirement           types.SpecificationRequirement `json:"requirement" mapstructure:"requirement"`
+	DisplayOrder          int64                          `json:"display_order" mapstructure:"display_order"`
+}
+
+type ReplaceCategorySpecificationTemplateOutput struct {
+	Replaced bool                          `json:"replaced"`
+	Total    int                           `json:"total"`
+	Errors   []*CategorySpecificationError `json:"errors,omitempty"`
+	Message  string                        `json:"message"`
+}
+
+type CategorySpecificationError struct {
+	Index                 int                         `json:"index"`
+	SpecificationPublicID types.SpecificationPublicID `json:"specification_public_id"`
+	Message               string                      `json:"message"`
+}
+
+type GetProductCompletenessInput struct {
+	PublicID types.ProductPublicID `mapstructure:"public_id"`
+}
+
+type GetProductCompletenessOutput struct {
+	ProductPublicID    types.ProductPublicID          `json:"product_public_id"`
+	HasTemplate        bool                           `json:"has_template"`
+	Complete           bool                           `json:"complete"`
+	Published          bool                           `json:"published"`
+	RequiredTotal      int                            `json:"required_total"`
+	RequiredFilled     int                            `json:"required_filled"`
+	RecommendedTotal   int                            `json:"recommended_total"`
+	RecommendedFilled  int                            `json:"recommended_filled"`
+	MissingRequired    []*CategorySpecificationOutput `json:"missing_required"`
+	MissingRecommended []*CategorySpecificationOutput `json:"missing_recommended"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "project/internal/domain/types"
+
+// FakeStoreProduct is a product in the fakestoreapi.com format.
+type FakeStoreProduct struct {
+	ID          int64                  `json:"id"`
+	Title       string                 `json:"title"`
+	Price       float64                `json:"price"`
+	Description string                 `json:"description"`
+	Category    string                 `json:"category"`
+	Image       string                 `json:"image"`
+	Rating      FakeStoreProductRating `json:"rating"`
+}
+
+type FakeStoreProductRating struct {
+	Rate  float64 `json:"rate"`
+	Count int64   `json:"count"`
+}
+
+type ImportFakeStoreProductsInput struct {
+	Products  []*FakeStoreProduct
+	Actor     types.Actor `json:"-" mapstructure:"-"`
+	ActorRole types.Role  `json:"-" mapstructure:"-"`
+}
+
+type ImportedFakeStoreProduct struct {
+	ExternalID string                `json:"external_id"`
+	PublicID   types.ProductPublicID `json:"public_id"`
+	Name       types.ProductName     `json:"name"`
+	Status     string                `json:"status"`
+}
+
+type ImportFakeStoreProductsOutput struct {
+	Total             int                         `json:"total"`
+	Created           int                         `json:"created"`
+	Updated           int                         `json:"updated"`
+	Unchanged         int                         `json:"unchanged"`
+	Failed            int                         `json:"failed"`
+	CategoriesCreated int                         `json:"categories_created"`
+	Products          []*ImportedFakeStoreProduct `json:"products"`
+	Errors            []*ImportProductError       `json:"errors"`
+}
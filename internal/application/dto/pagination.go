@@ -1,8 +1,9 @@
 package dto
 
 type PaginatorInput struct {
-	Skip  int64 `mapstructure:"skip"`
-	Limit int64 `mapstructure:"limit"`
+	Skip   int64  `mapstructure:"skip"`
+	Limit  int64  `mapstructure:"limit"`
+	Search string `mapstructure:"search"`
 }
 
 type PaginatorOutput struct {
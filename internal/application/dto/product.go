@@ -8,24 +8,40 @@ type CompareProductsInput struct {
 }
 
 type CompareProductsOutput struct {
+	Left           *ComparedProductOutput            `json:"left"`
+	Right          *ComparedProductOutput            `json:"right"`
 	Price          *PriceComparisonOutput            `json:"price"`
 	Rating         *RatingComparisonOutput           `json:"rating"`
 	Specifications []*SpecificationsComparisonOutput `json:"specifications"`
 }
 
+type ComparedProductOutput struct {
+	PublicID     types.ProductPublicID `json:"public_id"`
+	Name         types.ProductName     `json:"name"`
+	VariantLabel string                `json:"variant_label,omitempty"`
+}
+
+// PriceComparisonOutput compares the best offers, LeftOffer and RightOffer are
+// omitted when the catalog price was used.
 type PriceComparisonOutput struct {
-	Left     int64            `json:"left"`
-	Right    int64            `json:"right"`
-	Insights []*InsightOutput `json:"insights"`
+	Left       int64               `json:"left"`
+	Right      int64               `json:"right"`
+	LeftOffer  *ProductOfferOutput `json:"left_offer,omitempty"`
+	RightOffer *ProductOfferOutput `json:"right_offer,omitempty"`
+	Insights   []*InsightOutput    `json:"insights"`
 }
 
 type RatingComparisonOutput struct {
-	Left     int8             `json:"left"`
-	Right    int8             `json:"right"`
-	Insights []*InsightOutput `json:"insights"`
+	Left             int8             `json:"left"`
+	Right            int8             `json:"right"`
+	LeftReviewCount  int64            `json:"left_review_count"`
+	RightReviewCount int64            `json:"right_review_count"`
+	Insights         []*InsightOutput `json:"insights"`
 }
 
 type SpecificationsComparisonOutput struct {
+	PublicID types.SpecificationPublicID    `json:"public_id"`
+	Title    string                         `json:"name"`
 	Left     *SpecificationComparisonOutput `json:"left"`
 	Right    *SpecificationComparisonOutput `json:"right"`
 	Type     types.SpecificationType        `json:"type"`
@@ -45,7 +61,10 @@ type InsightOutput struct {
 }
 
 type DeleteOneProductInput struct {
-	PublicID types.ProductPublicID `mapstructure:"public_id"`
+	PublicID  types.ProductPublicID `mapstructure:"public_id"`
+	Version   int64                 `mapstructure:"version"`
+	Actor     types.Actor           `json:"-" mapstructure:"-"`
+	ActorRole types.Role            `json:"-" mapstructure:"-"`
 }
 
 type DeleteOneProductOutput struct {
@@ -53,6 +72,30 @@ type DeleteOneProductOutput struct {
 	Message string `json:"message"`
 }
 
+type RestoreOneProductInput struct {
+	PublicID  types.ProductPublicID `mapstructure:"public_id"`
+	Actor     types.Actor           `json:"-" mapstructure:"-"`
+	ActorRole types.Role            `json:"-" mapstructure:"-"`
+}
+
+type RestoreOneProductOutput struct {
+	Restored bool   `json:"restored"`
+	Message  string `json:"message"`
+}
+
+type PublishOneProductInput struct {
+	PublicID  types.ProductPublicID `mapstructure:"public_id"`
+	Version   int64                 `mapstructure:"version"`
+	Actor     types.Actor           `json:"-" mapstructure:"-"`
+	ActorRole types.Role            `json:"-" mapstructure:"-"`
+}
+
+type PublishOneProductOutput struct {
+	Published       bool                           `json:"published"`
+	MissingRequired []*CategorySpecificationOutput `json:"missing_required,omitempty"`
+	Message         string                         `json:"message"`
+}
+
 type UpdateOneProductInput struct {
 	PublicID         types.ProductPublicID  `mapstructure:"public_id"`
 	Name             types.ProductName      `json:"name" mapstructure:"name"`
@@ -61,11 +104,16 @@ type UpdateOneProductInput struct {
 	ImageURL         string                 `json:"image_url" mapstructure:"image_url"`
 	Rating           int8                   `json:"rating" mapstructure:"rating"`
 	CategoryPublicID types.CategoryPublicID `json:"category_public_id" mapstructure:"category_public_id"`
+	VariantLabel     string                 `json:"variant_label" mapstructure:"variant_label"`
+	Version          int64                  `json:"version" mapstructure:"version"`
+	Actor            types.Actor            `json:"-" mapstructure:"-"`
+	ActorRole        types.Role             `json:"-" mapstructure:"-"`
 }
 
 type UpdateOneProductOutput struct {
 	Updated bool   `json:"updated"`
 	Message string `json:"message"`
+	Version int64  `json:"version"`
 }
 
 type CreateOneProductInput struct {
@@ -74,6 +122,8 @@ type CreateOneProductInput struct {
 	Price            int64                  `json:"price" mapstructure:"price"`
 	ImageURL         string                 `json:"image_url" mapstructure:"image_url"`
 	CategoryPublicID types.CategoryPublicID `json:"category_public_id" mapstructure:"category_public_id"`
+	Actor            types.Actor            `json:"-" mapstructure:"-"`
+	ActorRole        types.Role             `json:"-" mapstructure:"-"`
 }
 
 type CreateOneProductOutput struct {
@@ -81,7 +131,7 @@ type CreateOneProductOutput struct {
 }
 
 type GetAllProductsByCategoryIdInput struct {
-	PaginatorInput   *PaginatorInput        `mapstructure:"paginator"`
+	PaginatorInput   *PaginatorInput        `mapstructure:"pagination"`
 	CategoryPublicID types.CategoryPublicID `mapstructure:"category_public_id"`
 }
 
@@ -91,16 +141,20 @@ type GetAllProductsByCategoryIdOutput struct {
 }
 
 type GetAllProductsByCategoryIdUnit struct {
-	PublicID    types.ProductPublicID `json:"public_id"`
-	Price       int64                 `json:"price"`
-	Rating      int8                  `json:"rating"`
-	ImageURL    string                `json:"image_url"`
-	Name        types.ProductName     `json:"name"`
-	Description string                `json:"description"`
+	PublicID    types.ProductPublicID   `json:"public_id"`
+	Price       int64                   `json:"price"`
+	Rating      int8                    `json:"rating"`
+	ReviewCount int64                   `json:"review_count"`
+	ImageURL    string                  `json:"image_url"`
+	Name        types.ProductName       `json:"name"`
+	Description string                  `json:"description"`
+	BestOffer   *ProductOfferOutput     `json:"best_offer"`
+	PriceSpread int64                   `json:"price_spread"`
+	Variants    []*ProductVariantOutput `json:"variants"`
 }
 
 type GetAllProductsInput struct {
-	PaginatorInput *PaginatorInput `mapstructure:"paginator"`
+	PaginatorInput *PaginatorInput `mapstructure:"pagination"`
 }
 
 type GetAllProductsOutput struct {
@@ -109,12 +163,16 @@ type GetAllProductsOutput struct {
 }
 
 type GetAllProductsUnit struct {
-	PublicID    types.ProductPublicID `json:"public_id"`
-	Price       int64                 `json:"price"`
-	Rating      int8                  `json:"rating"`
-	ImageURL    string                `json:"image_url"`
-	Name        types.ProductName     `json:"name"`
-	Description string                `json:"description"`
+	PublicID    types.ProductPublicID   `json:"public_id"`
+	Price       int64                   `json:"price"`
+	Rating      int8                    `json:"rating"`
+	ReviewCount int64                   `json:"review_count"`
+	ImageURL    string                  `json:"image_url"`
+	Name        types.ProductName       `json:"name"`
+	Description string                  `json:"description"`
+	BestOffer   *ProductOfferOutput     `json:"best_offer"`
+	PriceSpread int64                   `json:"price_spread"`
+	Variants    []*ProductVariantOutput `json:"variants"`
 }
 
 type GetOneProductByPublicIdInput struct {
@@ -122,11 +180,18 @@ type GetOneProductByPublicIdInput struct {
 }
 
 type GetOneProductByPublicIdOutput struct {
-	Price       int64             `json:"price"`
-	Rating      int8              `json:"rating"`
-	ImageURL    string            `json:"image_url"`
-	Name        types.ProductName `json:"name"`
-	Description string            `json:"description"`
+	Price          int64                 `json:"price"`
+	Rating         int8                  `json:"rating"`
+	ReviewCount    int64                 `json:"review_count"`
+	ImageURL       string                `json:"image_url"`
+	Name           types.ProductName     `json:"name"`
+	Description    string                `json:"description"`
+	ParentPublicID types.ProductPublicID `json:"parent_public_id,omitempty"`
+	VariantLabel   string                `json:"variant_label,omitempty"`
+	BestOffer      *ProductOfferOutput   `json:"best_offer"`
+	PriceSpread    int64                 `json:"price_spread"`
+	Offers         []*ProductOfferOutput `json:"offers"`
+	Version        int64                 `json:"version"`
 }
 
 type GetOneProductWithSpecificationsByPublicIdInput struct {
@@ -136,10 +201,17 @@ type GetOneProductWithSpecificationsByPublicIdInput struct {
 type GetOneProductWithSpecificationsByPublicIdOutput struct {
 	Price                int64                              `json:"price"`
 	Rating               int8                               `json:"rating"`
+	ReviewCount          int64                              `json:"review_count"`
 	ImageURL             string                             `json:"image_url"`
 	Name                 types.ProductName                  `json:"name"`
 	Description          string                             `json:"description"`
 	SpecificationsGroups []*ProductSpecificationGroupOutput `json:"specifications_groups"`
+	ParentPublicID       types.ProductPublicID              `json:"parent_public_id,omitempty"`
+	VariantLabel         string                             `json:"variant_label,omitempty"`
+	Variants             []*ProductVariantOutput            `json:"variants,omitempty"`
+	BestOffer            *ProductOfferOutput                `json:"best_offer"`
+	PriceSpread          int64                              `json:"price_spread"`
+	Offers               []*ProductOfferOutput              `json:"offers"`
 }
 
 type ProductSpecificationGroupOutput struct {
@@ -156,4 +228,6 @@ type ProductSpecificationOutput struct {
 	StringValue string                      `json:"string_value"`
 	IntValue    int64                       `json:"int_value"`
 	BoolValue   bool                        `json:"bool_value"`
+	Inherited   bool                        `json:"inherited"`
+	Version     int64                       `json:"version"`
 }
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"iter"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/types"
+)
+
+type ExportProductsInput struct {
+	PaginatorInput   *PaginatorInput        `mapstructure:"pagination"`
+	CategoryPublicID types.CategoryPublicID `mapstructure:"category_public_id"`
+	Format           string                 `mapstructure:"format"`
+}
+
+// ExportSpecificationColumn is one specification column of the export. Key is the
+// specification title, or its public ID when the title is shared by more than one
+// specification, so exported files can be imported back.
+type ExportSpecificationColumn struct {
+	Key      string                      `json:"key"`
+	PublicID types.SpecificationPublicID `json:"public_id"`
+	Title    string                      `json:"title"`
+	Type     types.SpecificationType     `json:"type"`
+}
+
+type ExportProductUnit struct {
+	PublicID         types.ProductPublicID  `json:"public_id"`
+	Name             types.ProductName      `json:"name"`
+	Description      string                 `json:"description"`
+	Price            int64                  `json:"price"`
+	Rating           int8                   `json:"rating"`
+	ImageURL         string                 `json:"image_url"`
+	Category         string                 `json:"category"`
+	CategoryPublicID types.CategoryPublicID `json:"category_public_id"`
+	Specifications   map[string]any         `json:"specifications"`
+}
+
+// ExportProductsOutput holds the columns up front and the products as a lazy sequence,
+// read in batches while it is consumed so the catalog is never fully in memory.
+type ExportProductsOutput struct {
+	Columns  []*ExportSpecificationColumn
+	Products iter.Seq2[*ExportProductUnit, exceptions.UsecaseException]
+}
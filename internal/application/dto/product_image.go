@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"mime/multipart"
+	"project/internal/domain/types"
+)
+
+type ProductImageOutput struct {
+	PublicID     types.ProductImagePublicID `json:"public_id"`
+	Position     int                        `json:"position"`
+	AltText      string                     `json:"alt_text"`
+	ContentType  string                     `json:"content_type"`
+	Size         int64                      `json:"size"`
+	Width        int                        `json:"width"`
+	Height       int                        `json:"height"`
+	URL          string                     `json:"url"`
+	ThumbnailURL string                     `json:"thumbnail_url"`
+}
+
+type CreateOneProductImageInput struct {
+	ProductPublicID types.ProductPublicID `mapstructure:"public_id"`
+	File            *multipart.FileHeader `mapstructure:"file" swaggerignore:"true"`
+	AltText         string                `mapstructure:"alt_text"`
+	Position        *int                  `mapstructure:"position"`
+	Actor           types.Actor           `json:"-" mapstructure:"-"`
+	ActorRole       types.Role            `json:"-" mapstructure:"-"`
+}
+
+type CreateOneProductImageOutput struct {
+	Image *ProductImageOutput `json:"image"`
+}
+
+type GetAllProductImagesInput struct {
+	ProductPublicID types.ProductPublicID `mapstructure:"public_id"`
+}
+
+type GetAllProductImagesOutput struct {
+	Images []*ProductImageOutput `json:"images"`
+}
+
+type UpdateOneProductImageInput struct {
+	ProductPublicID types.ProductPublicID      `mapstructure:"public_id"`
+	ImagePublicID   types.ProductImagePublicID `mapstructure:"image_public_id"`
+	AltText         *string                    `json:"alt_text" mapstructure:"alt_text"`
+	Position        *int                       `json:"position" mapstructure:"position"`
+	Actor           types.Actor                `json:"-" mapstructure:"-"`
+	ActorRole       types.Role                 `json:"-" mapstructure:"-"`
+}
+
+type UpdateOneProductImageOutput struct {
+	Updated bool   `json:"updated"`
+	Message string `json:"message"`
+}
+
+type DeleteOneProductImageInput struct {
+	ProductPublicID types.ProductPublicID      `mapstructure:"public_id"`
+	ImagePublicID   types.ProductImagePublicID `mapstructure:"image_public_id"`
+	Actor           types.Actor                `json:"-" mapstructure:"-"`
+	ActorRole       types.Role                 `json:"-" mapstructure:"-"`
+}
+
+type DeleteOneProductImageOutput struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,55 @@
+package dto
+
+import "project/internal/domain/types"
+
+// ImportProductRow is one decoded product of an import file. Price and Rating keep the
+// raw decoded value (number from JSON, text from CSV) and Specifications is keyed by
+// specification public ID or title.
+type ImportProductRow struct {
+	Row            int            `json:"row"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description"`
+	Price          any            `json:"price"`
+	Rating         any            `json:"rating"`
+	ImageURL       string         `json:"image_url"`
+	Category       string         `json:"category"`
+	Specifications map[string]any `json:"specifications"`
+}
+
+type ImportProductsInput struct {
+	Rows      []*ImportProductRow
+	Format    string      `mapstructure:"format"`
+	DryRun    bool        `mapstructure:"dry_run"`
+	Offset    int         `mapstructure:"offset"`
+	ChunkSize int         `mapstructure:"chunk_size"`
+	Actor     types.Actor `json:"-" mapstructure:"-"`
+	ActorRole types.Role  `json:"-" mapstructure:"-"`
+}
+
+type ImportProductError struct {
+	Row     int    `json:"row"`
+	Name    string `json:"name"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type ImportedProduct struct {
+	Row      int                   `json:"row"`
+	PublicID types.ProductPublicID `json:"public_id"`
+	Name     types.ProductName     `json:"name"`
+}
+
+type ImportProductsOutput struct {
+	DryRun     bool                  `json:"dry_run"`
+	Total      int                   `json:"total"`
+	Offset     int                   `json:"offset"`
+	ChunkSize  int                   `json:"chunk_size"`
+	Processed  int                   `json:"processed"`
+	Valid      int                   `json:"valid"`
+	Imported   int                   `json:"imported"`
+	Failed     int                   `json:"failed"`
+	NextOffset int                   `json:"next_offset"`
+	Done       bool                  `json:"done"`
+	Products   []*ImportedProduct    `json:"products"`
+	Errors     []*ImportProductError `json:"errors"`
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+type ProductOfferOutput struct {
+	PublicID         types.ProductOfferPublicID     `json:"public_id"`
+	RetailerPublicID types.RetailerPublicID         `json:"retailer_public_id"`
+	RetailerName     string                         `json:"retailer_name"`
+	Price            int64                          `json:"price"`
+	URL              string                         `json:"url"`
+	Availability     types.ProductOfferAvailability `json:"availability"`
+	LastSeenAt       time.Time                      `json:"last_seen_at"`
+	Current          bool                           `json:"current"`
+}
+
+type UpsertOneProductOfferInput struct {
+	ProductPublicID  types.ProductPublicID          `mapstructure:"public_id"`
+	RetailerPublicID types.RetailerPublicID         `mapstructure:"retailer_public_id"`
+	Price            int64                          `json:"price" mapstructure:"price"`
+	URL              string                         `json:"url" mapstructure:"url"`
+	Availability     types.ProductOfferAvailability `json:"availability" mapstructure:"availability"`
+	LastSeenAt       string                         `json:"last_seen_at" mapstructure:"last_seen_at"`
+	Actor            types.Actor                    `json:"-" mapstructure:"-"`
+	ActorRole        types.Role                     `json:"-" mapstructure:"-"`
+}
+
+type UpsertOneProductOfferOutput struct {
+	PublicID types.ProductOfferPublicID `json:"public_id"`
+	Created  bool                       `json:"created"`
+}
+
+type GetAllProductOffersInput struct {
+	ProductPublicID types.ProductPublicID `mapstructure:"public_id"`
+}
+
+// GetAllProductOffersOutput lists the offers of every retailer, BestOffer is null
+// when no store sells the product right now.
+type GetAllProductOffersOutput struct {
+	BestOffer   *ProductOfferOutput   `json:"best_offer"`
+	PriceSpread int64                 `json:"price_spread"`
+	Offers      []*ProductOfferOutput `json:"offers"`
+}
+
+type DeleteOneProductOfferInput struct {
+	ProductPublicID  types.ProductPublicID  `mapstructure:"public_id"`
+	RetailerPublicID types.RetailerPublicID `mapstructure:"retailer_public_id"`
+	Actor            types.Actor            `json:"-" mapstructure:"-"`
+	ActorRole        types.Role             `json:"-" mapstructure:"-"`
+}
+
+type DeleteOneProductOfferOutput struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message"`
+}
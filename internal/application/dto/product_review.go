@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+type ProductReviewOutput struct {
+	PublicID     types.ProductReviewPublicID `json:"public_id"`
+	Author       string                      `json:"author"`
+	Stars        int8                        `json:"stars"`
+	Text         string                      `json:"text"`
+	HelpfulVotes int64                       `json:"helpful_votes"`
+	Status       types.ProductReviewStatus   `json:"status"`
+	CreatedAt    time.Time                   `json:"created_at"`
+}
+
+type ProductRatingSummaryOutput struct {
+	Rating       int8             `json:"rating"`
+	ReviewCount  int64            `json:"review_count"`
+	Distribution map[string]int64 `json:"distribution"`
+}
+
+type CreateOneProductReviewInput struct {
+	ProductPublicID types.ProductPublicID `mapstructure:"public_id"`
+	Author          string                `json:"author" mapstructure:"author"`
+	Stars           int8                  `json:"stars" mapstructure:"stars"`
+	Text            string                `json:"text" mapstructure:"text"`
+	ActorRole       types.Role            `json:"-" mapstructure:"-"`
+}
+
+type CreateOneProductReviewOutput struct {
+	PublicID types.ProductReviewPublicID `json:"public_id"`
+	Status   types.ProductReviewStatus   `json:"status"`
+}
+
+type GetAllProductReviewsInput struct {
+	PaginatorInput  *PaginatorInput           `mapstructure:"pagination"`
+	ProductPublicID types.ProductPublicID     `mapstructure:"public_id"`
+	Status          types.ProductReviewStatus `mapstructure:"status"`
+}
+
+type GetAllProductReviewsOutput struct {
+	PaginatorOutput *PaginatorOutput            `json:"paginator"`
+	Summary         *ProductRatingSummaryOutput `json:"summary"`
+	Reviews         []*ProductReviewOutput      `json:"reviews"`
+}
+
+type ModerateOneProductReviewInput struct {
+	ProductPublicID types.ProductPublicID       `mapstructure:"public_id"`
+	ReviewPublicID  types.ProductReviewPublicID `mapstructure:"review_public_id"`
+	Status          types.ProductReviewStatus   `json:"status" mapstructure:"status"`
+	ActorRole       types.Role                  `json:"-" mapstructure:"-"`
+}
+
+type ModerateOneProductReviewOutput struct {
+	Moderated bool                        `json:"moderated"`
+	Message   string                      `json:"message"`
+	Summary   *ProductRatingSummaryOutput `json:"summary"`
+}
+
+type VoteProductReviewHelpfulInput struct {
+	ProductPublicID types.ProductPublicID       `mapstructure:"public_id"`
+	ReviewPublicID  types.ProductReviewPublicID `mapstructure:"review_public_id"`
+	ActorRole       types.Role                  `json:"-" mapstructure:"-"`
+}
+
+type VoteProductReviewHelpfulOutput struct {
+	HelpfulVotes int64 `json:"helpful_votes"`
+}
@@ -8,9 +8,75 @@ type CreateOneProductSpecificationValueInput struct {
 	StringValue           string                      `json:"string_value" mapstructure:"string_value"`
 	IntValue              int64                       `json:"int_value" mapstructure:"int_value"`
 	BoolValue             bool                        `json:"bool_value" mapstructure:"bool_value"`
+	Actor                 types.Actor                 `json:"-" mapstructure:"-"`
+	ActorRole             types.Role                  `json:"-" mapstructure:"-"`
 }
 
 type CreateOneProductSpecificationValueOutput struct {
 	Created bool   `json:"created"`
 	Message string `json:"message"`
 }
+
+type UpdateOneProductSpecificationValueInput struct {
+	ProductPublicID       types.ProductPublicID       `mapstructure:"public_id"`
+	SpecificationPublicID types.SpecificationPublicID `mapstructure:"specification_public_id"`
+	StringValue           string                      `json:"string_value" mapstructure:"string_value"`
+	IntValue              int64                       `json:"int_value" mapstructure:"int_value"`
+	BoolValue             bool                        `json:"bool_value" mapstructure:"bool_value"`
+	Version               int64                       `json:"version" mapstructure:"version"`
+	Actor                 types.Actor                 `json:"-" mapstructure:"-"`
+	ActorRole             types.Role                  `json:"-" mapstructure:"-"`
+}
+
+type UpdateOneProductSpecificationValueOutput struct {
+	Updated bool   `json:"updated"`
+	Message string `json:"message"`
+	Version int64  `json:"version"`
+}
+
+type UpsertOneProductSpecificationValueInput struct {
+	ProductPublicID       types.ProductPublicID       `mapstructure:"public_id"`
+	SpecificationPublicID types.SpecificationPublicID `mapstructure:"specification_public_id"`
+	StringValue           string                      `json:"string_value" mapstructure:"string_value"`
+	IntValue              int64                       `json:"int_value" mapstructure:"int_value"`
+	BoolValue             bool                        `json:"bool_value" mapstructure:"bool_value"`
+	Actor                 types.Actor                 `json:"-" mapstructure:"-"`
+	ActorRole             types.Role                  `json:"-" mapstructure:"-"`
+}
+
+type UpsertOneProductSpecificationValueOutput struct {
+	Saved   bool   `json:"saved"`
+	Message string `json:"message"`
+	Version int64  `json:"version"`
+}
+
+type DeleteOneProductSpecificationValueInput struct {
+	ProductPublicID       types.ProductPublicID       `mapstructure:"public_id"`
+	SpecificationPublicID types.SpecificationPublicID `mapstructure:"specification_public_id"`
+	Actor                 types.Actor                 `json:"-" mapstructure:"-"`
+	ActorRole             types.Role                  `json:"-" mapstructure:"-"`
+}
+
+type DeleteOneProductSpecificationValueOutput struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message"`
+}
+
+type ReplaceProductSpecificationValuesInput struct {
+	ProductPublicID types.ProductPublicID               `mapstructure:"public_id"`
+	Specifications  map[types.SpecificationPublicID]any `json:"specifications" mapstructure:"specifications"`
+	Actor           types.Actor                         `json:"-" mapstructure:"-"`
+	ActorRole       types.Role                          `json:"-" mapstructure:"-"`
+}
+
+type ReplaceProductSpecificationValuesOutput struct {
+	Replaced bool                              `json:"replaced"`
+	Total    int                               `json:"total"`
+	Errors   []*ProductSpecificationValueError `json:"errors,omitempty"`
+	Message  string                            `json:"message"`
+}
+
+type ProductSpecificationValueError struct {
+	SpecificationPublicID types.SpecificationPublicID `json:"specification_public_id"`
+	Message               string                      `json:"message"`
+}
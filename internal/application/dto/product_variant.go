@@ -0,0 +1,36 @@
+package dto
+
+import "project/internal/domain/types"
+
+type ProductVariantOutput struct {
+	PublicID     types.ProductPublicID `json:"public_id"`
+	VariantLabel string                `json:"variant_label"`
+	Name         types.ProductName     `json:"name"`
+	Price        int64                 `json:"price"`
+	Rating       int8                  `json:"rating"`
+	ReviewCount  int64                 `json:"review_count"`
+	ImageURL     string                `json:"image_url"`
+}
+
+type CreateOneProductVariantInput struct {
+	ParentPublicID types.ProductPublicID `mapstructure:"public_id"`
+	VariantLabel   string                `json:"variant_label" mapstructure:"variant_label"`
+	Name           types.ProductName     `json:"name" mapstructure:"name"`
+	Description    string                `json:"description" mapstructure:"description"`
+	Price          int64                 `json:"price" mapstructure:"price"`
+	ImageURL       string                `json:"image_url" mapstructure:"image_url"`
+	Actor          types.Actor           `json:"-" mapstructure:"-"`
+	ActorRole      types.Role            `json:"-" mapstructure:"-"`
+}
+
+type CreateOneProductVariantOutput struct {
+	PublicID types.ProductPublicID `json:"public_id"`
+}
+
+type GetAllProductVariantsInput struct {
+	PublicID types.ProductPublicID `mapstructure:"public_id"`
+}
+
+type GetAllProductVariantsOutput struct {
+	Variants []*ProductVariantOutput `json:"variants"`
+}
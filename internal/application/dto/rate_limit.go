@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+// TakeRateLimitTokenInput names the client by what authenticated it, or by its IP
+// address when nothing did.
+type TakeRateLimitTokenInput struct {
+	Policy types.RateLimitPolicyName
+	Client string
+}
+
+type TakeRateLimitTokenOutput struct {
+	// Taken tells if the request can go through.
+	Taken     bool
+	Limit     int64
+	Window    time.Duration
+	Remaining int64
+	// ResetIn is how long until the bucket is full, RetryIn until the next request
+	// can be made.
+	ResetIn time.Duration
+	RetryIn time.Duration
+}
+
+type PruneRateLimitBucketsOutput struct {
+	Pruned int64 `json:"pruned"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "project/internal/domain/types"
+
+type RetailerOutput struct {
+	PublicID   types.RetailerPublicID `json:"public_id"`
+	Name       string                 `json:"name"`
+	WebsiteURL string                 `json:"website_url"`
+}
+
+type GetAllRetailersOutput struct {
+	Retailers []*RetailerOutput `json:"retailers"`
+}
+
+type CreateOneRetailerInput struct {
+	Name       string      `json:"name" mapstructure:"name"`
+	WebsiteURL string      `json:"website_url" mapstructure:"website_url"`
+	Actor      types.Actor `json:"-" mapstructure:"-"`
+	ActorRole  types.Role  `json:"-" mapstructure:"-"`
+}
+
+type CreateOneRetailerOutput struct {
+	PublicID types.RetailerPublicID `json:"public_id"`
+}
+
+type UpdateOneRetailerInput struct {
+	PublicID   types.RetailerPublicID `mapstructure:"public_id"`
+	Name       string                 `json:"name" mapstructure:"name"`
+	WebsiteURL string                 `json:"website_url" mapstructure:"website_url"`
+	Actor      types.Actor            `json:"-" mapstructure:"-"`
+	ActorRole  types.Role             `json:"-" mapstructure:"-"`
+}
+
+type UpdateOneRetailerOutput struct {
+	Updated bool   `json:"updated"`
+	Message string `json:"message"`
+}
+
+type DeleteOneRetailerInput struct {
+	PublicID  types.RetailerPublicID `mapstructure:"public_id"`
+	Actor     types.Actor            `json:"-" mapstructure:"-"`
+	ActorRole types.Role             `json:"-" mapstructure:"-"`
+}
+
+type DeleteOneRetailerOutput struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+// RevisionOutput identifies what a read returns, it goes out as the ETag and the
+// Last-Modified of the response.
+type RevisionOutput struct {
+	Tag        string    `json:"tag"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
+
+// GetProductsRevisionInput takes the products of a single read, in the order the
+// read returns them.
+type GetProductsRevisionInput struct {
+	PublicIDs []types.ProductPublicID `mapstructure:"public_ids"`
+}
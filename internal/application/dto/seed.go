@@ -0,0 +1,69 @@
+package dto
+
+import "project/internal/domain/types"
+
+// SeedFixture is the declarative content of a seed profile. Every row carries its
+// ID and public ID so seeding is repeatable and the built-in specifications keep
+// the IDs the comparison rules rely on.
+type SeedFixture struct {
+	Include             []string                       `yaml:"include" json:"include"`
+	SpecificationGroups []*SeedSpecificationGroupInput `yaml:"specification_groups" json:"specification_groups"`
+	Specifications      []*SeedSpecificationInput      `yaml:"specifications" json:"specifications"`
+	Categories          []*SeedCategoryInput           `yaml:"categories" json:"categories"`
+	Products            []*SeedProductInput            `yaml:"products" json:"products"`
+}
+
+type SeedSpecificationGroupInput struct {
+	ID          types.SpecificationGroupID       `yaml:"id" json:"id"`
+	PublicID    types.SpecificationGroupPublicID `yaml:"public_id" json:"public_id"`
+	Name        string                           `yaml:"name" json:"name"`
+	Description string                           `yaml:"description" json:"description"`
+}
+
+type SeedSpecificationInput struct {
+	ID                   types.SpecificationID       `yaml:"id" json:"id"`
+	PublicID             types.SpecificationPublicID `yaml:"public_id" json:"public_id"`
+	SpecificationGroupID types.SpecificationGroupID  `yaml:"specification_group_id" json:"specification_group_id"`
+	Title                string                      `yaml:"title" json:"title"`
+	Type                 types.SpecificationType     `yaml:"type" json:"type"`
+}
+
+type SeedCategoryInput struct {
+	ID             types.CategoryID                  `yaml:"id" json:"id"`
+	PublicID       types.CategoryPublicID            `yaml:"public_id" json:"public_id"`
+	Name           string                            `yaml:"name" json:"name"`
+	Description    string                            `yaml:"description" json:"description"`
+	Specifications []*SeedCategorySpecificationInput `yaml:"specifications" json:"specifications"`
+}
+
+type SeedCategorySpecificationInput struct {
+	SpecificationID types.SpecificationID          `yaml:"specification_id" json:"specification_id"`
+	Requirement     types.SpecificationRequirement `yaml:"requirement" json:"requirement"`
+}
+
+type SeedProductInput struct {
+	ID             types.ProductID               `yaml:"id" json:"id"`
+	PublicID       types.ProductPublicID         `yaml:"public_id" json:"public_id"`
+	CategoryID     types.CategoryID              `yaml:"category_id" json:"category_id"`
+	Name           string                        `yaml:"name" json:"name"`
+	Description    string                        `yaml:"description" json:"description"`
+	Price          int64                         `yaml:"price" json:"price"`
+	Rating         int8                          `yaml:"rating" json:"rating"`
+	ImageURL       string                        `yaml:"image_url" json:"image_url"`
+	Specifications map[types.SpecificationID]any `yaml:"specifications" json:"specifications"`
+}
+
+type SeedCatalogInput struct {
+	Fixture   *SeedFixture
+	Reset     bool
+	ActorRole types.Role
+}
+
+type SeedCatalogOutput struct {
+	Reset               bool `json:"reset"`
+	SpecificationGroups int  `json:"specification_groups"`
+	Specifications      int  `json:"specifications"`
+	Categories          int  `json:"categories"`
+	Products            int  `json:"products"`
+	SpecificationValues int  `json:"specification_values"`
+}
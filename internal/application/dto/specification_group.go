@@ -11,3 +11,49 @@ type SpecificationGroupOutput struct {
 	Name        string                           `json:"name"`
 	Description string                           `json:"description"`
 }
+
+type CreateOneSpecificationGroupInput struct {
+	Name        string      `json:"name" mapstructure:"name"`
+	Description string      `json:"description" mapstructure:"description"`
+	Actor       types.Actor `json:"-" mapstructure:"-"`
+	ActorRole   types.Role  `json:"-" mapstructure:"-"`
+}
+
+type CreateOneSpecificationGroupOutput struct {
+	PublicID types.SpecificationGroupPublicID `json:"public_id"`
+}
+
+type UpdateOneSpecificationGroupInput struct {
+	PublicID    types.SpecificationGroupPublicID `mapstructure:"public_id"`
+	Name        string                           `json:"name" mapstructure:"name"`
+	Description string                           `json:"description" mapstructure:"description"`
+	Actor       types.Actor                      `json:"-" mapstructure:"-"`
+	ActorRole   types.Role                       `json:"-" mapstructure:"-"`
+}
+
+type UpdateOneSpecificationGroupOutput struct {
+	Updated bool   `json:"updated"`
+	Message string `json:"message"`
+}
+
+type DeleteOneSpecificationGroupInput struct {
+	PublicID  types.SpecificationGroupPublicID `mapstructure:"public_id"`
+	Actor     types.Actor                      `json:"-" mapstructure:"-"`
+	ActorRole types.Role                       `json:"-" mapstructure:"-"`
+}
+
+type DeleteOneSpecificationGroupOutput struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message"`
+}
+
+type RestoreOneSpecificationGroupInput struct {
+	PublicID  types.SpecificationGroupPublicID `mapstructure:"public_id"`
+	Actor     types.Actor                      `json:"-" mapstructure:"-"`
+	ActorRole types.Role                       `json:"-" mapstructure:"-"`
+}
+
+type RestoreOneSpecificationGroupOutput struct {
+	Restored bool   `json:"restored"`
+	Message  string `json:"message"`
+}
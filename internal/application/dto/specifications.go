@@ -15,3 +15,53 @@ type SpecificationOutput struct {
 	Title    string                      `json:"name"`
 	Type     types.SpecificationType     `json:"type"`
 }
+
+type CreateOneSpecificationInput struct {
+	Title                      string                           `json:"title" mapstructure:"title"`
+	Type                       types.SpecificationType          `json:"type" mapstructure:"type"`
+	SpecificationGroupPublicID types.SpecificationGroupPublicID `json:"specification_group_public_id" mapstructure:"specification_group_public_id"`
+	Actor                      types.Actor                      `json:"-" mapstructure:"-"`
+	ActorRole                  types.Role                       `json:"-" mapstructure:"-"`
+}
+
+type CreateOneSpecificationOutput struct {
+	PublicID types.SpecificationPublicID `json:"public_id"`
+}
+
+type UpdateOneSpecificationInput struct {
+	PublicID                   types.SpecificationPublicID      `mapstructure:"public_id"`
+	Title                      string                           `json:"title" mapstructure:"title"`
+	Type                       types.SpecificationType          `json:"type" mapstructure:"type"`
+	SpecificationGroupPublicID types.SpecificationGroupPublicID `json:"specification_group_public_id" mapstructure:"specification_group_public_id"`
+	ConvertValues              bool                             `json:"convert_values" mapstructure:"convert_values"`
+	Actor                      types.Actor                      `json:"-" mapstructure:"-"`
+	ActorRole                  types.Role                       `json:"-" mapstructure:"-"`
+}
+
+type UpdateOneSpecificationOutput struct {
+	Updated         bool   `json:"updated"`
+	ConvertedValues int    `json:"converted_values"`
+	Message         string `json:"message"`
+}
+
+type DeleteOneSpecificationInput struct {
+	PublicID  types.SpecificationPublicID `mapstructure:"public_id"`
+	Actor     types.Actor                 `json:"-" mapstructure:"-"`
+	ActorRole types.Role                  `json:"-" mapstructure:"-"`
+}
+
+type DeleteOneSpecificationOutput struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message"`
+}
+
+type RestoreOneSpecificationInput struct {
+	PublicID  types.SpecificationPublicID `mapstructure:"public_id"`
+	Actor     types.Actor                 `json:"-" mapstructure:"-"`
+	ActorRole types.Role                  `json:"-" mapstructure:"-"`
+}
+
+type RestoreOneSpecificationOutput struct {
+	Restored bool   `json:"restored"`
+	Message  string `json:"message"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+type TrashItemOutput struct {
+	EntityType types.TrashEntityType `json:"entity_type"`
+	PublicID   string                `json:"public_id"`
+	Name       string                `json:"name"`
+	DeletedAt  time.Time             `json:"deleted_at"`
+	PurgeAt    time.Time             `json:"purge_at"`
+}
+
+type GetAllTrashItemsInput struct {
+	PaginatorInput *PaginatorInput       `mapstructure:"pagination"`
+	EntityType     types.TrashEntityType `mapstructure:"entity_type"`
+	ActorRole      types.Role            `json:"-" mapstructure:"-"`
+}
+
+type GetAllTrashItemsOutput struct {
+	PaginatorOutput *PaginatorOutput   `json:"paginator"`
+	Items           []*TrashItemOutput `json:"items"`
+}
+
+type PurgeTrashInput struct {
+	// Before purges what was deleted before it instead of before the retention.
+	Before    string      `mapstructure:"before"`
+	Actor     types.Actor `json:"-" mapstructure:"-"`
+	ActorRole types.Role  `json:"-" mapstructure:"-"`
+}
+
+type PurgeTrashOutput struct {
+	Purged  []*TrashItemOutput `json:"purged"`
+	Message string             `json:"message"`
+}
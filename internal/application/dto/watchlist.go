@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+type WatchlistOutput struct {
+	PublicID          types.WatchlistPublicID `json:"public_id"`
+	ProductPublicID   types.ProductPublicID   `json:"product_public_id"`
+	TargetPrice       int64                   `json:"target_price"`
+	DropPercent       int64                   `json:"drop_percent"`
+	BasePrice         int64                   `json:"base_price"`
+	ThresholdPrice    int64                   `json:"threshold_price"`
+	LastNotifiedPrice int64                   `json:"last_notified_price"`
+	CreatedAt         time.Time               `json:"created_at"`
+}
+
+type WatchlistDeliveryOutput struct {
+	Trigger        types.WatchlistTrigger        `json:"trigger"`
+	Price          int64                         `json:"price"`
+	ThresholdPrice int64                         `json:"threshold_price"`
+	Status         types.WatchlistDeliveryStatus `json:"status"`
+	Attempts       int64                         `json:"attempts"`
+	StatusCode     int64                         `json:"status_code"`
+	Error          string                        `json:"error"`
+	CreatedAt      time.Time                     `json:"created_at"`
+}
+
+// WatchlistAlertPayload is the body posted to the webhook when a price drops,
+// SubscriberToken tells the receiver who to notify.
+type WatchlistAlertPayload struct {
+	Event             string                  `json:"event"`
+	WatchlistPublicID types.WatchlistPublicID `json:"watchlist_public_id"`
+	SubscriberToken   string                  `json:"subscriber_token"`
+	ProductPublicID   types.ProductPublicID   `json:"product_public_id"`
+	ProductName       types.ProductName       `json:"product_name"`
+	Price             int64                   `json:"price"`
+	ThresholdPrice    int64                   `json:"threshold_price"`
+	TargetPrice       int64                   `json:"target_price"`
+	DropPercent       int64                   `json:"drop_percent"`
+	BestOffer         *ProductOfferOutput     `json:"best_offer"`
+	Trigger           types.WatchlistTrigger  `json:"trigger"`
+	SentAt            time.Time               `json:"sent_at"`
+}
+
+type CreateOneWatchlistInput struct {
+	SubscriberToken string                `json:"subscriber_token" mapstructure:"subscriber_token"`
+	ProductPublicID types.ProductPublicID `json:"product_public_id" mapstructure:"product_public_id"`
+	TargetPrice     int64                 `json:"target_price" mapstructure:"target_price"`
+	DropPercent     int64                 `json:"drop_percent" mapstructure:"drop_percent"`
+	ActorRole       types.Role            `json:"-" mapstructure:"-"`
+}
+
+type CreateOneWatchlistOutput struct {
+	PublicID       types.WatchlistPublicID `json:"public_id"`
+	ThresholdPrice int64                   `json:"threshold_price"`
+}
+
+type GetAllWatchlistsInput struct {
+	SubscriberToken string `mapstructure:"subscriber_token"`
+}
+
+type GetAllWatchlistsOutput struct {
+	Watchlists []*WatchlistOutput `json:"watchlists"`
+}
+
+type DeleteOneWatchlistInput struct {
+	PublicID        types.WatchlistPublicID `mapstructure:"public_id"`
+	SubscriberToken string                  `mapstructure:"subscriber_token"`
+	ActorRole       types.Role              `json:"-" mapstructure:"-"`
+}
+
+type DeleteOneWatchlistOutput struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message"`
+}
+
+type GetAllWatchlistDeliveriesInput struct {
+	PaginatorInput  *PaginatorInput               `mapstructure:"pagination"`
+	PublicID        types.WatchlistPublicID       `mapstructure:"public_id"`
+	SubscriberToken string                        `mapstructure:"subscriber_token"`
+	Status          types.WatchlistDeliveryStatus `mapstructure:"status"`
+}
+
+type GetAllWatchlistDeliveriesOutput struct {
+	PaginatorOutput *PaginatorOutput           `json:"paginator"`
+	Deliveries      []*WatchlistDeliveryOutput `json:"deliveries"`
+}
+
+type SweepWatchlistsOutput struct {
+	Products  int `json:"products"`
+	Delivered int `json:"delivered"`
+	Failed    int `json:"failed"`
+}
@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"encoding/json"
+	"project/internal/domain/types"
+	"time"
+)
+
+type WebhookSubscriptionOutput struct {
+	PublicID   types.WebhookSubscriptionPublicID `json:"public_id"`
+	URL        string                            `json:"url"`
+	EventTypes []types.OutboxEventType           `json:"event_types"`
+	CreatedAt  time.Time                         `json:"created_at"`
+}
+
+type WebhookDeliveryOutput struct {
+	EventID           int64                       `json:"event_id"`
+	EventType         types.OutboxEventType       `json:"event_type"`
+	AggregateType     types.OutboxAggregateType   `json:"aggregate_type"`
+	AggregatePublicID string                      `json:"aggregate_public_id"`
+	Status            types.WebhookDeliveryStatus `json:"status"`
+	Attempts          int64                       `json:"attempts"`
+	NextAttemptAt     *time.Time                  `json:"next_attempt_at"`
+	LastStatusCode    int64                       `json:"last_status_code"`
+	LastError         string                      `json:"last_error"`
+	DeliveredAt       *time.Time                  `json:"delivered_at"`
+	CreatedAt         time.Time                   `json:"created_at"`
+}
+
+// WebhookEventPayload is the body posted to the subscriptions, Data is the
+// aggregate after the change.
+type WebhookEventPayload struct {
+	ID                int64                     `json:"id"`
+	Type              types.OutboxEventType     `json:"type"`
+	AggregateType     types.OutboxAggregateType `json:"aggregate_type"`
+	AggregatePublicID string                    `json:"aggregate_public_id"`
+	OccurredAt        time.Time                 `json:"occurred_at"`
+	Data              json.RawMessage           `json:"data"`
+}
+
+type CreateOneWebhookSubscriptionInput struct {
+	URL        string                  `json:"url" mapstructure:"url"`
+	Secret     string                  `json:"secret" mapstructure:"secret"`
+	EventTypes []types.OutboxEventType `json:"event_types" mapstructure:"event_types"`
+	ActorRole  types.Role              `json:"-" mapstructure:"-"`
+}
+
+// CreateOneWebhookSubscriptionOutput is the only time the secret is shown, it is
+// generated when the input has none.
+type CreateOneWebhookSubscriptionOutput struct {
+	PublicID types.WebhookSubscriptionPublicID `json:"public_id"`
+	Secret   string                            `json:"secret"`
+}
+
+type GetAllWebhookSubscriptionsInput struct {
+	ActorRole types.Role `json:"-" mapstructure:"-"`
+}
+
+type GetAllWebhookSubscriptionsOutput struct {
+	Subscriptions []*WebhookSubscriptionOutput `json:"subscriptions"`
+}
+
+type DeleteOneWebhookSubscriptionInput struct {
+	PublicID  types.WebhookSubscriptionPublicID `mapstructure:"public_id"`
+	ActorRole types.Role                        `json:"-" mapstructure:"-"`
+}
+
+type DeleteOneWebhookSubscriptionOutput struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message"`
+}
+
+type GetAllWebhookDeliveriesInput struct {
+	PaginatorInput *PaginatorInput                   `mapstructure:"pagination"`
+	PublicID       types.WebhookSubscriptionPublicID `mapstructure:"public_id"`
+	Status         types.WebhookDeliveryStatus       `mapstructure:"status"`
+	ActorRole      types.Role                        `json:"-" mapstructure:"-"`
+}
+
+type GetAllWebhookDeliveriesOutput struct {
+	PaginatorOutput *PaginatorOutput         `json:"paginator"`
+	Deliveries      []*WebhookDeliveryOutput `json:"deliveries"`
+}
+
+type ReplayWebhookDeliveriesInput struct {
+	PublicID  types.WebhookSubscriptionPublicID `mapstructure:"public_id"`
+	Since     string                            `json:"since" mapstructure:"since"`
+	ActorRole types.Role                        `json:"-" mapstructure:"-"`
+}
+
+type ReplayWebhookDeliveriesOutput struct {
+	Replayed int64 `json:"replayed"`
+}
+
+type DispatchOutboxEventsOutput struct {
+	Events       int `json:"events"`
+	Delivered    int `json:"delivered"`
+	Failed       int `json:"failed"`
+	DeadLettered int `json:"dead_lettered"`
+}
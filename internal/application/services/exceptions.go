@@ -16,6 +16,18 @@ func GetStatusCodeFromError(err error) int {
 			return 404
 		}
 
+		if err.Reason == constants.RepositoryUniqueConstraintError {
+			return 409
+		}
+
+		if err.Reason == constants.RepositoryVersionConflictError {
+			return 412
+		}
+
+		if err.Reason == constants.RepositoryInvalidTokenError {
+			return 401
+		}
+
 		return 500
 	case *exceptions.BaseUsecase:
 		return err.StatusCode
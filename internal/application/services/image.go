@@ -0,0 +1,124 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"project/internal/domain/constants"
+	"slices"
+)
+
+// maxImagePixels keeps a small file that declares a huge canvas from being decoded.
+const maxImagePixels = 40_000_000
+
+// DecodeImage checks the content itself, not the content type sent by the client,
+// and decodes it. Only the types in constants.ProductImageContentTypes are accepted.
+func DecodeImage(content []byte) (image.Image, string, error) {
+	contentType := http.DetectContentType(content)
+
+	if !slices.Contains(constants.ProductImageContentTypes, contentType) {
+		return nil, "", fmt.Errorf("unsupported image type %s", contentType)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid image: %w", err)
+	}
+
+	if config.Width*config.Height > maxImagePixels {
+		return nil, "", fmt.Errorf("image of %dx%d is too large", config.Width, config.Height)
+	}
+
+	var img image.Image
+
+	switch contentType {
+	case constants.ProductImageContentTypeJPEG:
+		img, err = jpeg.Decode(bytes.NewReader(content))
+	case constants.ProductImageContentTypePNG:
+		img, err = png.Decode(bytes.NewReader(content))
+	case constants.ProductImageContentTypeGIF:
+		img, err = gif.Decode(bytes.NewReader(content))
+	}
+
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid image: %w", err)
+	}
+
+	return img, contentType, nil
+}
+
+// Thumbnail scales the image down so its longest side is at most side pixels,
+// averaging the source pixels under each thumbnail pixel. Smaller images are
+// returned as they are.
+func Thumbnail(img image.Image, side int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	if width <= side && height <= side {
+		return img
+	}
+
+	thumbWidth, thumbHeight := side, side
+
+	if width > height {
+		thumbHeight = max(1, height*side/width)
+	} else {
+		thumbWidth = max(1, width*side/height)
+	}
+
+	thumb := image.NewRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+
+	for y := range thumbHeight {
+		y0 := bounds.Min.Y + y*height/thumbHeight
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*height/thumbHeight)
+
+		for x := range thumbWidth {
+			x0 := bounds.Min.X + x*width/thumbWidth
+			x1 := max(x0+1, bounds.Min.X+(x+1)*width/thumbWidth)
+
+			var r, g, b, a, n uint64
+
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					sr, sg, sb, sa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(sr), g+uint64(sg), b+uint64(sb), a+uint64(sa)
+					n++
+				}
+			}
+
+			thumb.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+
+	return thumb
+}
+
+// EncodeThumbnail encodes JPEG thumbnails as JPEG and everything else as PNG, GIF
+// thumbnails keep only the first frame. It returns the content type used.
+func EncodeThumbnail(img image.Image, contentType string) ([]byte, string, error) {
+	var buffer bytes.Buffer
+
+	if contentType == constants.ProductImageContentTypeJPEG {
+		if err := jpeg.Encode(&buffer, img, &jpeg.Options{Quality: 85}); err != nil {
+			return nil, "", err
+		}
+
+		return buffer.Bytes(), constants.ProductImageContentTypeJPEG, nil
+	}
+
+	if err := png.Encode(&buffer, img); err != nil {
+		return nil, "", err
+	}
+
+	return buffer.Bytes(), constants.ProductImageContentTypePNG, nil
+}
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"fmt"
+	"time"
+)
+
+// timestampLayouts are the formats accepted by validator.String().Timestamp(),
+// values without a zone are read as UTC.
+var timestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	time.DateOnly,
+}
+
+func ParseTimestamp(value string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		parsed, err := time.ParseInLocation(layout, value, time.UTC)
+
+		if err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", value)
+}
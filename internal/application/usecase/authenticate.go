@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"errors"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"strings"
+	"time"
+)
+
+type Authenticate struct {
+	ApiKeyRepository repository.ApiKey
+	// TokenVerifier is nil when no JWT key is configured.
+	TokenVerifier repository.TokenVerifier
+	code          string
+}
+
+func NewAuthenticate(
+	apiKeyRepository repository.ApiKey,
+	tokenVerifier repository.TokenVerifier,
+) *Authenticate {
+	return &Authenticate{
+		code:             "Authenticate",
+		ApiKeyRepository: apiKeyRepository,
+		TokenVerifier:    tokenVerifier,
+	}
+}
+
+// Execute tells who the credential belongs to. A credential that starts with the API
+// key prefix is looked up as a key, anything else is verified as a JWT. Every failure
+// is a 401 with the same message, the reason is only logged.
+func (u *Authenticate) Execute(input *dto.AuthenticateInput) (*dto.PrincipalOutput, exceptions.UsecaseException) {
+	credential := strings.TrimSpace(input.Credential)
+
+	if scheme, token, found := strings.Cut(credential, " "); found && strings.EqualFold(scheme, "Bearer") {
+		credential = strings.TrimSpace(token)
+	}
+
+	if credential == "" {
+		return nil, u.unauthorized(errors.New("Error authenticating, credential is empty"))
+	}
+
+	var principal *entity.Principal
+
+	if strings.HasPrefix(credential, constants.ApiKeyPrefix+"_") {
+		apiKeyPrincipal, usecaseErr := u.authenticateApiKey(credential)
+
+		if usecaseErr != nil {
+			return nil, usecaseErr
+		}
+
+		principal = apiKeyPrincipal
+	} else {
+		if u.TokenVerifier == nil {
+			return nil, u.unauthorized(errors.New("Error authenticating, no JWT key is configured"))
+		}
+
+		tokenPrincipal, repoErr := u.TokenVerifier.Verify(credential, time.Now())
+
+		if repoErr != nil {
+			return nil, u.unauthorized(repoErr)
+		}
+
+		principal = tokenPrincipal
+	}
+
+	return &dto.PrincipalOutput{
+		Subject:        principal.Subject,
+		Method:         principal.Method,
+		Role:           principal.Role,
+		ApiKeyPublicID: principal.ApiKeyPublicID,
+	}, nil
+}
+
+func (u *Authenticate) authenticateApiKey(credential string) (*entity.Principal, exceptions.UsecaseException) {
+	publicID, ok := entity.ParseApiKey(credential)
+
+	if !ok {
+		return nil, u.unauthorized(errors.New("Error authenticating, malformed api key"))
+	}
+
+	apiKey, repoErr := u.ApiKeyRepository.GetOneByPublicID(publicID)
+
+	if repoErr != nil {
+		if repoErr.Instance().Reason == constants.RepositoryNotFoundError {
+			return nil, u.unauthorized(repoErr)
+		}
+
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting api key",
+		})
+	}
+
+	entityErr := apiKey.Verify(credential)
+
+	if entityErr != nil {
+		return nil, u.unauthorized(entityErr)
+	}
+
+	repoErr = u.ApiKeyRepository.TouchOne(apiKey)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error updating api key last use in repository",
+		})
+	}
+
+	principal, entityErr := entity.NewPrincipal(entity.PrincipalProps{
+		Subject:        types.Actor(apiKey.Name),
+		Method:         constants.AuthMethodApiKey,
+		Role:           apiKey.Role,
+		ApiKeyPublicID: apiKey.PublicID,
+	})
+
+	if entityErr != nil {
+		return nil, u.unauthorized(entityErr)
+	}
+
+	return principal, nil
+}
+
+func (u *Authenticate) unauthorized(err error) exceptions.UsecaseException {
+	return exceptions.Usecase(err, exceptions.UsecaseOpts{
+		Code:       u.code,
+		StatusCode: 401,
+		Message:    "Invalid or expired credential",
+	})
+}
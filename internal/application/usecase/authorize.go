@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	exceptions "project/internal/domain/exception"
+	domainServices "project/internal/domain/services"
+	"project/internal/domain/types"
+)
+
+// authorize is the first thing a write use case does, whatever the transport. A
+// denial is a 403 whose data tells which permission the role lacked.
+func authorize(code string, role types.Role, permission types.Permission) exceptions.UsecaseException {
+	if domainServices.RoleHasPermission(role, permission) {
+		return nil
+	}
+
+	return exceptions.Usecase(fmt.Errorf("Error authorizing %s, role %q does not have the %s permission", code, role, permission), exceptions.UsecaseOpts{
+		Code:       code,
+		StatusCode: 403,
+		Message:    "Permission denied",
+		Data: &dto.PermissionDeniedOutput{
+			Operation:  code,
+			Permission: permission,
+			Role:       role,
+		},
+	})
+}
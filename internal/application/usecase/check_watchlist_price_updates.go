@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type CheckWatchlistPriceUpdates struct {
+	ProductRepository      repository.Product
+	ProductOfferRepository repository.ProductOffer
+	WatchlistRepository    repository.Watchlist
+	WebhookSender          repository.WebhookSender
+	code                   string
+}
+
+func NewCheckWatchlistPriceUpdates(
+	productRepository repository.Product,
+	productOfferRepository repository.ProductOffer,
+	watchlistRepository repository.Watchlist,
+	webhookSender repository.WebhookSender,
+) *CheckWatchlistPriceUpdates {
+	return &CheckWatchlistPriceUpdates{
+		code:                   "CheckWatchlistPriceUpdates",
+		ProductRepository:      productRepository,
+		ProductOfferRepository: productOfferRepository,
+		WatchlistRepository:    watchlistRepository,
+		WebhookSender:          webhookSender,
+	}
+}
+
+// Execute checks the watched products whose price a product update changed. The
+// update only leaves the check, posting the alerts, with the webhook retries, is done
+// here out of the request. A check is taken before the alerts are sent, the ones
+// that fail are sent again by the sweep.
+func (u *CheckWatchlistPriceUpdates) Execute() (*dto.SweepWatchlistsOutput, exceptions.UsecaseException) {
+	publicIds, repoErr := u.WatchlistRepository.TakePriceChecks(constants.WatchlistPriceCheckBatchSize)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error taking watchlist price checks",
+		})
+	}
+
+	products := make([]*entity.Product, 0, len(publicIds))
+
+	for _, publicId := range publicIds {
+		product, repoErr := u.ProductRepository.GetOneByPublicId(publicId)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error getting product",
+			})
+		}
+
+		products = append(products, product)
+	}
+
+	if len(products) == 0 {
+		return &dto.SweepWatchlistsOutput{}, nil
+	}
+
+	usecaseErr := attachProductOffers(u.ProductOfferRepository, products, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return notifyWatchlists(u.WatchlistRepository, u.WebhookSender, products, constants.WatchlistTriggerPriceUpdate, u.code)
+}
@@ -6,19 +6,27 @@ import (
 	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"time"
 )
 
 type CompareProducts struct {
-	ProductRepository repository.Product
-	code              string
+	ProductRepository       repository.Product
+	SpecificationRepository repository.Specification
+	ProductOfferRepository  repository.ProductOffer
+	code                    string
 }
 
 func NewCompareProducts(
 	productRepository repository.Product,
+	specificationRepository repository.Specification,
+	productOfferRepository repository.ProductOffer,
 ) *CompareProducts {
 	return &CompareProducts{
-		code:              "CompareProducts",
-		ProductRepository: productRepository,
+		code:                    "CompareProducts",
+		ProductRepository:       productRepository,
+		SpecificationRepository: specificationRepository,
+		ProductOfferRepository:  productOfferRepository,
 	}
 }
 
@@ -43,6 +51,20 @@ func (u *CompareProducts) Execute(input *dto.CompareProductsInput) (*dto.Compare
 		})
 	}
 
+	// variants are compared with the values they inherit from their family
+	for _, product := range []*entity.Product{leftProduct, rightProduct} {
+		if usecaseErr := inheritFamilySpecificationValues(u.ProductRepository, product, u.code); usecaseErr != nil {
+			return nil, usecaseErr
+		}
+	}
+
+	// prices are compared through the best offer of each product
+	usecaseErr := attachProductOffers(u.ProductOfferRepository, []*entity.Product{leftProduct, rightProduct}, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	result, entityErr := leftProduct.Compare(rightProduct)
 
 	if entityErr != nil {
@@ -53,18 +75,47 @@ func (u *CompareProducts) Execute(input *dto.CompareProductsInput) (*dto.Compare
 		})
 	}
 
-	return u.toCompareProductsOutput(result)
+	specifications, repoErr := u.SpecificationRepository.GetAll()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specifications",
+		})
+	}
+
+	specificationsById := make(map[types.SpecificationID]*entity.Specification, len(specifications))
+
+	for _, specification := range specifications {
+		specificationsById[specification.ID] = specification
+	}
+
+	return u.toCompareProductsOutput(leftProduct, rightProduct, result, specificationsById)
 }
 
-func (u *CompareProducts) toCompareProductsOutput(result *entity.ComparisonProductsResult) (*dto.CompareProductsOutput, exceptions.UsecaseException) {
+func (u *CompareProducts) toCompareProductsOutput(
+	leftProduct *entity.Product,
+	rightProduct *entity.Product,
+	result *entity.ComparisonProductsResult,
+	specificationsById map[types.SpecificationID]*entity.Specification,
+) (*dto.CompareProductsOutput, exceptions.UsecaseException) {
+	now := time.Now()
+
 	output := &dto.CompareProductsOutput{
+		Left:  &dto.ComparedProductOutput{PublicID: leftProduct.PublicID, Name: leftProduct.Name, VariantLabel: leftProduct.VariantLabel},
+		Right: &dto.ComparedProductOutput{PublicID: rightProduct.PublicID, Name: rightProduct.Name, VariantLabel: rightProduct.VariantLabel},
 		Price: &dto.PriceComparisonOutput{
-			Left:  result.PriceComparisonResult.Left,
-			Right: result.PriceComparisonResult.Right,
+			Left:       result.PriceComparisonResult.Left,
+			Right:      result.PriceComparisonResult.Right,
+			LeftOffer:  toProductOfferOutput(result.PriceComparisonResult.LeftOffer, now),
+			RightOffer: toProductOfferOutput(result.PriceComparisonResult.RightOffer, now),
 		},
 		Rating: &dto.RatingComparisonOutput{
-			Left:  result.RatingComparisonResult.Left,
-			Right: result.RatingComparisonResult.Right,
+			Left:             result.RatingComparisonResult.Left,
+			Right:            result.RatingComparisonResult.Right,
+			LeftReviewCount:  result.RatingComparisonResult.LeftReviewCount,
+			RightReviewCount: result.RatingComparisonResult.RightReviewCount,
 		},
 		Specifications: []*dto.SpecificationsComparisonOutput{},
 	}
@@ -100,6 +151,11 @@ func (u *CompareProducts) toCompareProductsOutput(result *entity.ComparisonProdu
 			},
 		}
 
+		if specification, exists := specificationsById[specificationResult.Left.SpecificationID]; exists {
+			outputSpecification.PublicID = specification.PublicID
+			outputSpecification.Title = specification.Title
+		}
+
 		for _, insight := range specificationResult.Insights {
 			outputSpecification.Insights = append(outputSpecification.Insights, &dto.InsightOutput{
 				Favorable: insight.Favorable,
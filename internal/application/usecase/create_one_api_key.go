@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type CreateOneApiKey struct {
+	ApiKeyRepository repository.ApiKey
+	code             string
+}
+
+func NewCreateOneApiKey(
+	apiKeyRepository repository.ApiKey,
+) *CreateOneApiKey {
+	return &CreateOneApiKey{
+		code:             "CreateOneApiKey",
+		ApiKeyRepository: apiKeyRepository,
+	}
+}
+
+// Execute generates a key named after the client that will use it, with one of the
+// roles a key can carry. The key is in the
+// output and nowhere else, a lost key is revoked and a new one created.
+func (u *CreateOneApiKey) Execute(input *dto.CreateOneApiKeyInput) (*dto.CreateOneApiKeyOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionApiKeyManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	apiKey, entityErr := entity.NewApiKey(entity.ApiKeyProps{
+		Name: input.Name,
+		Role: input.Role,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr := u.ApiKeyRepository.CreateOne(apiKey)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating api key in repository",
+		})
+	}
+
+	return &dto.CreateOneApiKeyOutput{
+		PublicID: apiKey.PublicID,
+		Name:     apiKey.Name,
+		Key:      apiKey.Key,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type CreateOneCategory struct {
+	CategoryRepository repository.Category
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewCreateOneCategory(
+	categoryRepository repository.Category,
+	auditLogRepository repository.AuditLog,
+) *CreateOneCategory {
+	return &CreateOneCategory{
+		code:               "CreateOneCategory",
+		CategoryRepository: categoryRepository,
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+func (u *CreateOneCategory) Execute(input *dto.CreateOneCategoryInput) (*dto.CreateOneCategoryOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionCategoryWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	exists, repoErr := u.CategoryRepository.ExistsByName(input.Name, "")
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if category exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error creating new category, invalid name -> already exists other category with name %s", input.Name), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Category already exists",
+		})
+	}
+
+	category, entityErr := entity.NewCategory(entity.CategoryProps{
+		Name:        input.Name,
+		Description: input.Description,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error creating category in domain",
+		})
+	}
+
+	repoErr = u.CategoryRepository.CreateOne(category)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating category in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityCategory,
+		EntityPublicID: string(category.PublicID),
+		Operation:      constants.AuditOperationCreate,
+		After:          categoryAuditFields(category),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.CreateOneCategoryOutput{
+		PublicID: category.PublicID,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"project/internal/application/dto"
 	"project/internal/application/services"
+	"project/internal/domain/constants"
 	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
@@ -12,21 +13,28 @@ import (
 type CreateOneProduct struct {
 	ProductRepository  repository.Product
 	CategoryRepository repository.Category
+	AuditLogRepository repository.AuditLog
 	code               string
 }
 
 func NewCreateOneProduct(
 	productRepository repository.Product,
 	categoryRepository repository.Category,
+	auditLogRepository repository.AuditLog,
 ) *CreateOneProduct {
 	return &CreateOneProduct{
 		code:               "CreateOneProduct",
 		ProductRepository:  productRepository,
 		CategoryRepository: categoryRepository,
+		AuditLogRepository: auditLogRepository,
 	}
 }
 
 func (u *CreateOneProduct) Execute(input *dto.CreateOneProductInput) (*dto.CreateOneProductOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionProductWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	category, repoErr := u.CategoryRepository.GetOneByPublicID(input.CategoryPublicID)
 
 	if repoErr != nil {
@@ -82,6 +90,17 @@ func (u *CreateOneProduct) Execute(input *dto.CreateOneProductInput) (*dto.Creat
 		})
 	}
 
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationCreate,
+		After:          productAuditFields(product, category.PublicID),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	return &dto.CreateOneProductOutput{
 		PublicID: product.PublicID,
 	}, nil
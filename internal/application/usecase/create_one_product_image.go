@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type CreateOneProductImage struct {
+	ProductRepository      repository.Product
+	ProductImageRepository repository.ProductImage
+	ImageStorage           repository.ImageStorage
+	AuditLogRepository     repository.AuditLog
+	code                   string
+}
+
+func NewCreateOneProductImage(
+	productRepository repository.Product,
+	productImageRepository repository.ProductImage,
+	imageStorage repository.ImageStorage,
+	auditLogRepository repository.AuditLog,
+) *CreateOneProductImage {
+	return &CreateOneProductImage{
+		code:                   "CreateOneProductImage",
+		ProductRepository:      productRepository,
+		ProductImageRepository: productImageRepository,
+		ImageStorage:           imageStorage,
+		AuditLogRepository:     auditLogRepository,
+	}
+}
+
+func (u *CreateOneProductImage) Execute(input *dto.CreateOneProductImageInput) (*dto.CreateOneProductImageOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionProductWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	usecaseErr := loadProductGallery(u.ProductImageRepository, product, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	before := productGalleryAuditFields(product.Images)
+
+	content, err := u.readFile(input)
+
+	if err != nil {
+		return nil, exceptions.Usecase(err, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    err.Error(),
+		})
+	}
+
+	img, contentType, err := services.DecodeImage(content)
+
+	if err != nil {
+		return nil, exceptions.Usecase(err, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    fmt.Sprintf("Invalid image, %v", err),
+		})
+	}
+
+	thumbnail, thumbnailContentType, err := services.EncodeThumbnail(services.Thumbnail(img, constants.ProductImageThumbnailSide), contentType)
+
+	if err != nil {
+		return nil, exceptions.Usecase(err, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error generating image thumbnail",
+		})
+	}
+
+	image, entityErr := entity.NewProductImage(entity.ProductImageProps{
+		ProductID:   product.ID,
+		AltText:     input.AltText,
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	image.SetStorageKeys(product.PublicID, thumbnailContentType)
+
+	entityErr = product.AddImage(image)
+
+	if entityErr == nil && input.Position != nil {
+		_, entityErr = product.MoveImage(image.PublicID, *input.Position)
+	}
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr = u.ImageStorage.Save(image.StorageKey, content, image.ContentType)
+
+	if repoErr == nil {
+		repoErr = u.ImageStorage.Save(image.ThumbnailKey, thumbnail, thumbnailContentType)
+	}
+
+	if repoErr == nil {
+		repoErr = u.ProductImageRepository.CreateOne(image, product.Images)
+	}
+
+	if repoErr != nil {
+		u.ImageStorage.Delete(image.StorageKey)
+		u.ImageStorage.Delete(image.ThumbnailKey)
+
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error saving product image",
+		})
+	}
+
+	usecaseErr = recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          productGalleryAuditFields(product.Images),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.CreateOneProductImageOutput{
+		Image: toProductImageOutput(u.ImageStorage, image),
+	}, nil
+}
+
+// readFile reads at most one byte over the limit, the declared size of a multipart
+// part is not trusted.
+func (u *CreateOneProductImage) readFile(input *dto.CreateOneProductImageInput) ([]byte, error) {
+	if input.File == nil {
+		return nil, errors.New("Missing image file")
+	}
+
+	file, err := input.File.Open()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error reading image file, %v", err)
+	}
+
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, constants.ProductImageMaxSize+1))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error reading image file, %v", err)
+	}
+
+	if int64(len(content)) > constants.ProductImageMaxSize {
+		return nil, fmt.Errorf("Image file is larger than %d bytes", constants.ProductImageMaxSize)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type CreateOneProductReview struct {
+	ProductRepository       repository.Product
+	ProductReviewRepository repository.ProductReview
+	code                    string
+}
+
+func NewCreateOneProductReview(
+	productRepository repository.Product,
+	productReviewRepository repository.ProductReview,
+) *CreateOneProductReview {
+	return &CreateOneProductReview{
+		code:                    "CreateOneProductReview",
+		ProductRepository:       productRepository,
+		ProductReviewRepository: productReviewRepository,
+	}
+}
+
+// Execute stores the review as pending, it only counts for the rating after it is
+// approved by moderation.
+func (u *CreateOneProductReview) Execute(input *dto.CreateOneProductReviewInput) (*dto.CreateOneProductReviewOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionReviewWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	review, entityErr := entity.NewProductReview(entity.ProductReviewProps{
+		ProductID: product.ID,
+		Author:    input.Author,
+		Stars:     input.Stars,
+		Text:      input.Text,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr = u.ProductReviewRepository.CreateOne(review)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating product review in repository",
+		})
+	}
+
+	return &dto.CreateOneProductReviewOutput{
+		PublicID: review.PublicID,
+		Status:   review.Status,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"fmt"
 	"project/internal/application/dto"
 	"project/internal/application/services"
 	"project/internal/domain/constants"
@@ -13,6 +14,8 @@ type CreateOneProductSpecificationValue struct {
 	ProductRepository                   repository.Product
 	SpecificationRepository             repository.Specification
 	ProductSpecificationValueRepository repository.ProductSpecificationValue
+	CategorySpecificationRepository     repository.CategorySpecification
+	AuditLogRepository                  repository.AuditLog
 	code                                string
 }
 
@@ -20,16 +23,24 @@ func NewCreateOneProductSpecificationValue(
 	productRepository repository.Product,
 	specificationRepository repository.Specification,
 	productSpecificationValueRepository repository.ProductSpecificationValue,
+	categorySpecificationRepository repository.CategorySpecification,
+	auditLogRepository repository.AuditLog,
 ) *CreateOneProductSpecificationValue {
 	return &CreateOneProductSpecificationValue{
 		code:                                "CreateOneProductSpecificationValue",
 		ProductRepository:                   productRepository,
 		SpecificationRepository:             specificationRepository,
 		ProductSpecificationValueRepository: productSpecificationValueRepository,
+		CategorySpecificationRepository:     categorySpecificationRepository,
+		AuditLogRepository:                  auditLogRepository,
 	}
 }
 
 func (u *CreateOneProductSpecificationValue) Execute(input *dto.CreateOneProductSpecificationValueInput) (*dto.CreateOneProductSpecificationValueOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationValueWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
 
 	if repoErr != nil {
@@ -50,11 +61,40 @@ func (u *CreateOneProductSpecificationValue) Execute(input *dto.CreateOneProduct
 		})
 	}
 
+	template, repoErr := u.CategorySpecificationRepository.GetTemplateByCategoryID(product.CategoryID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category specification template",
+		})
+	}
+
+	if !template.Allows(specification.ID) {
+		return nil, exceptions.Usecase(fmt.Errorf("Error writing product specification value, specification %s is not part of the template of category %d", specification.PublicID, product.CategoryID), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    "Specification not allowed for product category",
+		})
+	}
+
+	specValue, entityErr := entity.NewSpecValue(specification.Type, input.StringValue, input.IntValue, input.BoolValue)
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Invalid specification type",
+		})
+	}
+
 	productSpecificationValue, entityErr := entity.NewProductSpecificationValue(entity.ProductSpecificationValueProps{
 		ID:              0,
 		ProductID:       product.ID,
 		SpecificationID: specification.ID,
-		Value:           &entity.SpecValue{},
+		Type:            specification.Type,
+		Value:           specValue,
 	})
 
 	if entityErr != nil {
@@ -65,21 +105,6 @@ func (u *CreateOneProductSpecificationValue) Execute(input *dto.CreateOneProduct
 		})
 	}
 
-	switch specification.Type {
-	case constants.SpecificationTypeString:
-		productSpecificationValue.Value.StringValue = &input.StringValue
-	case constants.SpecificationTypeInt:
-		productSpecificationValue.Value.IntValue = &input.IntValue
-	case constants.SpecificationTypeBool:
-		productSpecificationValue.Value.BoolValue = &input.BoolValue
-	default:
-		return nil, exceptions.Usecase(nil, exceptions.UsecaseOpts{
-			Code:       u.code,
-			StatusCode: 500,
-			Message:    "Invalid specification type",
-		})
-	}
-
 	repoErr = u.ProductSpecificationValueRepository.CreateOne(productSpecificationValue)
 
 	if repoErr != nil {
@@ -90,6 +115,17 @@ func (u *CreateOneProductSpecificationValue) Execute(input *dto.CreateOneProduct
 		})
 	}
 
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		After:          productSpecificationAuditFields(specification.PublicID, productSpecificationValue),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	return &dto.CreateOneProductSpecificationValueOutput{
 		Created: true,
 		Message: "Product specification value created successfully",
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"strings"
+)
+
+type CreateOneProductVariant struct {
+	ProductRepository  repository.Product
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewCreateOneProductVariant(
+	productRepository repository.Product,
+	auditLogRepository repository.AuditLog,
+) *CreateOneProductVariant {
+	return &CreateOneProductVariant{
+		code:               "CreateOneProductVariant",
+		ProductRepository:  productRepository,
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+func (u *CreateOneProductVariant) Execute(input *dto.CreateOneProductVariantInput) (*dto.CreateOneProductVariantOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionProductWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	parent, repoErr := u.ProductRepository.GetOneByPublicId(input.ParentPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	if parent.IsVariant() {
+		return nil, exceptions.Usecase(fmt.Errorf("product %s is a variant of %s", parent.PublicID, parent.ParentPublicID), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    "Variants cannot have variants, use the family product",
+		})
+	}
+
+	variants, repoErr := u.ProductRepository.GetAllVariants(parent)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product variants",
+		})
+	}
+
+	for _, variant := range variants {
+		if strings.EqualFold(variant.VariantLabel, input.VariantLabel) {
+			return nil, exceptions.Usecase(errors.New("Error creating product variant, label already used in the family"), exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 409,
+				Message:    fmt.Sprintf("Variant %s already exists", variant.VariantLabel),
+			})
+		}
+	}
+
+	variant, entityErr := parent.NewVariant(entity.ProductVariantProps{
+		VariantLabel: input.VariantLabel,
+		Name:         input.Name,
+		Description:  input.Description,
+		Price:        input.Price,
+		ImageURL:     input.ImageURL,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error creating product variant in domain",
+		})
+	}
+
+	repoErr = u.ProductRepository.CreateOne(variant)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating product variant in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(variant.PublicID),
+		Operation:      constants.AuditOperationCreate,
+		After:          productAuditFields(variant, parent.CategoryPublicID),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.CreateOneProductVariantOutput{
+		PublicID: variant.PublicID,
+	}, nil
+}
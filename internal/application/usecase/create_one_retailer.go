@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"strings"
+)
+
+type CreateOneRetailer struct {
+	RetailerRepository repository.Retailer
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewCreateOneRetailer(
+	retailerRepository repository.Retailer,
+	auditLogRepository repository.AuditLog,
+) *CreateOneRetailer {
+	return &CreateOneRetailer{
+		code:               "CreateOneRetailer",
+		RetailerRepository: retailerRepository,
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+func (u *CreateOneRetailer) Execute(input *dto.CreateOneRetailerInput) (*dto.CreateOneRetailerOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionRetailerWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	exists, repoErr := u.RetailerRepository.ExistsByName(strings.TrimSpace(input.Name), "")
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if retailer exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error creating new retailer, invalid name -> already exists other retailer with name %s", input.Name), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Retailer already exists",
+		})
+	}
+
+	retailer, entityErr := entity.NewRetailer(entity.RetailerProps{
+		Name:       input.Name,
+		WebsiteURL: input.WebsiteURL,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr = u.RetailerRepository.CreateOne(retailer)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating retailer in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityRetailer,
+		EntityPublicID: string(retailer.PublicID),
+		Operation:      constants.AuditOperationCreate,
+		After:          retailerAuditFields(retailer),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.CreateOneRetailerOutput{
+		PublicID: retailer.PublicID,
+	}, nil
+}
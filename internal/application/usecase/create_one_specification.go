@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type CreateOneSpecification struct {
+	SpecificationRepository      repository.Specification
+	SpecificationGroupRepository repository.SpecificationGroup
+	AuditLogRepository           repository.AuditLog
+	code                         string
+}
+
+func NewCreateOneSpecification(
+	specificationRepository repository.Specification,
+	specificationGroupRepository repository.SpecificationGroup,
+	auditLogRepository repository.AuditLog,
+) *CreateOneSpecification {
+	return &CreateOneSpecification{
+		code:                         "CreateOneSpecification",
+		SpecificationRepository:      specificationRepository,
+		SpecificationGroupRepository: specificationGroupRepository,
+		AuditLogRepository:           auditLogRepository,
+	}
+}
+
+func (u *CreateOneSpecification) Execute(input *dto.CreateOneSpecificationInput) (*dto.CreateOneSpecificationOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	group, repoErr := u.SpecificationGroupRepository.GetOneByPublicID(input.SpecificationGroupPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification group",
+		})
+	}
+
+	exists, repoErr := u.SpecificationRepository.ExistsByTitle(input.Title, group.ID, "")
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if specification exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error creating new specification, invalid title -> already exists other specification with title %s in group %s", input.Title, group.PublicID), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Specification already exists",
+		})
+	}
+
+	specification, entityErr := entity.NewSpecification(entity.SpecificationProps{
+		Title:                 input.Title,
+		EspecificationGroupID: group.ID,
+		Type:                  input.Type,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error creating specification in domain",
+		})
+	}
+
+	repoErr = u.SpecificationRepository.CreateOne(specification)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating specification in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntitySpecification,
+		EntityPublicID: string(specification.PublicID),
+		Operation:      constants.AuditOperationCreate,
+		After:          specificationAuditFields(specification, group.PublicID),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.CreateOneSpecificationOutput{
+		PublicID: specification.PublicID,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type CreateOneSpecificationGroup struct {
+	SpecificationGroupRepository repository.SpecificationGroup
+	AuditLogRepository           repository.AuditLog
+	code                         string
+}
+
+func NewCreateOneSpecificationGroup(
+	specificationGroupRepository repository.SpecificationGroup,
+	auditLogRepository repository.AuditLog,
+) *CreateOneSpecificationGroup {
+	return &CreateOneSpecificationGroup{
+		code:                         "CreateOneSpecificationGroup",
+		SpecificationGroupRepository: specificationGroupRepository,
+		AuditLogRepository:           auditLogRepository,
+	}
+}
+
+func (u *CreateOneSpecificationGroup) Execute(input *dto.CreateOneSpecificationGroupInput) (*dto.CreateOneSpecificationGroupOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	exists, repoErr := u.SpecificationGroupRepository.ExistsByName(input.Name, "")
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if specification group exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error creating new specification group, invalid name -> already exists other specification group with name %s", input.Name), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Specification group already exists",
+		})
+	}
+
+	group, entityErr := entity.NewSpecificationGroup(entity.SpecificationGroupProps{
+		Name:        input.Name,
+		Description: input.Description,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error creating specification group in domain",
+		})
+	}
+
+	repoErr = u.SpecificationGroupRepository.CreateOne(group)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating specification group in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntitySpecificationGroup,
+		EntityPublicID: string(group.PublicID),
+		Operation:      constants.AuditOperationCreate,
+		After:          specificationGroupAuditFields(group),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.CreateOneSpecificationGroupOutput{
+		PublicID: group.PublicID,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"strings"
+	"time"
+)
+
+type CreateOneWatchlist struct {
+	ProductRepository      repository.Product
+	ProductOfferRepository repository.ProductOffer
+	WatchlistRepository    repository.Watchlist
+	code                   string
+}
+
+func NewCreateOneWatchlist(
+	productRepository repository.Product,
+	productOfferRepository repository.ProductOffer,
+	watchlistRepository repository.Watchlist,
+) *CreateOneWatchlist {
+	return &CreateOneWatchlist{
+		code:                   "CreateOneWatchlist",
+		ProductRepository:      productRepository,
+		ProductOfferRepository: productOfferRepository,
+		WatchlistRepository:    watchlistRepository,
+	}
+}
+
+// Execute watches the product for the subscriber. A percentage drop is measured from
+// the effective price of the product right now.
+func (u *CreateOneWatchlist) Execute(input *dto.CreateOneWatchlistInput) (*dto.CreateOneWatchlistOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionWatchlistWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	subscriberToken := strings.TrimSpace(input.SubscriberToken)
+
+	exists, repoErr := u.WatchlistRepository.ExistsBySubscriberToken(subscriberToken, product.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if watchlist exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error creating new watchlist, the subscriber already watches product %s", product.PublicID), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Watchlist already exists",
+		})
+	}
+
+	usecaseErr := attachProductOffers(u.ProductOfferRepository, []*entity.Product{product}, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	watchlist, entityErr := entity.NewWatchlist(entity.WatchlistProps{
+		ProductID:       product.ID,
+		ProductPublicID: product.PublicID,
+		SubscriberToken: subscriberToken,
+		TargetPrice:     input.TargetPrice,
+		DropPercent:     input.DropPercent,
+		BasePrice:       product.EffectivePrice(time.Now()),
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr = u.WatchlistRepository.CreateOne(watchlist)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating watchlist in repository",
+		})
+	}
+
+	return &dto.CreateOneWatchlistOutput{
+		PublicID:       watchlist.PublicID,
+		ThresholdPrice: watchlist.ThresholdPrice(),
+	}, nil
+}
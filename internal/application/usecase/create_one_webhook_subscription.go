@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"strings"
+)
+
+type CreateOneWebhookSubscription struct {
+	WebhookSubscriptionRepository repository.WebhookSubscription
+	code                          string
+}
+
+func NewCreateOneWebhookSubscription(
+	webhookSubscriptionRepository repository.WebhookSubscription,
+) *CreateOneWebhookSubscription {
+	return &CreateOneWebhookSubscription{
+		code:                          "CreateOneWebhookSubscription",
+		WebhookSubscriptionRepository: webhookSubscriptionRepository,
+	}
+}
+
+// Execute registers the URL to receive the outbox events, from the next event on.
+// Older events are only sent when replayed.
+func (u *CreateOneWebhookSubscription) Execute(input *dto.CreateOneWebhookSubscriptionInput) (*dto.CreateOneWebhookSubscriptionOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionWebhookManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	url := strings.TrimSpace(input.URL)
+
+	exists, repoErr := u.WebhookSubscriptionRepository.ExistsByURL(url)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if webhook subscription exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error creating new webhook subscription, %s is already subscribed", url), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Webhook subscription already exists",
+		})
+	}
+
+	subscription, entityErr := entity.NewWebhookSubscription(entity.WebhookSubscriptionProps{
+		URL:        url,
+		Secret:     input.Secret,
+		EventTypes: input.EventTypes,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr = u.WebhookSubscriptionRepository.CreateOne(subscription)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating webhook subscription in repository",
+		})
+	}
+
+	return &dto.CreateOneWebhookSubscriptionOutput{
+		PublicID: subscription.PublicID,
+		Secret:   subscription.Secret,
+	}, nil
+}
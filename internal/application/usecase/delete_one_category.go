@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type DeleteOneCategory struct {
+	CategoryRepository repository.Category
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewDeleteOneCategory(
+	categoryRepository repository.Category,
+	auditLogRepository repository.AuditLog,
+) *DeleteOneCategory {
+	return &DeleteOneCategory{
+		code:               "DeleteOneCategory",
+		CategoryRepository: categoryRepository,
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+func (u *DeleteOneCategory) Execute(input *dto.DeleteOneCategoryInput) (*dto.DeleteOneCategoryOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionCategoryDelete); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	category, repoErr := u.CategoryRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category",
+		})
+	}
+
+	totalProducts, repoErr := u.CategoryRepository.CountProducts(category.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error counting category products",
+		})
+	}
+
+	if totalProducts > 0 {
+		return nil, exceptions.Usecase(fmt.Errorf("Error deleting category, category %s still contains %d products", category.PublicID, totalProducts), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Category still contains products",
+		})
+	}
+
+	repoErr = u.CategoryRepository.DeleteOne(category)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error deleting category",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityCategory,
+		EntityPublicID: string(category.PublicID),
+		Operation:      constants.AuditOperationDelete,
+		Before:         categoryAuditFields(category),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.DeleteOneCategoryOutput{
+		Deleted: true,
+		Message: "Category deleted successfully",
+	}, nil
+}
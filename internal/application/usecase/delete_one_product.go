@@ -3,25 +3,34 @@ package usecase
 import (
 	"project/internal/application/dto"
 	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
 )
 
 type DeleteOneProduct struct {
-	ProductRepository repository.Product
-	code              string
+	ProductRepository  repository.Product
+	AuditLogRepository repository.AuditLog
+	code               string
 }
 
 func NewDeleteOneProduct(
 	productRepository repository.Product,
+	auditLogRepository repository.AuditLog,
 ) *DeleteOneProduct {
 	return &DeleteOneProduct{
-		code:              "DeleteOneProduct",
-		ProductRepository: productRepository,
+		code:               "DeleteOneProduct",
+		ProductRepository:  productRepository,
+		AuditLogRepository: auditLogRepository,
 	}
 }
 
 func (u *DeleteOneProduct) Execute(input *dto.DeleteOneProductInput) (*dto.DeleteOneProductOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionProductDelete); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	product, repoErr := u.ProductRepository.GetOneByPublicId(input.PublicID)
 
 	if repoErr != nil {
@@ -32,6 +41,22 @@ func (u *DeleteOneProduct) Execute(input *dto.DeleteOneProductInput) (*dto.Delet
 		})
 	}
 
+	usecaseErr := checkProductVersion(product, input.Version, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	variants, repoErr := u.ProductRepository.GetAllVariants(product)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product variants",
+		})
+	}
+
 	repoErr = u.ProductRepository.DeleteOne(product)
 
 	if repoErr != nil {
@@ -42,6 +67,24 @@ func (u *DeleteOneProduct) Execute(input *dto.DeleteOneProductInput) (*dto.Delet
 		})
 	}
 
+	// the variants of a family are deleted with it
+	writes := make([]auditWrite, 0, len(variants)+1)
+
+	for _, deleted := range append([]*entity.Product{product}, variants...) {
+		writes = append(writes, auditWrite{
+			EntityType:     constants.AuditEntityProduct,
+			EntityPublicID: string(deleted.PublicID),
+			Operation:      constants.AuditOperationDelete,
+			Before:         productAuditFields(deleted, product.CategoryPublicID),
+		})
+	}
+
+	usecaseErr = recordAudit(u.AuditLogRepository, input.Actor, u.code, writes...)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	return &dto.DeleteOneProductOutput{
 		Deleted: true,
 		Message: "Product deleted successfully",
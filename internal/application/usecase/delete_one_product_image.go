@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type DeleteOneProductImage struct {
+	ProductRepository      repository.Product
+	ProductImageRepository repository.ProductImage
+	ImageStorage           repository.ImageStorage
+	AuditLogRepository     repository.AuditLog
+	code                   string
+}
+
+func NewDeleteOneProductImage(
+	productRepository repository.Product,
+	productImageRepository repository.ProductImage,
+	imageStorage repository.ImageStorage,
+	auditLogRepository repository.AuditLog,
+) *DeleteOneProductImage {
+	return &DeleteOneProductImage{
+		code:                   "DeleteOneProductImage",
+		ProductRepository:      productRepository,
+		ProductImageRepository: productImageRepository,
+		ImageStorage:           imageStorage,
+		AuditLogRepository:     auditLogRepository,
+	}
+}
+
+func (u *DeleteOneProductImage) Execute(input *dto.DeleteOneProductImageInput) (*dto.DeleteOneProductImageOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionProductWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	usecaseErr := loadProductGallery(u.ProductImageRepository, product, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	before := productGalleryAuditFields(product.Images)
+
+	image, entityErr := product.RemoveImage(input.ImagePublicID)
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 404,
+			Message:    "Product image not found",
+		})
+	}
+
+	repoErr = u.ProductImageRepository.DeleteOne(image, product.Images)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error deleting product image",
+		})
+	}
+
+	usecaseErr = recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          productGalleryAuditFields(product.Images),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	// The row is already gone, a file that could not be removed is reported so it can
+	// be cleaned up by hand.
+	for _, key := range []string{image.StorageKey, image.ThumbnailKey} {
+		repoErr = u.ImageStorage.Delete(key)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Product image deleted but its file could not be removed",
+			})
+		}
+	}
+
+	return &dto.DeleteOneProductImageOutput{
+		Deleted: true,
+		Message: "Product image deleted successfully",
+	}, nil
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type DeleteOneProductOffer struct {
+	ProductRepository      repository.Product
+	RetailerRepository     repository.Retailer
+	ProductOfferRepository repository.ProductOffer
+	AuditLogRepository     repository.AuditLog
+	code                   string
+}
+
+func NewDeleteOneProductOffer(
+	productRepository repository.Product,
+	retailerRepository repository.Retailer,
+	productOfferRepository repository.ProductOffer,
+	auditLogRepository repository.AuditLog,
+) *DeleteOneProductOffer {
+	return &DeleteOneProductOffer{
+		code:                   "DeleteOneProductOffer",
+		ProductRepository:      productRepository,
+		RetailerRepository:     retailerRepository,
+		ProductOfferRepository: productOfferRepository,
+		AuditLogRepository:     auditLogRepository,
+	}
+}
+
+func (u *DeleteOneProductOffer) Execute(input *dto.DeleteOneProductOfferInput) (*dto.DeleteOneProductOfferOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionPriceWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	retailer, repoErr := u.RetailerRepository.GetOneByPublicID(input.RetailerPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting retailer",
+		})
+	}
+
+	offer, repoErr := u.ProductOfferRepository.GetOneByRetailerID(product.ID, retailer.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product offer",
+		})
+	}
+
+	repoErr = u.ProductOfferRepository.DeleteOne(offer)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error deleting product offer in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         productOfferAuditFields(offer),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.DeleteOneProductOfferOutput{
+		Deleted: true,
+		Message: "Product offer deleted successfully",
+	}, nil
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type DeleteOneProductSpecificationValue struct {
+	ProductRepository                   repository.Product
+	SpecificationRepository             repository.Specification
+	ProductSpecificationValueRepository repository.ProductSpecificationValue
+	AuditLogRepository                  repository.AuditLog
+	code                                string
+}
+
+func NewDeleteOneProductSpecificationValue(
+	productRepository repository.Product,
+	specificationRepository repository.Specification,
+	productSpecificationValueRepository repository.ProductSpecificationValue,
+	auditLogRepository repository.AuditLog,
+) *DeleteOneProductSpecificationValue {
+	return &DeleteOneProductSpecificationValue{
+		code:                                "DeleteOneProductSpecificationValue",
+		ProductRepository:                   productRepository,
+		SpecificationRepository:             specificationRepository,
+		ProductSpecificationValueRepository: productSpecificationValueRepository,
+		AuditLogRepository:                  auditLogRepository,
+	}
+}
+
+func (u *DeleteOneProductSpecificationValue) Execute(input *dto.DeleteOneProductSpecificationValueInput) (*dto.DeleteOneProductSpecificationValueOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationValueWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	specification, repoErr := u.SpecificationRepository.GetOneByPublicID(input.SpecificationPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification",
+		})
+	}
+
+	productSpecificationValue, repoErr := u.ProductSpecificationValueRepository.FindOneByProductIDAndSpecificationID(product.ID, specification.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product specification value",
+		})
+	}
+
+	repoErr = u.ProductSpecificationValueRepository.DeleteOne(productSpecificationValue)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error deleting product specification value",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         productSpecificationAuditFields(specification.PublicID, productSpecificationValue),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.DeleteOneProductSpecificationValueOutput{
+		Deleted: true,
+		Message: "Product specification value deleted successfully",
+	}, nil
+}
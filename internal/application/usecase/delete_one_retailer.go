@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type DeleteOneRetailer struct {
+	RetailerRepository repository.Retailer
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewDeleteOneRetailer(
+	retailerRepository repository.Retailer,
+	auditLogRepository repository.AuditLog,
+) *DeleteOneRetailer {
+	return &DeleteOneRetailer{
+		code:               "DeleteOneRetailer",
+		RetailerRepository: retailerRepository,
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+func (u *DeleteOneRetailer) Execute(input *dto.DeleteOneRetailerInput) (*dto.DeleteOneRetailerOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionRetailerDelete); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	retailer, repoErr := u.RetailerRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting retailer",
+		})
+	}
+
+	repoErr = u.RetailerRepository.DeleteOne(retailer)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error deleting retailer",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityRetailer,
+		EntityPublicID: string(retailer.PublicID),
+		Operation:      constants.AuditOperationDelete,
+		Before:         retailerAuditFields(retailer),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.DeleteOneRetailerOutput{
+		Deleted: true,
+		Message: "Retailer deleted successfully",
+	}, nil
+}
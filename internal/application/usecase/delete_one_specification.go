@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type DeleteOneSpecification struct {
+	SpecificationRepository repository.Specification
+	AuditLogRepository      repository.AuditLog
+	code                    string
+}
+
+func NewDeleteOneSpecification(
+	specificationRepository repository.Specification,
+	auditLogRepository repository.AuditLog,
+) *DeleteOneSpecification {
+	return &DeleteOneSpecification{
+		code:                    "DeleteOneSpecification",
+		SpecificationRepository: specificationRepository,
+		AuditLogRepository:      auditLogRepository,
+	}
+}
+
+func (u *DeleteOneSpecification) Execute(input *dto.DeleteOneSpecificationInput) (*dto.DeleteOneSpecificationOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	specification, repoErr := u.SpecificationRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification",
+		})
+	}
+
+	totalValues, repoErr := u.SpecificationRepository.CountValues(specification.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error counting specification values",
+		})
+	}
+
+	if totalValues > 0 {
+		return nil, exceptions.Usecase(fmt.Errorf("Error deleting specification, specification %s still has %d product values", specification.PublicID, totalValues), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Specification still has product values",
+		})
+	}
+
+	repoErr = u.SpecificationRepository.DeleteOne(specification)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error deleting specification",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntitySpecification,
+		EntityPublicID: string(specification.PublicID),
+		Operation:      constants.AuditOperationDelete,
+		Before:         specificationAuditFields(specification, specification.SpecificationGroupPublicID),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.DeleteOneSpecificationOutput{
+		Deleted: true,
+		Message: "Specification deleted successfully",
+	}, nil
+}
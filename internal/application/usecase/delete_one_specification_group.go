@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type DeleteOneSpecificationGroup struct {
+	SpecificationGroupRepository repository.SpecificationGroup
+	SpecificationRepository      repository.Specification
+	AuditLogRepository           repository.AuditLog
+	code                         string
+}
+
+func NewDeleteOneSpecificationGroup(
+	specificationGroupRepository repository.SpecificationGroup,
+	specificationRepository repository.Specification,
+	auditLogRepository repository.AuditLog,
+) *DeleteOneSpecificationGroup {
+	return &DeleteOneSpecificationGroup{
+		code:                         "DeleteOneSpecificationGroup",
+		SpecificationGroupRepository: specificationGroupRepository,
+		SpecificationRepository:      specificationRepository,
+		AuditLogRepository:           auditLogRepository,
+	}
+}
+
+func (u *DeleteOneSpecificationGroup) Execute(input *dto.DeleteOneSpecificationGroupInput) (*dto.DeleteOneSpecificationGroupOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	group, repoErr := u.SpecificationGroupRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification group",
+		})
+	}
+
+	specifications, repoErr := u.SpecificationRepository.GetAllByGroupID(group.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification group specifications",
+		})
+	}
+
+	// the specifications go to the trash with the group, unless a product still has
+	// a value for one of them
+	for _, specification := range specifications {
+		totalValues, repoErr := u.SpecificationRepository.CountValues(specification.ID)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error counting specification values",
+			})
+		}
+
+		if totalValues > 0 {
+			return nil, exceptions.Usecase(fmt.Errorf("Error deleting specification group, specification %s of group %s is used by %d products", specification.PublicID, group.PublicID, totalValues), exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 409,
+				Message:    "Specification group has specifications in use",
+			})
+		}
+	}
+
+	repoErr = u.SpecificationGroupRepository.DeleteOne(group)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error deleting specification group",
+		})
+	}
+
+	writes := make([]auditWrite, 0, len(specifications)+1)
+
+	writes = append(writes, auditWrite{
+		EntityType:     constants.AuditEntitySpecificationGroup,
+		EntityPublicID: string(group.PublicID),
+		Operation:      constants.AuditOperationDelete,
+		Before:         specificationGroupAuditFields(group),
+	})
+
+	for _, specification := range specifications {
+		writes = append(writes, auditWrite{
+			EntityType:     constants.AuditEntitySpecification,
+			EntityPublicID: string(specification.PublicID),
+			Operation:      constants.AuditOperationDelete,
+			Before:         specificationAuditFields(specification, group.PublicID),
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, writes...)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.DeleteOneSpecificationGroupOutput{
+		Deleted: true,
+		Message: "Specification group deleted successfully",
+	}, nil
+}
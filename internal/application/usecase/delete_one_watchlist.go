@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type DeleteOneWatchlist struct {
+	WatchlistRepository repository.Watchlist
+	code                string
+}
+
+func NewDeleteOneWatchlist(
+	watchlistRepository repository.Watchlist,
+) *DeleteOneWatchlist {
+	return &DeleteOneWatchlist{
+		code:                "DeleteOneWatchlist",
+		WatchlistRepository: watchlistRepository,
+	}
+}
+
+func (u *DeleteOneWatchlist) Execute(input *dto.DeleteOneWatchlistInput) (*dto.DeleteOneWatchlistOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionWatchlistWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	watchlist, repoErr := u.WatchlistRepository.GetOneByPublicID(input.PublicID, input.SubscriberToken)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting watchlist",
+		})
+	}
+
+	repoErr = u.WatchlistRepository.DeleteOne(watchlist)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error deleting watchlist in repository",
+		})
+	}
+
+	return &dto.DeleteOneWatchlistOutput{
+		Deleted: true,
+		Message: "Watchlist deleted successfully",
+	}, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type DeleteOneWebhookSubscription struct {
+	WebhookSubscriptionRepository repository.WebhookSubscription
+	code                          string
+}
+
+func NewDeleteOneWebhookSubscription(
+	webhookSubscriptionRepository repository.WebhookSubscription,
+) *DeleteOneWebhookSubscription {
+	return &DeleteOneWebhookSubscription{
+		code:                          "DeleteOneWebhookSubscription",
+		WebhookSubscriptionRepository: webhookSubscriptionRepository,
+	}
+}
+
+// Execute stops the deliveries to the subscription, the pending ones included.
+func (u *DeleteOneWebhookSubscription) Execute(input *dto.DeleteOneWebhookSubscriptionInput) (*dto.DeleteOneWebhookSubscriptionOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionWebhookManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	subscription, repoErr := u.WebhookSubscriptionRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting webhook subscription",
+		})
+	}
+
+	repoErr = u.WebhookSubscriptionRepository.DeleteOne(subscription)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error deleting webhook subscription in repository",
+		})
+	}
+
+	return &dto.DeleteOneWebhookSubscriptionOutput{
+		Deleted: true,
+		Message: "Webhook subscription deleted successfully",
+	}, nil
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"encoding/json"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"time"
+)
+
+type DispatchOutboxEvents struct {
+	OutboxRepository              repository.Outbox
+	WebhookSubscriptionRepository repository.WebhookSubscription
+	WebhookPoster                 repository.WebhookPoster
+	// MaxAttempts is how many times a delivery is tried before it is dead-lettered,
+	// Backoff the wait before the second attempt, doubled on each one.
+	MaxAttempts int64
+	Backoff     time.Duration
+	// LockFor is how long the deliveries taken on a dispatch are kept from the other
+	// dispatchers, longer than posting a whole batch takes.
+	LockFor time.Duration
+	code    string
+}
+
+func NewDispatchOutboxEvents(
+	outboxRepository repository.Outbox,
+	webhookSubscriptionRepository repository.WebhookSubscription,
+	webhookPoster repository.WebhookPoster,
+	maxAttempts int64,
+	backoff time.Duration,
+	lockFor time.Duration,
+) *DispatchOutboxEvents {
+	return &DispatchOutboxEvents{
+		code:                          "DispatchOutboxEvents",
+		OutboxRepository:              outboxRepository,
+		WebhookSubscriptionRepository: webhookSubscriptionRepository,
+		WebhookPoster:                 webhookPoster,
+		MaxAttempts:                   maxAttempts,
+		Backoff:                       backoff,
+		LockFor:                       lockFor,
+	}
+}
+
+// Execute fans the new outbox events out to the subscriptions that accept them and
+// then attempts the deliveries that are due. A delivery is only marked delivered
+// after the subscription answers 2xx, so an event can arrive more than once but is
+// never lost: receivers deduplicate by the event id. Events and deliveries are taken
+// atomically, so dispatchers running side by side do not send them twice.
+func (u *DispatchOutboxEvents) Execute() (*dto.DispatchOutboxEventsOutput, exceptions.UsecaseException) {
+	output := &dto.DispatchOutboxEventsOutput{}
+
+	subscriptions, repoErr := u.WebhookSubscriptionRepository.GetAll()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting webhook subscriptions",
+		})
+	}
+
+	events, repoErr := u.OutboxRepository.GetAllUndispatched(constants.OutboxDispatchBatchSize)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting outbox events",
+		})
+	}
+
+	for _, event := range events {
+		accepting := make([]*entity.WebhookSubscription, 0, len(subscriptions))
+
+		for _, subscription := range subscriptions {
+			if subscription.Accepts(event.Type) {
+				accepting = append(accepting, subscription)
+			}
+		}
+
+		dispatched, repoErr := u.OutboxRepository.Dispatch(event, accepting)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error dispatching outbox event",
+			})
+		}
+
+		if dispatched {
+			output.Events++
+		}
+	}
+
+	subscriptionsByID := make(map[int64]*entity.WebhookSubscription, len(subscriptions))
+
+	for _, subscription := range subscriptions {
+		subscriptionsByID[subscription.ID] = subscription
+	}
+
+	now := time.Now()
+
+	deliveries, repoErr := u.WebhookSubscriptionRepository.ClaimDueDeliveries(now, now.Add(u.LockFor), constants.OutboxDispatchBatchSize)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error claiming due webhook deliveries",
+		})
+	}
+
+	for _, delivery := range deliveries {
+		subscription, ok := subscriptionsByID[delivery.SubscriptionID]
+
+		// subscribed after GetAll, it is attempted again once its lock is over
+		if !ok {
+			continue
+		}
+
+		usecaseErr := u.deliver(subscription, delivery)
+
+		if usecaseErr != nil {
+			return nil, usecaseErr
+		}
+
+		switch delivery.Status {
+		case constants.WebhookDeliveryStatusDelivered:
+			output.Delivered++
+		case constants.WebhookDeliveryStatusDead:
+			output.DeadLettered++
+		default:
+			output.Failed++
+		}
+	}
+
+	return output, nil
+}
+
+func (u *DispatchOutboxEvents) deliver(subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) exceptions.UsecaseException {
+	payload, err := json.Marshal(dto.WebhookEventPayload{
+		ID:                delivery.Event.ID,
+		Type:              delivery.Event.Type,
+		AggregateType:     delivery.Event.AggregateType,
+		AggregatePublicID: delivery.Event.AggregatePublicID,
+		OccurredAt:        delivery.Event.CreatedAt,
+		Data:              delivery.Event.Payload,
+	})
+
+	if err != nil {
+		return exceptions.Usecase(err, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error encoding webhook event",
+		})
+	}
+
+	statusCode, repoErr := u.WebhookPoster.Post(subscription.URL, subscription.Secret, delivery.Event.ID, payload)
+
+	var entityErr exceptions.EntityException
+
+	if repoErr != nil {
+		entityErr = delivery.MarkFailed(statusCode, string(repoErr.Instance().Err), time.Now(), u.MaxAttempts, u.Backoff)
+	} else {
+		entityErr = delivery.MarkDelivered(statusCode, time.Now())
+	}
+
+	if entityErr != nil {
+		return exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr = u.WebhookSubscriptionRepository.UpdateOneDelivery(delivery)
+
+	if repoErr != nil {
+		return exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error updating webhook delivery",
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"strings"
+)
+
+const exportBatchSize = 200
+
+type ExportProducts struct {
+	ProductRepository                   repository.Product
+	CategoryRepository                  repository.Category
+	SpecificationRepository             repository.Specification
+	ProductSpecificationValueRepository repository.ProductSpecificationValue
+	code                                string
+}
+
+func NewExportProducts(
+	productRepository repository.Product,
+	categoryRepository repository.Category,
+	specificationRepository repository.Specification,
+	productSpecificationValueRepository repository.ProductSpecificationValue,
+) *ExportProducts {
+	return &ExportProducts{
+		code:                                "ExportProducts",
+		ProductRepository:                   productRepository,
+		CategoryRepository:                  categoryRepository,
+		SpecificationRepository:             specificationRepository,
+		ProductSpecificationValueRepository: productSpecificationValueRepository,
+	}
+}
+
+// Execute resolves the filters and the specification columns right away, so invalid
+// input fails before anything is written, and returns the products as a sequence
+// that reads exportBatchSize products (and their values) at a time.
+func (u *ExportProducts) Execute(input *dto.ExportProductsInput) (*dto.ExportProductsOutput, exceptions.UsecaseException) {
+	cursorInput := entity.ProductCursorInput{}
+
+	if input.PaginatorInput != nil {
+		cursorInput.Skip = max(input.PaginatorInput.Skip, 0)
+		cursorInput.Limit = max(input.PaginatorInput.Limit, 0)
+	}
+
+	if input.CategoryPublicID != "" {
+		category, repoErr := u.CategoryRepository.GetOneByPublicID(input.CategoryPublicID)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error getting category",
+			})
+		}
+
+		cursorInput.CategoryID = category.ID
+	}
+
+	categories, repoErr := u.CategoryRepository.GetAll()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting categories",
+		})
+	}
+
+	specifications, repoErr := u.SpecificationRepository.GetAll()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specifications",
+		})
+	}
+
+	categoriesById := make(map[types.CategoryID]*entity.Category, len(categories))
+
+	for _, category := range categories {
+		categoriesById[category.ID] = category
+	}
+
+	columns := toExportSpecificationColumns(specifications)
+	columnsById := make(map[types.SpecificationID]*dto.ExportSpecificationColumn, len(columns))
+
+	for i, specification := range specifications {
+		columnsById[specification.ID] = columns[i]
+	}
+
+	return &dto.ExportProductsOutput{
+		Columns: columns,
+		Products: func(yield func(*dto.ExportProductUnit, exceptions.UsecaseException) bool) {
+			remaining := cursorInput.Limit
+
+			for {
+				batchInput := cursorInput
+				batchInput.Limit = exportBatchSize
+
+				if cursorInput.Limit > 0 {
+					batchInput.Limit = min(remaining, exportBatchSize)
+				}
+
+				products, repoErr := u.ProductRepository.GetManyAfterID(batchInput)
+
+				if repoErr != nil {
+					yield(nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+						Code:       u.code,
+						StatusCode: services.GetStatusCodeFromError(repoErr),
+						Message:    "Error getting products",
+					}))
+					return
+				}
+
+				if len(products) == 0 {
+					return
+				}
+
+				productIds := make([]types.ProductID, len(products))
+
+				for i, product := range products {
+					productIds[i] = product.ID
+				}
+
+				values, repoErr := u.ProductSpecificationValueRepository.FindManyByProductIDs(productIds)
+
+				if repoErr != nil {
+					yield(nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+						Code:       u.code,
+						StatusCode: services.GetStatusCodeFromError(repoErr),
+						Message:    "Error getting product specification values",
+					}))
+					return
+				}
+
+				valuesByProductId := make(map[types.ProductID][]*entity.ProductSpecificationValue, len(products))
+
+				for _, value := range values {
+					valuesByProductId[value.ProductID] = append(valuesByProductId[value.ProductID], value)
+				}
+
+				for _, product := range products {
+					unit := toExportProductUnit(product, categoriesById[product.CategoryID], valuesByProductId[product.ID], columnsById)
+
+					if !yield(unit, nil) {
+						return
+					}
+				}
+
+				cursorInput.AfterID = products[len(products)-1].ID
+				cursorInput.Skip = 0
+
+				if cursorInput.Limit > 0 {
+					remaining -= int64(len(products))
+
+					if remaining <= 0 {
+						return
+					}
+				}
+
+				if int64(len(products)) < batchInput.Limit {
+					return
+				}
+			}
+		},
+	}, nil
+}
+
+func toExportSpecificationColumns(specifications []*entity.Specification) []*dto.ExportSpecificationColumn {
+	titles := make(map[string]int, len(specifications))
+
+	for _, specification := range specifications {
+		titles[strings.ToLower(specification.Title)]++
+	}
+
+	columns := make([]*dto.ExportSpecificationColumn, len(specifications))
+
+	for i, specification := range specifications {
+		key := specification.Title
+
+		if titles[strings.ToLower(specification.Title)] > 1 {
+			key = string(specification.PublicID)
+		}
+
+		columns[i] = &dto.ExportSpecificationColumn{
+			Key:      key,
+			PublicID: specification.PublicID,
+			Title:    specification.Title,
+			Type:     specification.Type,
+		}
+	}
+
+	return columns
+}
+
+func toExportProductUnit(
+	product *entity.Product,
+	category *entity.Category,
+	values []*entity.ProductSpecificationValue,
+	columnsById map[types.SpecificationID]*dto.ExportSpecificationColumn,
+) *dto.ExportProductUnit {
+	unit := &dto.ExportProductUnit{
+		PublicID:       product.PublicID,
+		Name:           product.Name,
+		Description:    product.Description,
+		Price:          product.Price,
+		Rating:         product.Rating,
+		ImageURL:       product.ImageURL,
+		Specifications: make(map[string]any, len(values)),
+	}
+
+	if category != nil {
+		unit.Category = category.Name
+		unit.CategoryPublicID = category.PublicID
+	}
+
+	for _, value := range values {
+		column, exists := columnsById[value.SpecificationID]
+
+		if !exists {
+			continue
+		}
+
+		switch {
+		case value.Value.StringValue != nil:
+			unit.Specifications[column.Key] = *value.Value.StringValue
+		case value.Value.IntValue != nil:
+			unit.Specifications[column.Key] = *value.Value.IntValue
+		case value.Value.BoolValue != nil:
+			unit.Specifications[column.Key] = *value.Value.BoolValue
+		}
+	}
+
+	return unit
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetAllApiKeys struct {
+	ApiKeyRepository repository.ApiKey
+	code             string
+}
+
+func NewGetAllApiKeys(
+	apiKeyRepository repository.ApiKey,
+) *GetAllApiKeys {
+	return &GetAllApiKeys{
+		code:             "GetAllApiKeys",
+		ApiKeyRepository: apiKeyRepository,
+	}
+}
+
+// Execute lists the keys, the revoked ones included, without their hashes.
+func (u *GetAllApiKeys) Execute(input *dto.GetAllApiKeysInput) (*dto.GetAllApiKeysOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionApiKeyManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	apiKeys, repoErr := u.ApiKeyRepository.GetAll()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting api keys",
+		})
+	}
+
+	outputApiKeys := make([]*dto.ApiKeyOutput, len(apiKeys))
+
+	for i, apiKey := range apiKeys {
+		outputApiKeys[i] = toApiKeyOutput(apiKey)
+	}
+
+	return &dto.GetAllApiKeysOutput{
+		ApiKeys: outputApiKeys,
+	}, nil
+}
+
+func toApiKeyOutput(apiKey *entity.ApiKey) *dto.ApiKeyOutput {
+	output := &dto.ApiKeyOutput{
+		PublicID:  apiKey.PublicID,
+		Name:      apiKey.Name,
+		Role:      apiKey.Role,
+		CreatedAt: apiKey.CreatedAt,
+	}
+
+	if !apiKey.LastUsedAt.IsZero() {
+		output.LastUsedAt = &apiKey.LastUsedAt
+	}
+
+	if apiKey.IsRevoked() {
+		output.RevokedAt = &apiKey.RevokedAt
+	}
+
+	return output
+}
@@ -0,0 +1,321 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"slices"
+)
+
+type GetAllAuditEntries struct {
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewGetAllAuditEntries(
+	auditLogRepository repository.AuditLog,
+) *GetAllAuditEntries {
+	return &GetAllAuditEntries{
+		code:               "GetAllAuditEntries",
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+// Execute lists the audit log, the latest writes first.
+func (u *GetAllAuditEntries) Execute(input *dto.GetAllAuditEntriesInput) (*dto.GetAllAuditEntriesOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionAuditRead); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	if input.EntityType != "" && !slices.Contains(constants.AuditEntityTypes, input.EntityType) {
+		return nil, exceptions.Usecase(fmt.Errorf("invalid audit entity type %q", input.EntityType), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    fmt.Sprintf("Entity type must be one of %v", constants.AuditEntityTypes),
+		})
+	}
+
+	if input.Operation != "" && !slices.Contains(constants.AuditOperations, input.Operation) {
+		return nil, exceptions.Usecase(fmt.Errorf("invalid audit operation %q", input.Operation), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    fmt.Sprintf("Operation must be one of %v", constants.AuditOperations),
+		})
+	}
+
+	filter := entity.AuditLogFilter{
+		Actor:          input.Actor,
+		EntityType:     input.EntityType,
+		EntityPublicID: input.EntityPublicID,
+		Operation:      input.Operation,
+	}
+
+	var err error
+
+	if input.From != "" {
+		filter.From, err = services.ParseTimestamp(input.From)
+
+		if err != nil {
+			return nil, exceptions.Usecase(err, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 422,
+				Message:    "Invalid from",
+			})
+		}
+	}
+
+	if input.To != "" {
+		filter.To, err = services.ParseTimestamp(input.To)
+
+		if err != nil {
+			return nil, exceptions.Usecase(err, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 422,
+				Message:    "Invalid to",
+			})
+		}
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return nil, exceptions.Usecase(fmt.Errorf("audit range ends at %s before it starts at %s", filter.To, filter.From), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    "To cannot be before from",
+		})
+	}
+
+	paginationInput := entity.PaginatorInput{
+		Skip:  input.PaginatorInput.Skip,
+		Limit: input.PaginatorInput.Limit,
+	}
+
+	entries, paginationOutput, repoErr := u.AuditLogRepository.GetAll(filter, paginationInput)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting audit entries",
+		})
+	}
+
+	return &dto.GetAllAuditEntriesOutput{
+		PaginatorOutput: &dto.PaginatorOutput{Total: paginationOutput.Total},
+		Entries:         toAuditEntryOutputs(entries),
+	}, nil
+}
+
+func toAuditEntryOutputs(entries []*entity.AuditEntry) []*dto.AuditEntryOutput {
+	outputs := make([]*dto.AuditEntryOutput, len(entries))
+
+	for i, entry := range entries {
+		changes := make([]*dto.AuditChangeOutput, len(entry.Changes))
+
+		for j, change := range entry.Changes {
+			changes[j] = &dto.AuditChangeOutput{
+				Field:  change.Field,
+				Before: change.Before,
+				After:  change.After,
+			}
+		}
+
+		outputs[i] = &dto.AuditEntryOutput{
+			Actor:          entry.Actor,
+			EntityType:     entry.EntityType,
+			EntityPublicID: entry.EntityPublicID,
+			Operation:      entry.Operation,
+			Changes:        changes,
+			CreatedAt:      entry.CreatedAt,
+		}
+	}
+
+	return outputs
+}
+
+// auditWrite is a write to an entity, with its audited fields before and after it.
+// Before is nil for a create and After is nil for a delete.
+type auditWrite struct {
+	EntityType     types.AuditEntityType
+	EntityPublicID string
+	Operation      types.AuditOperation
+	Before         map[string]any
+	After          map[string]any
+}
+
+// recordAudit writes what the actor changed to the audit log. A write that changed
+// none of the audited fields, like an update that sent the same values, is skipped.
+func recordAudit(auditLogRepository repository.AuditLog, actor types.Actor, code string, writes ...auditWrite) exceptions.UsecaseException {
+	entries := make([]*entity.AuditEntry, 0, len(writes))
+
+	for _, write := range writes {
+		changes := entity.DiffAuditFields(write.Before, write.After)
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		entry, entityErr := entity.NewAuditEntry(entity.AuditEntryProps{
+			Actor:          actor,
+			EntityType:     write.EntityType,
+			EntityPublicID: write.EntityPublicID,
+			Operation:      write.Operation,
+			Changes:        changes,
+		})
+
+		if entityErr != nil {
+			return exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+				Code:       code,
+				StatusCode: 500,
+				Message:    "Error creating audit entry in domain",
+			})
+		}
+
+		entries = append(entries, entry)
+	}
+
+	var repoErr exceptions.RepositoryException
+
+	switch len(entries) {
+	case 0:
+		return nil
+	case 1:
+		repoErr = auditLogRepository.CreateOne(entries[0])
+	default:
+		repoErr = auditLogRepository.CreateMany(entries)
+	}
+
+	if repoErr != nil {
+		return exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error recording audit entry",
+		})
+	}
+
+	return nil
+}
+
+func productAuditFields(product *entity.Product, categoryPublicID types.CategoryPublicID) map[string]any {
+	fields := map[string]any{
+		"category_public_id": string(categoryPublicID),
+		"name":               string(product.Name),
+		"description":        product.Description,
+		"price":              product.Price,
+		"rating":             int64(product.Rating),
+		"image_url":          product.ImageURL,
+	}
+
+	if product.IsVariant() {
+		fields["parent_public_id"] = string(product.ParentPublicID)
+		fields["variant_label"] = product.VariantLabel
+	}
+
+	return fields
+}
+
+func categoryAuditFields(category *entity.Category) map[string]any {
+	return map[string]any{
+		"name":        category.Name,
+		"description": category.Description,
+	}
+}
+
+// categoryTemplateAuditFields records each specification of the template as its own
+// field, so a replaced template only shows the entries that changed.
+func categoryTemplateAuditFields(template *entity.CategoryTemplate) map[string]any {
+	fields := make(map[string]any, len(template.Specifications))
+
+	for _, categorySpecification := range template.Specifications {
+		fields["specifications."+string(categorySpecification.Specification.PublicID)] = map[string]any{
+			"requirement":   string(categorySpecification.Requirement),
+			"display_order": categorySpecification.DisplayOrder,
+		}
+	}
+
+	return fields
+}
+
+func specificationAuditFields(specification *entity.Specification, groupPublicID types.SpecificationGroupPublicID) map[string]any {
+	return map[string]any{
+		"title":                         specification.Title,
+		"type":                          string(specification.Type),
+		"specification_group_public_id": string(groupPublicID),
+	}
+}
+
+func specificationGroupAuditFields(group *entity.SpecificationGroup) map[string]any {
+	return map[string]any{
+		"name":        group.Name,
+		"description": group.Description,
+	}
+}
+
+func retailerAuditFields(retailer *entity.Retailer) map[string]any {
+	return map[string]any{
+		"name":        retailer.Name,
+		"website_url": retailer.WebsiteURL,
+	}
+}
+
+// productSpecificationAuditField is the field of a product specification value on
+// the audit log of its product.
+func productSpecificationAuditField(specificationPublicID types.SpecificationPublicID) string {
+	return "specifications." + string(specificationPublicID)
+}
+
+// productSpecificationAuditFields are the audited fields of one specification value
+// of a product, nil when the product has no value of its own.
+func productSpecificationAuditFields(specificationPublicID types.SpecificationPublicID, value *entity.ProductSpecificationValue) map[string]any {
+	if value == nil {
+		return nil
+	}
+
+	return map[string]any{
+		productSpecificationAuditField(specificationPublicID): specValueAuditValue(value.Value),
+	}
+}
+
+func specValueAuditValue(value *entity.SpecValue) any {
+	switch {
+	case value == nil:
+		return nil
+	case value.StringValue != nil:
+		return *value.StringValue
+	case value.IntValue != nil:
+		return *value.IntValue
+	case value.BoolValue != nil:
+		return *value.BoolValue
+	default:
+		return nil
+	}
+}
+
+func productOfferAuditFields(offer *entity.ProductOffer) map[string]any {
+	return map[string]any{
+		"offers." + string(offer.RetailerPublicID): map[string]any{
+			"price":        offer.Price,
+			"url":          offer.URL,
+			"availability": string(offer.Availability),
+		},
+	}
+}
+
+// productGalleryAuditFields records each image as its own field, so moving one image
+// also shows the images it shifted.
+func productGalleryAuditFields(images []*entity.ProductImage) map[string]any {
+	fields := make(map[string]any, len(images))
+
+	for _, image := range images {
+		fields["images."+string(image.PublicID)] = map[string]any{
+			"position": int64(image.Position),
+			"alt_text": image.AltText,
+		}
+	}
+
+	return fields
+}
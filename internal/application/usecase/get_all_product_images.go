@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetAllProductImages struct {
+	ProductRepository      repository.Product
+	ProductImageRepository repository.ProductImage
+	ImageStorage           repository.ImageStorage
+	code                   string
+}
+
+func NewGetAllProductImages(
+	productRepository repository.Product,
+	productImageRepository repository.ProductImage,
+	imageStorage repository.ImageStorage,
+) *GetAllProductImages {
+	return &GetAllProductImages{
+		code:                   "GetAllProductImages",
+		ProductRepository:      productRepository,
+		ProductImageRepository: productImageRepository,
+		ImageStorage:           imageStorage,
+	}
+}
+
+func (u *GetAllProductImages) Execute(input *dto.GetAllProductImagesInput) (*dto.GetAllProductImagesOutput, exceptions.UsecaseException) {
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	usecaseErr := loadProductGallery(u.ProductImageRepository, product, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	images := make([]*dto.ProductImageOutput, 0, len(product.Images))
+
+	for _, image := range product.Images {
+		images = append(images, toProductImageOutput(u.ImageStorage, image))
+	}
+
+	return &dto.GetAllProductImagesOutput{
+		Images: images,
+	}, nil
+}
+
+// loadProductGallery sets product.Images, the gallery operations of the entity need
+// the current images to keep the positions in sequence.
+func loadProductGallery(productImageRepository repository.ProductImage, product *entity.Product, code string) exceptions.UsecaseException {
+	images, repoErr := productImageRepository.GetAllByProductID(product.ID)
+
+	if repoErr != nil {
+		return exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product images",
+		})
+	}
+
+	product.Images = images
+
+	return nil
+}
+
+func toProductImageOutput(imageStorage repository.ImageStorage, image *entity.ProductImage) *dto.ProductImageOutput {
+	return &dto.ProductImageOutput{
+		PublicID:     image.PublicID,
+		Position:     image.Position,
+		AltText:      image.AltText,
+		ContentType:  image.ContentType,
+		Size:         image.Size,
+		Width:        image.Width,
+		Height:       image.Height,
+		URL:          imageStorage.URL(image.StorageKey),
+		ThumbnailURL: imageStorage.URL(image.ThumbnailKey),
+	}
+}
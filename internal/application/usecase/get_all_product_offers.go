@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"time"
+)
+
+type GetAllProductOffers struct {
+	ProductRepository      repository.Product
+	ProductOfferRepository repository.ProductOffer
+	code                   string
+}
+
+func NewGetAllProductOffers(
+	productRepository repository.Product,
+	productOfferRepository repository.ProductOffer,
+) *GetAllProductOffers {
+	return &GetAllProductOffers{
+		code:                   "GetAllProductOffers",
+		ProductRepository:      productRepository,
+		ProductOfferRepository: productOfferRepository,
+	}
+}
+
+func (u *GetAllProductOffers) Execute(input *dto.GetAllProductOffersInput) (*dto.GetAllProductOffersOutput, exceptions.UsecaseException) {
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	usecaseErr := attachProductOffers(u.ProductOfferRepository, []*entity.Product{product}, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	now := time.Now()
+
+	return &dto.GetAllProductOffersOutput{
+		BestOffer:   toProductOfferOutput(product.BestOffer(now), now),
+		PriceSpread: product.OfferSpread(now),
+		Offers:      toProductOfferOutputs(product.Offers, now),
+	}, nil
+}
+
+// attachProductOffers sets the Offers of every product with a single query.
+func attachProductOffers(productOfferRepository repository.ProductOffer, products []*entity.Product, code string) exceptions.UsecaseException {
+	productIds := make([]types.ProductID, len(products))
+	productsById := make(map[types.ProductID]*entity.Product, len(products))
+
+	for i, product := range products {
+		productIds[i] = product.ID
+		productsById[product.ID] = product
+		product.Offers = []*entity.ProductOffer{}
+	}
+
+	offers, repoErr := productOfferRepository.GetAllByProductIDs(productIds)
+
+	if repoErr != nil {
+		return exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product offers",
+		})
+	}
+
+	for _, offer := range offers {
+		if product, exists := productsById[offer.ProductID]; exists {
+			product.Offers = append(product.Offers, offer)
+		}
+	}
+
+	return nil
+}
+
+func toProductOfferOutput(offer *entity.ProductOffer, now time.Time) *dto.ProductOfferOutput {
+	if offer == nil {
+		return nil
+	}
+
+	return &dto.ProductOfferOutput{
+		PublicID:         offer.PublicID,
+		RetailerPublicID: offer.RetailerPublicID,
+		RetailerName:     offer.RetailerName,
+		Price:            offer.Price,
+		URL:              offer.URL,
+		Availability:     offer.Availability,
+		LastSeenAt:       offer.LastSeenAt,
+		Current:          offer.IsCurrent(now),
+	}
+}
+
+func toProductOfferOutputs(offers []*entity.ProductOffer, now time.Time) []*dto.ProductOfferOutput {
+	outputs := make([]*dto.ProductOfferOutput, len(offers))
+
+	for i, offer := range offers {
+		outputs[i] = toProductOfferOutput(offer, now)
+	}
+
+	return outputs
+}
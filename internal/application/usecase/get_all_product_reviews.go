@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"strconv"
+)
+
+type GetAllProductReviews struct {
+	ProductRepository       repository.Product
+	ProductReviewRepository repository.ProductReview
+	code                    string
+}
+
+func NewGetAllProductReviews(
+	productRepository repository.Product,
+	productReviewRepository repository.ProductReview,
+) *GetAllProductReviews {
+	return &GetAllProductReviews{
+		code:                    "GetAllProductReviews",
+		ProductRepository:       productRepository,
+		ProductReviewRepository: productReviewRepository,
+	}
+}
+
+// Execute lists the approved reviews, the most helpful first. Moderation lists the
+// pending or rejected ones through the status filter.
+func (u *GetAllProductReviews) Execute(input *dto.GetAllProductReviewsInput) (*dto.GetAllProductReviewsOutput, exceptions.UsecaseException) {
+	status := input.Status
+
+	switch status {
+	case "":
+		status = constants.ProductReviewStatusApproved
+	case constants.ProductReviewStatusPending, constants.ProductReviewStatusApproved, constants.ProductReviewStatusRejected:
+	default:
+		return nil, exceptions.Usecase(fmt.Errorf("invalid review status %q", status), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    fmt.Sprintf("Status must be %s, %s or %s", constants.ProductReviewStatusPending, constants.ProductReviewStatusApproved, constants.ProductReviewStatusRejected),
+		})
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	paginationInput := entity.PaginatorInput{
+		Skip:  input.PaginatorInput.Skip,
+		Limit: input.PaginatorInput.Limit,
+	}
+
+	reviews, paginationOutput, repoErr := u.ProductReviewRepository.GetAllByProductID(product.ID, status, paginationInput)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product reviews",
+		})
+	}
+
+	summary, repoErr := u.ProductReviewRepository.GetRatingSummary(product.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product rating summary",
+		})
+	}
+
+	outputReviews := make([]*dto.ProductReviewOutput, len(reviews))
+
+	for i, review := range reviews {
+		outputReviews[i] = toProductReviewOutput(review)
+	}
+
+	return &dto.GetAllProductReviewsOutput{
+		PaginatorOutput: &dto.PaginatorOutput{Total: paginationOutput.Total},
+		Summary:         toProductRatingSummaryOutput(summary),
+		Reviews:         outputReviews,
+	}, nil
+}
+
+func toProductReviewOutput(review *entity.ProductReview) *dto.ProductReviewOutput {
+	return &dto.ProductReviewOutput{
+		PublicID:     review.PublicID,
+		Author:       review.Author,
+		Stars:        review.Stars,
+		Text:         review.Text,
+		HelpfulVotes: review.HelpfulVotes,
+		Status:       review.Status,
+		CreatedAt:    review.CreatedAt,
+	}
+}
+
+func toProductRatingSummaryOutput(summary *entity.ProductRatingSummary) *dto.ProductRatingSummaryOutput {
+	distribution := make(map[string]int64, len(summary.Distribution))
+
+	for stars, count := range summary.Distribution {
+		distribution[strconv.Itoa(int(stars))] = count
+	}
+
+	return &dto.ProductRatingSummaryOutput{
+		Rating:       summary.Rating,
+		ReviewCount:  summary.Count,
+		Distribution: distribution,
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetAllProductVariants struct {
+	ProductRepository repository.Product
+	code              string
+}
+
+func NewGetAllProductVariants(
+	productRepository repository.Product,
+) *GetAllProductVariants {
+	return &GetAllProductVariants{
+		code:              "GetAllProductVariants",
+		ProductRepository: productRepository,
+	}
+}
+
+// Execute lists the variants of a family. For a variant it lists its siblings,
+// itself included.
+func (u *GetAllProductVariants) Execute(input *dto.GetAllProductVariantsInput) (*dto.GetAllProductVariantsOutput, exceptions.UsecaseException) {
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	if product.IsVariant() {
+		product, repoErr = u.ProductRepository.GetOneByPublicId(product.ParentPublicID)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error getting product family",
+			})
+		}
+	}
+
+	variants, repoErr := u.ProductRepository.GetAllVariants(product)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product variants",
+		})
+	}
+
+	return &dto.GetAllProductVariantsOutput{
+		Variants: toProductVariantOutputs(variants),
+	}, nil
+}
+
+func toProductVariantOutputs(variants []*entity.Product) []*dto.ProductVariantOutput {
+	outputs := make([]*dto.ProductVariantOutput, len(variants))
+
+	for i, variant := range variants {
+		outputs[i] = &dto.ProductVariantOutput{
+			PublicID:     variant.PublicID,
+			VariantLabel: variant.VariantLabel,
+			Name:         variant.Name,
+			Price:        variant.Price,
+			Rating:       variant.Rating,
+			ReviewCount:  variant.ReviewCount,
+			ImageURL:     variant.ImageURL,
+		}
+	}
+
+	return outputs
+}
+
+// inheritFamilySpecificationValues completes the specification values of a variant
+// with the ones of its family. Other products are left untouched.
+func inheritFamilySpecificationValues(productRepository repository.Product, product *entity.Product, code string) exceptions.UsecaseException {
+	if !product.IsVariant() {
+		return nil
+	}
+
+	parent, repoErr := productRepository.GetOneByPublicId(product.ParentPublicID)
+
+	if repoErr != nil {
+		return exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product family",
+		})
+	}
+
+	entityErr := product.InheritSpecificationValues(parent)
+
+	if entityErr != nil {
+		return exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: 500,
+			Message:    "Error inheriting family specifications",
+		})
+	}
+
+	return nil
+}
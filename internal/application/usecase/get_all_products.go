@@ -6,26 +6,31 @@ import (
 	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
+	"time"
 )
 
 type GetAllProducts struct {
-	ProductRepository repository.Product
-	code              string
+	ProductRepository      repository.Product
+	ProductOfferRepository repository.ProductOffer
+	code                   string
 }
 
 func NewGetAllProducts(
 	productRepository repository.Product,
+	productOfferRepository repository.ProductOffer,
 ) *GetAllProducts {
 	return &GetAllProducts{
-		ProductRepository: productRepository,
-		code:              "GetAllProducts",
+		ProductRepository:      productRepository,
+		ProductOfferRepository: productOfferRepository,
+		code:                   "GetAllProducts",
 	}
 }
 
 func (u *GetAllProducts) Execute(input *dto.GetAllProductsInput) (*dto.GetAllProductsOutput, exceptions.UsecaseException) {
 	paginationInput := entity.PaginatorInput{
-		Skip:  input.PaginatorInput.Skip,
-		Limit: input.PaginatorInput.Limit,
+		Skip:   input.PaginatorInput.Skip,
+		Limit:  input.PaginatorInput.Limit,
+		Search: input.PaginatorInput.Search,
 	}
 
 	products, paginationOutput, repoErr := u.ProductRepository.GetAll(paginationInput)
@@ -38,20 +43,31 @@ func (u *GetAllProducts) Execute(input *dto.GetAllProductsInput) (*dto.GetAllPro
 		})
 	}
 
+	usecaseErr := attachProductOffers(u.ProductOfferRepository, products, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	return u.toGetAllProductsOutput(products, paginationOutput)
 }
 
 func (u *GetAllProducts) toGetAllProductsOutput(products []*entity.Product, paginationOutput entity.PaginatorOutput) (*dto.GetAllProductsOutput, exceptions.UsecaseException) {
 	outputProducts := make([]*dto.GetAllProductsUnit, len(products))
+	now := time.Now()
 
 	for i, product := range products {
 		outputProducts[i] = &dto.GetAllProductsUnit{
 			PublicID:    product.PublicID,
 			Price:       product.Price,
 			Rating:      product.Rating,
+			ReviewCount: product.ReviewCount,
 			ImageURL:    product.ImageURL,
 			Name:        product.Name,
 			Description: product.Description,
+			BestOffer:   toProductOfferOutput(product.BestOffer(now), now),
+			PriceSpread: product.OfferSpread(now),
+			Variants:    toProductVariantOutputs(product.Variants),
 		}
 	}
 
@@ -6,22 +6,26 @@ import (
 	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
+	"time"
 )
 
 type GetAllProductsByCategoryId struct {
-	ProductRepository  repository.Product
-	CategoryRepository repository.Category
-	code               string
+	ProductRepository      repository.Product
+	CategoryRepository     repository.Category
+	ProductOfferRepository repository.ProductOffer
+	code                   string
 }
 
 func NewGetAllProductsByCategoryId(
 	productRepository repository.Product,
 	categoryRepository repository.Category,
+	productOfferRepository repository.ProductOffer,
 ) *GetAllProductsByCategoryId {
 	return &GetAllProductsByCategoryId{
-		ProductRepository:  productRepository,
-		CategoryRepository: categoryRepository,
-		code:               "GetAllProductsByCategoryId",
+		ProductRepository:      productRepository,
+		CategoryRepository:     categoryRepository,
+		ProductOfferRepository: productOfferRepository,
+		code:                   "GetAllProductsByCategoryId",
 	}
 }
 
@@ -37,8 +41,9 @@ func (u *GetAllProductsByCategoryId) Execute(input *dto.GetAllProductsByCategory
 	}
 
 	paginationInput := entity.PaginatorInput{
-		Skip:  input.PaginatorInput.Skip,
-		Limit: input.PaginatorInput.Limit,
+		Skip:   input.PaginatorInput.Skip,
+		Limit:  input.PaginatorInput.Limit,
+		Search: input.PaginatorInput.Search,
 	}
 
 	products, paginationOutput, repoErr := u.ProductRepository.GetAllByCategoryID(category.ID, paginationInput)
@@ -51,20 +56,31 @@ func (u *GetAllProductsByCategoryId) Execute(input *dto.GetAllProductsByCategory
 		})
 	}
 
+	usecaseErr := attachProductOffers(u.ProductOfferRepository, products, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	return u.toGetAllProductsByCategoryIdOutput(products, paginationOutput)
 }
 
 func (u *GetAllProductsByCategoryId) toGetAllProductsByCategoryIdOutput(products []*entity.Product, paginationOutput entity.PaginatorOutput) (*dto.GetAllProductsByCategoryIdOutput, exceptions.UsecaseException) {
 	outputProducts := make([]*dto.GetAllProductsByCategoryIdUnit, len(products))
+	now := time.Now()
 
 	for i, product := range products {
 		outputProducts[i] = &dto.GetAllProductsByCategoryIdUnit{
 			PublicID:    product.PublicID,
 			Price:       product.Price,
 			Rating:      product.Rating,
+			ReviewCount: product.ReviewCount,
 			ImageURL:    product.ImageURL,
 			Name:        product.Name,
 			Description: product.Description,
+			BestOffer:   toProductOfferOutput(product.BestOffer(now), now),
+			PriceSpread: product.OfferSpread(now),
+			Variants:    toProductVariantOutputs(product.Variants),
 		}
 	}
 
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetAllRetailers struct {
+	RetailerRepository repository.Retailer
+	code               string
+}
+
+func NewGetAllRetailers(
+	retailerRepository repository.Retailer,
+) *GetAllRetailers {
+	return &GetAllRetailers{
+		code:               "GetAllRetailers",
+		RetailerRepository: retailerRepository,
+	}
+}
+
+func (u *GetAllRetailers) Execute() (*dto.GetAllRetailersOutput, exceptions.UsecaseException) {
+	retailers, repoErr := u.RetailerRepository.GetAll()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error getting retailers",
+		})
+	}
+
+	outputRetailers := make([]*dto.RetailerOutput, len(retailers))
+
+	for i, retailer := range retailers {
+		outputRetailers[i] = &dto.RetailerOutput{
+			PublicID:   retailer.PublicID,
+			Name:       retailer.Name,
+			WebsiteURL: retailer.WebsiteURL,
+		}
+	}
+
+	return &dto.GetAllRetailersOutput{
+		Retailers: outputRetailers,
+	}, nil
+}
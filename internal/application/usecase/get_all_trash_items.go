@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"slices"
+	"time"
+)
+
+type GetAllTrashItems struct {
+	TrashRepository repository.Trash
+	Retention       time.Duration
+	code            string
+}
+
+func NewGetAllTrashItems(
+	trashRepository repository.Trash,
+	retention time.Duration,
+) *GetAllTrashItems {
+	return &GetAllTrashItems{
+		code:            "GetAllTrashItems",
+		TrashRepository: trashRepository,
+		Retention:       retention,
+	}
+}
+
+// Execute lists the trash, the latest deleted first, with when each item is purged.
+func (u *GetAllTrashItems) Execute(input *dto.GetAllTrashItemsInput) (*dto.GetAllTrashItemsOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionTrashRead); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	if input.EntityType != "" && !slices.Contains(constants.TrashEntityTypes, input.EntityType) {
+		return nil, exceptions.Usecase(fmt.Errorf("invalid trash entity type %q", input.EntityType), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    fmt.Sprintf("Entity type must be one of %v", constants.TrashEntityTypes),
+		})
+	}
+
+	paginationInput := entity.PaginatorInput{
+		Skip:  input.PaginatorInput.Skip,
+		Limit: input.PaginatorInput.Limit,
+	}
+
+	items, paginationOutput, repoErr := u.TrashRepository.GetAll(entity.TrashFilter{EntityType: input.EntityType}, paginationInput)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting trash items",
+		})
+	}
+
+	return &dto.GetAllTrashItemsOutput{
+		PaginatorOutput: &dto.PaginatorOutput{Total: paginationOutput.Total},
+		Items:           toTrashItemOutputs(items, u.Retention),
+	}, nil
+}
+
+func toTrashItemOutputs(items []*entity.TrashItem, retention time.Duration) []*dto.TrashItemOutput {
+	outputs := make([]*dto.TrashItemOutput, len(items))
+
+	for i, item := range items {
+		outputs[i] = &dto.TrashItemOutput{
+			EntityType: item.EntityType,
+			PublicID:   item.PublicID,
+			Name:       item.Name,
+			DeletedAt:  item.DeletedAt,
+			PurgeAt:    item.PurgeAt(retention),
+		}
+	}
+
+	return outputs
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetAllWatchlistDeliveries struct {
+	WatchlistRepository repository.Watchlist
+	code                string
+}
+
+func NewGetAllWatchlistDeliveries(
+	watchlistRepository repository.Watchlist,
+) *GetAllWatchlistDeliveries {
+	return &GetAllWatchlistDeliveries{
+		code:                "GetAllWatchlistDeliveries",
+		WatchlistRepository: watchlistRepository,
+	}
+}
+
+// Execute lists the alerts sent for the watchlist, the latest first.
+func (u *GetAllWatchlistDeliveries) Execute(input *dto.GetAllWatchlistDeliveriesInput) (*dto.GetAllWatchlistDeliveriesOutput, exceptions.UsecaseException) {
+	switch input.Status {
+	case "", constants.WatchlistDeliveryStatusDelivered, constants.WatchlistDeliveryStatusFailed:
+	default:
+		return nil, exceptions.Usecase(fmt.Errorf("invalid delivery status %q", input.Status), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    fmt.Sprintf("Status must be %s or %s", constants.WatchlistDeliveryStatusDelivered, constants.WatchlistDeliveryStatusFailed),
+		})
+	}
+
+	watchlist, repoErr := u.WatchlistRepository.GetOneByPublicID(input.PublicID, input.SubscriberToken)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting watchlist",
+		})
+	}
+
+	paginationInput := entity.PaginatorInput{
+		Skip:  input.PaginatorInput.Skip,
+		Limit: input.PaginatorInput.Limit,
+	}
+
+	deliveries, paginationOutput, repoErr := u.WatchlistRepository.GetAllDeliveries(watchlist.ID, input.Status, paginationInput)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting watchlist deliveries",
+		})
+	}
+
+	outputDeliveries := make([]*dto.WatchlistDeliveryOutput, len(deliveries))
+
+	for i, delivery := range deliveries {
+		outputDeliveries[i] = &dto.WatchlistDeliveryOutput{
+			Trigger:        delivery.Trigger,
+			Price:          delivery.Price,
+			ThresholdPrice: delivery.ThresholdPrice,
+			Status:         delivery.Status,
+			Attempts:       delivery.Attempts,
+			StatusCode:     delivery.StatusCode,
+			Error:          delivery.Error,
+			CreatedAt:      delivery.CreatedAt,
+		}
+	}
+
+	return &dto.GetAllWatchlistDeliveriesOutput{
+		PaginatorOutput: &dto.PaginatorOutput{Total: paginationOutput.Total},
+		Deliveries:      outputDeliveries,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetAllWatchlists struct {
+	WatchlistRepository repository.Watchlist
+	code                string
+}
+
+func NewGetAllWatchlists(
+	watchlistRepository repository.Watchlist,
+) *GetAllWatchlists {
+	return &GetAllWatchlists{
+		code:                "GetAllWatchlists",
+		WatchlistRepository: watchlistRepository,
+	}
+}
+
+func (u *GetAllWatchlists) Execute(input *dto.GetAllWatchlistsInput) (*dto.GetAllWatchlistsOutput, exceptions.UsecaseException) {
+	watchlists, repoErr := u.WatchlistRepository.GetAllBySubscriberToken(input.SubscriberToken)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting watchlists",
+		})
+	}
+
+	outputWatchlists := make([]*dto.WatchlistOutput, len(watchlists))
+
+	for i, watchlist := range watchlists {
+		outputWatchlists[i] = toWatchlistOutput(watchlist)
+	}
+
+	return &dto.GetAllWatchlistsOutput{
+		Watchlists: outputWatchlists,
+	}, nil
+}
+
+func toWatchlistOutput(watchlist *entity.Watchlist) *dto.WatchlistOutput {
+	return &dto.WatchlistOutput{
+		PublicID:          watchlist.PublicID,
+		ProductPublicID:   watchlist.ProductPublicID,
+		TargetPrice:       watchlist.TargetPrice,
+		DropPercent:       watchlist.DropPercent,
+		BasePrice:         watchlist.BasePrice,
+		ThresholdPrice:    watchlist.ThresholdPrice(),
+		LastNotifiedPrice: watchlist.LastNotifiedPrice,
+		CreatedAt:         watchlist.CreatedAt,
+	}
+}
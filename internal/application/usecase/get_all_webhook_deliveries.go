@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetAllWebhookDeliveries struct {
+	WebhookSubscriptionRepository repository.WebhookSubscription
+	code                          string
+}
+
+func NewGetAllWebhookDeliveries(
+	webhookSubscriptionRepository repository.WebhookSubscription,
+) *GetAllWebhookDeliveries {
+	return &GetAllWebhookDeliveries{
+		code:                          "GetAllWebhookDeliveries",
+		WebhookSubscriptionRepository: webhookSubscriptionRepository,
+	}
+}
+
+// Execute lists the deliveries of the subscription, the latest first. The dead
+// letters are the ones with the dead status.
+func (u *GetAllWebhookDeliveries) Execute(input *dto.GetAllWebhookDeliveriesInput) (*dto.GetAllWebhookDeliveriesOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionWebhookRead); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	switch input.Status {
+	case "", constants.WebhookDeliveryStatusPending, constants.WebhookDeliveryStatusSending, constants.WebhookDeliveryStatusDelivered, constants.WebhookDeliveryStatusDead:
+	default:
+		return nil, exceptions.Usecase(fmt.Errorf("invalid delivery status %q", input.Status), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    fmt.Sprintf("Status must be %s, %s, %s or %s", constants.WebhookDeliveryStatusPending, constants.WebhookDeliveryStatusSending, constants.WebhookDeliveryStatusDelivered, constants.WebhookDeliveryStatusDead),
+		})
+	}
+
+	subscription, repoErr := u.WebhookSubscriptionRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting webhook subscription",
+		})
+	}
+
+	paginationInput := entity.PaginatorInput{
+		Skip:  input.PaginatorInput.Skip,
+		Limit: input.PaginatorInput.Limit,
+	}
+
+	deliveries, paginationOutput, repoErr := u.WebhookSubscriptionRepository.GetAllDeliveries(subscription.ID, input.Status, paginationInput)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting webhook deliveries",
+		})
+	}
+
+	outputDeliveries := make([]*dto.WebhookDeliveryOutput, len(deliveries))
+
+	for i, delivery := range deliveries {
+		outputDeliveries[i] = &dto.WebhookDeliveryOutput{
+			EventID:           delivery.EventID,
+			EventType:         delivery.Event.Type,
+			AggregateType:     delivery.Event.AggregateType,
+			AggregatePublicID: delivery.Event.AggregatePublicID,
+			Status:            delivery.Status,
+			Attempts:          delivery.Attempts,
+			LastStatusCode:    delivery.LastStatusCode,
+			LastError:         delivery.LastError,
+			CreatedAt:         delivery.CreatedAt,
+		}
+
+		if delivery.Status == constants.WebhookDeliveryStatusPending {
+			outputDeliveries[i].NextAttemptAt = &delivery.NextAttemptAt
+		}
+
+		if !delivery.DeliveredAt.IsZero() {
+			outputDeliveries[i].DeliveredAt = &delivery.DeliveredAt
+		}
+	}
+
+	return &dto.GetAllWebhookDeliveriesOutput{
+		PaginatorOutput: &dto.PaginatorOutput{Total: paginationOutput.Total},
+		Deliveries:      outputDeliveries,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetAllWebhookSubscriptions struct {
+	WebhookSubscriptionRepository repository.WebhookSubscription
+	code                          string
+}
+
+func NewGetAllWebhookSubscriptions(
+	webhookSubscriptionRepository repository.WebhookSubscription,
+) *GetAllWebhookSubscriptions {
+	return &GetAllWebhookSubscriptions{
+		code:                          "GetAllWebhookSubscriptions",
+		WebhookSubscriptionRepository: webhookSubscriptionRepository,
+	}
+}
+
+// Execute lists the subscriptions without their secrets.
+func (u *GetAllWebhookSubscriptions) Execute(input *dto.GetAllWebhookSubscriptionsInput) (*dto.GetAllWebhookSubscriptionsOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionWebhookRead); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	subscriptions, repoErr := u.WebhookSubscriptionRepository.GetAll()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting webhook subscriptions",
+		})
+	}
+
+	outputSubscriptions := make([]*dto.WebhookSubscriptionOutput, len(subscriptions))
+
+	for i, subscription := range subscriptions {
+		outputSubscriptions[i] = toWebhookSubscriptionOutput(subscription)
+	}
+
+	return &dto.GetAllWebhookSubscriptionsOutput{
+		Subscriptions: outputSubscriptions,
+	}, nil
+}
+
+func toWebhookSubscriptionOutput(subscription *entity.WebhookSubscription) *dto.WebhookSubscriptionOutput {
+	return &dto.WebhookSubscriptionOutput{
+		PublicID:   subscription.PublicID,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
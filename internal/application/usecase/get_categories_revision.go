@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetCategoriesRevision struct {
+	CategoryRepository repository.Category
+	code               string
+}
+
+func NewGetCategoriesRevision(
+	categoryRepository repository.Category,
+) *GetCategoriesRevision {
+	return &GetCategoriesRevision{
+		CategoryRepository: categoryRepository,
+		code:               "GetCategoriesRevision",
+	}
+}
+
+func (u *GetCategoriesRevision) Execute() (*dto.RevisionOutput, exceptions.UsecaseException) {
+	revision, repoErr := u.CategoryRepository.GetRevision()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting categories revision",
+		})
+	}
+
+	return &dto.RevisionOutput{
+		Tag:        revision.Tag,
+		ModifiedAt: revision.ModifiedAt,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetCategorySpecificationTemplate struct {
+	CategoryRepository              repository.Category
+	CategorySpecificationRepository repository.CategorySpecification
+	code                            string
+}
+
+func NewGetCategorySpecificationTemplate(
+	categoryRepository repository.Category,
+	categorySpecificationRepository repository.CategorySpecification,
+) *GetCategorySpecificationTemplate {
+	return &GetCategorySpecificationTemplate{
+		code:                            "GetCategorySpecificationTemplate",
+		CategoryRepository:              categoryRepository,
+		CategorySpecificationRepository: categorySpecificationRepository,
+	}
+}
+
+func (u *GetCategorySpecificationTemplate) Execute(input *dto.GetCategorySpecificationTemplateInput) (*dto.GetCategorySpecificationTemplateOutput, exceptions.UsecaseException) {
+	category, repoErr := u.CategoryRepository.GetOneByPublicID(input.CategoryPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category",
+		})
+	}
+
+	template, repoErr := u.CategorySpecificationRepository.GetTemplateByCategoryID(category.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category specification template",
+		})
+	}
+
+	return &dto.GetCategorySpecificationTemplateOutput{
+		CategoryPublicID: category.PublicID,
+		Specifications:   toCategorySpecificationOutputs(template.Specifications),
+	}, nil
+}
+
+func toCategorySpecificationOutputs(specifications []*entity.CategorySpecification) []*dto.CategorySpecificationOutput {
+	outputs := make([]*dto.CategorySpecificationOutput, len(specifications))
+
+	for i, specification := range specifications {
+		outputs[i] = &dto.CategorySpecificationOutput{
+			Requirement:  specification.Requirement,
+			DisplayOrder: specification.DisplayOrder,
+		}
+
+		if specification.Specification != nil {
+			outputs[i].SpecificationPublicID = specification.Specification.PublicID
+			outputs[i].Title = specification.Specification.Title
+			outputs[i].Type = specification.Specification.Type
+		}
+	}
+
+	return outputs
+}
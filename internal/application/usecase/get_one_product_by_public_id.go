@@ -3,21 +3,26 @@ package usecase
 import (
 	"project/internal/application/dto"
 	"project/internal/application/services"
+	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
+	"time"
 )
 
 type GetOneProductByPublicId struct {
-	code              string
-	ProductRepository repository.Product
+	code                   string
+	ProductRepository      repository.Product
+	ProductOfferRepository repository.ProductOffer
 }
 
 func NewGetOneProductByPublicId(
 	productRepository repository.Product,
+	productOfferRepository repository.ProductOffer,
 ) *GetOneProductByPublicId {
 	return &GetOneProductByPublicId{
-		code:              "GetOneProductByPublicId",
-		ProductRepository: productRepository,
+		code:                   "GetOneProductByPublicId",
+		ProductRepository:      productRepository,
+		ProductOfferRepository: productOfferRepository,
 	}
 }
 
@@ -32,11 +37,26 @@ func (u *GetOneProductByPublicId) Execute(input *dto.GetOneProductByPublicIdInpu
 		})
 	}
 
+	usecaseErr := attachProductOffers(u.ProductOfferRepository, []*entity.Product{product}, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	now := time.Now()
+
 	return &dto.GetOneProductByPublicIdOutput{
-		Price:       product.Price,
-		Rating:      product.Rating,
-		ImageURL:    product.ImageURL,
-		Name:        product.Name,
-		Description: product.Description,
+		Price:          product.Price,
+		Rating:         product.Rating,
+		ReviewCount:    product.ReviewCount,
+		ImageURL:       product.ImageURL,
+		Name:           product.Name,
+		Description:    product.Description,
+		ParentPublicID: product.ParentPublicID,
+		VariantLabel:   product.VariantLabel,
+		BestOffer:      toProductOfferOutput(product.BestOffer(now), now),
+		PriceSpread:    product.OfferSpread(now),
+		Offers:         toProductOfferOutputs(product.Offers, now),
+		Version:        product.Version,
 	}, nil
 }
@@ -9,19 +9,23 @@ import (
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
 	"project/internal/domain/types"
+	"time"
 )
 
 type GetOneProductWithSpecificationsByPublicId struct {
-	ProductRepository repository.Product
-	code              string
+	ProductRepository      repository.Product
+	ProductOfferRepository repository.ProductOffer
+	code                   string
 }
 
 func NewGetOneProductWithSpecificationsByPublicId(
 	productRepository repository.Product,
+	productOfferRepository repository.ProductOffer,
 ) *GetOneProductWithSpecificationsByPublicId {
 	return &GetOneProductWithSpecificationsByPublicId{
-		code:              "GetOneProductWithSpecificationsByPublicId",
-		ProductRepository: productRepository,
+		code:                   "GetOneProductWithSpecificationsByPublicId",
+		ProductRepository:      productRepository,
+		ProductOfferRepository: productOfferRepository,
 	}
 }
 
@@ -36,13 +40,41 @@ func (u *GetOneProductWithSpecificationsByPublicId) Execute(input *dto.GetOnePro
 		})
 	}
 
+	usecaseErr := attachProductOffers(u.ProductOfferRepository, []*entity.Product{productAggregate.Product}, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	now := time.Now()
+
 	output := &dto.GetOneProductWithSpecificationsByPublicIdOutput{
 		Price:                productAggregate.Product.Price,
 		Rating:               productAggregate.Product.Rating,
+		ReviewCount:          productAggregate.Product.ReviewCount,
 		ImageURL:             productAggregate.Product.ImageURL,
 		Name:                 productAggregate.Product.Name,
 		Description:          productAggregate.Product.Description,
 		SpecificationsGroups: []*dto.ProductSpecificationGroupOutput{},
+		ParentPublicID:       productAggregate.Product.ParentPublicID,
+		VariantLabel:         productAggregate.Product.VariantLabel,
+		BestOffer:            toProductOfferOutput(productAggregate.Product.BestOffer(now), now),
+		PriceSpread:          productAggregate.Product.OfferSpread(now),
+		Offers:               toProductOfferOutputs(productAggregate.Product.Offers, now),
+	}
+
+	if !productAggregate.Product.IsVariant() {
+		variants, repoErr := u.ProductRepository.GetAllVariants(productAggregate.Product)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error getting product variants",
+			})
+		}
+
+		output.Variants = toProductVariantOutputs(variants)
 	}
 
 	if !productAggregate.Product.HasSpecifications() {
@@ -71,9 +103,11 @@ func (u *GetOneProductWithSpecificationsByPublicId) Execute(input *dto.GetOnePro
 			}
 
 			outputSpecification := &dto.ProductSpecificationOutput{
-				PublicID: specification.PublicID,
-				Title:    specification.Title,
-				Type:     specification.Type,
+				PublicID:  specification.PublicID,
+				Title:     specification.Title,
+				Type:      specification.Type,
+				Inherited: specificationValue.Inherited,
+				Version:   specificationValue.Version,
 			}
 
 			switch specification.Type {
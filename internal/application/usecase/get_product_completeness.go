@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetProductCompleteness struct {
+	ProductRepository               repository.Product
+	CategorySpecificationRepository repository.CategorySpecification
+	code                            string
+}
+
+func NewGetProductCompleteness(
+	productRepository repository.Product,
+	categorySpecificationRepository repository.CategorySpecification,
+) *GetProductCompleteness {
+	return &GetProductCompleteness{
+		code:                            "GetProductCompleteness",
+		ProductRepository:               productRepository,
+		CategorySpecificationRepository: categorySpecificationRepository,
+	}
+}
+
+func (u *GetProductCompleteness) Execute(input *dto.GetProductCompletenessInput) (*dto.GetProductCompletenessOutput, exceptions.UsecaseException) {
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	if usecaseErr := inheritFamilySpecificationValues(u.ProductRepository, product, u.code); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	template, repoErr := u.CategorySpecificationRepository.GetTemplateByCategoryID(product.CategoryID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category specification template",
+		})
+	}
+
+	completeness := template.Completeness(product.SpecificationValues)
+
+	return &dto.GetProductCompletenessOutput{
+		ProductPublicID:    product.PublicID,
+		HasTemplate:        !template.IsEmpty(),
+		Complete:           completeness.Complete(),
+		Published:          product.IsPublished(),
+		RequiredTotal:      completeness.RequiredTotal,
+		RequiredFilled:     completeness.RequiredFilled,
+		RecommendedTotal:   completeness.RecommendedTotal,
+		RecommendedFilled:  completeness.RecommendedFilled,
+		MissingRequired:    toCategorySpecificationOutputs(completeness.MissingRequired),
+		MissingRecommended: toCategorySpecificationOutputs(completeness.MissingRecommended),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetProductHistory struct {
+	ProductRepository  repository.Product
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewGetProductHistory(
+	productRepository repository.Product,
+	auditLogRepository repository.AuditLog,
+) *GetProductHistory {
+	return &GetProductHistory{
+		code:               "GetProductHistory",
+		ProductRepository:  productRepository,
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+// Execute lists the writes to the product, the latest first, including the ones to
+// its specification values, offers and images. The history of a deleted product is
+// still listed.
+func (u *GetProductHistory) Execute(input *dto.GetProductHistoryInput) (*dto.GetProductHistoryOutput, exceptions.UsecaseException) {
+	filter := entity.AuditLogFilter{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(input.PublicID),
+	}
+
+	paginationInput := entity.PaginatorInput{
+		Skip:  input.PaginatorInput.Skip,
+		Limit: input.PaginatorInput.Limit,
+	}
+
+	entries, paginationOutput, repoErr := u.AuditLogRepository.GetAll(filter, paginationInput)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product history",
+		})
+	}
+
+	// a product without history may still exist, written before the audit log
+	if paginationOutput.Total == 0 {
+		_, repoErr = u.ProductRepository.GetOneByPublicId(input.PublicID)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error getting product",
+			})
+		}
+	}
+
+	return &dto.GetProductHistoryOutput{
+		PaginatorOutput: &dto.PaginatorOutput{Total: paginationOutput.Total},
+		Entries:         toAuditEntryOutputs(entries),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"time"
+)
+
+type GetProductsRevision struct {
+	ProductRepository repository.Product
+	code              string
+}
+
+func NewGetProductsRevision(
+	productRepository repository.Product,
+) *GetProductsRevision {
+	return &GetProductsRevision{
+		ProductRepository: productRepository,
+		code:              "GetProductsRevision",
+	}
+}
+
+// Execute combines the revisions of the products in order, so a comparison changes
+// when either side does and swapping the sides gives another one.
+func (u *GetProductsRevision) Execute(input *dto.GetProductsRevisionInput) (*dto.RevisionOutput, exceptions.UsecaseException) {
+	now := time.Now()
+
+	var revision *entity.Revision
+
+	for _, publicId := range input.PublicIDs {
+		productRevision, repoErr := u.ProductRepository.GetRevision(publicId, now)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error getting product revision",
+			})
+		}
+
+		if revision == nil {
+			revision = productRevision
+			continue
+		}
+
+		revision = revision.Combine(productRevision)
+	}
+
+	if revision == nil {
+		revision = entity.NewRevision()
+	}
+
+	return &dto.RevisionOutput{
+		Tag:        revision.Tag,
+		ModifiedAt: revision.ModifiedAt,
+	}, nil
+}
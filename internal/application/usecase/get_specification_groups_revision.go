@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type GetSpecificationGroupsRevision struct {
+	SpecificationGroupRepository repository.SpecificationGroup
+	code                         string
+}
+
+func NewGetSpecificationGroupsRevision(
+	specificationGroupRepository repository.SpecificationGroup,
+) *GetSpecificationGroupsRevision {
+	return &GetSpecificationGroupsRevision{
+		SpecificationGroupRepository: specificationGroupRepository,
+		code:                         "GetSpecificationGroupsRevision",
+	}
+}
+
+func (u *GetSpecificationGroupsRevision) Execute() (*dto.RevisionOutput, exceptions.UsecaseException) {
+	revision, repoErr := u.SpecificationGroupRepository.GetRevision()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification groups revision",
+		})
+	}
+
+	return &dto.RevisionOutput{
+		Tag:        revision.Tag,
+		ModifiedAt: revision.ModifiedAt,
+	}, nil
+}
@@ -0,0 +1,351 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	domainServices "project/internal/domain/services"
+	"project/internal/domain/types"
+	"strconv"
+	"strings"
+)
+
+const (
+	fakeStoreProductCreated   = "created"
+	fakeStoreProductUpdated   = "updated"
+	fakeStoreProductUnchanged = "unchanged"
+)
+
+type ImportFakeStoreProducts struct {
+	ProductRepository           repository.Product
+	CategoryRepository          repository.Category
+	ExternalReferenceRepository repository.ExternalReference
+	AuditLogRepository          repository.AuditLog
+	code                        string
+}
+
+func NewImportFakeStoreProducts(
+	productRepository repository.Product,
+	categoryRepository repository.Category,
+	externalReferenceRepository repository.ExternalReference,
+	auditLogRepository repository.AuditLog,
+) *ImportFakeStoreProducts {
+	return &ImportFakeStoreProducts{
+		code:                        "ImportFakeStoreProducts",
+		ProductRepository:           productRepository,
+		CategoryRepository:          categoryRepository,
+		ExternalReferenceRepository: externalReferenceRepository,
+		AuditLogRepository:          auditLogRepository,
+	}
+}
+
+// Execute imports Fake Store products one by one. Products already imported from the
+// source, found through their external ID, are updated in place, so importing the
+// same file again creates nothing new. Categories are matched by name (case
+// insensitive) and created when missing.
+func (u *ImportFakeStoreProducts) Execute(input *dto.ImportFakeStoreProductsInput) (*dto.ImportFakeStoreProductsOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionCatalogImport); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	categories, repoErr := u.CategoryRepository.GetAll()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting categories",
+		})
+	}
+
+	categoriesByName := make(map[string]*entity.Category, len(categories))
+
+	for _, category := range categories {
+		categoriesByName[strings.ToLower(category.Name)] = category
+	}
+
+	output := &dto.ImportFakeStoreProductsOutput{
+		Total:    len(input.Products),
+		Products: []*dto.ImportedFakeStoreProduct{},
+		Errors:   []*dto.ImportProductError{},
+	}
+
+	for i, item := range input.Products {
+		imported, rowError, usecaseErr := u.importProduct(i+1, item, categoriesByName, output, input.Actor)
+
+		if usecaseErr != nil {
+			return nil, usecaseErr
+		}
+
+		if rowError != nil {
+			output.Failed++
+			output.Errors = append(output.Errors, rowError)
+			continue
+		}
+
+		switch imported.Status {
+		case fakeStoreProductCreated:
+			output.Created++
+		case fakeStoreProductUpdated:
+			output.Updated++
+		default:
+			output.Unchanged++
+		}
+
+		output.Products = append(output.Products, imported)
+	}
+
+	return output, nil
+}
+
+func (u *ImportFakeStoreProducts) importProduct(
+	row int,
+	item *dto.FakeStoreProduct,
+	categoriesByName map[string]*entity.Category,
+	output *dto.ImportFakeStoreProductsOutput,
+	actor types.Actor,
+) (*dto.ImportedFakeStoreProduct, *dto.ImportProductError, exceptions.UsecaseException) {
+	name := strings.TrimSpace(item.Title)
+	externalId := strconv.FormatInt(item.ID, 10)
+
+	rowError := func(field string, message string) *dto.ImportProductError {
+		return &dto.ImportProductError{
+			Row:     row,
+			Name:    name,
+			Field:   field,
+			Message: message,
+		}
+	}
+
+	if item.ID <= 0 {
+		return nil, rowError("id", "id must be greater than 0"), nil
+	}
+
+	rating, err := domainServices.ConvertStarsToRating(item.Rating.Rate)
+
+	if err != nil {
+		return nil, rowError("rating", err.Error()), nil
+	}
+
+	categoryName := strings.TrimSpace(item.Category)
+
+	if categoryName == "" {
+		return nil, rowError("category", "category is required"), nil
+	}
+
+	category, exists := categoriesByName[strings.ToLower(categoryName)]
+
+	if !exists {
+		newCategory, entityErr := entity.NewCategory(entity.CategoryProps{
+			Name: categoryName,
+		})
+
+		if entityErr != nil {
+			return nil, rowError("category", string(entityErr.Instance().Err)), nil
+		}
+
+		repoErr := u.CategoryRepository.CreateOne(newCategory)
+
+		if repoErr != nil {
+			return nil, nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    fmt.Sprintf("Error creating category %q", categoryName),
+			})
+		}
+
+		usecaseErr := recordAudit(u.AuditLogRepository, actor, u.code, auditWrite{
+			EntityType:     constants.AuditEntityCategory,
+			EntityPublicID: string(newCategory.PublicID),
+			Operation:      constants.AuditOperationCreate,
+			After:          categoryAuditFields(newCategory),
+		})
+
+		if usecaseErr != nil {
+			return nil, nil, usecaseErr
+		}
+
+		category = newCategory
+		categoriesByName[strings.ToLower(categoryName)] = category
+		output.CategoriesCreated++
+	}
+
+	props := entity.UpdateProductProps{
+		CategoryID:  category.ID,
+		Name:        types.ProductName(name),
+		Description: strings.TrimSpace(item.Description),
+		Price:       domainServices.ConvertAmountToCents(item.Price),
+		Rating:      rating,
+		ImageURL:    strings.TrimSpace(item.Image),
+	}
+
+	externalReference, repoErr := u.ExternalReferenceRepository.GetOneBySourceAndExternalID(constants.ExternalSourceFakeStore, externalId)
+
+	if repoErr != nil && services.GetStatusCodeFromError(repoErr) != 404 {
+		return nil, nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting external reference",
+		})
+	}
+
+	if externalReference != nil {
+		return u.updateProduct(externalReference, category, props, actor, rowError)
+	}
+
+	productExists, repoErr := u.ProductRepository.ExistsByName(props.Name, "")
+
+	if repoErr != nil {
+		return nil, nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if product exists",
+		})
+	}
+
+	if productExists {
+		return nil, rowError("name", fmt.Sprintf("product %q already exists and was not imported from this source", name)), nil
+	}
+
+	product, entityErr := entity.NewProduct(entity.ProductProps{
+		CategoryID:  props.CategoryID,
+		Name:        props.Name,
+		Description: props.Description,
+		Price:       props.Price,
+		Rating:      props.Rating,
+		ImageURL:    props.ImageURL,
+	})
+
+	if entityErr != nil {
+		return nil, rowError("product", string(entityErr.Instance().Err)), nil
+	}
+
+	newExternalReference, entityErr := entity.NewExternalReference(entity.ExternalReferenceProps{
+		Source:     constants.ExternalSourceFakeStore,
+		ExternalID: externalId,
+	})
+
+	if entityErr != nil {
+		return nil, rowError("id", string(entityErr.Instance().Err)), nil
+	}
+
+	repoErr = u.ProductRepository.CreateOneWithExternalReference(product, newExternalReference)
+
+	if repoErr != nil {
+		return nil, nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    fmt.Sprintf("Error creating product %q", name),
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationCreate,
+		After:          productAuditFields(product, category.PublicID),
+	})
+
+	if usecaseErr != nil {
+		return nil, nil, usecaseErr
+	}
+
+	return &dto.ImportedFakeStoreProduct{
+		ExternalID: externalId,
+		PublicID:   product.PublicID,
+		Name:       product.Name,
+		Status:     fakeStoreProductCreated,
+	}, nil, nil
+}
+
+func (u *ImportFakeStoreProducts) updateProduct(
+	externalReference *entity.ExternalReference,
+	category *entity.Category,
+	props entity.UpdateProductProps,
+	actor types.Actor,
+	rowError func(field string, message string) *dto.ImportProductError,
+) (*dto.ImportedFakeStoreProduct, *dto.ImportProductError, exceptions.UsecaseException) {
+	product, repoErr := u.ProductRepository.GetOneByPublicId(externalReference.ProductPublicID)
+
+	if repoErr != nil {
+		if services.GetStatusCodeFromError(repoErr) == 404 {
+			return nil, rowError("id", fmt.Sprintf("product %s imported from this source was deleted", externalReference.ProductPublicID)), nil
+		}
+
+		return nil, nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	imported := &dto.ImportedFakeStoreProduct{
+		ExternalID: externalReference.ExternalID,
+		PublicID:   product.PublicID,
+		Name:       props.Name,
+		Status:     fakeStoreProductUnchanged,
+	}
+
+	if product.CategoryID == props.CategoryID &&
+		product.Name == props.Name &&
+		product.Description == props.Description &&
+		product.Price == props.Price &&
+		product.Rating == props.Rating &&
+		product.ImageURL == props.ImageURL {
+		return imported, nil, nil
+	}
+
+	if product.Name != props.Name {
+		nameExists, repoErr := u.ProductRepository.ExistsByName(props.Name, product.PublicID)
+
+		if repoErr != nil {
+			return nil, nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error checking if product exists",
+			})
+		}
+
+		if nameExists {
+			return nil, rowError("name", fmt.Sprintf("product %q already exists", props.Name)), nil
+		}
+	}
+
+	before := productAuditFields(product, product.CategoryPublicID)
+
+	entityErr := product.Update(props)
+
+	if entityErr != nil {
+		return nil, rowError("product", string(entityErr.Instance().Err)), nil
+	}
+
+	repoErr = u.ProductRepository.UpdateOne(product)
+
+	if repoErr != nil {
+		return nil, nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    fmt.Sprintf("Error updating product %q", props.Name),
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          productAuditFields(product, category.PublicID),
+	})
+
+	if usecaseErr != nil {
+		return nil, nil, usecaseErr
+	}
+
+	imported.Status = fakeStoreProductUpdated
+
+	return imported, nil, nil
+}
@@ -0,0 +1,446 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultImportChunkSize = 100
+	maxImportChunkSize     = 1000
+)
+
+type ImportProducts struct {
+	ProductRepository               repository.Product
+	CategoryRepository              repository.Category
+	SpecificationRepository         repository.Specification
+	CategorySpecificationRepository repository.CategorySpecification
+	AuditLogRepository              repository.AuditLog
+	code                            string
+}
+
+func NewImportProducts(
+	productRepository repository.Product,
+	categoryRepository repository.Category,
+	specificationRepository repository.Specification,
+	categorySpecificationRepository repository.CategorySpecification,
+	auditLogRepository repository.AuditLog,
+) *ImportProducts {
+	return &ImportProducts{
+		code:                            "ImportProducts",
+		ProductRepository:               productRepository,
+		CategoryRepository:              categoryRepository,
+		SpecificationRepository:         specificationRepository,
+		CategorySpecificationRepository: categorySpecificationRepository,
+		AuditLogRepository:              auditLogRepository,
+	}
+}
+
+// importLookup resolves the category and specification references of the rows,
+// accepting either public IDs or names/titles (case insensitive).
+type importLookup struct {
+	categoriesByPublicId     map[types.CategoryPublicID]*entity.Category
+	categoriesByName         map[string]*entity.Category
+	specificationsByPublicId map[types.SpecificationPublicID]*entity.Specification
+	specificationsByTitle    map[string][]*entity.Specification
+	templates                map[types.CategoryID]*entity.CategoryTemplate
+}
+
+// Execute validates the chunk of rows starting at input.Offset and, unless it is a
+// dry run, writes its valid rows in a single transaction. Invalid rows are reported
+// and skipped; the caller resumes with NextOffset until Done.
+func (u *ImportProducts) Execute(input *dto.ImportProductsInput) (*dto.ImportProductsOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionCatalogImport); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	chunkSize := input.ChunkSize
+
+	if chunkSize <= 0 {
+		chunkSize = defaultImportChunkSize
+	}
+
+	chunkSize = min(chunkSize, maxImportChunkSize)
+	total := len(input.Rows)
+	start := min(max(input.Offset, 0), total)
+	end := min(start+chunkSize, total)
+
+	lookup, usecaseErr := u.loadLookup()
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	previousNames := make(map[types.ProductName]int, end)
+
+	for _, row := range input.Rows[:start] {
+		if _, exists := previousNames[types.ProductName(row.Name)]; !exists {
+			previousNames[types.ProductName(row.Name)] = row.Row
+		}
+	}
+
+	output := &dto.ImportProductsOutput{
+		DryRun:    input.DryRun,
+		Total:     total,
+		Offset:    start,
+		ChunkSize: chunkSize,
+		Products:  []*dto.ImportedProduct{},
+		Errors:    []*dto.ImportProductError{},
+	}
+
+	products := []*entity.Product{}
+	productRows := []*dto.ImportProductRow{}
+
+	for _, row := range input.Rows[start:end] {
+		product, rowErrors, usecaseErr := u.buildProduct(row, lookup, previousNames)
+
+		if usecaseErr != nil {
+			return nil, usecaseErr
+		}
+
+		if _, exists := previousNames[types.ProductName(row.Name)]; !exists {
+			previousNames[types.ProductName(row.Name)] = row.Row
+		}
+
+		if len(rowErrors) > 0 {
+			output.Failed++
+			output.Errors = append(output.Errors, rowErrors...)
+			continue
+		}
+
+		products = append(products, product)
+		productRows = append(productRows, row)
+	}
+
+	if !input.DryRun && len(products) > 0 {
+		repoErr := u.ProductRepository.CreateMany(products)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    fmt.Sprintf("Error importing rows %d to %d, nothing from this chunk was written", start+1, end),
+			})
+		}
+
+		usecaseErr = recordAudit(u.AuditLogRepository, input.Actor, u.code, lookup.auditWrites(products)...)
+
+		if usecaseErr != nil {
+			return nil, usecaseErr
+		}
+
+		output.Imported = len(products)
+	}
+
+	for i, product := range products {
+		output.Products = append(output.Products, &dto.ImportedProduct{
+			Row:      productRows[i].Row,
+			PublicID: product.PublicID,
+			Name:     product.Name,
+		})
+	}
+
+	output.Processed = end - start
+	output.Valid = len(products)
+	output.NextOffset = end
+	output.Done = end >= total
+
+	return output, nil
+}
+
+func (u *ImportProducts) loadLookup() (*importLookup, exceptions.UsecaseException) {
+	categories, repoErr := u.CategoryRepository.GetAll()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting categories",
+		})
+	}
+
+	specifications, repoErr := u.SpecificationRepository.GetAll()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specifications",
+		})
+	}
+
+	lookup := &importLookup{
+		categoriesByPublicId:     make(map[types.CategoryPublicID]*entity.Category, len(categories)),
+		categoriesByName:         make(map[string]*entity.Category, len(categories)),
+		specificationsByPublicId: make(map[types.SpecificationPublicID]*entity.Specification, len(specifications)),
+		specificationsByTitle:    make(map[string][]*entity.Specification, len(specifications)),
+		templates:                map[types.CategoryID]*entity.CategoryTemplate{},
+	}
+
+	for _, category := range categories {
+		lookup.categoriesByPublicId[category.PublicID] = category
+		lookup.categoriesByName[strings.ToLower(category.Name)] = category
+	}
+
+	for _, specification := range specifications {
+		title := strings.ToLower(specification.Title)
+
+		lookup.specificationsByPublicId[specification.PublicID] = specification
+		lookup.specificationsByTitle[title] = append(lookup.specificationsByTitle[title], specification)
+	}
+
+	return lookup, nil
+}
+
+// auditWrites are the creates of the imported products, with their specification
+// values as fields.
+func (l *importLookup) auditWrites(products []*entity.Product) []auditWrite {
+	categoryPublicIds := make(map[types.CategoryID]types.CategoryPublicID, len(l.categoriesByPublicId))
+
+	for _, category := range l.categoriesByPublicId {
+		categoryPublicIds[category.ID] = category.PublicID
+	}
+
+	specificationPublicIds := make(map[types.SpecificationID]types.SpecificationPublicID, len(l.specificationsByPublicId))
+
+	for _, specification := range l.specificationsByPublicId {
+		specificationPublicIds[specification.ID] = specification.PublicID
+	}
+
+	writes := make([]auditWrite, len(products))
+
+	for i, product := range products {
+		fields := productAuditFields(product, categoryPublicIds[product.CategoryID])
+
+		for _, value := range product.SpecificationValues {
+			fields[productSpecificationAuditField(specificationPublicIds[value.SpecificationID])] = specValueAuditValue(value.Value)
+		}
+
+		writes[i] = auditWrite{
+			EntityType:     constants.AuditEntityProduct,
+			EntityPublicID: string(product.PublicID),
+			Operation:      constants.AuditOperationCreate,
+			After:          fields,
+		}
+	}
+
+	return writes
+}
+
+func (u *ImportProducts) template(lookup *importLookup, categoryID types.CategoryID) (*entity.CategoryTemplate, exceptions.UsecaseException) {
+	if template, exists := lookup.templates[categoryID]; exists {
+		return template, nil
+	}
+
+	template, repoErr := u.CategorySpecificationRepository.GetTemplateByCategoryID(categoryID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category specification template",
+		})
+	}
+
+	lookup.templates[categoryID] = template
+
+	return template, nil
+}
+
+func (u *ImportProducts) buildProduct(
+	row *dto.ImportProductRow,
+	lookup *importLookup,
+	previousNames map[types.ProductName]int,
+) (*entity.Product, []*dto.ImportProductError, exceptions.UsecaseException) {
+	rowErrors := []*dto.ImportProductError{}
+
+	addError := func(field string, message string) {
+		rowErrors = append(rowErrors, &dto.ImportProductError{
+			Row:     row.Row,
+			Name:    row.Name,
+			Field:   field,
+			Message: message,
+		})
+	}
+
+	category := lookup.categoriesByPublicId[types.CategoryPublicID(row.Category)]
+
+	if category == nil {
+		category = lookup.categoriesByName[strings.ToLower(strings.TrimSpace(row.Category))]
+	}
+
+	if row.Category == "" {
+		addError("category", "category is required")
+	} else if category == nil {
+		addError("category", fmt.Sprintf("category %q not found", row.Category))
+	}
+
+	if previousRow, exists := previousNames[types.ProductName(row.Name)]; exists && row.Name != "" {
+		addError("name", fmt.Sprintf("product %q is repeated, first seen in row %d", row.Name, previousRow))
+	} else if row.Name != "" {
+		exists, repoErr := u.ProductRepository.ExistsByName(types.ProductName(row.Name), "")
+
+		if repoErr != nil {
+			return nil, nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error checking if product exists",
+			})
+		}
+
+		if exists {
+			addError("name", fmt.Sprintf("product %q already exists", row.Name))
+		}
+	}
+
+	price, err := importInt(row.Price)
+
+	if err != nil {
+		addError("price", err.Error())
+	}
+
+	rating, err := importInt(row.Rating)
+
+	if err == nil && (rating < math.MinInt8 || rating > math.MaxInt8) {
+		err = fmt.Errorf("value %d is out of range", rating)
+	}
+
+	if err != nil {
+		addError("rating", err.Error())
+	}
+
+	if category == nil {
+		return nil, rowErrors, nil
+	}
+
+	var product *entity.Product
+	var entityErr exceptions.EntityException
+
+	if len(rowErrors) == 0 {
+		product, entityErr = entity.NewProduct(entity.ProductProps{
+			Name:        types.ProductName(row.Name),
+			Description: row.Description,
+			Price:       price,
+			Rating:      int8(rating),
+			ImageURL:    row.ImageURL,
+			CategoryID:  category.ID,
+		})
+
+		if entityErr != nil {
+			addError("product", string(entityErr.Instance().Err))
+		}
+	}
+
+	template, usecaseErr := u.template(lookup, category.ID)
+
+	if usecaseErr != nil {
+		return nil, nil, usecaseErr
+	}
+
+	keys := make([]string, 0, len(row.Specifications))
+
+	for key := range row.Specifications {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		raw := row.Specifications[key]
+
+		if raw == nil || raw == "" {
+			continue
+		}
+
+		specification := lookup.specificationsByPublicId[types.SpecificationPublicID(key)]
+
+		if specification == nil {
+			matches := lookup.specificationsByTitle[strings.ToLower(strings.TrimSpace(key))]
+
+			if len(matches) > 1 {
+				addError(key, "specification title is ambiguous, use its public ID")
+				continue
+			}
+
+			if len(matches) == 1 {
+				specification = matches[0]
+			}
+		}
+
+		if specification == nil {
+			addError(key, "specification not found")
+			continue
+		}
+
+		if !template.Allows(specification.ID) {
+			addError(key, "specification is not part of the category template")
+			continue
+		}
+
+		var value *entity.SpecValue
+
+		if text, isText := raw.(string); isText {
+			value, entityErr = entity.ParseSpecValueText(specification.Type, text)
+		} else {
+			value, entityErr = entity.ParseSpecValue(specification.Type, raw)
+		}
+
+		if entityErr == nil && product != nil {
+			entityErr = product.AddSpecificationValue(specification, value)
+		}
+
+		if entityErr != nil {
+			addError(key, string(entityErr.Instance().Err))
+		}
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, rowErrors, nil
+	}
+
+	return product, rowErrors, nil
+}
+
+// importInt reads an integer column that may come as a JSON number or as CSV text;
+// missing values are read as zero.
+func importInt(raw any) (int64, error) {
+	switch value := raw.(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return int64(value), nil
+	case int64:
+		return value, nil
+	case float64:
+		if value != math.Trunc(value) {
+			return 0, fmt.Errorf("expected an integer value, got %v", value)
+		}
+		return int64(value), nil
+	case string:
+		text := strings.TrimSpace(value)
+
+		if text == "" {
+			return 0, nil
+		}
+
+		intVal, err := strconv.ParseInt(text, 10, 64)
+
+		if err != nil {
+			return 0, fmt.Errorf("expected an integer value, got %q", value)
+		}
+
+		return intVal, nil
+	default:
+		return 0, fmt.Errorf("expected an integer value, got %T", raw)
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type ModerateOneProductReview struct {
+	ProductRepository       repository.Product
+	ProductReviewRepository repository.ProductReview
+	code                    string
+}
+
+func NewModerateOneProductReview(
+	productRepository repository.Product,
+	productReviewRepository repository.ProductReview,
+) *ModerateOneProductReview {
+	return &ModerateOneProductReview{
+		code:                    "ModerateOneProductReview",
+		ProductRepository:       productRepository,
+		ProductReviewRepository: productReviewRepository,
+	}
+}
+
+// Execute approves or rejects a review and recomputes the product rating from the
+// approved reviews.
+func (u *ModerateOneProductReview) Execute(input *dto.ModerateOneProductReviewInput) (*dto.ModerateOneProductReviewOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionReviewModerate); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	review, repoErr := u.ProductReviewRepository.GetOneByPublicID(product.ID, input.ReviewPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product review",
+		})
+	}
+
+	entityErr := review.Moderate(input.Status)
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr = u.ProductReviewRepository.ModerateOne(review, product)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error moderating product review",
+		})
+	}
+
+	summary, repoErr := u.ProductReviewRepository.GetRatingSummary(product.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product rating summary",
+		})
+	}
+
+	return &dto.ModerateOneProductReviewOutput{
+		Moderated: true,
+		Message:   "Product review moderated successfully",
+		Summary:   toProductRatingSummaryOutput(summary),
+	}, nil
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"encoding/json"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"time"
+)
+
+// notifyWatchlists checks the watchlists of the products, which must have their
+// offers attached, against the effective price and posts an alert to the webhook for
+// each drop. Every alert is logged as a delivery; a failed one is not marked as
+// notified, so the next check sends it again. Without a webhook nothing is sent.
+func notifyWatchlists(
+	watchlistRepository repository.Watchlist,
+	webhookSender repository.WebhookSender,
+	products []*entity.Product,
+	trigger types.WatchlistTrigger,
+	code string,
+) (*dto.SweepWatchlistsOutput, exceptions.UsecaseException) {
+	output := &dto.SweepWatchlistsOutput{Products: len(products)}
+	now := time.Now()
+
+	for _, product := range products {
+		watchlists, repoErr := watchlistRepository.GetAllByProductID(product.ID)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error getting product watchlists",
+			})
+		}
+
+		price := product.EffectivePrice(now)
+
+		for _, watchlist := range watchlists {
+			if watchlist.Rearm(price) {
+				repoErr = watchlistRepository.UpdateLastNotifiedPrice(watchlist)
+
+				if repoErr != nil {
+					return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+						Code:       code,
+						StatusCode: services.GetStatusCodeFromError(repoErr),
+						Message:    "Error updating watchlist in repository",
+					})
+				}
+			}
+
+			if webhookSender == nil || !watchlist.ShouldNotify(price) {
+				continue
+			}
+
+			delivery, usecaseErr := sendWatchlistAlert(watchlistRepository, webhookSender, watchlist, product, price, trigger, now, code)
+
+			if usecaseErr != nil {
+				return nil, usecaseErr
+			}
+
+			if delivery.Status == constants.WatchlistDeliveryStatusDelivered {
+				output.Delivered++
+			} else {
+				output.Failed++
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func sendWatchlistAlert(
+	watchlistRepository repository.Watchlist,
+	webhookSender repository.WebhookSender,
+	watchlist *entity.Watchlist,
+	product *entity.Product,
+	price int64,
+	trigger types.WatchlistTrigger,
+	now time.Time,
+	code string,
+) (*entity.WatchlistDelivery, exceptions.UsecaseException) {
+	payload, err := json.Marshal(&dto.WatchlistAlertPayload{
+		Event:             "price_drop",
+		WatchlistPublicID: watchlist.PublicID,
+		SubscriberToken:   watchlist.SubscriberToken,
+		ProductPublicID:   product.PublicID,
+		ProductName:       product.Name,
+		Price:             price,
+		ThresholdPrice:    watchlist.ThresholdPrice(),
+		TargetPrice:       watchlist.TargetPrice,
+		DropPercent:       watchlist.DropPercent,
+		BestOffer:         toProductOfferOutput(product.BestOffer(now), now),
+		Trigger:           trigger,
+		SentAt:            now.UTC().Truncate(time.Second),
+	})
+
+	if err != nil {
+		return nil, exceptions.Usecase(err, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: 500,
+			Message:    "Error encoding watchlist alert",
+		})
+	}
+
+	attempts, statusCode, sendErr := webhookSender.Send(payload)
+
+	deliveryProps := entity.WatchlistDeliveryProps{
+		WatchlistID:    watchlist.ID,
+		Trigger:        trigger,
+		Price:          price,
+		ThresholdPrice: watchlist.ThresholdPrice(),
+		Status:         constants.WatchlistDeliveryStatusDelivered,
+		Attempts:       attempts,
+		StatusCode:     statusCode,
+	}
+
+	if sendErr != nil {
+		deliveryProps.Status = constants.WatchlistDeliveryStatusFailed
+		deliveryProps.Error = string(sendErr.Instance().Err)
+	}
+
+	delivery, entityErr := entity.NewWatchlistDelivery(deliveryProps)
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: 500,
+			Message:    "Error creating watchlist delivery in domain",
+		})
+	}
+
+	repoErr := watchlistRepository.CreateOneDelivery(delivery)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating watchlist delivery in repository",
+		})
+	}
+
+	if sendErr != nil {
+		return delivery, nil
+	}
+
+	if entityErr := watchlist.MarkNotified(price); entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: 500,
+			Message:    "Error updating watchlist in domain",
+		})
+	}
+
+	repoErr = watchlistRepository.UpdateLastNotifiedPrice(watchlist)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error updating watchlist in repository",
+		})
+	}
+
+	return delivery, nil
+}
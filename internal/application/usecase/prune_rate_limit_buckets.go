@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"time"
+)
+
+type PruneRateLimitBuckets struct {
+	RateLimitCache      repository.RateLimitCache
+	RateLimitRepository repository.RateLimit
+	code                string
+}
+
+func NewPruneRateLimitBuckets(
+	rateLimitCache repository.RateLimitCache,
+	rateLimitRepository repository.RateLimit,
+) *PruneRateLimitBuckets {
+	return &PruneRateLimitBuckets{
+		code:                "PruneRateLimitBuckets",
+		RateLimitCache:      rateLimitCache,
+		RateLimitRepository: rateLimitRepository,
+	}
+}
+
+// Execute removes the buckets that are full again, from memory and from the
+// database. A client that comes back gets a new full bucket, the same as the one
+// removed. Pruned counts the database buckets.
+func (u *PruneRateLimitBuckets) Execute() (*dto.PruneRateLimitBucketsOutput, exceptions.UsecaseException) {
+	now := time.Now()
+
+	_, repoErr := u.RateLimitCache.DeleteFull(now)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error pruning rate limit buckets",
+		})
+	}
+
+	pruned, repoErr := u.RateLimitRepository.DeleteFull(now)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error pruning rate limit buckets",
+		})
+	}
+
+	return &dto.PruneRateLimitBucketsOutput{Pruned: pruned}, nil
+}
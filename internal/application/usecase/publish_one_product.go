@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type PublishOneProduct struct {
+	ProductRepository               repository.Product
+	CategorySpecificationRepository repository.CategorySpecification
+	AuditLogRepository              repository.AuditLog
+	code                            string
+}
+
+func NewPublishOneProduct(
+	productRepository repository.Product,
+	categorySpecificationRepository repository.CategorySpecification,
+	auditLogRepository repository.AuditLog,
+) *PublishOneProduct {
+	return &PublishOneProduct{
+		code:                            "PublishOneProduct",
+		ProductRepository:               productRepository,
+		CategorySpecificationRepository: categorySpecificationRepository,
+		AuditLogRepository:              auditLogRepository,
+	}
+}
+
+func (u *PublishOneProduct) Execute(input *dto.PublishOneProductInput) (*dto.PublishOneProductOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionProductWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	usecaseErr := checkProductVersion(product, input.Version, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	if product.IsPublished() {
+		return nil, exceptions.Usecase(fmt.Errorf("Error publishing product %s, it is already published", product.PublicID), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Product is already published",
+		})
+	}
+
+	// the gate is the completeness report, a variant passes it with the values it
+	// inherits from its family
+	if usecaseErr := inheritFamilySpecificationValues(u.ProductRepository, product, u.code); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	template, repoErr := u.CategorySpecificationRepository.GetTemplateByCategoryID(product.CategoryID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category specification template",
+		})
+	}
+
+	completeness := template.Completeness(product.SpecificationValues)
+
+	if !completeness.Complete() {
+		return &dto.PublishOneProductOutput{
+			Published:       false,
+			MissingRequired: toCategorySpecificationOutputs(completeness.MissingRequired),
+			Message:         "Product was not published",
+		}, exceptions.Usecase(fmt.Errorf("Error publishing product %s, %d of %d required specifications have no value", product.PublicID, len(completeness.MissingRequired), completeness.RequiredTotal), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    "Product is missing required specifications",
+		})
+	}
+
+	repoErr = u.ProductRepository.PublishOne(product)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error publishing product",
+		})
+	}
+
+	usecaseErr = recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         map[string]any{"published": false},
+		After:          map[string]any{"published": true},
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.PublishOneProductOutput{
+		Published: true,
+		Message:   "Product published successfully",
+	}, nil
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"time"
+)
+
+type PurgeTrash struct {
+	TrashRepository    repository.Trash
+	ImageStorage       repository.ImageStorage
+	AuditLogRepository repository.AuditLog
+	Retention          time.Duration
+	code               string
+}
+
+func NewPurgeTrash(
+	trashRepository repository.Trash,
+	imageStorage repository.ImageStorage,
+	auditLogRepository repository.AuditLog,
+	retention time.Duration,
+) *PurgeTrash {
+	return &PurgeTrash{
+		code:               "PurgeTrash",
+		TrashRepository:    trashRepository,
+		ImageStorage:       imageStorage,
+		AuditLogRepository: auditLogRepository,
+		Retention:          retention,
+	}
+}
+
+// Execute removes for good what was deleted before the retention, or before
+// input.Before when it is sent, and then the image files of the purged products.
+func (u *PurgeTrash) Execute(input *dto.PurgeTrashInput) (*dto.PurgeTrashOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionTrashPurge); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	now := time.Now()
+	before := now.Add(-u.Retention)
+
+	if input.Before != "" {
+		var err error
+
+		before, err = services.ParseTimestamp(input.Before)
+
+		if err != nil {
+			return nil, exceptions.Usecase(err, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 422,
+				Message:    "Invalid before",
+			})
+		}
+
+		if before.After(now) {
+			return nil, exceptions.Usecase(fmt.Errorf("purge limit %s is in the future", before), exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 422,
+				Message:    "Before cannot be in the future",
+			})
+		}
+	}
+
+	purge, repoErr := u.TrashRepository.Purge(before)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error purging trash",
+		})
+	}
+
+	writes := make([]auditWrite, len(purge.Items))
+
+	for i, item := range purge.Items {
+		// the trash and the audit log name the entities the same way
+		writes[i] = auditWrite{
+			EntityType:     types.AuditEntityType(item.EntityType),
+			EntityPublicID: item.PublicID,
+			Operation:      constants.AuditOperationPurge,
+			Before:         map[string]any{"name": item.Name},
+		}
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, writes...)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	// The rows are already gone, a file that could not be removed is reported so it
+	// can be cleaned up by hand.
+	for _, key := range purge.StorageKeys {
+		repoErr = u.ImageStorage.Delete(key)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Trash purged but a product image file could not be removed",
+			})
+		}
+	}
+
+	return &dto.PurgeTrashOutput{
+		Purged:  toTrashItemOutputs(purge.Items, u.Retention),
+		Message: "Trash purged successfully",
+	}, nil
+}
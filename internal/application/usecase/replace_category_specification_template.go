@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+)
+
+type ReplaceCategorySpecificationTemplate struct {
+	CategoryRepository              repository.Category
+	SpecificationRepository         repository.Specification
+	CategorySpecificationRepository repository.CategorySpecification
+	AuditLogRepository              repository.AuditLog
+	code                            string
+}
+
+func NewReplaceCategorySpecificationTemplate(
+	categoryRepository repository.Category,
+	specificationRepository repository.Specification,
+	categorySpecificationRepository repository.CategorySpecification,
+	auditLogRepository repository.AuditLog,
+) *ReplaceCategorySpecificationTemplate {
+	return &ReplaceCategorySpecificationTemplate{
+		code:                            "ReplaceCategorySpecificationTemplate",
+		CategoryRepository:              categoryRepository,
+		SpecificationRepository:         specificationRepository,
+		CategorySpecificationRepository: categorySpecificationRepository,
+		AuditLogRepository:              auditLogRepository,
+	}
+}
+
+func (u *ReplaceCategorySpecificationTemplate) Execute(input *dto.ReplaceCategorySpecificationTemplateInput) (*dto.ReplaceCategorySpecificationTemplateOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionCategoryWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	category, repoErr := u.CategoryRepository.GetOneByPublicID(input.CategoryPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category",
+		})
+	}
+
+	publicIds := make([]types.SpecificationPublicID, len(input.Specifications))
+
+	for i, entry := range input.Specifications {
+		publicIds[i] = entry.SpecificationPublicID
+	}
+
+	specifications, repoErr := u.SpecificationRepository.GetManyByPublicIDs(publicIds)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specifications",
+		})
+	}
+
+	specificationsByPublicId := make(map[types.SpecificationPublicID]*entity.Specification, len(specifications))
+
+	for _, specification := range specifications {
+		specificationsByPublicId[specification.PublicID] = specification
+	}
+
+	categorySpecifications := make([]*entity.CategorySpecification, 0, len(input.Specifications))
+	entryErrors := []*dto.CategorySpecificationError{}
+	seen := make(map[types.SpecificationPublicID]bool, len(input.Specifications))
+
+	for i, entry := range input.Specifications {
+		specification, exists := specificationsByPublicId[entry.SpecificationPublicID]
+
+		if !exists {
+			entryErrors = append(entryErrors, &dto.CategorySpecificationError{
+				Index:                 i,
+				SpecificationPublicID: entry.SpecificationPublicID,
+				Message:               "specification not found",
+			})
+			continue
+		}
+
+		if seen[entry.SpecificationPublicID] {
+			entryErrors = append(entryErrors, &dto.CategorySpecificationError{
+				Index:                 i,
+				SpecificationPublicID: entry.SpecificationPublicID,
+				Message:               "specification is listed more than once",
+			})
+			continue
+		}
+
+		seen[entry.SpecificationPublicID] = true
+
+		categorySpecification, entityErr := entity.NewCategorySpecification(entity.CategorySpecificationProps{
+			CategoryID:      category.ID,
+			SpecificationID: specification.ID,
+			Requirement:     entry.Requirement,
+			DisplayOrder:    entry.DisplayOrder,
+			Specification:   specification,
+		})
+
+		if entityErr != nil {
+			entryErrors = append(entryErrors, &dto.CategorySpecificationError{
+				Index:                 i,
+				SpecificationPublicID: entry.SpecificationPublicID,
+				Message:               string(entityErr.Instance().Err),
+			})
+			continue
+		}
+
+		categorySpecifications = append(categorySpecifications, categorySpecification)
+	}
+
+	if len(entryErrors) > 0 {
+		return &dto.ReplaceCategorySpecificationTemplateOutput{
+			Replaced: false,
+			Total:    len(input.Specifications),
+			Errors:   entryErrors,
+			Message:  "Category specification template was not changed",
+		}, exceptions.Usecase(fmt.Errorf("Error replacing category specification template, %d of %d entries are invalid", len(entryErrors), len(input.Specifications)), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    "Invalid category specification template",
+		})
+	}
+
+	previousTemplate, repoErr := u.CategorySpecificationRepository.GetTemplateByCategoryID(category.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category specification template",
+		})
+	}
+
+	template, entityErr := entity.NewCategoryTemplate(category.ID, categorySpecifications)
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error creating category specification template in domain",
+		})
+	}
+
+	repoErr = u.CategorySpecificationRepository.ReplaceTemplate(template)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error replacing category specification template in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityCategory,
+		EntityPublicID: string(category.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         categoryTemplateAuditFields(previousTemplate),
+		After:          categoryTemplateAuditFields(template),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.ReplaceCategorySpecificationTemplateOutput{
+		Replaced: true,
+		Total:    len(template.Specifications),
+		Message:  "Category specification template replaced successfully",
+	}, nil
+}
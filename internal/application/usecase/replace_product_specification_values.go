@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"slices"
+)
+
+type ReplaceProductSpecificationValues struct {
+	ProductRepository                   repository.Product
+	SpecificationRepository             repository.Specification
+	ProductSpecificationValueRepository repository.ProductSpecificationValue
+	CategorySpecificationRepository     repository.CategorySpecification
+	AuditLogRepository                  repository.AuditLog
+	code                                string
+}
+
+func NewReplaceProductSpecificationValues(
+	productRepository repository.Product,
+	specificationRepository repository.Specification,
+	productSpecificationValueRepository repository.ProductSpecificationValue,
+	categorySpecificationRepository repository.CategorySpecification,
+	auditLogRepository repository.AuditLog,
+) *ReplaceProductSpecificationValues {
+	return &ReplaceProductSpecificationValues{
+		code:                                "ReplaceProductSpecificationValues",
+		ProductRepository:                   productRepository,
+		SpecificationRepository:             specificationRepository,
+		ProductSpecificationValueRepository: productSpecificationValueRepository,
+		CategorySpecificationRepository:     categorySpecificationRepository,
+		AuditLogRepository:                  auditLogRepository,
+	}
+}
+
+func (u *ReplaceProductSpecificationValues) Execute(input *dto.ReplaceProductSpecificationValuesInput) (*dto.ReplaceProductSpecificationValuesOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationValueWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	template, repoErr := u.CategorySpecificationRepository.GetTemplateByCategoryID(product.CategoryID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category specification template",
+		})
+	}
+
+	publicIds := make([]types.SpecificationPublicID, 0, len(input.Specifications))
+
+	for publicId := range input.Specifications {
+		publicIds = append(publicIds, publicId)
+	}
+
+	slices.Sort(publicIds)
+
+	specifications, repoErr := u.SpecificationRepository.GetManyByPublicIDs(publicIds)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specifications",
+		})
+	}
+
+	specificationsByPublicId := make(map[types.SpecificationPublicID]*entity.Specification, len(specifications))
+
+	for _, specification := range specifications {
+		specificationsByPublicId[specification.PublicID] = specification
+	}
+
+	values := make([]*entity.ProductSpecificationValue, 0, len(publicIds))
+	entryErrors := []*dto.ProductSpecificationValueError{}
+
+	for _, publicId := range publicIds {
+		specification, exists := specificationsByPublicId[publicId]
+
+		if !exists {
+			entryErrors = append(entryErrors, &dto.ProductSpecificationValueError{
+				SpecificationPublicID: publicId,
+				Message:               "specification not found",
+			})
+			continue
+		}
+
+		if !template.Allows(specification.ID) {
+			entryErrors = append(entryErrors, &dto.ProductSpecificationValueError{
+				SpecificationPublicID: publicId,
+				Message:               "specification is not part of the category template",
+			})
+			continue
+		}
+
+		specValue, entityErr := entity.ParseSpecValue(specification.Type, input.Specifications[publicId])
+
+		if entityErr != nil {
+			entryErrors = append(entryErrors, &dto.ProductSpecificationValueError{
+				SpecificationPublicID: publicId,
+				Message:               string(entityErr.Instance().Err),
+			})
+			continue
+		}
+
+		value, entityErr := entity.NewProductSpecificationValue(entity.ProductSpecificationValueProps{
+			ProductID:       product.ID,
+			SpecificationID: specification.ID,
+			Type:            specification.Type,
+			Value:           specValue,
+		})
+
+		if entityErr != nil {
+			entryErrors = append(entryErrors, &dto.ProductSpecificationValueError{
+				SpecificationPublicID: publicId,
+				Message:               string(entityErr.Instance().Err),
+			})
+			continue
+		}
+
+		values = append(values, value)
+	}
+
+	if len(entryErrors) > 0 {
+		return &dto.ReplaceProductSpecificationValuesOutput{
+			Replaced: false,
+			Total:    len(publicIds),
+			Errors:   entryErrors,
+			Message:  "No specification value was written",
+		}, exceptions.Usecase(fmt.Errorf("Error replacing product specification values, %d of %d entries are invalid", len(entryErrors), len(publicIds)), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    "Invalid product specification values",
+		})
+	}
+
+	repoErr = u.ProductSpecificationValueRepository.ReplaceManyByProductID(product.ID, values)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error replacing product specification values in repository",
+		})
+	}
+
+	specificationPublicIds := make(map[types.SpecificationID]types.SpecificationPublicID, len(template.Specifications))
+
+	for _, categorySpecification := range template.Specifications {
+		specificationPublicIds[categorySpecification.SpecificationID] = categorySpecification.Specification.PublicID
+	}
+
+	before := map[string]any{}
+	after := map[string]any{}
+
+	for _, value := range product.SpecificationValues {
+		if publicId, exists := specificationPublicIds[value.SpecificationID]; exists {
+			before[productSpecificationAuditField(publicId)] = specValueAuditValue(value.Value)
+		}
+	}
+
+	for _, value := range values {
+		after[productSpecificationAuditField(specificationPublicIds[value.SpecificationID])] = specValueAuditValue(value.Value)
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          after,
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.ReplaceProductSpecificationValuesOutput{
+		Replaced: true,
+		Total:    len(values),
+		Message:  "Product specification values replaced successfully",
+	}, nil
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type ReplayWebhookDeliveries struct {
+	OutboxRepository              repository.Outbox
+	WebhookSubscriptionRepository repository.WebhookSubscription
+	code                          string
+}
+
+func NewReplayWebhookDeliveries(
+	outboxRepository repository.Outbox,
+	webhookSubscriptionRepository repository.WebhookSubscription,
+) *ReplayWebhookDeliveries {
+	return &ReplayWebhookDeliveries{
+		code:                          "ReplayWebhookDeliveries",
+		OutboxRepository:              outboxRepository,
+		WebhookSubscriptionRepository: webhookSubscriptionRepository,
+	}
+}
+
+// Execute sends events to the subscription again on the next dispatch. Without since
+// it retries the dead letters, with since it sends every event the subscription
+// accepts from then on, delivered or not, including the ones from before it existed.
+func (u *ReplayWebhookDeliveries) Execute(input *dto.ReplayWebhookDeliveriesInput) (*dto.ReplayWebhookDeliveriesOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionWebhookManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	subscription, repoErr := u.WebhookSubscriptionRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting webhook subscription",
+		})
+	}
+
+	if input.Since == "" {
+		replayed, repoErr := u.WebhookSubscriptionRepository.ReplayDeadDeliveries(subscription)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error replaying dead webhook deliveries",
+			})
+		}
+
+		return &dto.ReplayWebhookDeliveriesOutput{Replayed: replayed}, nil
+	}
+
+	since, err := services.ParseTimestamp(input.Since)
+
+	if err != nil {
+		return nil, exceptions.Usecase(err, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    "Invalid since",
+		})
+	}
+
+	events, repoErr := u.OutboxRepository.GetAllSince(since)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting outbox events",
+		})
+	}
+
+	accepted := make([]*entity.OutboxEvent, 0, len(events))
+
+	for _, event := range events {
+		if subscription.Accepts(event.Type) {
+			accepted = append(accepted, event)
+		}
+	}
+
+	repoErr = u.WebhookSubscriptionRepository.ReplayDeliveries(subscription, accepted)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error replaying webhook deliveries",
+		})
+	}
+
+	return &dto.ReplayWebhookDeliveriesOutput{Replayed: int64(len(accepted))}, nil
+}
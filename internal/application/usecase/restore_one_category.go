@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type RestoreOneCategory struct {
+	CategoryRepository repository.Category
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewRestoreOneCategory(
+	categoryRepository repository.Category,
+	auditLogRepository repository.AuditLog,
+) *RestoreOneCategory {
+	return &RestoreOneCategory{
+		code:               "RestoreOneCategory",
+		CategoryRepository: categoryRepository,
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+func (u *RestoreOneCategory) Execute(input *dto.RestoreOneCategoryInput) (*dto.RestoreOneCategoryOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionCategoryDelete); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	category, repoErr := u.CategoryRepository.GetOneDeletedByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting deleted category",
+		})
+	}
+
+	exists, repoErr := u.CategoryRepository.ExistsByName(category.Name, category.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if category exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error restoring category, already exists other category with name %s", category.Name), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Category already exists",
+		})
+	}
+
+	repoErr = u.CategoryRepository.RestoreOne(category)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error restoring category",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityCategory,
+		EntityPublicID: string(category.PublicID),
+		Operation:      constants.AuditOperationRestore,
+		After:          categoryAuditFields(category),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.RestoreOneCategoryOutput{
+		Restored: true,
+		Message:  "Category restored successfully",
+	}, nil
+}
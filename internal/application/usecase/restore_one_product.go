@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type RestoreOneProduct struct {
+	ProductRepository  repository.Product
+	CategoryRepository repository.Category
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewRestoreOneProduct(
+	productRepository repository.Product,
+	categoryRepository repository.Category,
+	auditLogRepository repository.AuditLog,
+) *RestoreOneProduct {
+	return &RestoreOneProduct{
+		code:               "RestoreOneProduct",
+		ProductRepository:  productRepository,
+		CategoryRepository: categoryRepository,
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+func (u *RestoreOneProduct) Execute(input *dto.RestoreOneProductInput) (*dto.RestoreOneProductOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionProductDelete); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneDeletedByPublicId(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting deleted product",
+		})
+	}
+
+	// a product comes back only under a live category, and a variant only into a
+	// live family, the parent has to be restored first
+	_, repoErr = u.CategoryRepository.GetOneByPublicID(product.CategoryPublicID)
+
+	if repoErr != nil {
+		if services.GetStatusCodeFromError(repoErr) == 404 {
+			return nil, exceptions.Usecase(fmt.Errorf("Error restoring product, category %s is deleted", product.CategoryPublicID), exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 409,
+				Message:    "Product category is deleted, restore it first",
+			})
+		}
+
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product category",
+		})
+	}
+
+	if product.IsVariant() {
+		_, repoErr = u.ProductRepository.GetOneByPublicId(product.ParentPublicID)
+
+		if repoErr != nil {
+			if services.GetStatusCodeFromError(repoErr) == 404 {
+				return nil, exceptions.Usecase(fmt.Errorf("Error restoring product variant, family %s is deleted", product.ParentPublicID), exceptions.UsecaseOpts{
+					Code:       u.code,
+					StatusCode: 409,
+					Message:    "Product family is deleted, restore it first",
+				})
+			}
+
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error getting product family",
+			})
+		}
+	} else {
+		exists, repoErr := u.ProductRepository.ExistsByName(product.Name, product.PublicID)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error checking if product exists",
+			})
+		}
+
+		if exists {
+			return nil, exceptions.Usecase(fmt.Errorf("Error restoring product, already exists other product with name %s", product.Name), exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 409,
+				Message:    "Product already exists",
+			})
+		}
+	}
+
+	repoErr = u.ProductRepository.RestoreOne(product)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error restoring product",
+		})
+	}
+
+	variants, repoErr := u.ProductRepository.GetAllVariants(product)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product variants",
+		})
+	}
+
+	// the variants deleted with the family come back with it
+	writes := make([]auditWrite, 0, len(variants)+1)
+
+	for _, restored := range append([]*entity.Product{product}, variants...) {
+		writes = append(writes, auditWrite{
+			EntityType:     constants.AuditEntityProduct,
+			EntityPublicID: string(restored.PublicID),
+			Operation:      constants.AuditOperationRestore,
+			After:          productAuditFields(restored, product.CategoryPublicID),
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, writes...)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.RestoreOneProductOutput{
+		Restored: true,
+		Message:  "Product restored successfully",
+	}, nil
+}
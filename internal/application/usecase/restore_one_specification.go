@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type RestoreOneSpecification struct {
+	SpecificationRepository      repository.Specification
+	SpecificationGroupRepository repository.SpecificationGroup
+	AuditLogRepository           repository.AuditLog
+	code                         string
+}
+
+func NewRestoreOneSpecification(
+	specificationRepository repository.Specification,
+	specificationGroupRepository repository.SpecificationGroup,
+	auditLogRepository repository.AuditLog,
+) *RestoreOneSpecification {
+	return &RestoreOneSpecification{
+		code:                         "RestoreOneSpecification",
+		SpecificationRepository:      specificationRepository,
+		SpecificationGroupRepository: specificationGroupRepository,
+		AuditLogRepository:           auditLogRepository,
+	}
+}
+
+func (u *RestoreOneSpecification) Execute(input *dto.RestoreOneSpecificationInput) (*dto.RestoreOneSpecificationOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	specification, repoErr := u.SpecificationRepository.GetOneDeletedByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting deleted specification",
+		})
+	}
+
+	// a specification of a deleted group comes back with the group
+	_, repoErr = u.SpecificationGroupRepository.GetOneByPublicID(specification.SpecificationGroupPublicID)
+
+	if repoErr != nil {
+		if services.GetStatusCodeFromError(repoErr) == 404 {
+			return nil, exceptions.Usecase(fmt.Errorf("Error restoring specification, group %s is deleted", specification.SpecificationGroupPublicID), exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 409,
+				Message:    "Specification group is deleted, restore it first",
+			})
+		}
+
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification group",
+		})
+	}
+
+	exists, repoErr := u.SpecificationRepository.ExistsByTitle(specification.Title, specification.EspecificationGroupID, specification.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if specification exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error restoring specification, already exists other specification with title %s in group %s", specification.Title, specification.SpecificationGroupPublicID), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Specification already exists",
+		})
+	}
+
+	repoErr = u.SpecificationRepository.RestoreOne(specification)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error restoring specification",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntitySpecification,
+		EntityPublicID: string(specification.PublicID),
+		Operation:      constants.AuditOperationRestore,
+		After:          specificationAuditFields(specification, specification.SpecificationGroupPublicID),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.RestoreOneSpecificationOutput{
+		Restored: true,
+		Message:  "Specification restored successfully",
+	}, nil
+}
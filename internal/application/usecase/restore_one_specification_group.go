@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type RestoreOneSpecificationGroup struct {
+	SpecificationGroupRepository repository.SpecificationGroup
+	SpecificationRepository      repository.Specification
+	AuditLogRepository           repository.AuditLog
+	code                         string
+}
+
+func NewRestoreOneSpecificationGroup(
+	specificationGroupRepository repository.SpecificationGroup,
+	specificationRepository repository.Specification,
+	auditLogRepository repository.AuditLog,
+) *RestoreOneSpecificationGroup {
+	return &RestoreOneSpecificationGroup{
+		code:                         "RestoreOneSpecificationGroup",
+		SpecificationGroupRepository: specificationGroupRepository,
+		SpecificationRepository:      specificationRepository,
+		AuditLogRepository:           auditLogRepository,
+	}
+}
+
+func (u *RestoreOneSpecificationGroup) Execute(input *dto.RestoreOneSpecificationGroupInput) (*dto.RestoreOneSpecificationGroupOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	group, repoErr := u.SpecificationGroupRepository.GetOneDeletedByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting deleted specification group",
+		})
+	}
+
+	exists, repoErr := u.SpecificationGroupRepository.ExistsByName(group.Name, group.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if specification group exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error restoring specification group, already exists other specification group with name %s", group.Name), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Specification group already exists",
+		})
+	}
+
+	repoErr = u.SpecificationGroupRepository.RestoreOne(group)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error restoring specification group",
+		})
+	}
+
+	specifications, repoErr := u.SpecificationRepository.GetAllByGroupID(group.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification group specifications",
+		})
+	}
+
+	// the specifications deleted with the group come back with it
+	writes := make([]auditWrite, 0, len(specifications)+1)
+
+	writes = append(writes, auditWrite{
+		EntityType:     constants.AuditEntitySpecificationGroup,
+		EntityPublicID: string(group.PublicID),
+		Operation:      constants.AuditOperationRestore,
+		After:          specificationGroupAuditFields(group),
+	})
+
+	for _, specification := range specifications {
+		writes = append(writes, auditWrite{
+			EntityType:     constants.AuditEntitySpecification,
+			EntityPublicID: string(specification.PublicID),
+			Operation:      constants.AuditOperationRestore,
+			After:          specificationAuditFields(specification, group.PublicID),
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, writes...)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.RestoreOneSpecificationGroupOutput{
+		Restored: true,
+		Message:  "Specification group restored successfully",
+	}, nil
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type RevokeOneApiKey struct {
+	ApiKeyRepository repository.ApiKey
+	code             string
+}
+
+func NewRevokeOneApiKey(
+	apiKeyRepository repository.ApiKey,
+) *RevokeOneApiKey {
+	return &RevokeOneApiKey{
+		code:             "RevokeOneApiKey",
+		ApiKeyRepository: apiKeyRepository,
+	}
+}
+
+// Execute rejects the key from the next request on. The key is kept, so the list
+// still shows when it was used.
+func (u *RevokeOneApiKey) Execute(input *dto.RevokeOneApiKeyInput) (*dto.RevokeOneApiKeyOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionApiKeyManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	apiKey, repoErr := u.ApiKeyRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting api key",
+		})
+	}
+
+	if apiKey.IsRevoked() {
+		return nil, exceptions.Usecase(fmt.Errorf("Error revoking api key, %s is already revoked", apiKey.PublicID), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Api key is already revoked",
+		})
+	}
+
+	repoErr = u.ApiKeyRepository.RevokeOne(apiKey)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error revoking api key in repository",
+		})
+	}
+
+	return &dto.RevokeOneApiKeyOutput{
+		Revoked: true,
+		Message: "Api key revoked successfully",
+	}, nil
+}
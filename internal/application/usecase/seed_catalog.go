@@ -0,0 +1,318 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/aggregate"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"slices"
+)
+
+type SeedCatalog struct {
+	SeedRepository repository.Seed
+	code           string
+}
+
+func NewSeedCatalog(seedRepository repository.Seed) *SeedCatalog {
+	return &SeedCatalog{
+		code:           "SeedCatalog",
+		SeedRepository: seedRepository,
+	}
+}
+
+// Execute validates the whole fixture through the domain entities before touching
+// the database, then optionally wipes the catalog and writes the fixture in a
+// single transaction.
+func (u *SeedCatalog) Execute(input *dto.SeedCatalogInput) (*dto.SeedCatalogOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionCatalogSeed); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	catalog, err := buildSeedCatalog(input.Fixture)
+
+	if err != nil {
+		return nil, exceptions.Usecase(err, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    fmt.Sprintf("Invalid seed fixture: %v", err),
+		})
+	}
+
+	if input.Reset {
+		repoErr := u.SeedRepository.Reset()
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error resetting catalog",
+			})
+		}
+	}
+
+	repoErr := u.SeedRepository.Apply(catalog)
+
+	if repoErr != nil {
+		message := "Error writing seed"
+
+		if services.GetStatusCodeFromError(repoErr) == 409 {
+			message = fmt.Sprintf("Error writing seed, %s. Run it with reset to start from an empty catalog", repoErr.Instance().Err)
+		}
+
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    message,
+		})
+	}
+
+	output := &dto.SeedCatalogOutput{
+		Reset:               input.Reset,
+		SpecificationGroups: len(catalog.SpecificationGroups),
+		Specifications:      len(catalog.Specifications),
+		Categories:          len(catalog.Categories),
+		Products:            len(catalog.Products),
+	}
+
+	for _, product := range catalog.Products {
+		output.SpecificationValues += len(product.SpecificationValues)
+	}
+
+	return output, nil
+}
+
+// buildSeedCatalog turns the fixture into entities. Every row must carry an ID and a
+// public ID, references must point to rows of the same fixture and the built-in
+// specifications must all be present with their expected types.
+func buildSeedCatalog(fixture *dto.SeedFixture) (*aggregate.Catalog, error) {
+	catalog := &aggregate.Catalog{}
+
+	groups := make(map[types.SpecificationGroupID]bool, len(fixture.SpecificationGroups))
+	groupPublicIds := make(map[types.SpecificationGroupPublicID]bool, len(fixture.SpecificationGroups))
+
+	for _, input := range fixture.SpecificationGroups {
+		if err := seedIdentity("specification group", int64(input.ID), string(input.PublicID), groups[input.ID], groupPublicIds[input.PublicID]); err != nil {
+			return nil, err
+		}
+
+		group, entityErr := entity.NewSpecificationGroup(entity.SpecificationGroupProps{
+			ID:          input.ID,
+			PublicID:    input.PublicID,
+			Name:        input.Name,
+			Description: input.Description,
+		})
+
+		if entityErr != nil {
+			return nil, fmt.Errorf("specification group %d: %s", input.ID, entityErr.Instance().Err)
+		}
+
+		groups[input.ID] = true
+		groupPublicIds[input.PublicID] = true
+		catalog.SpecificationGroups = append(catalog.SpecificationGroups, group)
+	}
+
+	specifications := make(map[types.SpecificationID]*entity.Specification, len(fixture.Specifications))
+	specificationPublicIds := make(map[types.SpecificationPublicID]bool, len(fixture.Specifications))
+
+	for _, input := range fixture.Specifications {
+		if err := seedIdentity("specification", int64(input.ID), string(input.PublicID), specifications[input.ID] != nil, specificationPublicIds[input.PublicID]); err != nil {
+			return nil, err
+		}
+
+		if !groups[input.SpecificationGroupID] {
+			return nil, fmt.Errorf("specification %d: specification group %d is not in the fixture", input.ID, input.SpecificationGroupID)
+		}
+
+		specification, entityErr := entity.NewSpecification(entity.SpecificationProps{
+			ID:                    input.ID,
+			PublicID:              input.PublicID,
+			Title:                 input.Title,
+			EspecificationGroupID: input.SpecificationGroupID,
+			Type:                  input.Type,
+		})
+
+		if entityErr != nil {
+			return nil, fmt.Errorf("specification %d: %s", input.ID, entityErr.Instance().Err)
+		}
+
+		specifications[input.ID] = specification
+		specificationPublicIds[input.PublicID] = true
+		catalog.Specifications = append(catalog.Specifications, specification)
+	}
+
+	builtInIds := make([]types.SpecificationID, 0, len(constants.BuiltInSpecifications))
+
+	for id := range constants.BuiltInSpecifications {
+		builtInIds = append(builtInIds, id)
+	}
+
+	slices.Sort(builtInIds)
+
+	for _, id := range builtInIds {
+		specification, exists := specifications[id]
+
+		if !exists {
+			return nil, fmt.Errorf("built-in specification %d is missing", id)
+		}
+
+		if specification.Type != constants.BuiltInSpecifications[id] {
+			return nil, fmt.Errorf("built-in specification %d must be of type %s, got %s", id, constants.BuiltInSpecifications[id], specification.Type)
+		}
+	}
+
+	templates := make(map[types.CategoryID]*entity.CategoryTemplate, len(fixture.Categories))
+	categoryPublicIds := make(map[types.CategoryPublicID]bool, len(fixture.Categories))
+
+	for _, input := range fixture.Categories {
+		_, exists := templates[input.ID]
+
+		if err := seedIdentity("category", int64(input.ID), string(input.PublicID), exists, categoryPublicIds[input.PublicID]); err != nil {
+			return nil, err
+		}
+
+		category, entityErr := entity.NewCategory(entity.CategoryProps{
+			ID:          input.ID,
+			PublicID:    input.PublicID,
+			Name:        input.Name,
+			Description: input.Description,
+		})
+
+		if entityErr != nil {
+			return nil, fmt.Errorf("category %d: %s", input.ID, entityErr.Instance().Err)
+		}
+
+		categorySpecifications := make([]*entity.CategorySpecification, 0, len(input.Specifications))
+
+		for i, entry := range input.Specifications {
+			specification, exists := specifications[entry.SpecificationID]
+
+			if !exists {
+				return nil, fmt.Errorf("category %d: specification %d is not in the fixture", input.ID, entry.SpecificationID)
+			}
+
+			categorySpecification, entityErr := entity.NewCategorySpecification(entity.CategorySpecificationProps{
+				CategoryID:      category.ID,
+				SpecificationID: specification.ID,
+				Requirement:     entry.Requirement,
+				DisplayOrder:    int64(i + 1),
+				Specification:   specification,
+			})
+
+			if entityErr != nil {
+				return nil, fmt.Errorf("category %d: %s", input.ID, entityErr.Instance().Err)
+			}
+
+			categorySpecifications = append(categorySpecifications, categorySpecification)
+		}
+
+		template, entityErr := entity.NewCategoryTemplate(category.ID, categorySpecifications)
+
+		if entityErr != nil {
+			return nil, fmt.Errorf("category %d: %s", input.ID, entityErr.Instance().Err)
+		}
+
+		templates[category.ID] = template
+		categoryPublicIds[category.PublicID] = true
+		catalog.Categories = append(catalog.Categories, category)
+		catalog.CategoryTemplates = append(catalog.CategoryTemplates, template)
+	}
+
+	products := make(map[types.ProductID]bool, len(fixture.Products))
+	productPublicIds := make(map[types.ProductPublicID]bool, len(fixture.Products))
+	productNames := make(map[types.ProductName]types.ProductID, len(fixture.Products))
+
+	for _, input := range fixture.Products {
+		if err := seedIdentity("product", int64(input.ID), string(input.PublicID), products[input.ID], productPublicIds[input.PublicID]); err != nil {
+			return nil, err
+		}
+
+		if previousId, exists := productNames[types.ProductName(input.Name)]; exists {
+			return nil, fmt.Errorf("product %d: name %q is already used by product %d", input.ID, input.Name, previousId)
+		}
+
+		template, exists := templates[input.CategoryID]
+
+		if !exists {
+			return nil, fmt.Errorf("product %d: category %d is not in the fixture", input.ID, input.CategoryID)
+		}
+
+		product, entityErr := entity.NewProduct(entity.ProductProps{
+			ID:          input.ID,
+			PublicID:    input.PublicID,
+			CategoryID:  input.CategoryID,
+			Name:        types.ProductName(input.Name),
+			Description: input.Description,
+			Price:       input.Price,
+			Rating:      input.Rating,
+			ImageURL:    input.ImageURL,
+		})
+
+		if entityErr != nil {
+			return nil, fmt.Errorf("product %d: %s", input.ID, entityErr.Instance().Err)
+		}
+
+		specificationIds := make([]types.SpecificationID, 0, len(input.Specifications))
+
+		for id := range input.Specifications {
+			specificationIds = append(specificationIds, id)
+		}
+
+		slices.Sort(specificationIds)
+
+		for _, id := range specificationIds {
+			specification, exists := specifications[id]
+
+			if !exists {
+				return nil, fmt.Errorf("product %d: specification %d is not in the fixture", input.ID, id)
+			}
+
+			if !template.Allows(id) {
+				return nil, fmt.Errorf("product %d: specification %d is not part of the template of category %d", input.ID, id, input.CategoryID)
+			}
+
+			value, entityErr := entity.ParseSpecValue(specification.Type, input.Specifications[id])
+
+			if entityErr == nil {
+				entityErr = product.AddSpecificationValue(specification, value)
+			}
+
+			if entityErr != nil {
+				return nil, fmt.Errorf("product %d: specification %d: %s", input.ID, id, entityErr.Instance().Err)
+			}
+		}
+
+		products[product.ID] = true
+		productPublicIds[product.PublicID] = true
+		productNames[product.Name] = product.ID
+		catalog.Products = append(catalog.Products, product)
+	}
+
+	return catalog, nil
+}
+
+// seedIdentity checks the ID and public ID of a fixture row; both are required so
+// running the seed again updates the same rows.
+func seedIdentity(kind string, id int64, publicId string, idTaken bool, publicIdTaken bool) error {
+	if id <= 0 {
+		return fmt.Errorf("%s %q needs an id greater than 0", kind, publicId)
+	}
+
+	if publicId == "" {
+		return fmt.Errorf("%s %d needs a public_id", kind, id)
+	}
+
+	if idTaken {
+		return fmt.Errorf("%s id %d is repeated", kind, id)
+	}
+
+	if publicIdTaken {
+		return fmt.Errorf("%s public_id %q is repeated", kind, publicId)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type SweepWatchlists struct {
+	ProductRepository      repository.Product
+	ProductOfferRepository repository.ProductOffer
+	WatchlistRepository    repository.Watchlist
+	WebhookSender          repository.WebhookSender
+	code                   string
+}
+
+func NewSweepWatchlists(
+	productRepository repository.Product,
+	productOfferRepository repository.ProductOffer,
+	watchlistRepository repository.Watchlist,
+	webhookSender repository.WebhookSender,
+) *SweepWatchlists {
+	return &SweepWatchlists{
+		code:                   "SweepWatchlists",
+		ProductRepository:      productRepository,
+		ProductOfferRepository: productOfferRepository,
+		WatchlistRepository:    watchlistRepository,
+		WebhookSender:          webhookSender,
+	}
+}
+
+// Execute checks every watched product, catching the drops that do not come from a
+// product update, like a cheaper offer or an offer going stale, and resending the
+// alerts that failed.
+func (u *SweepWatchlists) Execute() (*dto.SweepWatchlistsOutput, exceptions.UsecaseException) {
+	publicIds, repoErr := u.WatchlistRepository.GetAllWatchedProductPublicIDs()
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting watched products",
+		})
+	}
+
+	products := make([]*entity.Product, 0, len(publicIds))
+
+	for _, publicId := range publicIds {
+		product, repoErr := u.ProductRepository.GetOneByPublicId(publicId)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error getting product",
+			})
+		}
+
+		products = append(products, product)
+	}
+
+	if len(products) == 0 {
+		return &dto.SweepWatchlistsOutput{}, nil
+	}
+
+	usecaseErr := attachProductOffers(u.ProductOfferRepository, products, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return notifyWatchlists(u.WatchlistRepository, u.WebhookSender, products, constants.WatchlistTriggerSweep, u.code)
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"time"
+)
+
+type TakeRateLimitToken struct {
+	RateLimitCache      repository.RateLimitCache
+	RateLimitRepository repository.RateLimit
+	Policies            map[types.RateLimitPolicyName]*entity.RateLimitPolicy
+	code                string
+}
+
+func NewTakeRateLimitToken(
+	rateLimitCache repository.RateLimitCache,
+	rateLimitRepository repository.RateLimit,
+	policies map[types.RateLimitPolicyName]*entity.RateLimitPolicy,
+) *TakeRateLimitToken {
+	return &TakeRateLimitToken{
+		code:                "TakeRateLimitToken",
+		RateLimitCache:      rateLimitCache,
+		RateLimitRepository: rateLimitRepository,
+		Policies:            policies,
+	}
+}
+
+// Execute spends a token of the client's bucket. The bucket in memory answers first,
+// a request it refuses never reaches the database. The one in the database is shared
+// by the prefork workers and outlives a restart, its answer is kept in memory.
+// When the database fails, the error is returned with the token already spent in
+// memory, which then limits the client alone.
+func (u *TakeRateLimitToken) Execute(input *dto.TakeRateLimitTokenInput) (*dto.TakeRateLimitTokenOutput, exceptions.UsecaseException) {
+	policy, found := u.Policies[input.Policy]
+
+	if !found {
+		return nil, exceptions.Usecase(fmt.Errorf("rate limit policy %q is not enabled", input.Policy), exceptions.UsecaseOpts{
+			Code:    u.code,
+			Message: "Error limiting the request rate",
+		})
+	}
+
+	now := time.Now()
+	key := string(policy.Name) + ":" + input.Client
+
+	bucket, taken, repoErr := u.RateLimitCache.Take(key, policy, now)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error limiting the request rate",
+		})
+	}
+
+	if taken {
+		bucket, taken, repoErr = u.RateLimitRepository.Take(key, policy, now)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error limiting the request rate",
+			})
+		}
+
+		u.RateLimitCache.Save(bucket)
+	}
+
+	return &dto.TakeRateLimitTokenOutput{
+		Taken:     taken,
+		Limit:     policy.Limit,
+		Window:    policy.Window,
+		Remaining: bucket.Remaining(),
+		ResetIn:   bucket.ResetIn(),
+		RetryIn:   bucket.RetryIn(),
+	}, nil
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type UpdateOneCategory struct {
+	CategoryRepository repository.Category
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewUpdateOneCategory(
+	categoryRepository repository.Category,
+	auditLogRepository repository.AuditLog,
+) *UpdateOneCategory {
+	return &UpdateOneCategory{
+		code:               "UpdateOneCategory",
+		CategoryRepository: categoryRepository,
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+func (u *UpdateOneCategory) Execute(input *dto.UpdateOneCategoryInput) (*dto.UpdateOneCategoryOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionCategoryWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	category, repoErr := u.CategoryRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category",
+		})
+	}
+
+	exists, repoErr := u.CategoryRepository.ExistsByName(input.Name, category.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if category exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error updating category, invalid name -> already exists other category with name %s", input.Name), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Category already exists",
+		})
+	}
+
+	before := categoryAuditFields(category)
+
+	entityErr := category.Update(entity.UpdateCategoryProps{
+		Name:        input.Name,
+		Description: input.Description,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error updating category in domain",
+		})
+	}
+
+	repoErr = u.CategoryRepository.UpdateOne(category)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error updating category in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityCategory,
+		EntityPublicID: string(category.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          categoryAuditFields(category),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.UpdateOneCategoryOutput{
+		Updated: true,
+		Message: "Category updated successfully",
+	}, nil
+}
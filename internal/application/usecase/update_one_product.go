@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"project/internal/application/dto"
 	"project/internal/application/services"
+	"project/internal/domain/constants"
 	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
@@ -12,21 +13,28 @@ import (
 type UpdateOneProduct struct {
 	ProductRepository  repository.Product
 	CategoryRepository repository.Category
+	AuditLogRepository repository.AuditLog
 	code               string
 }
 
 func NewUpdateOneProduct(
 	productRepository repository.Product,
 	categoryRepository repository.Category,
+	auditLogRepository repository.AuditLog,
 ) *UpdateOneProduct {
 	return &UpdateOneProduct{
 		code:               "UpdateOneProduct",
 		ProductRepository:  productRepository,
 		CategoryRepository: categoryRepository,
+		AuditLogRepository: auditLogRepository,
 	}
 }
 
 func (u *UpdateOneProduct) Execute(input *dto.UpdateOneProductInput) (*dto.UpdateOneProductOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionProductWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	category, repoErr := u.CategoryRepository.GetOneByPublicID(input.CategoryPublicID)
 
 	if repoErr != nil {
@@ -47,31 +55,65 @@ func (u *UpdateOneProduct) Execute(input *dto.UpdateOneProductInput) (*dto.Updat
 		})
 	}
 
-	exists, repoErr := u.ProductRepository.ExistsByName(input.Name, product.PublicID)
+	usecaseErr := checkProductVersion(product, input.Version, u.code)
 
-	if repoErr != nil {
-		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
-			Code:       u.code,
-			StatusCode: services.GetStatusCodeFromError(repoErr),
-			Message:    "Error checking if product exists",
-		})
+	if usecaseErr != nil {
+		return nil, usecaseErr
 	}
 
-	if exists {
-		return nil, exceptions.Usecase(fmt.Errorf("Error updating product, invalid name -> already exists other product with name %s", input.Name), exceptions.UsecaseOpts{
-			Code:       u.code,
-			StatusCode: 409,
-			Message:    "Product already exists",
-		})
+	variantLabel := input.VariantLabel
+
+	if product.IsVariant() {
+		if category.ID != product.CategoryID {
+			return nil, exceptions.Usecase(fmt.Errorf("Error updating product, variant %s follows the category of its family", product.PublicID), exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 422,
+				Message:    "A variant always has the category of its family",
+			})
+		}
+
+		if variantLabel == "" {
+			variantLabel = product.VariantLabel
+		}
+	} else {
+		if variantLabel != "" {
+			return nil, exceptions.Usecase(fmt.Errorf("Error updating product, %s is not a variant", product.PublicID), exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 422,
+				Message:    "Only variants have a variant label",
+			})
+		}
+
+		// variants share the family name, so only families and single products are checked
+		exists, repoErr := u.ProductRepository.ExistsByName(input.Name, product.PublicID)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error checking if product exists",
+			})
+		}
+
+		if exists {
+			return nil, exceptions.Usecase(fmt.Errorf("Error updating product, invalid name -> already exists other product with name %s", input.Name), exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 409,
+				Message:    "Product already exists",
+			})
+		}
 	}
 
+	before := productAuditFields(product, product.CategoryPublicID)
+
 	entityErr := product.Update(entity.UpdateProductProps{
-		CategoryID:  category.ID,
-		Name:        input.Name,
-		Description: input.Description,
-		Price:       input.Price,
-		Rating:      input.Rating,
-		ImageURL:    input.ImageURL,
+		CategoryID:   category.ID,
+		Name:         input.Name,
+		Description:  input.Description,
+		Price:        input.Price,
+		Rating:       input.Rating,
+		ImageURL:     input.ImageURL,
+		VariantLabel: variantLabel,
 	})
 
 	if entityErr != nil {
@@ -92,8 +134,45 @@ func (u *UpdateOneProduct) Execute(input *dto.UpdateOneProductInput) (*dto.Updat
 		})
 	}
 
+	usecaseErr = recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          productAuditFields(product, category.PublicID),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
 	return &dto.UpdateOneProductOutput{
 		Updated: true,
 		Message: "Product updated successfully",
+		Version: product.Version,
 	}, nil
 }
+
+// checkProductVersion refuses a write that does not say which version of the product
+// it is based on, or that is based on an older one.
+func checkProductVersion(product *entity.Product, version int64, code string) exceptions.UsecaseException {
+	if version == 0 {
+		return exceptions.Usecase(fmt.Errorf("Error writing product %s, no version given", product.PublicID), exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: 428,
+			Message:    "Product version is required, send it in If-Match or in the version field",
+		})
+	}
+
+	entityErr := product.CheckVersion(version)
+
+	if entityErr != nil {
+		return exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       code,
+			StatusCode: 412,
+			Message:    "Product was changed by someone else, read it again",
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type UpdateOneProductImage struct {
+	ProductRepository      repository.Product
+	ProductImageRepository repository.ProductImage
+	AuditLogRepository     repository.AuditLog
+	code                   string
+}
+
+func NewUpdateOneProductImage(
+	productRepository repository.Product,
+	productImageRepository repository.ProductImage,
+	auditLogRepository repository.AuditLog,
+) *UpdateOneProductImage {
+	return &UpdateOneProductImage{
+		code:                   "UpdateOneProductImage",
+		ProductRepository:      productRepository,
+		ProductImageRepository: productImageRepository,
+		AuditLogRepository:     auditLogRepository,
+	}
+}
+
+func (u *UpdateOneProductImage) Execute(input *dto.UpdateOneProductImageInput) (*dto.UpdateOneProductImageOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionProductWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	usecaseErr := loadProductGallery(u.ProductImageRepository, product, u.code)
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	before := productGalleryAuditFields(product.Images)
+
+	image, entityErr := product.FindImage(input.ImagePublicID)
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 404,
+			Message:    "Product image not found",
+		})
+	}
+
+	if input.Position != nil {
+		_, entityErr = product.MoveImage(image.PublicID, *input.Position)
+	}
+
+	if entityErr == nil && input.AltText != nil {
+		entityErr = image.UpdateAltText(*input.AltText)
+	}
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr = u.ProductImageRepository.UpdateOne(image, product.Images)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error updating product image",
+		})
+	}
+
+	usecaseErr = recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          productGalleryAuditFields(product.Images),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.UpdateOneProductImageOutput{
+		Updated: true,
+		Message: "Product image updated successfully",
+	}, nil
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type UpdateOneProductSpecificationValue struct {
+	ProductRepository                   repository.Product
+	SpecificationRepository             repository.Specification
+	ProductSpecificationValueRepository repository.ProductSpecificationValue
+	CategorySpecificationRepository     repository.CategorySpecification
+	AuditLogRepository                  repository.AuditLog
+	code                                string
+}
+
+func NewUpdateOneProductSpecificationValue(
+	productRepository repository.Product,
+	specificationRepository repository.Specification,
+	productSpecificationValueRepository repository.ProductSpecificationValue,
+	categorySpecificationRepository repository.CategorySpecification,
+	auditLogRepository repository.AuditLog,
+) *UpdateOneProductSpecificationValue {
+	return &UpdateOneProductSpecificationValue{
+		code:                                "UpdateOneProductSpecificationValue",
+		ProductRepository:                   productRepository,
+		SpecificationRepository:             specificationRepository,
+		ProductSpecificationValueRepository: productSpecificationValueRepository,
+		CategorySpecificationRepository:     categorySpecificationRepository,
+		AuditLogRepository:                  auditLogRepository,
+	}
+}
+
+func (u *UpdateOneProductSpecificationValue) Execute(input *dto.UpdateOneProductSpecificationValueInput) (*dto.UpdateOneProductSpecificationValueOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationValueWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	specification, repoErr := u.SpecificationRepository.GetOneByPublicID(input.SpecificationPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification",
+		})
+	}
+
+	template, repoErr := u.CategorySpecificationRepository.GetTemplateByCategoryID(product.CategoryID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category specification template",
+		})
+	}
+
+	if !template.Allows(specification.ID) {
+		return nil, exceptions.Usecase(fmt.Errorf("Error writing product specification value, specification %s is not part of the template of category %d", specification.PublicID, product.CategoryID), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    "Specification not allowed for product category",
+		})
+	}
+
+	productSpecificationValue, repoErr := u.ProductSpecificationValueRepository.FindOneByProductIDAndSpecificationID(product.ID, specification.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product specification value",
+		})
+	}
+
+	// the version is optional here, when it is sent the value must still be at it
+	if input.Version != 0 {
+		entityErr := productSpecificationValue.CheckVersion(input.Version)
+
+		if entityErr != nil {
+			return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 412,
+				Message:    "Product specification value was changed by someone else, read it again",
+			})
+		}
+	}
+
+	before := productSpecificationAuditFields(specification.PublicID, productSpecificationValue)
+
+	specValue, entityErr := entity.NewSpecValue(specification.Type, input.StringValue, input.IntValue, input.BoolValue)
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Invalid specification type",
+		})
+	}
+
+	entityErr = productSpecificationValue.UpdateValue(specValue)
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error updating product specification value in domain",
+		})
+	}
+
+	repoErr = u.ProductSpecificationValueRepository.UpdateOne(productSpecificationValue)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error updating product specification value in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          productSpecificationAuditFields(specification.PublicID, productSpecificationValue),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.UpdateOneProductSpecificationValueOutput{
+		Updated: true,
+		Message: "Product specification value updated successfully",
+		Version: productSpecificationValue.Version,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"strings"
+)
+
+type UpdateOneRetailer struct {
+	RetailerRepository repository.Retailer
+	AuditLogRepository repository.AuditLog
+	code               string
+}
+
+func NewUpdateOneRetailer(
+	retailerRepository repository.Retailer,
+	auditLogRepository repository.AuditLog,
+) *UpdateOneRetailer {
+	return &UpdateOneRetailer{
+		code:               "UpdateOneRetailer",
+		RetailerRepository: retailerRepository,
+		AuditLogRepository: auditLogRepository,
+	}
+}
+
+func (u *UpdateOneRetailer) Execute(input *dto.UpdateOneRetailerInput) (*dto.UpdateOneRetailerOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionRetailerWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	retailer, repoErr := u.RetailerRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting retailer",
+		})
+	}
+
+	exists, repoErr := u.RetailerRepository.ExistsByName(strings.TrimSpace(input.Name), retailer.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if retailer exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error updating retailer, invalid name -> already exists other retailer with name %s", input.Name), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Retailer already exists",
+		})
+	}
+
+	before := retailerAuditFields(retailer)
+
+	entityErr := retailer.Update(entity.UpdateRetailerProps{
+		Name:       input.Name,
+		WebsiteURL: input.WebsiteURL,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr = u.RetailerRepository.UpdateOne(retailer)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error updating retailer in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityRetailer,
+		EntityPublicID: string(retailer.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          retailerAuditFields(retailer),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.UpdateOneRetailerOutput{
+		Updated: true,
+		Message: "Retailer updated successfully",
+	}, nil
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type UpdateOneSpecification struct {
+	SpecificationRepository             repository.Specification
+	SpecificationGroupRepository        repository.SpecificationGroup
+	ProductSpecificationValueRepository repository.ProductSpecificationValue
+	AuditLogRepository                  repository.AuditLog
+	code                                string
+}
+
+func NewUpdateOneSpecification(
+	specificationRepository repository.Specification,
+	specificationGroupRepository repository.SpecificationGroup,
+	productSpecificationValueRepository repository.ProductSpecificationValue,
+	auditLogRepository repository.AuditLog,
+) *UpdateOneSpecification {
+	return &UpdateOneSpecification{
+		code:                                "UpdateOneSpecification",
+		SpecificationRepository:             specificationRepository,
+		SpecificationGroupRepository:        specificationGroupRepository,
+		ProductSpecificationValueRepository: productSpecificationValueRepository,
+		AuditLogRepository:                  auditLogRepository,
+	}
+}
+
+func (u *UpdateOneSpecification) Execute(input *dto.UpdateOneSpecificationInput) (*dto.UpdateOneSpecificationOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	specification, repoErr := u.SpecificationRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification",
+		})
+	}
+
+	group, repoErr := u.SpecificationGroupRepository.GetOneByPublicID(input.SpecificationGroupPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification group",
+		})
+	}
+
+	exists, repoErr := u.SpecificationRepository.ExistsByTitle(input.Title, group.ID, specification.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if specification exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error updating specification, invalid title -> already exists other specification with title %s in group %s", input.Title, group.PublicID), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Specification already exists",
+		})
+	}
+
+	before := specificationAuditFields(specification, specification.SpecificationGroupPublicID)
+
+	typeChanged := specification.Type != input.Type
+
+	entityErr := specification.Update(entity.UpdateSpecificationProps{
+		Title:                 input.Title,
+		EspecificationGroupID: group.ID,
+		Type:                  input.Type,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error updating specification in domain",
+		})
+	}
+
+	if !typeChanged {
+		repoErr = u.SpecificationRepository.UpdateOne(specification)
+
+		if repoErr != nil {
+			return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: services.GetStatusCodeFromError(repoErr),
+				Message:    "Error updating specification in repository",
+			})
+		}
+
+		usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+			EntityType:     constants.AuditEntitySpecification,
+			EntityPublicID: string(specification.PublicID),
+			Operation:      constants.AuditOperationUpdate,
+			Before:         before,
+			After:          specificationAuditFields(specification, group.PublicID),
+		})
+
+		if usecaseErr != nil {
+			return nil, usecaseErr
+		}
+
+		return &dto.UpdateOneSpecificationOutput{
+			Updated: true,
+			Message: "Specification updated successfully",
+		}, nil
+	}
+
+	totalValues, repoErr := u.SpecificationRepository.CountValues(specification.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error counting specification values",
+		})
+	}
+
+	if totalValues > 0 && !input.ConvertValues {
+		return nil, exceptions.Usecase(fmt.Errorf("Error updating specification, specification %s has %d values and its type cannot change without convert_values", specification.PublicID, totalValues), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Specification type cannot change while values exist",
+		})
+	}
+
+	values, repoErr := u.ProductSpecificationValueRepository.FindManyBySpecificationID(specification.ID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification values",
+		})
+	}
+
+	for _, value := range values {
+		entityErr = value.ConvertTo(specification.Type)
+
+		if entityErr != nil {
+			return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 409,
+				Message:    fmt.Sprintf("Error converting value of product %d to %s", value.ProductID, specification.Type),
+			})
+		}
+	}
+
+	repoErr = u.SpecificationRepository.UpdateOneWithValues(specification, values)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error updating specification in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntitySpecification,
+		EntityPublicID: string(specification.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          specificationAuditFields(specification, group.PublicID),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.UpdateOneSpecificationOutput{
+		Updated:         true,
+		ConvertedValues: len(values),
+		Message:         "Specification updated successfully",
+	}, nil
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type UpdateOneSpecificationGroup struct {
+	SpecificationGroupRepository repository.SpecificationGroup
+	AuditLogRepository           repository.AuditLog
+	code                         string
+}
+
+func NewUpdateOneSpecificationGroup(
+	specificationGroupRepository repository.SpecificationGroup,
+	auditLogRepository repository.AuditLog,
+) *UpdateOneSpecificationGroup {
+	return &UpdateOneSpecificationGroup{
+		code:                         "UpdateOneSpecificationGroup",
+		SpecificationGroupRepository: specificationGroupRepository,
+		AuditLogRepository:           auditLogRepository,
+	}
+}
+
+func (u *UpdateOneSpecificationGroup) Execute(input *dto.UpdateOneSpecificationGroupInput) (*dto.UpdateOneSpecificationGroupOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationManage); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	group, repoErr := u.SpecificationGroupRepository.GetOneByPublicID(input.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification group",
+		})
+	}
+
+	exists, repoErr := u.SpecificationGroupRepository.ExistsByName(input.Name, group.PublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error checking if specification group exists",
+		})
+	}
+
+	if exists {
+		return nil, exceptions.Usecase(fmt.Errorf("Error updating specification group, invalid name -> already exists other specification group with name %s", input.Name), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 409,
+			Message:    "Specification group already exists",
+		})
+	}
+
+	before := specificationGroupAuditFields(group)
+
+	entityErr := group.Update(entity.UpdateSpecificationGroupProps{
+		Name:        input.Name,
+		Description: input.Description,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error updating specification group in domain",
+		})
+	}
+
+	repoErr = u.SpecificationGroupRepository.UpdateOne(group)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error updating specification group in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntitySpecificationGroup,
+		EntityPublicID: string(group.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          specificationGroupAuditFields(group),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.UpdateOneSpecificationGroupOutput{
+		Updated: true,
+		Message: "Specification group updated successfully",
+	}, nil
+}
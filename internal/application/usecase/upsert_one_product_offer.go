@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"time"
+)
+
+type UpsertOneProductOffer struct {
+	ProductRepository      repository.Product
+	RetailerRepository     repository.Retailer
+	ProductOfferRepository repository.ProductOffer
+	AuditLogRepository     repository.AuditLog
+	code                   string
+}
+
+func NewUpsertOneProductOffer(
+	productRepository repository.Product,
+	retailerRepository repository.Retailer,
+	productOfferRepository repository.ProductOffer,
+	auditLogRepository repository.AuditLog,
+) *UpsertOneProductOffer {
+	return &UpsertOneProductOffer{
+		code:                   "UpsertOneProductOffer",
+		ProductRepository:      productRepository,
+		RetailerRepository:     retailerRepository,
+		ProductOfferRepository: productOfferRepository,
+		AuditLogRepository:     auditLogRepository,
+	}
+}
+
+// Execute records that the retailer sells the product at the given price, creating
+// the offer on the first sighting. Without last_seen_at the offer is seen now.
+func (u *UpsertOneProductOffer) Execute(input *dto.UpsertOneProductOfferInput) (*dto.UpsertOneProductOfferOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionPriceWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	lastSeenAt := time.Now()
+
+	if input.LastSeenAt != "" {
+		parsed, err := services.ParseTimestamp(input.LastSeenAt)
+
+		if err != nil {
+			return nil, exceptions.Usecase(err, exceptions.UsecaseOpts{
+				Code:       u.code,
+				StatusCode: 422,
+				Message:    "Invalid last_seen_at",
+			})
+		}
+
+		lastSeenAt = parsed
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	retailer, repoErr := u.RetailerRepository.GetOneByPublicID(input.RetailerPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting retailer",
+		})
+	}
+
+	offer, repoErr := u.ProductOfferRepository.GetOneByRetailerID(product.ID, retailer.ID)
+
+	if repoErr != nil && repoErr.Instance().Reason != constants.RepositoryNotFoundError {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product offer",
+		})
+	}
+
+	if offer == nil {
+		return u.create(product, retailer, input, lastSeenAt)
+	}
+
+	before := productOfferAuditFields(offer)
+
+	entityErr := offer.Update(entity.UpdateProductOfferProps{
+		Price:        input.Price,
+		URL:          input.URL,
+		Availability: input.Availability,
+		LastSeenAt:   lastSeenAt,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr = u.ProductOfferRepository.UpdateOne(offer)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error updating product offer in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         before,
+		After:          productOfferAuditFields(offer),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.UpsertOneProductOfferOutput{
+		PublicID: offer.PublicID,
+		Created:  false,
+	}, nil
+}
+
+func (u *UpsertOneProductOffer) create(product *entity.Product, retailer *entity.Retailer, input *dto.UpsertOneProductOfferInput, lastSeenAt time.Time) (*dto.UpsertOneProductOfferOutput, exceptions.UsecaseException) {
+	offer, entityErr := entity.NewProductOffer(entity.ProductOfferProps{
+		ProductID:        product.ID,
+		RetailerID:       retailer.ID,
+		RetailerPublicID: retailer.PublicID,
+		RetailerName:     retailer.Name,
+		Price:            input.Price,
+		URL:              input.URL,
+		Availability:     input.Availability,
+		LastSeenAt:       lastSeenAt,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	repoErr := u.ProductOfferRepository.CreateOne(offer)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error creating product offer in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		After:          productOfferAuditFields(offer),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.UpsertOneProductOfferOutput{
+		PublicID: offer.PublicID,
+		Created:  true,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type UpsertOneProductSpecificationValue struct {
+	ProductRepository                   repository.Product
+	SpecificationRepository             repository.Specification
+	ProductSpecificationValueRepository repository.ProductSpecificationValue
+	CategorySpecificationRepository     repository.CategorySpecification
+	AuditLogRepository                  repository.AuditLog
+	code                                string
+}
+
+func NewUpsertOneProductSpecificationValue(
+	productRepository repository.Product,
+	specificationRepository repository.Specification,
+	productSpecificationValueRepository repository.ProductSpecificationValue,
+	categorySpecificationRepository repository.CategorySpecification,
+	auditLogRepository repository.AuditLog,
+) *UpsertOneProductSpecificationValue {
+	return &UpsertOneProductSpecificationValue{
+		code:                                "UpsertOneProductSpecificationValue",
+		ProductRepository:                   productRepository,
+		SpecificationRepository:             specificationRepository,
+		ProductSpecificationValueRepository: productSpecificationValueRepository,
+		CategorySpecificationRepository:     categorySpecificationRepository,
+		AuditLogRepository:                  auditLogRepository,
+	}
+}
+
+func (u *UpsertOneProductSpecificationValue) Execute(input *dto.UpsertOneProductSpecificationValueInput) (*dto.UpsertOneProductSpecificationValueOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionSpecificationValueWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	specification, repoErr := u.SpecificationRepository.GetOneByPublicID(input.SpecificationPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting specification",
+		})
+	}
+
+	template, repoErr := u.CategorySpecificationRepository.GetTemplateByCategoryID(product.CategoryID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting category specification template",
+		})
+	}
+
+	if !template.Allows(specification.ID) {
+		return nil, exceptions.Usecase(fmt.Errorf("Error writing product specification value, specification %s is not part of the template of category %d", specification.PublicID, product.CategoryID), exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    "Specification not allowed for product category",
+		})
+	}
+
+	specValue, entityErr := entity.NewSpecValue(specification.Type, input.StringValue, input.IntValue, input.BoolValue)
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Invalid specification type",
+		})
+	}
+
+	productSpecificationValue, entityErr := entity.NewProductSpecificationValue(entity.ProductSpecificationValueProps{
+		ProductID:       product.ID,
+		SpecificationID: specification.ID,
+		Type:            specification.Type,
+		Value:           specValue,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 500,
+			Message:    "Error creating product specification value in domain",
+		})
+	}
+
+	var previousValue *entity.ProductSpecificationValue
+
+	for _, value := range product.SpecificationValues {
+		if value.SpecificationID == specification.ID {
+			previousValue = value
+		}
+	}
+
+	repoErr = u.ProductSpecificationValueRepository.UpsertOne(productSpecificationValue)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error saving product specification value in repository",
+		})
+	}
+
+	usecaseErr := recordAudit(u.AuditLogRepository, input.Actor, u.code, auditWrite{
+		EntityType:     constants.AuditEntityProduct,
+		EntityPublicID: string(product.PublicID),
+		Operation:      constants.AuditOperationUpdate,
+		Before:         productSpecificationAuditFields(specification.PublicID, previousValue),
+		After:          productSpecificationAuditFields(specification.PublicID, productSpecificationValue),
+	})
+
+	if usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	return &dto.UpsertOneProductSpecificationValueOutput{
+		Saved:   true,
+		Message: "Product specification value saved successfully",
+		Version: productSpecificationValue.Version,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/services"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+)
+
+type VoteProductReviewHelpful struct {
+	ProductRepository       repository.Product
+	ProductReviewRepository repository.ProductReview
+	code                    string
+}
+
+func NewVoteProductReviewHelpful(
+	productRepository repository.Product,
+	productReviewRepository repository.ProductReview,
+) *VoteProductReviewHelpful {
+	return &VoteProductReviewHelpful{
+		code:                    "VoteProductReviewHelpful",
+		ProductRepository:       productRepository,
+		ProductReviewRepository: productReviewRepository,
+	}
+}
+
+func (u *VoteProductReviewHelpful) Execute(input *dto.VoteProductReviewHelpfulInput) (*dto.VoteProductReviewHelpfulOutput, exceptions.UsecaseException) {
+	if usecaseErr := authorize(u.code, input.ActorRole, constants.PermissionReviewWrite); usecaseErr != nil {
+		return nil, usecaseErr
+	}
+
+	product, repoErr := u.ProductRepository.GetOneByPublicId(input.ProductPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product",
+		})
+	}
+
+	review, repoErr := u.ProductReviewRepository.GetOneByPublicID(product.ID, input.ReviewPublicID)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error getting product review",
+		})
+	}
+
+	entityErr := review.VoteHelpful()
+
+	if entityErr != nil {
+		return nil, exceptions.Usecase(entityErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: 422,
+			Message:    string(entityErr.Instance().Err),
+		})
+	}
+
+	// the repository counts the vote in the database, concurrent votes are not lost
+	repoErr = u.ProductReviewRepository.AddHelpfulVote(review)
+
+	if repoErr != nil {
+		return nil, exceptions.Usecase(repoErr, exceptions.UsecaseOpts{
+			Code:       u.code,
+			StatusCode: services.GetStatusCodeFromError(repoErr),
+			Message:    "Error voting product review",
+		})
+	}
+
+	return &dto.VoteProductReviewHelpfulOutput{
+		HelpfulVotes: review.HelpfulVotes,
+	}, nil
+}
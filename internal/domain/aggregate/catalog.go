@@ -0,0 +1,13 @@
+package aggregate
+
+import "project/internal/domain/entity"
+
+// Catalog is a full set of catalog rows written together, as done by the seeds.
+// Products carry their specification values.
+type Catalog struct {
+	SpecificationGroups []*entity.SpecificationGroup
+	Specifications      []*entity.Specification
+	Categories          []*entity.Category
+	CategoryTemplates   []*entity.CategoryTemplate
+	Products            []*entity.Product
+}
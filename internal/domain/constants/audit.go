@@ -0,0 +1,42 @@
+package constants
+
+import "project/internal/domain/types"
+
+const (
+	AuditEntityProduct            types.AuditEntityType = "product"
+	AuditEntityCategory           types.AuditEntityType = "category"
+	AuditEntitySpecification      types.AuditEntityType = "specification"
+	AuditEntitySpecificationGroup types.AuditEntityType = "specification_group"
+	AuditEntityRetailer           types.AuditEntityType = "retailer"
+)
+
+var AuditEntityTypes = []types.AuditEntityType{
+	AuditEntityProduct,
+	AuditEntityCategory,
+	AuditEntitySpecification,
+	AuditEntitySpecificationGroup,
+	AuditEntityRetailer,
+}
+
+const (
+	AuditOperationCreate  types.AuditOperation = "create"
+	AuditOperationUpdate  types.AuditOperation = "update"
+	AuditOperationDelete  types.AuditOperation = "delete"
+	AuditOperationRestore types.AuditOperation = "restore"
+	// AuditOperationPurge is the permanent removal of an entity from the trash.
+	AuditOperationPurge types.AuditOperation = "purge"
+)
+
+var AuditOperations = []types.AuditOperation{
+	AuditOperationCreate,
+	AuditOperationUpdate,
+	AuditOperationDelete,
+	AuditOperationRestore,
+	AuditOperationPurge,
+}
+
+// AuditAnonymousActor is recorded when a write does not say who made it.
+const AuditAnonymousActor types.Actor = "anonymous"
+
+// AuditActorMaxLength bounds the actor name a request can send.
+const AuditActorMaxLength = 100
@@ -0,0 +1,99 @@
+package constants
+
+import "project/internal/domain/types"
+
+const (
+	AuthMethodApiKey types.AuthMethod = "api_key"
+	AuthMethodJWT    types.AuthMethod = "jwt"
+)
+
+// ApiKeyPrefix starts every API key, "pck_<public id>_<secret>", so a key is told
+// apart from a JWT and found by its public ID.
+const ApiKeyPrefix = "pck"
+
+// ApiKeySecretSize is the random bytes of the secret of an API key, written out in hex.
+const ApiKeySecretSize = 24
+
+const ApiKeyNameMaxLength = 100
+
+const (
+	// RoleViewer reads the catalog and keeps its own reviews and watchlists.
+	RoleViewer types.Role = "viewer"
+	// RoleEditor also changes products, prices, specification values, categories
+	// and retailers and reads the audit log, but deletes none of the catalog.
+	RoleEditor types.Role = "editor"
+	// RoleCatalogAdmin also deletes and restores, manages the specification types,
+	// moderates reviews, manages the webhooks and reads the trash.
+	RoleCatalogAdmin types.Role = "catalog-admin"
+	// RoleSystem is the server itself: the schedulers, the importers, the seed and
+	// shopctl on the database. It is never given to an API key or a JWT.
+	RoleSystem types.Role = "system"
+)
+
+// AssignableRoles are the roles an API key or a JWT can carry.
+var AssignableRoles = []types.Role{RoleViewer, RoleEditor, RoleCatalogAdmin}
+
+// JWTDefaultRole is the role of a JWT without the role claim.
+const JWTDefaultRole = RoleViewer
+
+const (
+	PermissionReviewWrite             types.Permission = "review:write"
+	PermissionWatchlistWrite          types.Permission = "watchlist:write"
+	PermissionProductWrite            types.Permission = "product:write"
+	PermissionPriceWrite              types.Permission = "price:write"
+	PermissionSpecificationValueWrite types.Permission = "specification_value:write"
+	PermissionCategoryWrite           types.Permission = "category:write"
+	PermissionRetailerWrite           types.Permission = "retailer:write"
+	PermissionProductDelete           types.Permission = "product:delete"
+	PermissionCategoryDelete          types.Permission = "category:delete"
+	PermissionRetailerDelete          types.Permission = "retailer:delete"
+	PermissionSpecificationManage     types.Permission = "specification:manage"
+	PermissionReviewModerate          types.Permission = "review:moderate"
+	PermissionWebhookManage           types.Permission = "webhook:manage"
+	PermissionCatalogImport           types.Permission = "catalog:import"
+	PermissionTrashPurge              types.Permission = "trash:purge"
+	PermissionCatalogSeed             types.Permission = "catalog:seed"
+	PermissionApiKeyManage            types.Permission = "api_key:manage"
+	PermissionAuditRead               types.Permission = "audit:read"
+	PermissionWebhookRead             types.Permission = "webhook:read"
+	PermissionTrashRead               types.Permission = "trash:read"
+)
+
+var viewerPermissions = []types.Permission{
+	PermissionReviewWrite,
+	PermissionWatchlistWrite,
+}
+
+var editorPermissions = append([]types.Permission{
+	PermissionProductWrite,
+	PermissionPriceWrite,
+	PermissionSpecificationValueWrite,
+	PermissionCategoryWrite,
+	PermissionRetailerWrite,
+	PermissionAuditRead,
+}, viewerPermissions...)
+
+var catalogAdminPermissions = append([]types.Permission{
+	PermissionProductDelete,
+	PermissionCategoryDelete,
+	PermissionRetailerDelete,
+	PermissionSpecificationManage,
+	PermissionReviewModerate,
+	PermissionWebhookManage,
+	PermissionCatalogImport,
+	PermissionTrashPurge,
+	PermissionWebhookRead,
+	PermissionTrashRead,
+}, editorPermissions...)
+
+// RolePermissions is what each role may do, every role has the permissions of the
+// ones before it.
+var RolePermissions = map[types.Role][]types.Permission{
+	RoleViewer:       viewerPermissions,
+	RoleEditor:       editorPermissions,
+	RoleCatalogAdmin: catalogAdminPermissions,
+	RoleSystem: append([]types.Permission{
+		PermissionCatalogSeed,
+		PermissionApiKeyManage,
+	}, catalogAdminPermissions...),
+}
@@ -0,0 +1,7 @@
+package constants
+
+import "project/internal/domain/types"
+
+const (
+	ExternalSourceFakeStore types.ExternalSource = "fakestore"
+)
@@ -0,0 +1,58 @@
+package constants
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+const (
+	OutboxAggregateProduct  types.OutboxAggregateType = "product"
+	OutboxAggregateCategory types.OutboxAggregateType = "category"
+)
+
+const (
+	OutboxEventProductCreated               types.OutboxEventType = "product.created"
+	OutboxEventProductUpdated               types.OutboxEventType = "product.updated"
+	OutboxEventProductDeleted               types.OutboxEventType = "product.deleted"
+	OutboxEventProductRestored              types.OutboxEventType = "product.restored"
+	OutboxEventProductSpecificationsUpdated types.OutboxEventType = "product.specifications_updated"
+	OutboxEventCategoryCreated              types.OutboxEventType = "category.created"
+	OutboxEventCategoryUpdated              types.OutboxEventType = "category.updated"
+	OutboxEventCategoryDeleted              types.OutboxEventType = "category.deleted"
+	OutboxEventCategoryRestored             types.OutboxEventType = "category.restored"
+)
+
+// OutboxEventTypes are the events a webhook subscription can ask for, besides the
+// wildcards of an aggregate like "product.*".
+var OutboxEventTypes = []types.OutboxEventType{
+	OutboxEventProductCreated,
+	OutboxEventProductUpdated,
+	OutboxEventProductDeleted,
+	OutboxEventProductRestored,
+	OutboxEventProductSpecificationsUpdated,
+	OutboxEventCategoryCreated,
+	OutboxEventCategoryUpdated,
+	OutboxEventCategoryDeleted,
+	OutboxEventCategoryRestored,
+}
+
+const (
+	WebhookDeliveryStatusPending types.WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryStatusSending is a delivery a dispatcher claimed, it is due again
+	// when the dispatcher stops before recording an answer.
+	WebhookDeliveryStatusSending   types.WebhookDeliveryStatus = "sending"
+	WebhookDeliveryStatusDelivered types.WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryStatusDead is a delivery that ran out of attempts, it is only
+	// sent again when replayed.
+	WebhookDeliveryStatusDead types.WebhookDeliveryStatus = "dead"
+)
+
+// WebhookSubscriptionMinSecretLength keeps the signing secrets hard to guess.
+const WebhookSubscriptionMinSecretLength = 16
+
+// WebhookDeliveryMaxBackoff caps the wait between two attempts of a delivery.
+const WebhookDeliveryMaxBackoff = time.Hour
+
+// OutboxDispatchBatchSize is how many events are fanned out, and how many deliveries
+// are attempted, on each dispatch.
+const OutboxDispatchBatchSize = 100
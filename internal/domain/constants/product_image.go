@@ -0,0 +1,19 @@
+package constants
+
+const (
+	ProductImageMaxSize       int64 = 5 << 20
+	ProductImageMaxPerProduct int   = 20
+	ProductImageThumbnailSide int   = 320
+)
+
+const (
+	ProductImageContentTypeJPEG = "image/jpeg"
+	ProductImageContentTypePNG  = "image/png"
+	ProductImageContentTypeGIF  = "image/gif"
+)
+
+var ProductImageContentTypes = []string{
+	ProductImageContentTypeJPEG,
+	ProductImageContentTypePNG,
+	ProductImageContentTypeGIF,
+}
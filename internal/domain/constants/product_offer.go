@@ -0,0 +1,15 @@
+package constants
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+const (
+	ProductOfferAvailabilityInStock    types.ProductOfferAvailability = "in_stock"
+	ProductOfferAvailabilityOutOfStock types.ProductOfferAvailability = "out_of_stock"
+)
+
+// ProductOfferMaxAge is how long an offer stays current after the store was last
+// seen selling the product at that price.
+const ProductOfferMaxAge = 7 * 24 * time.Hour
@@ -0,0 +1,14 @@
+package constants
+
+import "project/internal/domain/types"
+
+// Only approved reviews are public and count for the product rating.
+const (
+	ProductReviewStatusPending  types.ProductReviewStatus = "pending"
+	ProductReviewStatusApproved types.ProductReviewStatus = "approved"
+	ProductReviewStatusRejected types.ProductReviewStatus = "rejected"
+)
+
+// ProductReviewMinConfidentCount is the number of reviews below which a rating is
+// compared with a caveat.
+const ProductReviewMinConfidentCount int64 = 5
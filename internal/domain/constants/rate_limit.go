@@ -0,0 +1,30 @@
+package constants
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+const (
+	// RateLimitPolicyRead applies to the safe methods.
+	RateLimitPolicyRead types.RateLimitPolicyName = "read"
+	// RateLimitPolicyWrite applies to the requests that change the catalog.
+	RateLimitPolicyWrite types.RateLimitPolicyName = "write"
+	// RateLimitPolicyCompare applies to the product comparison, which runs several
+	// queries and every comparator on each request.
+	RateLimitPolicyCompare types.RateLimitPolicyName = "compare"
+	// RateLimitPolicyAuth applies, by IP address, to the requests that send a
+	// credential, before it is checked.
+	RateLimitPolicyAuth types.RateLimitPolicyName = "auth"
+)
+
+var RateLimitPolicyNames = []types.RateLimitPolicyName{
+	RateLimitPolicyRead,
+	RateLimitPolicyWrite,
+	RateLimitPolicyCompare,
+	RateLimitPolicyAuth,
+}
+
+// RateLimitMinWindow is the shortest window of a policy, the RateLimit headers count
+// in seconds.
+const RateLimitMinWindow = time.Second
@@ -12,6 +12,10 @@ const (
 	RepositoryQuerySyntaxError         RepositoryErrorReason = "query_syntax_error"
 	RepositoryTransactionError         RepositoryErrorReason = "transaction_error"
 	RepositoryTimeoutError             RepositoryErrorReason = "timeout_error"
+	RepositoryStorageError             RepositoryErrorReason = "storage_error"
+	RepositoryWebhookError             RepositoryErrorReason = "webhook_error"
+	RepositoryVersionConflictError     RepositoryErrorReason = "version_conflict_error"
+	RepositoryInvalidTokenError        RepositoryErrorReason = "invalid_token_error"
 )
 
 const (
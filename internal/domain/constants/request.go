@@ -0,0 +1,9 @@
+package constants
+
+const (
+	// RequestIDMaxLength is the longest X-Request-ID taken from a client, a longer
+	// one is replaced by a new ID.
+	RequestIDMaxLength = 128
+	// RequestIDSize is the number of random bytes of a new request ID, written in hex.
+	RequestIDSize = 16
+)
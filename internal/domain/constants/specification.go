@@ -26,3 +26,30 @@ const (
 	WeightKg       types.SpecificationID = 15
 	VolumeLiters   types.SpecificationID = 16
 )
+
+const (
+	SpecificationRequirementRequired    types.SpecificationRequirement = "required"
+	SpecificationRequirementRecommended types.SpecificationRequirement = "recommended"
+	SpecificationRequirementAllowed     types.SpecificationRequirement = "allowed"
+)
+
+// BuiltInSpecifications are the specifications the comparison rules are written
+// for, with the type each rule reads. Seeds must create them with these IDs.
+var BuiltInSpecifications = map[types.SpecificationID]types.SpecificationType{
+	PowerInWatts:   SpecificationTypeInt,
+	ConsumptionKwh: SpecificationTypeInt,
+	CapacityLiters: SpecificationTypeInt,
+	FrequencyMHz:   SpecificationTypeInt,
+	FrequencyGHz:   SpecificationTypeInt,
+	Threads:        SpecificationTypeInt,
+	TDPWatts:       SpecificationTypeInt,
+	USBC:           SpecificationTypeBool,
+	Waterproof:     SpecificationTypeBool,
+	NoiseDb:        SpecificationTypeInt,
+	CaloriesKcal:   SpecificationTypeInt,
+	WidthCm:        SpecificationTypeInt,
+	HeightCm:       SpecificationTypeInt,
+	DepthCm:        SpecificationTypeInt,
+	WeightKg:       SpecificationTypeInt,
+	VolumeLiters:   SpecificationTypeInt,
+}
@@ -0,0 +1,27 @@
+package constants
+
+import (
+	"project/internal/domain/types"
+	"time"
+)
+
+const (
+	TrashEntityProduct            types.TrashEntityType = "product"
+	TrashEntityCategory           types.TrashEntityType = "category"
+	TrashEntitySpecification      types.TrashEntityType = "specification"
+	TrashEntitySpecificationGroup types.TrashEntityType = "specification_group"
+)
+
+var TrashEntityTypes = []types.TrashEntityType{
+	TrashEntityProduct,
+	TrashEntityCategory,
+	TrashEntitySpecification,
+	TrashEntitySpecificationGroup,
+}
+
+// TrashDefaultRetention is how long a deleted entity stays restorable when the
+// retention is not configured.
+const TrashDefaultRetention = 30 * 24 * time.Hour
+
+// TrashPurgeActor is recorded in the audit log for the purges run by the scheduler.
+const TrashPurgeActor types.Actor = "trash-purge"
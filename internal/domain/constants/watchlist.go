@@ -0,0 +1,22 @@
+package constants
+
+import "project/internal/domain/types"
+
+// What made the watchlist check the product price.
+const (
+	WatchlistTriggerPriceUpdate types.WatchlistTrigger = "price_update"
+	WatchlistTriggerSweep       types.WatchlistTrigger = "sweep"
+)
+
+const (
+	WatchlistDeliveryStatusDelivered types.WatchlistDeliveryStatus = "delivered"
+	WatchlistDeliveryStatusFailed    types.WatchlistDeliveryStatus = "failed"
+)
+
+// WatchlistMinSubscriberTokenLength keeps subscriber tokens hard to guess, they are
+// the only thing that gives access to the watchlists.
+const WatchlistMinSubscriberTokenLength = 16
+
+// WatchlistPriceCheckBatchSize is how many products whose price was updated are
+// checked on each watchlist check.
+const WatchlistPriceCheckBatchSize = 100
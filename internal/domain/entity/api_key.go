@@ -0,0 +1,153 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/services"
+	. "project/internal/domain/types"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ApiKey authenticates the requests of a client. Only the hash of the key is kept,
+// the key itself is shown once when it is created.
+type ApiKey struct {
+	ID       int64
+	PublicID ApiKeyPublicID
+	Name     string
+	// Key is known only when the key is created, it is never read back.
+	Key        string
+	Hash       string
+	Role       Role
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	RevokedAt  time.Time
+}
+
+type ApiKeyProps struct {
+	ID         int64
+	PublicID   ApiKeyPublicID
+	Name       string
+	Hash       string
+	Role       Role
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	RevokedAt  time.Time
+}
+
+// NewApiKey generates the key when there is no Hash, that is when it is created.
+func NewApiKey(props ApiKeyProps) (*ApiKey, exceptions.EntityException) {
+	publicID, err := services.GeneratePublicID(props.PublicID)
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	apiKey := &ApiKey{
+		ID:         props.ID,
+		PublicID:   publicID,
+		Name:       strings.TrimSpace(props.Name),
+		Hash:       props.Hash,
+		Role:       props.Role,
+		CreatedAt:  props.CreatedAt,
+		LastUsedAt: props.LastUsedAt,
+		RevokedAt:  props.RevokedAt,
+	}
+
+	if apiKey.Hash == "" {
+		secret, err := services.GenerateSecret(constants.ApiKeySecretSize)
+		if err != nil {
+			return nil, exceptions.Entity(err, exceptions.EntityOpts{
+				Reason: constants.EntityBussinessError,
+			})
+		}
+
+		apiKey.Key = fmt.Sprintf("%s_%s_%s", constants.ApiKeyPrefix, publicID, secret)
+		apiKey.Hash = HashApiKey(apiKey.Key)
+	}
+
+	err = apiKey.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return apiKey, nil
+}
+
+// HashApiKey is what is stored of a key. The secret is random, a plain SHA-256 is
+// enough to keep it from being read back.
+func HashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseApiKey reads the public ID out of a key, false when it is not one.
+func ParseApiKey(key string) (ApiKeyPublicID, bool) {
+	parts := strings.Split(key, "_")
+
+	if len(parts) != 3 || parts[0] != constants.ApiKeyPrefix || len(parts[1]) != 8 || len(parts[2]) != constants.ApiKeySecretSize*2 {
+		return "", false
+	}
+
+	return ApiKeyPublicID(parts[1]), true
+}
+
+func (k *ApiKey) IsRevoked() bool {
+	return !k.RevokedAt.IsZero()
+}
+
+// Verify checks that key is this one and that it was not revoked.
+func (k *ApiKey) Verify(key string) exceptions.EntityException {
+	if k.IsRevoked() {
+		return exceptions.Entity(fmt.Errorf("api key %s is revoked", k.PublicID), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	if subtle.ConstantTimeCompare([]byte(HashApiKey(key)), []byte(k.Hash)) != 1 {
+		return exceptions.Entity(fmt.Errorf("api key %s does not match", k.PublicID), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	return nil
+}
+
+func (k *ApiKey) validate() error {
+	if k.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if len(k.PublicID) != 8 {
+		return errors.New("PublicID must be exactly 8 characters long")
+	}
+
+	if k.Name == "" {
+		return errors.New("Name cannot be empty")
+	}
+
+	if len(k.Name) > constants.ApiKeyNameMaxLength {
+		return fmt.Errorf("Name cannot be longer than %d characters", constants.ApiKeyNameMaxLength)
+	}
+
+	if !slices.Contains(constants.AssignableRoles, k.Role) {
+		return fmt.Errorf("Role %q is not valid", k.Role)
+	}
+
+	if len(k.Hash) != sha256.Size*2 {
+		return errors.New("Hash must be a hex SHA-256")
+	}
+
+	return nil
+}
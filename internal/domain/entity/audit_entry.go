@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	. "project/internal/domain/types"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// AuditChange is one field of an audited write. Before is nil for a field the entity
+// did not have and After is nil for a field it no longer has.
+type AuditChange struct {
+	Field  string
+	Before any
+	After  any
+}
+
+// AuditEntry records who wrote an entity, when, and how each of its fields changed.
+// Writes to the specification values, offers and images of a product are recorded
+// on the product, as the fields "specifications.<public_id>", "offers.<public_id>"
+// and "images.<public_id>".
+type AuditEntry struct {
+	ID             int64
+	Actor          Actor
+	EntityType     AuditEntityType
+	EntityPublicID string
+	Operation      AuditOperation
+	Changes        []AuditChange
+	CreatedAt      time.Time
+}
+
+type AuditEntryProps struct {
+	ID             int64
+	Actor          Actor
+	EntityType     AuditEntityType
+	EntityPublicID string
+	Operation      AuditOperation
+	Changes        []AuditChange
+	CreatedAt      time.Time
+}
+
+func NewAuditEntry(props AuditEntryProps) (*AuditEntry, exceptions.EntityException) {
+	entry := &AuditEntry{
+		ID:             props.ID,
+		Actor:          Actor(strings.TrimSpace(string(props.Actor))),
+		EntityType:     props.EntityType,
+		EntityPublicID: props.EntityPublicID,
+		Operation:      props.Operation,
+		Changes:        props.Changes,
+		CreatedAt:      props.CreatedAt,
+	}
+
+	if entry.Actor == "" {
+		entry.Actor = constants.AuditAnonymousActor
+	}
+
+	err := entry.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return entry, nil
+}
+
+// DiffAuditFields compares two snapshots of an entity, field by field, and returns
+// the fields that changed sorted by name. A nil snapshot is an entity that does not
+// exist, so every field of the other one is a change.
+func DiffAuditFields(before, after map[string]any) []AuditChange {
+	fields := make([]string, 0, len(before)+len(after))
+
+	for field := range before {
+		fields = append(fields, field)
+	}
+
+	for field := range after {
+		if _, ok := before[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+
+	slices.Sort(fields)
+
+	changes := make([]AuditChange, 0, len(fields))
+
+	for _, field := range fields {
+		if reflect.DeepEqual(before[field], after[field]) {
+			continue
+		}
+
+		changes = append(changes, AuditChange{
+			Field:  field,
+			Before: before[field],
+			After:  after[field],
+		})
+	}
+
+	return changes
+}
+
+func (e *AuditEntry) validate() error {
+	if e.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if len(e.Actor) > constants.AuditActorMaxLength {
+		return fmt.Errorf("Actor cannot be longer than %d characters", constants.AuditActorMaxLength)
+	}
+
+	if !slices.Contains(constants.AuditEntityTypes, e.EntityType) {
+		return fmt.Errorf("EntityType %q is not valid", e.EntityType)
+	}
+
+	if e.EntityPublicID == "" {
+		return errors.New("EntityPublicID cannot be empty")
+	}
+
+	if !slices.Contains(constants.AuditOperations, e.Operation) {
+		return fmt.Errorf("Operation %q is not valid", e.Operation)
+	}
+
+	if len(e.Changes) == 0 {
+		return errors.New("Changes cannot be empty")
+	}
+
+	for _, change := range e.Changes {
+		if change.Field == "" {
+			return errors.New("Field of a change cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// AuditLogFilter narrows the audit log, its zero fields match every entry. From and
+// To bound when the writes happened, both inclusive.
+type AuditLogFilter struct {
+	Actor          Actor
+	EntityType     AuditEntityType
+	EntityPublicID string
+	Operation      AuditOperation
+	From           time.Time
+	To             time.Time
+}
@@ -22,6 +22,11 @@ type CategoryProps struct {
 	Description string
 }
 
+type UpdateCategoryProps struct {
+	Name        string
+	Description string
+}
+
 func NewCategory(props CategoryProps) (*Category, exceptions.EntityException) {
 	publicID, err := services.GeneratePublicID(props.PublicID)
 	if err != nil {
@@ -48,6 +53,21 @@ func NewCategory(props CategoryProps) (*Category, exceptions.EntityException) {
 	return category, nil
 }
 
+func (c *Category) Update(props UpdateCategoryProps) exceptions.EntityException {
+	c.Name = props.Name
+	c.Description = props.Description
+
+	err := c.validate()
+
+	if err != nil {
+		return exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return nil
+}
+
 func (c *Category) validate() error {
 	if c.ID < 0 {
 		return errors.New("ID field cannot be less than 0")
@@ -0,0 +1,194 @@
+package entity
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	. "project/internal/domain/types"
+	"slices"
+)
+
+// CategorySpecification is one entry of a category template: it says whether a
+// specification is required, recommended or only allowed for products of the category.
+type CategorySpecification struct {
+	ID              int64
+	CategoryID      CategoryID
+	SpecificationID SpecificationID
+	Requirement     SpecificationRequirement
+	DisplayOrder    int64
+	Specification   *Specification
+}
+
+type CategorySpecificationProps struct {
+	ID              int64
+	CategoryID      CategoryID
+	SpecificationID SpecificationID
+	Requirement     SpecificationRequirement
+	DisplayOrder    int64
+	Specification   *Specification
+}
+
+type CategoryTemplate struct {
+	CategoryID     CategoryID
+	Specifications []*CategorySpecification
+}
+
+type CategoryTemplateCompleteness struct {
+	RequiredTotal      int
+	RequiredFilled     int
+	RecommendedTotal   int
+	RecommendedFilled  int
+	MissingRequired    []*CategorySpecification
+	MissingRecommended []*CategorySpecification
+}
+
+func NewCategorySpecification(props CategorySpecificationProps) (*CategorySpecification, exceptions.EntityException) {
+	categorySpecification := &CategorySpecification{
+		ID:              props.ID,
+		CategoryID:      props.CategoryID,
+		SpecificationID: props.SpecificationID,
+		Requirement:     props.Requirement,
+		DisplayOrder:    props.DisplayOrder,
+		Specification:   props.Specification,
+	}
+
+	err := categorySpecification.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return categorySpecification, nil
+}
+
+func (cs *CategorySpecification) validate() error {
+	if cs.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if cs.CategoryID <= 0 {
+		return errors.New("CategoryID field must be greater than 0")
+	}
+
+	if cs.SpecificationID <= 0 {
+		return errors.New("SpecificationID field must be greater than 0")
+	}
+
+	switch cs.Requirement {
+	case constants.SpecificationRequirementRequired, constants.SpecificationRequirementRecommended, constants.SpecificationRequirementAllowed:
+	default:
+		return errors.New("Requirement must be one of required, recommended or allowed")
+	}
+
+	if cs.DisplayOrder < 0 {
+		return errors.New("DisplayOrder field cannot be less than 0")
+	}
+
+	return nil
+}
+
+// NewCategoryTemplate builds a template sorted by display order. A category without
+// entries has no template and accepts any specification.
+func NewCategoryTemplate(categoryID CategoryID, specifications []*CategorySpecification) (*CategoryTemplate, exceptions.EntityException) {
+	template := &CategoryTemplate{
+		CategoryID:     categoryID,
+		Specifications: slices.Clone(specifications),
+	}
+
+	err := template.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	slices.SortStableFunc(template.Specifications, func(a, b *CategorySpecification) int {
+		return cmp.Compare(a.DisplayOrder, b.DisplayOrder)
+	})
+
+	return template, nil
+}
+
+func (t *CategoryTemplate) validate() error {
+	if t.CategoryID <= 0 {
+		return errors.New("CategoryID field must be greater than 0")
+	}
+
+	seen := make(map[SpecificationID]bool, len(t.Specifications))
+
+	for _, specification := range t.Specifications {
+		if specification.CategoryID != t.CategoryID {
+			return fmt.Errorf("specification %d belongs to category %d, not %d", specification.SpecificationID, specification.CategoryID, t.CategoryID)
+		}
+
+		if seen[specification.SpecificationID] {
+			return fmt.Errorf("specification %d is listed more than once", specification.SpecificationID)
+		}
+
+		seen[specification.SpecificationID] = true
+	}
+
+	return nil
+}
+
+func (t *CategoryTemplate) IsEmpty() bool {
+	return len(t.Specifications) == 0
+}
+
+// Allows reports whether products of the category may hold a value for the specification.
+func (t *CategoryTemplate) Allows(specificationID SpecificationID) bool {
+	if t.IsEmpty() {
+		return true
+	}
+
+	return slices.ContainsFunc(t.Specifications, func(cs *CategorySpecification) bool {
+		return cs.SpecificationID == specificationID
+	})
+}
+
+// Completeness lists the required and recommended specifications the values do not fill.
+func (t *CategoryTemplate) Completeness(values []*ProductSpecificationValue) *CategoryTemplateCompleteness {
+	filled := make(map[SpecificationID]bool, len(values))
+
+	for _, value := range values {
+		filled[value.SpecificationID] = true
+	}
+
+	completeness := &CategoryTemplateCompleteness{
+		MissingRequired:    []*CategorySpecification{},
+		MissingRecommended: []*CategorySpecification{},
+	}
+
+	for _, specification := range t.Specifications {
+		switch specification.Requirement {
+		case constants.SpecificationRequirementRequired:
+			completeness.RequiredTotal++
+
+			if filled[specification.SpecificationID] {
+				completeness.RequiredFilled++
+			} else {
+				completeness.MissingRequired = append(completeness.MissingRequired, specification)
+			}
+		case constants.SpecificationRequirementRecommended:
+			completeness.RecommendedTotal++
+
+			if filled[specification.SpecificationID] {
+				completeness.RecommendedFilled++
+			} else {
+				completeness.MissingRecommended = append(completeness.MissingRecommended, specification)
+			}
+		}
+	}
+
+	return completeness
+}
+
+// Complete tells if the product fills every required spec, the recommended ones can be missing.
+func (c *CategoryTemplateCompleteness) Complete() bool {
+	return len(c.MissingRequired) == 0
+}
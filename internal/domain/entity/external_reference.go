@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"errors"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	. "project/internal/domain/types"
+)
+
+// ExternalReference links a product to its identifier in an external catalog, so
+// importing the same source again updates the product instead of duplicating it.
+type ExternalReference struct {
+	ID              int64
+	Source          ExternalSource
+	ExternalID      string
+	ProductID       ProductID
+	ProductPublicID ProductPublicID
+}
+
+type ExternalReferenceProps struct {
+	ID              int64
+	Source          ExternalSource
+	ExternalID      string
+	ProductID       ProductID
+	ProductPublicID ProductPublicID
+}
+
+func NewExternalReference(props ExternalReferenceProps) (*ExternalReference, exceptions.EntityException) {
+	externalReference := &ExternalReference{
+		ID:              props.ID,
+		Source:          props.Source,
+		ExternalID:      props.ExternalID,
+		ProductID:       props.ProductID,
+		ProductPublicID: props.ProductPublicID,
+	}
+
+	err := externalReference.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return externalReference, nil
+}
+
+func (r *ExternalReference) validate() error {
+	if r.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if r.Source == "" {
+		return errors.New("Source cannot be empty")
+	}
+
+	if r.ExternalID == "" {
+		return errors.New("ExternalID cannot be empty")
+	}
+
+	if len(r.ExternalID) > 255 {
+		return errors.New("ExternalID cannot be longer than 255 characters")
+	}
+
+	if r.ProductID < 0 {
+		return errors.New("ProductID field cannot be less than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	. "project/internal/domain/types"
+	"slices"
+	"time"
+)
+
+// OutboxEvent is a change to a product or a category, written in the same
+// transaction as the change itself. Payload is the JSON of the aggregate after it.
+type OutboxEvent struct {
+	ID                int64
+	Type              OutboxEventType
+	AggregateType     OutboxAggregateType
+	AggregatePublicID string
+	Payload           []byte
+	CreatedAt         time.Time
+}
+
+type OutboxEventProps struct {
+	ID                int64
+	Type              OutboxEventType
+	AggregateType     OutboxAggregateType
+	AggregatePublicID string
+	Payload           []byte
+	CreatedAt         time.Time
+}
+
+func NewOutboxEvent(props OutboxEventProps) (*OutboxEvent, exceptions.EntityException) {
+	event := &OutboxEvent{
+		ID:                props.ID,
+		Type:              props.Type,
+		AggregateType:     props.AggregateType,
+		AggregatePublicID: props.AggregatePublicID,
+		Payload:           props.Payload,
+		CreatedAt:         props.CreatedAt,
+	}
+
+	err := event.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return event, nil
+}
+
+func (e *OutboxEvent) validate() error {
+	if e.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if !slices.Contains(constants.OutboxEventTypes, e.Type) {
+		return fmt.Errorf("Type %q is not valid", e.Type)
+	}
+
+	switch e.AggregateType {
+	case constants.OutboxAggregateProduct, constants.OutboxAggregateCategory:
+	default:
+		return fmt.Errorf("AggregateType %q is not valid", e.AggregateType)
+	}
+
+	if e.AggregatePublicID == "" {
+		return errors.New("AggregatePublicID cannot be empty")
+	}
+
+	if !json.Valid(e.Payload) {
+		return errors.New("Payload must be valid JSON")
+	}
+
+	return nil
+}
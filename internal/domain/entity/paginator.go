@@ -1,10 +1,24 @@
 package entity
 
+import . "project/internal/domain/types"
+
+// PaginatorInput pages a listing; Search is a LIKE pattern matched against names and
+// descriptions, empty to list everything.
 type PaginatorInput struct {
-	Skip  int64
-	Limit int64
+	Skip   int64
+	Limit  int64
+	Search string
 }
 
 type PaginatorOutput struct {
 	Total int64
 }
+
+// ProductCursorInput reads products in ID order starting after AfterID, so a large
+// catalog can be walked in batches. A zero CategoryID means every category.
+type ProductCursorInput struct {
+	AfterID    ProductID
+	CategoryID CategoryID
+	Skip       int64
+	Limit      int64
+}
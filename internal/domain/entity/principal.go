@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	. "project/internal/domain/types"
+	"slices"
+	"strings"
+)
+
+// Principal is who made an authenticated request: the name of the API key or the
+// subject of the JWT. It is the actor the audit log records, and its role says what
+// the use cases let it do.
+type Principal struct {
+	Subject Actor
+	Method  AuthMethod
+	Role    Role
+	// ApiKeyPublicID is the key the request was made with, empty for a JWT.
+	ApiKeyPublicID ApiKeyPublicID
+}
+
+type PrincipalProps struct {
+	Subject        Actor
+	Method         AuthMethod
+	Role           Role
+	ApiKeyPublicID ApiKeyPublicID
+}
+
+func NewPrincipal(props PrincipalProps) (*Principal, exceptions.EntityException) {
+	principal := &Principal{
+		Subject:        Actor(strings.TrimSpace(string(props.Subject))),
+		Method:         props.Method,
+		Role:           props.Role,
+		ApiKeyPublicID: props.ApiKeyPublicID,
+	}
+
+	err := principal.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return principal, nil
+}
+
+func (p *Principal) validate() error {
+	if p.Subject == "" {
+		return errors.New("Subject cannot be empty")
+	}
+
+	if len(p.Subject) > constants.AuditActorMaxLength {
+		return fmt.Errorf("Subject cannot be longer than %d characters", constants.AuditActorMaxLength)
+	}
+
+	if !slices.Contains([]AuthMethod{constants.AuthMethodApiKey, constants.AuthMethodJWT}, p.Method) {
+		return fmt.Errorf("Method %q is not valid", p.Method)
+	}
+
+	if !slices.Contains(constants.AssignableRoles, p.Role) {
+		return fmt.Errorf("Role %q is not valid", p.Role)
+	}
+
+	if p.Method == constants.AuthMethodApiKey && p.ApiKeyPublicID == "" {
+		return errors.New("ApiKeyPublicID cannot be empty for an api key")
+	}
+
+	return nil
+}
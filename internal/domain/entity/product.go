@@ -3,6 +3,9 @@ package entity
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"project/internal/domain/constants"
 	exceptions "project/internal/domain/exception"
@@ -14,45 +17,91 @@ type Product struct {
 	ID                  ProductID
 	PublicID            ProductPublicID
 	CategoryID          CategoryID
+	CategoryPublicID    CategoryPublicID // only loaded when a single product is read
 	Name                ProductName
 	Description         string
 	Price               int64 // in cents R$ 5.012,00 -> 501200
 	Rating              int8  // 0-50 (10 = 1 star, 25 = 2.5 stars, 50 = 5 stars)
+	ReviewCount         int64 // approved reviews, once there is one Rating is their aggregate
 	ImageURL            string
 	SpecificationValues []*ProductSpecificationValue
+	// A variant belongs to a family (its parent product), shares its category and
+	// inherits its specification values; its own values override the inherited ones.
+	ParentID       ProductID
+	ParentPublicID ProductPublicID
+	VariantLabel   string
+	Variants       []*Product
+	// Images is the gallery, ordered by Position. ImageURL stays as the free-form cover.
+	Images []*ProductImage
+	// Offers are the prices of the retailers, Price stays as the catalog price used
+	// when no offer is current.
+	Offers []*ProductOffer
+	// Version goes up on every write, a write based on an older one is refused.
+	Version int64
+	// PublishedAt is zero while the product is a draft, it is only published once
+	// the required specifications of its category have a value.
+	PublishedAt time.Time
 }
 
 type ProductProps struct {
 	ID                  ProductID
 	PublicID            ProductPublicID
 	CategoryID          CategoryID
+	CategoryPublicID    CategoryPublicID
 	Name                ProductName
 	Description         string
 	Price               int64
 	Rating              int8
+	ReviewCount         int64
 	ImageURL            string
 	SpecificationValues []*ProductSpecificationValue
+	ParentID            ProductID
+	ParentPublicID      ProductPublicID
+	VariantLabel        string
+	Variants            []*Product
+	Images              []*ProductImage
+	Offers              []*ProductOffer
+	Version             int64
+	PublishedAt         time.Time
 }
 
 type UpdateProductProps struct {
-	CategoryID  CategoryID
-	Name        ProductName
-	Description string
-	Price       int64
-	Rating      int8
-	ImageURL    string
+	CategoryID   CategoryID
+	Name         ProductName
+	Description  string
+	Price        int64
+	Rating       int8
+	ImageURL     string
+	VariantLabel string
 }
 
+// ProductVariantProps are the fields a variant does not take from its family. Name
+// and Description fall back to the family ones when empty.
+type ProductVariantProps struct {
+	PublicID     ProductPublicID
+	VariantLabel string
+	Name         ProductName
+	Description  string
+	Price        int64
+	ImageURL     string
+}
+
+// ComparisonProductPricesResult compares the best offers, LeftOffer and RightOffer
+// are nil when the catalog price was used instead.
 type ComparisonProductPricesResult struct {
-	Left     int64
-	Right    int64
-	Insights []*Insight
+	Left       int64
+	Right      int64
+	LeftOffer  *ProductOffer
+	RightOffer *ProductOffer
+	Insights   []*Insight
 }
 
 type ComparisonProductRatingsResult struct {
-	Left     int8
-	Right    int8
-	Insights []*Insight
+	Left             int8
+	Right            int8
+	LeftReviewCount  int64
+	RightReviewCount int64
+	Insights         []*Insight
 }
 
 type ComparisonProductsResult struct {
@@ -73,16 +122,38 @@ func NewProduct(props ProductProps) (*Product, exceptions.EntityException) {
 		props.SpecificationValues = []*ProductSpecificationValue{}
 	}
 
+	if props.Variants == nil {
+		props.Variants = []*Product{}
+	}
+
+	if props.Images == nil {
+		props.Images = []*ProductImage{}
+	}
+
+	if props.Offers == nil {
+		props.Offers = []*ProductOffer{}
+	}
+
 	product := &Product{
 		ID:                  props.ID,
 		PublicID:            publicID,
 		CategoryID:          props.CategoryID,
+		CategoryPublicID:    props.CategoryPublicID,
 		Name:                props.Name,
 		Description:         props.Description,
 		Price:               props.Price,
 		Rating:              props.Rating,
+		ReviewCount:         props.ReviewCount,
 		SpecificationValues: props.SpecificationValues,
 		ImageURL:            props.ImageURL,
+		ParentID:            props.ParentID,
+		ParentPublicID:      props.ParentPublicID,
+		VariantLabel:        props.VariantLabel,
+		Variants:            props.Variants,
+		Images:              props.Images,
+		Offers:              props.Offers,
+		Version:             props.Version,
+		PublishedAt:         props.PublishedAt,
 	}
 
 	err = product.validate()
@@ -104,14 +175,264 @@ func (p *Product) HasSpecifications() bool {
 	return len(p.SpecificationValues) > 0
 }
 
+func (p *Product) IsPublished() bool {
+	return !p.PublishedAt.IsZero()
+}
+
+func (p *Product) IsVariant() bool {
+	return p.ParentID > 0
+}
+
+// CheckVersion fails when version is not the current one, the product was changed
+// since the client read it.
+func (p *Product) CheckVersion(version int64) exceptions.EntityException {
+	if version != p.Version {
+		return exceptions.Entity(fmt.Errorf("product is at version %d, not %d", p.Version, version), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	return nil
+}
+
+func (p *Product) HasReviews() bool {
+	return p.ReviewCount > 0
+}
+
+// ApplyRatingSummary replaces the rating with the aggregate of the approved reviews.
+func (p *Product) ApplyRatingSummary(summary *ProductRatingSummary) exceptions.EntityException {
+	if summary.ProductID != p.ID {
+		return exceptions.Entity(errors.New("Rating summary does not belong to the product"), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	p.Rating = summary.Rating
+	p.ReviewCount = summary.Count
+
+	return nil
+}
+
+func (p *Product) HasVariants() bool {
+	return len(p.Variants) > 0
+}
+
+// NewVariant creates a variant of the product, in the same category. The current
+// variants must be loaded to catch a repeated label.
+func (p *Product) NewVariant(props ProductVariantProps) (*Product, exceptions.EntityException) {
+	if p.ID <= 0 {
+		return nil, exceptions.Entity(errors.New("Cannot create a variant of a product that was not persisted"), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	if p.IsVariant() {
+		return nil, exceptions.Entity(errors.New("Cannot create a variant of a variant"), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	for _, variant := range p.Variants {
+		if strings.EqualFold(variant.VariantLabel, props.VariantLabel) {
+			return nil, exceptions.Entity(fmt.Errorf("variant %s already exists", props.VariantLabel), exceptions.EntityOpts{
+				Reason: constants.EntityBussinessError,
+			})
+		}
+	}
+
+	if props.Name == "" {
+		props.Name = p.Name
+	}
+
+	if props.Description == "" {
+		props.Description = p.Description
+	}
+
+	variant, entityErr := NewProduct(ProductProps{
+		PublicID:       props.PublicID,
+		CategoryID:     p.CategoryID,
+		Name:           props.Name,
+		Description:    props.Description,
+		Price:          props.Price,
+		ImageURL:       props.ImageURL,
+		ParentID:       p.ID,
+		ParentPublicID: p.PublicID,
+		VariantLabel:   props.VariantLabel,
+	})
+
+	if entityErr != nil {
+		return nil, entityErr
+	}
+
+	p.Variants = append(p.Variants, variant)
+
+	return variant, nil
+}
+
+// InheritSpecificationValues adds the values of the family that the variant does not
+// override, marked as inherited. They keep the ID of the family value.
+func (p *Product) InheritSpecificationValues(parent *Product) exceptions.EntityException {
+	if !p.IsVariant() || p.ParentID != parent.ID {
+		return exceptions.Entity(errors.New("Product is not a variant of the given family"), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	overridden := make(map[SpecificationID]bool, len(p.SpecificationValues))
+
+	for _, value := range p.SpecificationValues {
+		overridden[value.SpecificationID] = true
+	}
+
+	for _, value := range parent.SpecificationValues {
+		if overridden[value.SpecificationID] {
+			continue
+		}
+
+		p.SpecificationValues = append(p.SpecificationValues, &ProductSpecificationValue{
+			ID:              value.ID,
+			ProductID:       p.ID,
+			SpecificationID: value.SpecificationID,
+			Type:            value.Type,
+			Value:           value.Value,
+			Inherited:       true,
+		})
+	}
+
+	return nil
+}
+
+// AddImage puts the image at the end of the gallery. The current gallery must be
+// loaded to keep the positions in sequence.
+func (p *Product) AddImage(image *ProductImage) exceptions.EntityException {
+	if image.ProductID != p.ID {
+		return exceptions.Entity(errors.New("Image does not belong to the product"), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	if len(p.Images) >= constants.ProductImageMaxPerProduct {
+		return exceptions.Entity(fmt.Errorf("a product can have at most %d images", constants.ProductImageMaxPerProduct), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	p.Images = append(p.Images, image)
+	p.renumberImages()
+
+	return nil
+}
+
+func (p *Product) FindImage(publicID ProductImagePublicID) (*ProductImage, exceptions.EntityException) {
+	for _, image := range p.Images {
+		if image.PublicID == publicID {
+			return image, nil
+		}
+	}
+
+	return nil, exceptions.Entity(fmt.Errorf("image %s not found in the product gallery", publicID), exceptions.EntityOpts{
+		Reason: constants.EntityBussinessError,
+	})
+}
+
+// MoveImage moves the image to position, shifting the others. A position past the
+// end moves it to the end.
+func (p *Product) MoveImage(publicID ProductImagePublicID, position int) (*ProductImage, exceptions.EntityException) {
+	if position < 0 {
+		return nil, exceptions.Entity(errors.New("Position cannot be negative"), exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	image, entityErr := p.FindImage(publicID)
+
+	if entityErr != nil {
+		return nil, entityErr
+	}
+
+	p.Images = slices.DeleteFunc(p.Images, func(current *ProductImage) bool {
+		return current == image
+	})
+
+	position = min(position, len(p.Images))
+
+	p.Images = slices.Insert(p.Images, position, image)
+	p.renumberImages()
+
+	return image, nil
+}
+
+// RemoveImage takes the image out of the gallery and closes the gap it leaves.
+func (p *Product) RemoveImage(publicID ProductImagePublicID) (*ProductImage, exceptions.EntityException) {
+	image, entityErr := p.FindImage(publicID)
+
+	if entityErr != nil {
+		return nil, entityErr
+	}
+
+	p.Images = slices.DeleteFunc(p.Images, func(current *ProductImage) bool {
+		return current == image
+	})
+	p.renumberImages()
+
+	return image, nil
+}
+
+func (p *Product) renumberImages() {
+	for position, image := range p.Images {
+		image.Position = position
+	}
+}
+
+// AddSpecificationValue attaches a value to the product, validating it as a product
+// specification value. The product may not be persisted yet: ProductID is whatever
+// the product currently has and is filled in by the repository when it is created.
+func (p *Product) AddSpecificationValue(specification *Specification, value *SpecValue) exceptions.EntityException {
+	for _, current := range p.SpecificationValues {
+		if current.SpecificationID == specification.ID {
+			return exceptions.Entity(fmt.Errorf("specification %d already has a value", specification.ID), exceptions.EntityOpts{
+				Reason: constants.EntityBussinessError,
+			})
+		}
+	}
+
+	productSpecificationValue := &ProductSpecificationValue{
+		ProductID:       p.ID,
+		SpecificationID: specification.ID,
+		Type:            specification.Type,
+		Value:           value,
+	}
+
+	err := productSpecificationValue.validateValue()
+
+	if err != nil {
+		return exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	p.SpecificationValues = append(p.SpecificationValues, productSpecificationValue)
+
+	return nil
+}
+
 func (p *Product) Update(props UpdateProductProps) exceptions.EntityException {
+	if p.IsVariant() && props.CategoryID != p.CategoryID {
+		return exceptions.Entity(errors.New("A variant always has the category of its family"), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
 	p.CategoryID = props.CategoryID
 	p.Name = props.Name
 	p.Description = props.Description
 	p.Price = props.Price
-	p.Rating = props.Rating
 	p.ImageURL = props.ImageURL
+	p.VariantLabel = props.VariantLabel
 
+	if !p.HasReviews() {
+		p.Rating = props.Rating
+	}
 	err := p.validate()
 
 	if err != nil {
@@ -130,16 +451,23 @@ func (p *Product) Compare(other *Product) (*ComparisonProductsResult, exceptions
 		})
 	}
 
+	now := time.Now()
+	price, otherPrice := p.EffectivePrice(now), other.EffectivePrice(now)
+
 	result := &ComparisonProductsResult{
 		PriceComparisonResult: &ComparisonProductPricesResult{
-			Left:     p.Price,
-			Right:    other.Price,
-			Insights: p.comparePrice(other.Price),
+			Left:       price,
+			Right:      otherPrice,
+			LeftOffer:  p.BestOffer(now),
+			RightOffer: other.BestOffer(now),
+			Insights:   p.comparePrice(price, otherPrice),
 		},
 		RatingComparisonResult: &ComparisonProductRatingsResult{
-			Left:     p.Rating,
-			Right:    other.Rating,
-			Insights: p.compareRating(other.Rating),
+			Left:             p.Rating,
+			Right:            other.Rating,
+			LeftReviewCount:  p.ReviewCount,
+			RightReviewCount: other.ReviewCount,
+			Insights:         p.compareRating(other),
 		},
 		SpecificationsComparisonResults: []*ComparisonProductSpecificationValues{},
 	}
@@ -148,6 +476,23 @@ func (p *Product) Compare(other *Product) (*ComparisonProductsResult, exceptions
 		for _, specificationVal := range p.SpecificationValues {
 			for _, otherSpecificationVal := range other.SpecificationValues {
 				if otherSpecificationVal.SpecificationID == specificationVal.SpecificationID {
+					if specificationVal.SharesValueWith(otherSpecificationVal) {
+						result.SpecificationsComparisonResults = append(result.SpecificationsComparisonResults, &ComparisonProductSpecificationValues{
+							Left:  specificationVal,
+							Right: otherSpecificationVal,
+							Insights: []*Insight{
+								NewInsight(InsightProps{
+									ProductID: p.ID,
+									Favorable: false,
+									Neutral:   true,
+									Message:   "shares the same value of the family",
+								}),
+							},
+						})
+
+						continue
+					}
+
 					specificationResult, err := specificationVal.Compare(otherSpecificationVal)
 
 					if err != nil {
@@ -213,14 +558,60 @@ func (p *Product) validate() error {
 		return errors.New("Rating must be between 0 and 50")
 	}
 
+	if p.Version < 0 {
+		return errors.New("Version cannot be negative")
+	}
+
+	if p.ReviewCount < 0 {
+		return errors.New("ReviewCount cannot be negative")
+	}
+
+	if p.IsVariant() && p.ParentID == p.ID {
+		return errors.New("A product cannot be a variant of itself")
+	}
+
+	if p.IsVariant() && p.VariantLabel == "" {
+		return errors.New("VariantLabel cannot be empty for a variant")
+	}
+
+	if !p.IsVariant() && p.VariantLabel != "" {
+		return errors.New("VariantLabel is only allowed on variants")
+	}
+
+	if len(p.VariantLabel) > 100 {
+		return errors.New("VariantLabel cannot be longer than 100 characters")
+	}
+
 	return nil
 }
 
-func (p *Product) compareRating(otherRating int8) []*Insight {
-	ratingDiff := p.Rating - otherRating
+// compareRating flags a difference that rests on only a few reviews as neutral.
+// Ratings without reviews (set by hand or imported) are compared as they are.
+func (p *Product) compareRating(other *Product) []*Insight {
+	ratingDiff := p.Rating - other.Rating
 	insights := []*Insight{}
 
 	switch {
+	case ratingDiff > 0 && hasFewReviews(p.ReviewCount):
+		insights = append(
+			insights,
+			NewInsight(InsightProps{
+				ProductID: p.ID,
+				Favorable: false,
+				Neutral:   true,
+				Message:   fmt.Sprintf("has higher rating, but based on only %s", formatReviewCount(p.ReviewCount)),
+			}),
+		)
+	case ratingDiff < 0 && hasFewReviews(other.ReviewCount):
+		insights = append(
+			insights,
+			NewInsight(InsightProps{
+				ProductID: p.ID,
+				Favorable: false,
+				Neutral:   true,
+				Message:   fmt.Sprintf("has lower rating, but the other one is based on only %s", formatReviewCount(other.ReviewCount)),
+			}),
+		)
 	case ratingDiff > 0:
 		insights = append(
 			insights,
@@ -256,8 +647,20 @@ func (p *Product) compareRating(otherRating int8) []*Insight {
 	return insights
 }
 
-func (p *Product) comparePrice(otherPrice int64) []*Insight {
-	priceDiff := p.Price - otherPrice
+func hasFewReviews(reviewCount int64) bool {
+	return reviewCount > 0 && reviewCount < constants.ProductReviewMinConfidentCount
+}
+
+func formatReviewCount(reviewCount int64) string {
+	if reviewCount == 1 {
+		return "1 review"
+	}
+
+	return fmt.Sprintf("%d reviews", reviewCount)
+}
+
+func (p *Product) comparePrice(price int64, otherPrice int64) []*Insight {
+	priceDiff := price - otherPrice
 	otherPriceIsZero := otherPrice == 0
 	insights := []*Insight{}
 
@@ -0,0 +1,141 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/services"
+	. "project/internal/domain/types"
+	"slices"
+)
+
+// ProductImage is one picture of the product gallery. The file and its thumbnail
+// live in the image storage under StorageKey and ThumbnailKey.
+type ProductImage struct {
+	ID           int64
+	PublicID     ProductImagePublicID
+	ProductID    ProductID
+	Position     int
+	AltText      string
+	ContentType  string
+	Size         int64
+	Width        int
+	Height       int
+	StorageKey   string
+	ThumbnailKey string
+}
+
+type ProductImageProps struct {
+	ID           int64
+	PublicID     ProductImagePublicID
+	ProductID    ProductID
+	Position     int
+	AltText      string
+	ContentType  string
+	Size         int64
+	Width        int
+	Height       int
+	StorageKey   string
+	ThumbnailKey string
+}
+
+var productImageExtensions = map[string]string{
+	constants.ProductImageContentTypeJPEG: ".jpg",
+	constants.ProductImageContentTypePNG:  ".png",
+	constants.ProductImageContentTypeGIF:  ".gif",
+}
+
+func NewProductImage(props ProductImageProps) (*ProductImage, exceptions.EntityException) {
+	publicID, err := services.GeneratePublicID(props.PublicID)
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	image := &ProductImage{
+		ID:           props.ID,
+		PublicID:     publicID,
+		ProductID:    props.ProductID,
+		Position:     props.Position,
+		AltText:      props.AltText,
+		ContentType:  props.ContentType,
+		Size:         props.Size,
+		Width:        props.Width,
+		Height:       props.Height,
+		StorageKey:   props.StorageKey,
+		ThumbnailKey: props.ThumbnailKey,
+	}
+
+	err = image.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return image, nil
+}
+
+// SetStorageKeys names the files of a new image after the product and the image
+// public IDs, e.g. products/1a2b3c4d/9f8e7d6c.jpg and products/1a2b3c4d/9f8e7d6c_thumb.jpg.
+// The thumbnail keeps the extension of its own content type.
+func (i *ProductImage) SetStorageKeys(productPublicID ProductPublicID, thumbnailContentType string) {
+	dir := path.Join("products", string(productPublicID))
+
+	i.StorageKey = path.Join(dir, string(i.PublicID)+productImageExtensions[i.ContentType])
+	i.ThumbnailKey = path.Join(dir, string(i.PublicID)+"_thumb"+productImageExtensions[thumbnailContentType])
+}
+
+func (i *ProductImage) UpdateAltText(altText string) exceptions.EntityException {
+	i.AltText = altText
+
+	err := i.validate()
+
+	if err != nil {
+		return exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return nil
+}
+
+func (i *ProductImage) validate() error {
+	if i.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if len(i.PublicID) != 8 {
+		return errors.New("PublicID must be exactly 8 characters long")
+	}
+
+	if i.ProductID <= 0 {
+		return errors.New("ProductID field must be greater than 0")
+	}
+
+	if i.Position < 0 {
+		return errors.New("Position cannot be negative")
+	}
+
+	if len(i.AltText) > 255 {
+		return errors.New("AltText cannot be longer than 255 characters")
+	}
+
+	if !slices.Contains(constants.ProductImageContentTypes, i.ContentType) {
+		return fmt.Errorf("ContentType %q is not supported", i.ContentType)
+	}
+
+	if i.Size <= 0 || i.Size > constants.ProductImageMaxSize {
+		return fmt.Errorf("Size must be between 1 and %d bytes", constants.ProductImageMaxSize)
+	}
+
+	if i.Width <= 0 || i.Height <= 0 {
+		return errors.New("Width and Height must be greater than 0")
+	}
+
+	return nil
+}
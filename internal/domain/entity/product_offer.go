@@ -0,0 +1,223 @@
+package entity
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net/url"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/services"
+	. "project/internal/domain/types"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ProductOffer is the price a retailer sells a product for. The retailer fields
+// besides RetailerID are read only, they come with the offer for display.
+type ProductOffer struct {
+	ID               int64
+	PublicID         ProductOfferPublicID
+	ProductID        ProductID
+	RetailerID       RetailerID
+	RetailerPublicID RetailerPublicID
+	RetailerName     string
+	Price            int64 // in cents
+	URL              string
+	Availability     ProductOfferAvailability
+	LastSeenAt       time.Time
+}
+
+type ProductOfferProps struct {
+	ID               int64
+	PublicID         ProductOfferPublicID
+	ProductID        ProductID
+	RetailerID       RetailerID
+	RetailerPublicID RetailerPublicID
+	RetailerName     string
+	Price            int64
+	URL              string
+	Availability     ProductOfferAvailability
+	LastSeenAt       time.Time
+}
+
+type UpdateProductOfferProps struct {
+	Price        int64
+	URL          string
+	Availability ProductOfferAvailability
+	LastSeenAt   time.Time
+}
+
+func NewProductOffer(props ProductOfferProps) (*ProductOffer, exceptions.EntityException) {
+	publicID, err := services.GeneratePublicID(props.PublicID)
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	if props.Availability == "" {
+		props.Availability = constants.ProductOfferAvailabilityInStock
+	}
+
+	offer := &ProductOffer{
+		ID:               props.ID,
+		PublicID:         publicID,
+		ProductID:        props.ProductID,
+		RetailerID:       props.RetailerID,
+		RetailerPublicID: props.RetailerPublicID,
+		RetailerName:     props.RetailerName,
+		Price:            props.Price,
+		URL:              strings.TrimSpace(props.URL),
+		Availability:     props.Availability,
+		LastSeenAt:       props.LastSeenAt.UTC().Truncate(time.Second),
+	}
+
+	err = offer.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return offer, nil
+}
+
+// Update stores a new sighting of the offer, LastSeenAt cannot go back in time.
+func (o *ProductOffer) Update(props UpdateProductOfferProps) exceptions.EntityException {
+	if props.Availability == "" {
+		props.Availability = constants.ProductOfferAvailabilityInStock
+	}
+
+	if props.LastSeenAt.Truncate(time.Second).Before(o.LastSeenAt) {
+		return exceptions.Entity(errors.New("LastSeenAt cannot be before the last sighting of the offer"), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	o.Price = props.Price
+	o.URL = strings.TrimSpace(props.URL)
+	o.Availability = props.Availability
+	o.LastSeenAt = props.LastSeenAt.UTC().Truncate(time.Second)
+
+	err := o.validate()
+
+	if err != nil {
+		return exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return nil
+}
+
+// IsCurrent reports whether the product can be bought for Price: the retailer has it
+// in stock and the offer was seen within ProductOfferMaxAge.
+func (o *ProductOffer) IsCurrent(now time.Time) bool {
+	return o.Availability == constants.ProductOfferAvailabilityInStock && now.Sub(o.LastSeenAt) <= constants.ProductOfferMaxAge
+}
+
+func (o *ProductOffer) validate() error {
+	if o.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if len(o.PublicID) != 8 {
+		return errors.New("PublicID must be exactly 8 characters long")
+	}
+
+	if o.ProductID <= 0 {
+		return errors.New("ProductID field must be greater than 0")
+	}
+
+	if o.RetailerID <= 0 {
+		return errors.New("RetailerID field must be greater than 0")
+	}
+
+	if o.Price <= 0 {
+		return errors.New("Price must be greater than 0")
+	}
+
+	if !isHttpURL(o.URL) {
+		return errors.New("URL must be an http or https URL")
+	}
+
+	if len(o.URL) > 2048 {
+		return errors.New("URL cannot be longer than 2048 characters")
+	}
+
+	switch o.Availability {
+	case constants.ProductOfferAvailabilityInStock, constants.ProductOfferAvailabilityOutOfStock:
+	default:
+		return fmt.Errorf("Availability %q is not valid", o.Availability)
+	}
+
+	if o.LastSeenAt.IsZero() {
+		return errors.New("LastSeenAt cannot be empty")
+	}
+
+	return nil
+}
+
+// CurrentOffers returns the offers the product can be bought for at now, the
+// cheapest first.
+func (p *Product) CurrentOffers(now time.Time) []*ProductOffer {
+	offers := make([]*ProductOffer, 0, len(p.Offers))
+
+	for _, offer := range p.Offers {
+		if offer.IsCurrent(now) {
+			offers = append(offers, offer)
+		}
+	}
+
+	slices.SortStableFunc(offers, func(a, b *ProductOffer) int {
+		return cmp.Compare(a.Price, b.Price)
+	})
+
+	return offers
+}
+
+// BestOffer is the cheapest current offer, nil when there is none.
+func (p *Product) BestOffer(now time.Time) *ProductOffer {
+	offers := p.CurrentOffers(now)
+
+	if len(offers) == 0 {
+		return nil
+	}
+
+	return offers[0]
+}
+
+// OfferSpread is the difference between the most expensive and the cheapest
+// current offers, 0 with less than two.
+func (p *Product) OfferSpread(now time.Time) int64 {
+	offers := p.CurrentOffers(now)
+
+	if len(offers) < 2 {
+		return 0
+	}
+
+	return offers[len(offers)-1].Price - offers[0].Price
+}
+
+// EffectivePrice is the price of the best offer, or the catalog Price when no store
+// sells the product right now.
+func (p *Product) EffectivePrice(now time.Time) int64 {
+	if offer := p.BestOffer(now); offer != nil {
+		return offer.Price
+	}
+
+	return p.Price
+}
+
+func isHttpURL(value string) bool {
+	parsed, err := url.ParseRequestURI(value)
+
+	if err != nil {
+		return false
+	}
+
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
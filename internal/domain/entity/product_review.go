@@ -0,0 +1,187 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/services"
+	. "project/internal/domain/types"
+	"strings"
+	"time"
+)
+
+// ProductReview is a customer review. It is public and counts for the product
+// rating only after moderation approves it.
+type ProductReview struct {
+	ID           int64
+	PublicID     ProductReviewPublicID
+	ProductID    ProductID
+	Author       string
+	Stars        int8 // 1-5
+	Text         string
+	HelpfulVotes int64
+	Status       ProductReviewStatus
+	CreatedAt    time.Time
+}
+
+type ProductReviewProps struct {
+	ID           int64
+	PublicID     ProductReviewPublicID
+	ProductID    ProductID
+	Author       string
+	Stars        int8
+	Text         string
+	HelpfulVotes int64
+	Status       ProductReviewStatus
+	CreatedAt    time.Time
+}
+
+// ProductRatingSummary aggregates the approved reviews of a product. Rating uses the
+// product scale, 10 per star.
+type ProductRatingSummary struct {
+	ProductID    ProductID
+	Rating       int8
+	Count        int64
+	Distribution map[int8]int64 // stars -> reviews
+}
+
+func NewProductReview(props ProductReviewProps) (*ProductReview, exceptions.EntityException) {
+	publicID, err := services.GeneratePublicID(props.PublicID)
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	if props.Status == "" {
+		props.Status = constants.ProductReviewStatusPending
+	}
+
+	review := &ProductReview{
+		ID:           props.ID,
+		PublicID:     publicID,
+		ProductID:    props.ProductID,
+		Author:       strings.TrimSpace(props.Author),
+		Stars:        props.Stars,
+		Text:         strings.TrimSpace(props.Text),
+		HelpfulVotes: props.HelpfulVotes,
+		Status:       props.Status,
+		CreatedAt:    props.CreatedAt,
+	}
+
+	err = review.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return review, nil
+}
+
+func (r *ProductReview) IsApproved() bool {
+	return r.Status == constants.ProductReviewStatusApproved
+}
+
+// Moderate approves or rejects the review, a moderated review can be moderated again.
+func (r *ProductReview) Moderate(status ProductReviewStatus) exceptions.EntityException {
+	if status != constants.ProductReviewStatusApproved && status != constants.ProductReviewStatusRejected {
+		return exceptions.Entity(fmt.Errorf("A review can only be moderated to %s or %s", constants.ProductReviewStatusApproved, constants.ProductReviewStatusRejected), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	r.Status = status
+
+	return nil
+}
+
+func (r *ProductReview) VoteHelpful() exceptions.EntityException {
+	if !r.IsApproved() {
+		return exceptions.Entity(errors.New("Only approved reviews can be voted"), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	r.HelpfulVotes++
+
+	return nil
+}
+
+func (r *ProductReview) validate() error {
+	if r.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if len(r.PublicID) != 8 {
+		return errors.New("PublicID must be exactly 8 characters long")
+	}
+
+	if r.ProductID <= 0 {
+		return errors.New("ProductID field must be greater than 0")
+	}
+
+	if r.Author == "" {
+		return errors.New("Author cannot be empty")
+	}
+
+	if len(r.Author) > 100 {
+		return errors.New("Author cannot be longer than 100 characters")
+	}
+
+	if r.Stars < 1 || r.Stars > 5 {
+		return errors.New("Stars must be between 1 and 5")
+	}
+
+	if len(r.Text) > 5000 {
+		return errors.New("Text cannot be longer than 5000 characters")
+	}
+
+	if r.HelpfulVotes < 0 {
+		return errors.New("HelpfulVotes cannot be negative")
+	}
+
+	switch r.Status {
+	case constants.ProductReviewStatusPending, constants.ProductReviewStatusApproved, constants.ProductReviewStatusRejected:
+	default:
+		return fmt.Errorf("Status %q is not valid", r.Status)
+	}
+
+	return nil
+}
+
+// NewProductRatingSummary computes the rating from the number of approved reviews
+// per stars. A product without reviews has rating 0.
+func NewProductRatingSummary(productID ProductID, distribution map[int8]int64) (*ProductRatingSummary, exceptions.EntityException) {
+	summary := &ProductRatingSummary{
+		ProductID:    productID,
+		Distribution: make(map[int8]int64, 5),
+	}
+
+	var total int64
+
+	for stars := int8(1); stars <= 5; stars++ {
+		summary.Distribution[stars] = 0
+	}
+
+	for stars, count := range distribution {
+		if stars < 1 || stars > 5 || count < 0 {
+			return nil, exceptions.Entity(fmt.Errorf("invalid distribution entry %d stars: %d reviews", stars, count), exceptions.EntityOpts{
+				Reason: constants.EntityValidationError,
+			})
+		}
+
+		summary.Distribution[stars] = count
+		summary.Count += count
+		total += int64(stars) * count
+	}
+
+	if summary.Count > 0 {
+		// round half up: 10 * total / count
+		summary.Rating = int8((total*20 + summary.Count) / (summary.Count * 2))
+	}
+
+	return summary, nil
+}
@@ -2,9 +2,13 @@ package entity
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"project/internal/domain/constants"
 	exceptions "project/internal/domain/exception"
 	. "project/internal/domain/types"
+	"strconv"
+	"strings"
 )
 
 type SpecValue struct {
@@ -19,6 +23,10 @@ type ProductSpecificationValue struct {
 	SpecificationID SpecificationID
 	Type            SpecificationType
 	Value           *SpecValue
+	// Inherited is set on a variant value that comes from its family.
+	Inherited bool
+	// Version goes up on every write, a write based on an older one is refused.
+	Version int64
 }
 
 type ProductSpecificationValueProps struct {
@@ -27,6 +35,8 @@ type ProductSpecificationValueProps struct {
 	SpecificationID SpecificationID
 	Type            SpecificationType
 	Value           *SpecValue
+	Inherited       bool
+	Version         int64
 }
 
 type ComparisonProductSpecificationValues struct {
@@ -42,6 +52,8 @@ func NewProductSpecificationValue(props ProductSpecificationValueProps) (*Produc
 		SpecificationID: props.SpecificationID,
 		Type:            props.Type,
 		Value:           props.Value,
+		Inherited:       props.Inherited,
+		Version:         props.Version,
 	}
 
 	err := productSpecificationValue.validate()
@@ -55,6 +67,24 @@ func NewProductSpecificationValue(props ProductSpecificationValueProps) (*Produc
 	return productSpecificationValue, nil
 }
 
+// CheckVersion fails when version is not the current one, the value was changed
+// since the client read it.
+func (s *ProductSpecificationValue) CheckVersion(version int64) exceptions.EntityException {
+	if version != s.Version {
+		return exceptions.Entity(fmt.Errorf("product specification value is at version %d, not %d", s.Version, version), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	return nil
+}
+
+// SharesValueWith reports whether both values are the same family value, inherited
+// by one or both of the products. Such values are equal and are not compared.
+func (s *ProductSpecificationValue) SharesValueWith(other *ProductSpecificationValue) bool {
+	return s.ID > 0 && s.ID == other.ID && (s.Inherited || other.Inherited)
+}
+
 func (s *ProductSpecificationValue) validateBeforeCompare(other *ProductSpecificationValue) error {
 	if s.ID <= 0 || other.ID <= 0 {
 		return errors.New("Cannot compare products with ID <= 0")
@@ -88,10 +118,20 @@ func (s *ProductSpecificationValue) validate() error {
 		return errors.New("ProductID field must be greater than 0")
 	}
 
+	return s.validateValue()
+}
+
+// validateValue checks everything but the product reference, so values of a
+// product that was not persisted yet can be validated before it gets an ID.
+func (s *ProductSpecificationValue) validateValue() error {
 	if s.SpecificationID <= 0 {
 		return errors.New("SpecificationID field must be greater than 0")
 	}
 
+	if s.Value == nil {
+		return errors.New("Value cannot be nil")
+	}
+
 	hasString := s.Value.StringValue != nil
 	hasInt := s.Value.IntValue != nil
 	hasBool := s.Value.BoolValue != nil
@@ -103,6 +143,191 @@ func (s *ProductSpecificationValue) validate() error {
 	return nil
 }
 
+// ConvertTo rewrites the stored value into the target specification type,
+// failing when the current value cannot be represented in it (e.g. "abc" to int).
+func (s *ProductSpecificationValue) ConvertTo(target SpecificationType) exceptions.EntityException {
+	converted, err := s.Value.convertTo(target)
+
+	if err != nil {
+		return exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	s.Value = converted
+	s.Type = target
+
+	return nil
+}
+
+func (v *SpecValue) convertTo(target SpecificationType) (*SpecValue, error) {
+	converted := &SpecValue{}
+
+	switch {
+	case v.StringValue != nil:
+		source := *v.StringValue
+
+		switch target {
+		case constants.SpecificationTypeString:
+			converted.StringValue = &source
+		case constants.SpecificationTypeInt:
+			intVal, err := strconv.ParseInt(strings.TrimSpace(source), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert string value %q to int", source)
+			}
+			converted.IntValue = &intVal
+		case constants.SpecificationTypeBool:
+			boolVal, err := strconv.ParseBool(strings.TrimSpace(source))
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert string value %q to bool", source)
+			}
+			converted.BoolValue = &boolVal
+		default:
+			return nil, fmt.Errorf("invalid target type %s", target)
+		}
+	case v.IntValue != nil:
+		source := *v.IntValue
+
+		switch target {
+		case constants.SpecificationTypeString:
+			stringVal := strconv.FormatInt(source, 10)
+			converted.StringValue = &stringVal
+		case constants.SpecificationTypeInt:
+			converted.IntValue = &source
+		case constants.SpecificationTypeBool:
+			boolVal := source != 0
+			converted.BoolValue = &boolVal
+		default:
+			return nil, fmt.Errorf("invalid target type %s", target)
+		}
+	case v.BoolValue != nil:
+		source := *v.BoolValue
+
+		switch target {
+		case constants.SpecificationTypeString:
+			stringVal := strconv.FormatBool(source)
+			converted.StringValue = &stringVal
+		case constants.SpecificationTypeInt:
+			var intVal int64
+			if source {
+				intVal = 1
+			}
+			converted.IntValue = &intVal
+		case constants.SpecificationTypeBool:
+			converted.BoolValue = &source
+		default:
+			return nil, fmt.Errorf("invalid target type %s", target)
+		}
+	default:
+		return nil, errors.New("no value to convert")
+	}
+
+	return converted, nil
+}
+
+// NewSpecValue picks the raw value matching the specification type, ignoring the others.
+func NewSpecValue(specType SpecificationType, stringValue string, intValue int64, boolValue bool) (*SpecValue, exceptions.EntityException) {
+	switch specType {
+	case constants.SpecificationTypeString:
+		return &SpecValue{StringValue: &stringValue}, nil
+	case constants.SpecificationTypeInt:
+		return &SpecValue{IntValue: &intValue}, nil
+	case constants.SpecificationTypeBool:
+		return &SpecValue{BoolValue: &boolValue}, nil
+	default:
+		return nil, exceptions.Entity(fmt.Errorf("invalid specification type %s", specType), exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+}
+
+// ParseSpecValue converts a raw decoded value (string, number or bool) into a SpecValue
+// of the given specification type without coercion: 65 is not accepted for a string spec.
+func ParseSpecValue(specType SpecificationType, raw any) (*SpecValue, exceptions.EntityException) {
+	var err error
+
+	switch specType {
+	case constants.SpecificationTypeString:
+		if value, ok := raw.(string); ok {
+			return &SpecValue{StringValue: &value}, nil
+		}
+		err = fmt.Errorf("expected a string value, got %T", raw)
+	case constants.SpecificationTypeInt:
+		switch value := raw.(type) {
+		case int:
+			intVal := int64(value)
+			return &SpecValue{IntValue: &intVal}, nil
+		case int64:
+			return &SpecValue{IntValue: &value}, nil
+		case float64:
+			if value == math.Trunc(value) {
+				intVal := int64(value)
+				return &SpecValue{IntValue: &intVal}, nil
+			}
+			err = fmt.Errorf("expected an integer value, got %v", value)
+		default:
+			err = fmt.Errorf("expected an integer value, got %T", raw)
+		}
+	case constants.SpecificationTypeBool:
+		if value, ok := raw.(bool); ok {
+			return &SpecValue{BoolValue: &value}, nil
+		}
+		err = fmt.Errorf("expected a boolean value, got %T", raw)
+	default:
+		err = fmt.Errorf("invalid specification type %s", specType)
+	}
+
+	return nil, exceptions.Entity(err, exceptions.EntityOpts{
+		Reason: constants.EntityValidationError,
+	})
+}
+
+// ParseSpecValueText converts a textual value (e.g. a CSV cell) into a SpecValue of the
+// given specification type, parsing integers and booleans from their text form.
+func ParseSpecValueText(specType SpecificationType, raw string) (*SpecValue, exceptions.EntityException) {
+	var err error
+
+	switch specType {
+	case constants.SpecificationTypeString:
+		return &SpecValue{StringValue: &raw}, nil
+	case constants.SpecificationTypeInt:
+		intVal, parseErr := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+		if parseErr == nil {
+			return &SpecValue{IntValue: &intVal}, nil
+		}
+		err = fmt.Errorf("expected an integer value, got %q", raw)
+	case constants.SpecificationTypeBool:
+		boolVal, parseErr := strconv.ParseBool(strings.TrimSpace(raw))
+		if parseErr == nil {
+			return &SpecValue{BoolValue: &boolVal}, nil
+		}
+		err = fmt.Errorf("expected a boolean value, got %q", raw)
+	default:
+		err = fmt.Errorf("invalid specification type %s", specType)
+	}
+
+	return nil, exceptions.Entity(err, exceptions.EntityOpts{
+		Reason: constants.EntityValidationError,
+	})
+}
+
+func (s *ProductSpecificationValue) UpdateValue(value *SpecValue) exceptions.EntityException {
+	previous := s.Value
+	s.Value = value
+
+	err := s.validate()
+
+	if err != nil {
+		s.Value = previous
+
+		return exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return nil
+}
+
 func (s *ProductSpecificationValue) Compare(other *ProductSpecificationValue) (*ComparisonProductSpecificationValues, exceptions.EntityException) {
 	if err := s.validateBeforeCompare(other); err != nil {
 		return nil, exceptions.Entity(err, exceptions.EntityOpts{
@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	. "project/internal/domain/types"
+	"slices"
+	"time"
+)
+
+// RateLimitPolicy is a token bucket: a client holds up to Limit tokens, spends one on
+// each request and gets Limit of them back over each Window, a little at a time.
+type RateLimitPolicy struct {
+	Name   RateLimitPolicyName
+	Limit  int64
+	Window time.Duration
+}
+
+type RateLimitPolicyProps struct {
+	Name   RateLimitPolicyName
+	Limit  int64
+	Window time.Duration
+}
+
+func NewRateLimitPolicy(props RateLimitPolicyProps) (*RateLimitPolicy, exceptions.EntityException) {
+	policy := &RateLimitPolicy{
+		Name:   props.Name,
+		Limit:  props.Limit,
+		Window: props.Window,
+	}
+
+	err := policy.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return policy, nil
+}
+
+func (p *RateLimitPolicy) validate() error {
+	if !slices.Contains(constants.RateLimitPolicyNames, p.Name) {
+		return fmt.Errorf("Name %q is not valid", p.Name)
+	}
+
+	if p.Limit < 1 {
+		return errors.New("Limit must be at least 1")
+	}
+
+	if p.Window < constants.RateLimitMinWindow {
+		return fmt.Errorf("Window cannot be shorter than %s", constants.RateLimitMinWindow)
+	}
+
+	return nil
+}
+
+// RefillRate is how many tokens come back each second.
+func (p *RateLimitPolicy) RefillRate() float64 {
+	return float64(p.Limit) / p.Window.Seconds()
+}
+
+// RateLimitBucket is what a client has left of a policy as of UpdatedAt. It keeps the
+// capacity and the refill rate it was last taken from, so it can tell when it is full
+// again without its policy.
+type RateLimitBucket struct {
+	Key        string
+	Tokens     float64
+	Capacity   float64
+	RefillRate float64
+	UpdatedAt  time.Time
+}
+
+// NewRateLimitBucket is the bucket of a client that made no request yet, a full one.
+func NewRateLimitBucket(key string, policy *RateLimitPolicy, now time.Time) *RateLimitBucket {
+	return &RateLimitBucket{
+		Key:        key,
+		Tokens:     float64(policy.Limit),
+		Capacity:   float64(policy.Limit),
+		RefillRate: policy.RefillRate(),
+		UpdatedAt:  now,
+	}
+}
+
+// Take refills the bucket up to now under the given policy and spends a token when a
+// whole one is left, telling if it did. A clock that went back refills nothing.
+func (b *RateLimitBucket) Take(policy *RateLimitPolicy, now time.Time) bool {
+	b.Capacity = float64(policy.Limit)
+	b.RefillRate = policy.RefillRate()
+	b.Tokens = b.refilled(now)
+
+	if now.After(b.UpdatedAt) {
+		b.UpdatedAt = now
+	}
+
+	if b.Tokens < 1 {
+		return false
+	}
+
+	b.Tokens--
+
+	return true
+}
+
+// IsFull tells if the bucket is refilled by now, a full bucket is the same as none.
+func (b *RateLimitBucket) IsFull(now time.Time) bool {
+	return b.refilled(now) >= b.Capacity
+}
+
+// Remaining is how many requests can be made right away.
+func (b *RateLimitBucket) Remaining() int64 {
+	return int64(math.Floor(b.Tokens))
+}
+
+// ResetIn is how long the bucket takes to be full again.
+func (b *RateLimitBucket) ResetIn() time.Duration {
+	return b.durationFor(b.Capacity - b.Tokens)
+}
+
+// RetryIn is how long until the next request can be made, 0 when it can be now.
+func (b *RateLimitBucket) RetryIn() time.Duration {
+	if b.Tokens >= 1 {
+		return 0
+	}
+
+	return b.durationFor(1 - b.Tokens)
+}
+
+func (b *RateLimitBucket) refilled(now time.Time) float64 {
+	elapsed := now.Sub(b.UpdatedAt).Seconds()
+
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	return math.Min(b.Capacity, b.Tokens+elapsed*b.RefillRate)
+}
+
+func (b *RateLimitBucket) durationFor(tokens float64) time.Duration {
+	if tokens <= 0 || b.RefillRate <= 0 {
+		return 0
+	}
+
+	return time.Duration(math.Ceil(tokens / b.RefillRate * float64(time.Second)))
+}
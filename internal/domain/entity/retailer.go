@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"errors"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/services"
+	. "project/internal/domain/types"
+	"strings"
+)
+
+// Retailer is a store that sells products, each sale is a ProductOffer.
+type Retailer struct {
+	ID         RetailerID
+	PublicID   RetailerPublicID
+	Name       string
+	WebsiteURL string
+}
+
+type RetailerProps struct {
+	ID         RetailerID
+	PublicID   RetailerPublicID
+	Name       string
+	WebsiteURL string
+}
+
+type UpdateRetailerProps struct {
+	Name       string
+	WebsiteURL string
+}
+
+func NewRetailer(props RetailerProps) (*Retailer, exceptions.EntityException) {
+	publicID, err := services.GeneratePublicID(props.PublicID)
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	retailer := &Retailer{
+		ID:         props.ID,
+		PublicID:   publicID,
+		Name:       strings.TrimSpace(props.Name),
+		WebsiteURL: strings.TrimSpace(props.WebsiteURL),
+	}
+
+	err = retailer.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return retailer, nil
+}
+
+func (r *Retailer) Update(props UpdateRetailerProps) exceptions.EntityException {
+	r.Name = strings.TrimSpace(props.Name)
+	r.WebsiteURL = strings.TrimSpace(props.WebsiteURL)
+
+	err := r.validate()
+
+	if err != nil {
+		return exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return nil
+}
+
+func (r *Retailer) validate() error {
+	if r.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if r.PublicID == "" {
+		return errors.New("PublicID cannot be empty")
+	}
+
+	if r.Name == "" {
+		return errors.New("Name cannot be empty")
+	}
+
+	if len(r.Name) > 255 {
+		return errors.New("Name cannot be longer than 255 characters")
+	}
+
+	if r.WebsiteURL != "" && !isHttpURL(r.WebsiteURL) {
+		return errors.New("WebsiteURL must be an http or https URL")
+	}
+
+	return nil
+}
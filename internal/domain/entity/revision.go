@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// RevisionPart is one row a read is built from. State carries what changes with no
+// version, it is empty for the rows that have one.
+type RevisionPart struct {
+	Kind      string
+	ID        int64
+	Version   int64
+	State     string
+	ChangedAt time.Time
+}
+
+// Revision identifies what a read returns: Tag changes with any of the rows it is
+// built from and ModifiedAt is the last time one of them changed.
+type Revision struct {
+	Tag        string
+	ModifiedAt time.Time
+}
+
+// NewRevision hashes the parts in the order given, the same rows always give the
+// same Tag.
+func NewRevision(parts ...RevisionPart) *Revision {
+	hash := sha256.New()
+	revision := &Revision{}
+
+	for _, part := range parts {
+		fmt.Fprintf(hash, "%s:%d:%d:%s:%d\n", part.Kind, part.ID, part.Version, part.State, part.ChangedAt.Unix())
+
+		if part.ChangedAt.After(revision.ModifiedAt) {
+			revision.ModifiedAt = part.ChangedAt
+		}
+	}
+
+	revision.Tag = hex.EncodeToString(hash.Sum(nil)[:16])
+
+	return revision
+}
+
+// Combine is the revision of a read built from several others, like a comparison.
+// The order counts, the left and right of a comparison are not interchangeable.
+func (r *Revision) Combine(others ...*Revision) *Revision {
+	hash := sha256.New()
+	revision := &Revision{Tag: r.Tag, ModifiedAt: r.ModifiedAt}
+
+	fmt.Fprintf(hash, "%s\n", r.Tag)
+
+	for _, other := range others {
+		fmt.Fprintf(hash, "%s\n", other.Tag)
+
+		if other.ModifiedAt.After(revision.ModifiedAt) {
+			revision.ModifiedAt = other.ModifiedAt
+		}
+	}
+
+	revision.Tag = hex.EncodeToString(hash.Sum(nil)[:16])
+
+	return revision
+}
@@ -9,6 +9,15 @@ import (
 )
 
 type Specification struct {
+	ID                         SpecificationID
+	PublicID                   SpecificationPublicID
+	Title                      string
+	EspecificationGroupID      SpecificationGroupID
+	SpecificationGroupPublicID SpecificationGroupPublicID // only loaded when a single specification is read
+	Type                       SpecificationType
+}
+
+type SpecificationProps struct {
 	ID                    SpecificationID
 	PublicID              SpecificationPublicID
 	Title                 string
@@ -16,9 +25,7 @@ type Specification struct {
 	Type                  SpecificationType
 }
 
-type SpecificationProps struct {
-	ID                    SpecificationID
-	PublicID              SpecificationPublicID
+type UpdateSpecificationProps struct {
 	Title                 string
 	EspecificationGroupID SpecificationGroupID
 	Type                  SpecificationType
@@ -51,6 +58,22 @@ func NewSpecification(props SpecificationProps) (*Specification, exceptions.Enti
 	return specification, nil
 }
 
+func (s *Specification) Update(props UpdateSpecificationProps) exceptions.EntityException {
+	s.Title = props.Title
+	s.EspecificationGroupID = props.EspecificationGroupID
+	s.Type = props.Type
+
+	err := s.validate()
+
+	if err != nil {
+		return exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return nil
+}
+
 func (s *Specification) validate() error {
 	if s.ID < 0 {
 		return errors.New("ID field cannot be less than 0")
@@ -76,5 +99,11 @@ func (s *Specification) validate() error {
 		return errors.New("Type cannot be empty")
 	}
 
+	switch s.Type {
+	case constants.SpecificationTypeString, constants.SpecificationTypeInt, constants.SpecificationTypeBool:
+	default:
+		return errors.New("Type must be one of string, int or bool")
+	}
+
 	return nil
 }
@@ -26,6 +26,11 @@ type SpecificationGroupProps struct {
 	Specifications      []*Specification
 }
 
+type UpdateSpecificationGroupProps struct {
+	Name        string
+	Description string
+}
+
 func NewSpecificationGroup(props SpecificationGroupProps) (*SpecificationGroup, exceptions.EntityException) {
 	publicId, err := services.GeneratePublicID(props.PublicID)
 
@@ -55,6 +60,21 @@ func NewSpecificationGroup(props SpecificationGroupProps) (*SpecificationGroup,
 	return specificationGroup, nil
 }
 
+func (sg *SpecificationGroup) Update(props UpdateSpecificationGroupProps) exceptions.EntityException {
+	sg.Name = props.Name
+	sg.Description = props.Description
+
+	err := sg.validate()
+
+	if err != nil {
+		return exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return nil
+}
+
 func (sg *SpecificationGroup) validate() error {
 	if sg.ID < 0 {
 		return errors.New("ID field cannot be less than 0")
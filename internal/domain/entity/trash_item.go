@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	. "project/internal/domain/types"
+	"slices"
+	"time"
+)
+
+// TrashItem is a soft deleted product, category, specification or specification
+// group. It can be restored until it is purged, once it is older than the retention.
+type TrashItem struct {
+	EntityType TrashEntityType
+	PublicID   string
+	Name       string
+	DeletedAt  time.Time
+}
+
+type TrashItemProps struct {
+	EntityType TrashEntityType
+	PublicID   string
+	Name       string
+	DeletedAt  time.Time
+}
+
+func NewTrashItem(props TrashItemProps) (*TrashItem, exceptions.EntityException) {
+	item := &TrashItem{
+		EntityType: props.EntityType,
+		PublicID:   props.PublicID,
+		Name:       props.Name,
+		DeletedAt:  props.DeletedAt,
+	}
+
+	err := item.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return item, nil
+}
+
+// PurgeAt is when the item stops being restorable.
+func (t *TrashItem) PurgeAt(retention time.Duration) time.Time {
+	return t.DeletedAt.Add(retention)
+}
+
+func (t *TrashItem) validate() error {
+	if !slices.Contains(constants.TrashEntityTypes, t.EntityType) {
+		return fmt.Errorf("EntityType %q is not valid", t.EntityType)
+	}
+
+	if t.PublicID == "" {
+		return errors.New("PublicID cannot be empty")
+	}
+
+	if t.DeletedAt.IsZero() {
+		return errors.New("DeletedAt cannot be empty")
+	}
+
+	return nil
+}
+
+// TrashFilter narrows the trash, an empty EntityType matches every entity.
+type TrashFilter struct {
+	EntityType TrashEntityType
+}
+
+// TrashPurge is what a purge removed for good: the entities and the storage keys of
+// the files of the product images that went with them.
+type TrashPurge struct {
+	Items       []*TrashItem
+	StorageKeys []string
+}
@@ -0,0 +1,246 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/services"
+	. "project/internal/domain/types"
+	"strings"
+	"time"
+)
+
+// Watchlist asks for an alert when the price of a product drops to TargetPrice, or by
+// DropPercent from BasePrice, the price when the watchlist was created. Exactly one
+// of TargetPrice and DropPercent is set.
+type Watchlist struct {
+	ID              int64
+	PublicID        WatchlistPublicID
+	ProductID       ProductID
+	ProductPublicID ProductPublicID
+	SubscriberToken string
+	TargetPrice     int64 // in cents
+	DropPercent     int64
+	BasePrice       int64 // in cents
+	// LastNotifiedPrice is the price of the last alert, 0 until the first one and
+	// again once the price goes back above the threshold.
+	LastNotifiedPrice int64
+	CreatedAt         time.Time
+}
+
+type WatchlistProps struct {
+	ID                int64
+	PublicID          WatchlistPublicID
+	ProductID         ProductID
+	ProductPublicID   ProductPublicID
+	SubscriberToken   string
+	TargetPrice       int64
+	DropPercent       int64
+	BasePrice         int64
+	LastNotifiedPrice int64
+	CreatedAt         time.Time
+}
+
+// WatchlistDelivery is one alert sent to the webhook, Attempts counts the retries.
+type WatchlistDelivery struct {
+	ID             int64
+	WatchlistID    int64
+	Trigger        WatchlistTrigger
+	Price          int64
+	ThresholdPrice int64
+	Status         WatchlistDeliveryStatus
+	Attempts       int64
+	StatusCode     int64
+	Error          string
+	CreatedAt      time.Time
+}
+
+type WatchlistDeliveryProps struct {
+	ID             int64
+	WatchlistID    int64
+	Trigger        WatchlistTrigger
+	Price          int64
+	ThresholdPrice int64
+	Status         WatchlistDeliveryStatus
+	Attempts       int64
+	StatusCode     int64
+	Error          string
+	CreatedAt      time.Time
+}
+
+func NewWatchlist(props WatchlistProps) (*Watchlist, exceptions.EntityException) {
+	publicID, err := services.GeneratePublicID(props.PublicID)
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	watchlist := &Watchlist{
+		ID:                props.ID,
+		PublicID:          publicID,
+		ProductID:         props.ProductID,
+		ProductPublicID:   props.ProductPublicID,
+		SubscriberToken:   strings.TrimSpace(props.SubscriberToken),
+		TargetPrice:       props.TargetPrice,
+		DropPercent:       props.DropPercent,
+		BasePrice:         props.BasePrice,
+		LastNotifiedPrice: props.LastNotifiedPrice,
+		CreatedAt:         props.CreatedAt,
+	}
+
+	err = watchlist.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return watchlist, nil
+}
+
+// ThresholdPrice is the price at or below which the subscriber is alerted.
+func (w *Watchlist) ThresholdPrice() int64 {
+	if w.TargetPrice > 0 {
+		return w.TargetPrice
+	}
+
+	return w.BasePrice * (100 - w.DropPercent) / 100
+}
+
+// ShouldNotify reports whether price is worth an alert: it reached the threshold and
+// is lower than the price of the last alert, so a price that stays low alerts once.
+func (w *Watchlist) ShouldNotify(price int64) bool {
+	if price <= 0 || price > w.ThresholdPrice() {
+		return false
+	}
+
+	return w.LastNotifiedPrice == 0 || price < w.LastNotifiedPrice
+}
+
+func (w *Watchlist) MarkNotified(price int64) exceptions.EntityException {
+	if price <= 0 {
+		return exceptions.Entity(errors.New("Notified price must be greater than 0"), exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	w.LastNotifiedPrice = price
+
+	return nil
+}
+
+// Rearm forgets the last alert once the price is back above the threshold, so the
+// next drop alerts again. It reports whether the watchlist changed.
+func (w *Watchlist) Rearm(price int64) bool {
+	if w.LastNotifiedPrice == 0 || price <= w.ThresholdPrice() {
+		return false
+	}
+
+	w.LastNotifiedPrice = 0
+
+	return true
+}
+
+func (w *Watchlist) validate() error {
+	if w.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if len(w.PublicID) != 8 {
+		return errors.New("PublicID must be exactly 8 characters long")
+	}
+
+	if w.ProductID <= 0 {
+		return errors.New("ProductID field must be greater than 0")
+	}
+
+	if len(w.SubscriberToken) < constants.WatchlistMinSubscriberTokenLength {
+		return fmt.Errorf("SubscriberToken must be at least %d characters long", constants.WatchlistMinSubscriberTokenLength)
+	}
+
+	if len(w.SubscriberToken) > 255 {
+		return errors.New("SubscriberToken cannot be longer than 255 characters")
+	}
+
+	if (w.TargetPrice > 0) == (w.DropPercent > 0) {
+		return errors.New("Either TargetPrice or DropPercent must be set")
+	}
+
+	if w.TargetPrice < 0 {
+		return errors.New("TargetPrice cannot be less than 0")
+	}
+
+	if w.DropPercent < 0 || w.DropPercent > 99 {
+		return errors.New("DropPercent must be between 1 and 99")
+	}
+
+	if w.BasePrice <= 0 {
+		return errors.New("BasePrice must be greater than 0")
+	}
+
+	if w.LastNotifiedPrice < 0 {
+		return errors.New("LastNotifiedPrice cannot be less than 0")
+	}
+
+	return nil
+}
+
+func NewWatchlistDelivery(props WatchlistDeliveryProps) (*WatchlistDelivery, exceptions.EntityException) {
+	delivery := &WatchlistDelivery{
+		ID:             props.ID,
+		WatchlistID:    props.WatchlistID,
+		Trigger:        props.Trigger,
+		Price:          props.Price,
+		ThresholdPrice: props.ThresholdPrice,
+		Status:         props.Status,
+		Attempts:       props.Attempts,
+		StatusCode:     props.StatusCode,
+		Error:          props.Error,
+		CreatedAt:      props.CreatedAt,
+	}
+
+	err := delivery.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return delivery, nil
+}
+
+func (d *WatchlistDelivery) validate() error {
+	if d.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if d.WatchlistID <= 0 {
+		return errors.New("WatchlistID field must be greater than 0")
+	}
+
+	switch d.Trigger {
+	case constants.WatchlistTriggerPriceUpdate, constants.WatchlistTriggerSweep:
+	default:
+		return fmt.Errorf("Trigger %q is not valid", d.Trigger)
+	}
+
+	switch d.Status {
+	case constants.WatchlistDeliveryStatusDelivered, constants.WatchlistDeliveryStatusFailed:
+	default:
+		return fmt.Errorf("Status %q is not valid", d.Status)
+	}
+
+	if d.Price <= 0 {
+		return errors.New("Price must be greater than 0")
+	}
+
+	if d.Attempts < 1 {
+		return errors.New("Attempts must be greater than 0")
+	}
+
+	return nil
+}
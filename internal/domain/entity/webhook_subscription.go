@@ -0,0 +1,288 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/services"
+	. "project/internal/domain/types"
+	"slices"
+	"strings"
+	"time"
+)
+
+// WebhookSubscription receives the outbox events of EventTypes at URL, signed with
+// Secret. An empty EventTypes receives every event and "product.*" every event of
+// the aggregate.
+type WebhookSubscription struct {
+	ID         int64
+	PublicID   WebhookSubscriptionPublicID
+	URL        string
+	Secret     string
+	EventTypes []OutboxEventType
+	CreatedAt  time.Time
+}
+
+type WebhookSubscriptionProps struct {
+	ID         int64
+	PublicID   WebhookSubscriptionPublicID
+	URL        string
+	Secret     string
+	EventTypes []OutboxEventType
+	CreatedAt  time.Time
+}
+
+// WebhookDelivery is an outbox event to be posted to a subscription. It stays
+// pending, retried at NextAttemptAt, until it is delivered or runs out of attempts,
+// and is sending while a dispatcher posts it.
+type WebhookDelivery struct {
+	ID             int64
+	EventID        int64
+	SubscriptionID int64
+	Status         WebhookDeliveryStatus
+	Attempts       int64
+	NextAttemptAt  time.Time
+	LastStatusCode int64
+	LastError      string
+	DeliveredAt    time.Time
+	CreatedAt      time.Time
+	Event          *OutboxEvent
+}
+
+type WebhookDeliveryProps struct {
+	ID             int64
+	EventID        int64
+	SubscriptionID int64
+	Status         WebhookDeliveryStatus
+	Attempts       int64
+	NextAttemptAt  time.Time
+	LastStatusCode int64
+	LastError      string
+	DeliveredAt    time.Time
+	CreatedAt      time.Time
+	Event          *OutboxEvent
+}
+
+func NewWebhookSubscription(props WebhookSubscriptionProps) (*WebhookSubscription, exceptions.EntityException) {
+	publicID, err := services.GeneratePublicID(props.PublicID)
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	secret := strings.TrimSpace(props.Secret)
+
+	if secret == "" {
+		secret, err = services.GenerateSecret(24)
+		if err != nil {
+			return nil, exceptions.Entity(err, exceptions.EntityOpts{
+				Reason: constants.EntityBussinessError,
+			})
+		}
+	}
+
+	eventTypes := []OutboxEventType{}
+
+	for _, eventType := range props.EventTypes {
+		eventType = OutboxEventType(strings.TrimSpace(string(eventType)))
+
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	subscription := &WebhookSubscription{
+		ID:         props.ID,
+		PublicID:   publicID,
+		URL:        strings.TrimSpace(props.URL),
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedAt:  props.CreatedAt,
+	}
+
+	err = subscription.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return subscription, nil
+}
+
+// Accepts reports whether events of eventType are sent to the subscription.
+func (s *WebhookSubscription) Accepts(eventType OutboxEventType) bool {
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+
+	for _, accepted := range s.EventTypes {
+		if accepted == eventType {
+			return true
+		}
+
+		aggregate, isWildcard := strings.CutSuffix(string(accepted), ".*")
+
+		if isWildcard && strings.HasPrefix(string(eventType), aggregate+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *WebhookSubscription) validate() error {
+	if s.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if len(s.PublicID) != 8 {
+		return errors.New("PublicID must be exactly 8 characters long")
+	}
+
+	if !isHttpURL(s.URL) {
+		return errors.New("URL must be an http or https URL")
+	}
+
+	if len(s.URL) > 2048 {
+		return errors.New("URL cannot be longer than 2048 characters")
+	}
+
+	if len(s.Secret) < constants.WebhookSubscriptionMinSecretLength {
+		return fmt.Errorf("Secret must be at least %d characters long", constants.WebhookSubscriptionMinSecretLength)
+	}
+
+	if len(s.Secret) > 255 {
+		return errors.New("Secret cannot be longer than 255 characters")
+	}
+
+	for _, eventType := range s.EventTypes {
+		if !isOutboxEventTypeFilter(eventType) {
+			return fmt.Errorf("EventType %q is not valid", eventType)
+		}
+	}
+
+	return nil
+}
+
+// isOutboxEventTypeFilter is true for the known event types and the wildcards of
+// the known aggregates.
+func isOutboxEventTypeFilter(eventType OutboxEventType) bool {
+	if slices.Contains(constants.OutboxEventTypes, eventType) {
+		return true
+	}
+
+	switch OutboxAggregateType(strings.TrimSuffix(string(eventType), ".*")) {
+	case constants.OutboxAggregateProduct, constants.OutboxAggregateCategory:
+		return strings.HasSuffix(string(eventType), ".*")
+	}
+
+	return false
+}
+
+func NewWebhookDelivery(props WebhookDeliveryProps) (*WebhookDelivery, exceptions.EntityException) {
+	if props.Status == "" {
+		props.Status = constants.WebhookDeliveryStatusPending
+	}
+
+	delivery := &WebhookDelivery{
+		ID:             props.ID,
+		EventID:        props.EventID,
+		SubscriptionID: props.SubscriptionID,
+		Status:         props.Status,
+		Attempts:       props.Attempts,
+		NextAttemptAt:  props.NextAttemptAt,
+		LastStatusCode: props.LastStatusCode,
+		LastError:      props.LastError,
+		DeliveredAt:    props.DeliveredAt,
+		CreatedAt:      props.CreatedAt,
+		Event:          props.Event,
+	}
+
+	err := delivery.validate()
+
+	if err != nil {
+		return nil, exceptions.Entity(err, exceptions.EntityOpts{
+			Reason: constants.EntityValidationError,
+		})
+	}
+
+	return delivery, nil
+}
+
+// MarkDelivered records the attempt that reached the subscription.
+func (d *WebhookDelivery) MarkDelivered(statusCode int64, now time.Time) exceptions.EntityException {
+	if d.Status != constants.WebhookDeliveryStatusSending {
+		return exceptions.Entity(fmt.Errorf("a %s delivery cannot be delivered", d.Status), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	d.Attempts++
+	d.Status = constants.WebhookDeliveryStatusDelivered
+	d.LastStatusCode = statusCode
+	d.LastError = ""
+	d.DeliveredAt = now.UTC().Truncate(time.Second)
+
+	return nil
+}
+
+// MarkFailed records a failed attempt. The next one waits backoff, doubled on each
+// attempt up to WebhookDeliveryMaxBackoff, and after maxAttempts the delivery is
+// dead-lettered.
+func (d *WebhookDelivery) MarkFailed(statusCode int64, reason string, now time.Time, maxAttempts int64, backoff time.Duration) exceptions.EntityException {
+	if d.Status != constants.WebhookDeliveryStatusSending {
+		return exceptions.Entity(fmt.Errorf("a %s delivery cannot fail", d.Status), exceptions.EntityOpts{
+			Reason: constants.EntityBussinessError,
+		})
+	}
+
+	d.Attempts++
+	d.Status = constants.WebhookDeliveryStatusPending
+	d.LastStatusCode = statusCode
+	d.LastError = reason
+
+	if d.Attempts >= maxAttempts {
+		d.Status = constants.WebhookDeliveryStatusDead
+		return nil
+	}
+
+	wait := backoff
+
+	for i := int64(1); i < d.Attempts && wait < constants.WebhookDeliveryMaxBackoff; i++ {
+		wait *= 2
+	}
+
+	d.NextAttemptAt = now.UTC().Add(min(wait, constants.WebhookDeliveryMaxBackoff)).Truncate(time.Second)
+
+	return nil
+}
+
+func (d *WebhookDelivery) validate() error {
+	if d.ID < 0 {
+		return errors.New("ID field cannot be less than 0")
+	}
+
+	if d.EventID <= 0 {
+		return errors.New("EventID field must be greater than 0")
+	}
+
+	if d.SubscriptionID <= 0 {
+		return errors.New("SubscriptionID field must be greater than 0")
+	}
+
+	switch d.Status {
+	case constants.WebhookDeliveryStatusPending, constants.WebhookDeliveryStatusSending, constants.WebhookDeliveryStatusDelivered, constants.WebhookDeliveryStatusDead:
+	default:
+		return fmt.Errorf("Status %q is not valid", d.Status)
+	}
+
+	if d.Attempts < 0 {
+		return errors.New("Attempts cannot be less than 0")
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package exceptions
 
 import (
 	"fmt"
+	"log/slog"
 	. "project/internal/domain/types"
 	"runtime"
 	"strings"
@@ -10,6 +11,10 @@ import (
 type Base[T BaseRepository | BaseUsecase | BaseEntity] interface {
 	error
 	Instance() *T
+	// SetRequestID ties the exception, and the exceptions it wraps, to the request
+	// that raised it.
+	SetRequestID(RequestID)
+	slog.LogValuer
 	indentStack(StackIndentSpaces) Stack
 	indentError(StackIndentSpaces) ExceptionErr
 }
@@ -60,3 +65,44 @@ func indentError(err ExceptionErr, spaces StackIndentSpaces) ExceptionErr {
 	}
 	return ExceptionErr(strings.Join(lines, "\n"))
 }
+
+// setCauseRequestID passes the request ID down to the exception that was wrapped, a
+// plain error has nowhere to keep it.
+func setCauseRequestID(cause error, requestID RequestID) {
+	if exception, isException := cause.(interface{ SetRequestID(RequestID) }); isException {
+		exception.SetRequestID(requestID)
+	}
+}
+
+// causeAttr logs the wrapped exception with its own fields, or the error text when
+// there is none.
+func causeAttr(cause error, err ExceptionErr) slog.Attr {
+	if valuer, isValuer := cause.(slog.LogValuer); isValuer {
+		return slog.Any("cause", valuer)
+	}
+
+	return slog.String("error", string(err))
+}
+
+// requestIDLine is the line of the request ID in the exception text, there is none
+// outside of a request.
+func requestIDLine(requestID RequestID) string {
+	if requestID == "" {
+		return ""
+	}
+
+	return "\n    - RequestID: " + string(requestID)
+}
+
+// stackFrames turns the stack into one "function file:line" entry per frame.
+func stackFrames(stack Stack) []string {
+	lines := strings.Split(string(stack), "\n")
+	frames := make([]string, 0, len(lines)/2)
+
+	for i := 0; i+1 < len(lines); i += 2 {
+		function := strings.TrimPrefix(strings.TrimSpace(lines[i]), "-> ")
+		frames = append(frames, function+" "+strings.TrimSpace(lines[i+1]))
+	}
+
+	return frames
+}
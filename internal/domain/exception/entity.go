@@ -2,6 +2,7 @@ package exceptions
 
 import (
 	"fmt"
+	"log/slog"
 
 	"project/internal/domain/constants"
 	. "project/internal/domain/types"
@@ -15,6 +16,8 @@ type BaseEntity struct {
 	Reason EntityErrorReason
 	Err    ExceptionErr
 	Stack  Stack
+	// RequestID is the request that raised the exception, empty outside of one.
+	RequestID RequestID
 }
 
 type EntityOpts struct {
@@ -67,12 +70,13 @@ func Entity(err error, opts ...EntityOpts) EntityException {
 
 func (e *BaseEntity) Error() string {
 	return fmt.Sprintf(`
-Entity Exception: {
+Entity Exception: {%s
     - Reason: %s
     - Stack:
 %s
     - Error: [[%s]]
 }`,
+		requestIDLine(e.RequestID),
 		e.Reason,
 		e.indentStack(8),
 		e.Err,
@@ -83,6 +87,20 @@ func (e *BaseEntity) Instance() *BaseEntity {
 	return e
 }
 
+func (e *BaseEntity) SetRequestID(requestID RequestID) {
+	e.RequestID = requestID
+}
+
+func (e *BaseEntity) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "entity"),
+		slog.String("reason", string(e.Reason)),
+		slog.String("request_id", string(e.RequestID)),
+		slog.Any("stack", stackFrames(e.Stack)),
+		slog.String("error", string(e.Err)),
+	)
+}
+
 func (e *BaseEntity) indentStack(indentSpaces StackIndentSpaces) Stack {
 	return indentStack(e.Stack, indentSpaces)
 }
@@ -2,6 +2,7 @@ package exceptions
 
 import (
 	"fmt"
+	"log/slog"
 
 	"project/internal/domain/constants"
 	. "project/internal/domain/types"
@@ -15,6 +16,9 @@ type BaseRepository struct {
 	Reason RepositoryErrorReason
 	Err    ExceptionErr
 	Stack  Stack
+	// RequestID is the request that raised the exception, empty outside of one.
+	RequestID RequestID
+	cause     error
 }
 
 type RepositoryOpts struct {
@@ -43,6 +47,7 @@ func Repo(err error, opts ...RepositoryOpts) RepositoryException {
 			Reason: constants.RepositoryUnknownError,
 			Err:    ExceptionErr(err.Error()),
 			Stack:  stack,
+			cause:  err,
 		}
 	}
 
@@ -60,22 +65,42 @@ func Repo(err error, opts ...RepositoryOpts) RepositoryException {
 		Reason: opt.Reason,
 		Stack:  stack,
 		Err:    ExceptionErr(err.Error()),
+		cause:  err,
 	}
 }
 
 func (e *BaseRepository) Error() string {
-	return fmt.Sprintf(`Database Exception: {
+	return fmt.Sprintf(`Database Exception: {%s
     - Reason: %s
     - Stack:
 %s
     - Error: [[%s]]
-}`, e.Reason, e.indentStack(8), e.Err)
+}`, requestIDLine(e.RequestID), e.Reason, e.indentStack(8), e.Err)
 }
 
 func (e *BaseRepository) Instance() *BaseRepository {
 	return e
 }
 
+func (e *BaseRepository) SetRequestID(requestID RequestID) {
+	e.RequestID = requestID
+	setCauseRequestID(e.cause, requestID)
+}
+
+func (e *BaseRepository) Unwrap() error {
+	return e.cause
+}
+
+func (e *BaseRepository) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "repository"),
+		slog.String("reason", string(e.Reason)),
+		slog.String("request_id", string(e.RequestID)),
+		slog.Any("stack", stackFrames(e.Stack)),
+		causeAttr(e.cause, e.Err),
+	)
+}
+
 func (e *BaseRepository) indentStack(indentSpaces StackIndentSpaces) Stack {
 	return indentStack(e.Stack, indentSpaces)
 }
@@ -2,6 +2,7 @@ package exceptions
 
 import (
 	"fmt"
+	"log/slog"
 
 	"project/internal/domain/constants"
 	. "project/internal/domain/types"
@@ -17,6 +18,11 @@ type BaseUsecase struct {
 	Message    string
 	Stack      Stack
 	Err        ExceptionErr
+	// Data is sent in the error response, for the errors a client can act on.
+	Data any
+	// RequestID is the request that raised the exception, empty outside of one.
+	RequestID RequestID
+	cause     error
 }
 
 type UsecaseOpts struct {
@@ -24,6 +30,7 @@ type UsecaseOpts struct {
 	StatusCode  int
 	Message     string
 	StackLength StackLength
+	Data        any
 }
 
 func Usecase(err error, opts ...UsecaseOpts) UsecaseException {
@@ -52,6 +59,7 @@ func Usecase(err error, opts ...UsecaseOpts) UsecaseException {
 			Message:    "Internal Error - Unknown",
 			Err:        ExceptionErr(err.Error()),
 			Stack:      stack,
+			cause:      err,
 		}
 	}
 
@@ -85,12 +93,14 @@ func Usecase(err error, opts ...UsecaseOpts) UsecaseException {
 		Message:    message,
 		Err:        ExceptionErr(err.Error()),
 		Stack:      stack,
+		Data:       opt.Data,
+		cause:      err,
 	}
 }
 
 func (e *BaseUsecase) Error() string {
 	return fmt.Sprintf(`
-Usecase Exception: {
+Usecase Exception: {%s
     - StatusCode: %d
     - Message: %s
     - Code: %s
@@ -100,13 +110,34 @@ Usecase Exception: {
 %s
     ]]
 }`,
-		e.StatusCode, e.Message, e.Code, e.indentStack(8), e.indentError(8))
+		requestIDLine(e.RequestID), e.StatusCode, e.Message, e.Code, e.indentStack(8), e.indentError(8))
 }
 
 func (e *BaseUsecase) Instance() *BaseUsecase {
 	return e
 }
 
+func (e *BaseUsecase) SetRequestID(requestID RequestID) {
+	e.RequestID = requestID
+	setCauseRequestID(e.cause, requestID)
+}
+
+func (e *BaseUsecase) Unwrap() error {
+	return e.cause
+}
+
+func (e *BaseUsecase) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "usecase"),
+		slog.String("code", e.Code),
+		slog.Int("status_code", e.StatusCode),
+		slog.String("message", e.Message),
+		slog.String("request_id", string(e.RequestID)),
+		slog.Any("stack", stackFrames(e.Stack)),
+		causeAttr(e.cause, e.Err),
+	)
+}
+
 func (e *BaseUsecase) indentStack(indentSpaces StackIndentSpaces) Stack {
 	return indentStack(e.Stack, indentSpaces)
 }
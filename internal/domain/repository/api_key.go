@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	. "project/internal/domain/types"
+)
+
+type ApiKey interface {
+	// GetOneByPublicID finds revoked keys too, Verify tells them apart.
+	GetOneByPublicID(ApiKeyPublicID) (*entity.ApiKey, RepositoryException)
+	GetAll() ([]*entity.ApiKey, RepositoryException)
+	CreateOne(*entity.ApiKey) RepositoryException
+	RevokeOne(*entity.ApiKey) RepositoryException
+	// TouchOne records that the key was just used.
+	TouchOne(*entity.ApiKey) RepositoryException
+}
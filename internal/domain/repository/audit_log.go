@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+)
+
+type AuditLog interface {
+	CreateOne(*entity.AuditEntry) RepositoryException
+	CreateMany([]*entity.AuditEntry) RepositoryException
+	// GetAll returns the entries matching the filter, the latest first.
+	GetAll(entity.AuditLogFilter, entity.PaginatorInput) ([]*entity.AuditEntry, entity.PaginatorOutput, RepositoryException)
+}
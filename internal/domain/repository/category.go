@@ -8,5 +8,14 @@ import (
 
 type Category interface {
 	GetOneByPublicID(CategoryPublicID) (*entity.Category, RepositoryException)
+	GetOneDeletedByPublicID(CategoryPublicID) (*entity.Category, RepositoryException)
 	GetAll() ([]*entity.Category, RepositoryException)
+	// GetRevision identifies what GetAll returns.
+	GetRevision() (*entity.Revision, RepositoryException)
+	ExistsByName(string, CategoryPublicID) (bool, RepositoryException)
+	CountProducts(CategoryID) (int64, RepositoryException)
+	CreateOne(*entity.Category) RepositoryException
+	UpdateOne(*entity.Category) RepositoryException
+	DeleteOne(*entity.Category) RepositoryException
+	RestoreOne(*entity.Category) RepositoryException
 }
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	. "project/internal/domain/types"
+)
+
+type CategorySpecification interface {
+	GetTemplateByCategoryID(CategoryID) (*entity.CategoryTemplate, RepositoryException)
+	ReplaceTemplate(*entity.CategoryTemplate) RepositoryException
+}
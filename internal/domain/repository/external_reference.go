@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	. "project/internal/domain/types"
+)
+
+type ExternalReference interface {
+	GetOneBySourceAndExternalID(ExternalSource, string) (*entity.ExternalReference, RepositoryException)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	. "project/internal/domain/exception"
+)
+
+// ImageStorage keeps the image files, the database only has their keys. URL is
+// where clients can fetch the file.
+type ImageStorage interface {
+	Save(key string, content []byte, contentType string) RepositoryException
+	Delete(key string) RepositoryException
+	URL(key string) string
+}
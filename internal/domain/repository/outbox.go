@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	"time"
+)
+
+type Outbox interface {
+	GetAllUndispatched(int64) ([]*entity.OutboxEvent, RepositoryException)
+	GetAllSince(time.Time) ([]*entity.OutboxEvent, RepositoryException)
+	// Dispatch creates a pending delivery of the event for each subscription and
+	// marks the event dispatched, in a single transaction. It tells false, changing
+	// nothing, when another dispatcher marked the event first.
+	Dispatch(*entity.OutboxEvent, []*entity.WebhookSubscription) (bool, RepositoryException)
+}
@@ -5,15 +5,27 @@ import (
 	"project/internal/domain/entity"
 	. "project/internal/domain/exception"
 	. "project/internal/domain/types"
+	"time"
 )
 
 type Product interface {
 	GetOneByPublicId(ProductPublicID) (*entity.Product, RepositoryException)
+	GetOneDeletedByPublicId(ProductPublicID) (*entity.Product, RepositoryException)
 	GetOneByPublicIdWithSpecificationGroups(ProductPublicID) (*aggregate.ProductWithSpecificationsGroups, RepositoryException)
 	GetAll(entity.PaginatorInput) ([]*entity.Product, entity.PaginatorOutput, RepositoryException)
 	GetAllByCategoryID(CategoryID, entity.PaginatorInput) ([]*entity.Product, entity.PaginatorOutput, RepositoryException)
+	GetManyAfterID(entity.ProductCursorInput) ([]*entity.Product, RepositoryException)
+	GetAllVariants(*entity.Product) ([]*entity.Product, RepositoryException)
+	// GetRevision identifies the product as read with its specifications, variants
+	// and offers, the offers that are current at the given time.
+	GetRevision(ProductPublicID, time.Time) (*entity.Revision, RepositoryException)
 	ExistsByName(ProductName, ProductPublicID) (bool, RepositoryException)
 	CreateOne(*entity.Product) RepositoryException
+	CreateMany([]*entity.Product) RepositoryException
+	CreateOneWithExternalReference(*entity.Product, *entity.ExternalReference) RepositoryException
 	DeleteOne(*entity.Product) RepositoryException
+	// RestoreOne restores the product together with the variants deleted with it.
+	RestoreOne(*entity.Product) RepositoryException
 	UpdateOne(*entity.Product) RepositoryException
+	PublishOne(*entity.Product) RepositoryException
 }
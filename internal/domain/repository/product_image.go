@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	. "project/internal/domain/types"
+)
+
+// ProductImage keeps the gallery rows. Create, Update and Delete take the whole
+// gallery so the positions of the other images are written in the same transaction.
+type ProductImage interface {
+	GetAllByProductID(ProductID) ([]*entity.ProductImage, RepositoryException)
+	CreateOne(*entity.ProductImage, []*entity.ProductImage) RepositoryException
+	UpdateOne(*entity.ProductImage, []*entity.ProductImage) RepositoryException
+	DeleteOne(*entity.ProductImage, []*entity.ProductImage) RepositoryException
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	. "project/internal/domain/types"
+)
+
+type ProductOffer interface {
+	// GetAllByProductIDs returns the offers of the given products in a single query,
+	// offers of deleted retailers are left out.
+	GetAllByProductIDs([]ProductID) ([]*entity.ProductOffer, RepositoryException)
+	GetOneByRetailerID(ProductID, RetailerID) (*entity.ProductOffer, RepositoryException)
+	CreateOne(*entity.ProductOffer) RepositoryException
+	UpdateOne(*entity.ProductOffer) RepositoryException
+	DeleteOne(*entity.ProductOffer) RepositoryException
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	. "project/internal/domain/types"
+)
+
+type ProductReview interface {
+	GetOneByPublicID(ProductID, ProductReviewPublicID) (*entity.ProductReview, RepositoryException)
+	GetAllByProductID(ProductID, ProductReviewStatus, entity.PaginatorInput) ([]*entity.ProductReview, entity.PaginatorOutput, RepositoryException)
+	GetRatingSummary(ProductID) (*entity.ProductRatingSummary, RepositoryException)
+	CreateOne(*entity.ProductReview) RepositoryException
+	// ModerateOne stores the review status and the new rating of the product in a
+	// single transaction, the product is updated with the recomputed summary.
+	ModerateOne(*entity.ProductReview, *entity.Product) RepositoryException
+	AddHelpfulVote(*entity.ProductReview) RepositoryException
+}
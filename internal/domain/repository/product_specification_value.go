@@ -8,5 +8,12 @@ import (
 
 type ProductSpecificationValue interface {
 	CreateOne(*entity.ProductSpecificationValue) RepositoryException
+	UpdateOne(*entity.ProductSpecificationValue) RepositoryException
+	UpsertOne(*entity.ProductSpecificationValue) RepositoryException
+	DeleteOne(*entity.ProductSpecificationValue) RepositoryException
+	ReplaceManyByProductID(ProductID, []*entity.ProductSpecificationValue) RepositoryException
+	FindOneByProductIDAndSpecificationID(ProductID, SpecificationID) (*entity.ProductSpecificationValue, RepositoryException)
 	FindManyByProductID(ProductID) ([]*entity.ProductSpecificationValue, RepositoryException)
+	FindManyByProductIDs([]ProductID) ([]*entity.ProductSpecificationValue, RepositoryException)
+	FindManyBySpecificationID(SpecificationID) ([]*entity.ProductSpecificationValue, RepositoryException)
 }
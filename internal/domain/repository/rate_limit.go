@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	"time"
+)
+
+// RateLimit keeps the token buckets of the clients, one per policy and client.
+type RateLimit interface {
+	// Take refills the bucket of key up to now and spends one of its tokens, in one
+	// step no other take can come in between. It returns the bucket as left and if a
+	// token was spent, a missing bucket starts full.
+	Take(key string, policy *entity.RateLimitPolicy, now time.Time) (*entity.RateLimitBucket, bool, RepositoryException)
+	// DeleteFull removes the buckets refilled by now and returns how many it removed.
+	DeleteFull(now time.Time) (int64, RepositoryException)
+}
+
+// RateLimitCache keeps the buckets in the memory of one process, in front of the
+// store the processes share.
+type RateLimitCache interface {
+	RateLimit
+	// Save replaces the bucket of its key with what the shared store holds.
+	Save(*entity.RateLimitBucket)
+}
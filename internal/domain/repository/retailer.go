@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	. "project/internal/domain/types"
+)
+
+type Retailer interface {
+	GetOneByPublicID(RetailerPublicID) (*entity.Retailer, RepositoryException)
+	GetAll() ([]*entity.Retailer, RepositoryException)
+	ExistsByName(string, RetailerPublicID) (bool, RepositoryException)
+	CreateOne(*entity.Retailer) RepositoryException
+	UpdateOne(*entity.Retailer) RepositoryException
+	// DeleteOne hides the retailer, its offers stop being listed and compared.
+	DeleteOne(*entity.Retailer) RepositoryException
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"project/internal/domain/aggregate"
+	. "project/internal/domain/exception"
+)
+
+type Seed interface {
+	Reset() RepositoryException
+	Apply(*aggregate.Catalog) RepositoryException
+}
@@ -7,6 +7,16 @@ import (
 )
 
 type Specification interface {
+	GetAll() ([]*entity.Specification, RepositoryException)
 	GetAllByGroupID(SpecificationGroupID) ([]*entity.Specification, RepositoryException)
 	GetOneByPublicID(SpecificationPublicID) (*entity.Specification, RepositoryException)
+	GetOneDeletedByPublicID(SpecificationPublicID) (*entity.Specification, RepositoryException)
+	GetManyByPublicIDs([]SpecificationPublicID) ([]*entity.Specification, RepositoryException)
+	ExistsByTitle(string, SpecificationGroupID, SpecificationPublicID) (bool, RepositoryException)
+	CountValues(SpecificationID) (int64, RepositoryException)
+	CreateOne(*entity.Specification) RepositoryException
+	UpdateOne(*entity.Specification) RepositoryException
+	UpdateOneWithValues(*entity.Specification, []*entity.ProductSpecificationValue) RepositoryException
+	DeleteOne(*entity.Specification) RepositoryException
+	RestoreOne(*entity.Specification) RepositoryException
 }
@@ -8,5 +8,16 @@ import (
 
 type SpecificationGroup interface {
 	GetAll() ([]*entity.SpecificationGroup, RepositoryException)
+	// GetRevision identifies what GetAll returns.
+	GetRevision() (*entity.Revision, RepositoryException)
 	GetOneByPublicID(SpecificationGroupPublicID) (*entity.SpecificationGroup, RepositoryException)
+	GetOneDeletedByPublicID(SpecificationGroupPublicID) (*entity.SpecificationGroup, RepositoryException)
+	ExistsByName(string, SpecificationGroupPublicID) (bool, RepositoryException)
+	CountSpecifications(SpecificationGroupID) (int64, RepositoryException)
+	CreateOne(*entity.SpecificationGroup) RepositoryException
+	UpdateOne(*entity.SpecificationGroup) RepositoryException
+	// DeleteOne deletes the group together with its specifications.
+	DeleteOne(*entity.SpecificationGroup) RepositoryException
+	// RestoreOne restores the group together with the specifications deleted with it.
+	RestoreOne(*entity.SpecificationGroup) RepositoryException
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	"time"
+)
+
+// TokenVerifier checks the signature and the claims of a bearer token, a JWT signed
+// with one of the keys configured on this server, and tells who it was issued to.
+type TokenVerifier interface {
+	Verify(token string, now time.Time) (*entity.Principal, RepositoryException)
+}
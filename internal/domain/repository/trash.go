@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	"time"
+)
+
+type Trash interface {
+	// GetAll returns the deleted entities matching the filter, the latest deleted first.
+	GetAll(entity.TrashFilter, entity.PaginatorInput) ([]*entity.TrashItem, entity.PaginatorOutput, RepositoryException)
+	// Purge removes for good what was deleted before the given time, together with the
+	// rows attached to it. An entity still referenced by another one, like a category
+	// whose deleted products are younger, waits for a later purge.
+	Purge(time.Time) (*entity.TrashPurge, RepositoryException)
+}
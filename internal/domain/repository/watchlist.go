@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	. "project/internal/domain/types"
+)
+
+type Watchlist interface {
+	// GetOneByPublicID only finds the watchlists of the subscriber token.
+	GetOneByPublicID(WatchlistPublicID, string) (*entity.Watchlist, RepositoryException)
+	GetAllBySubscriberToken(string) ([]*entity.Watchlist, RepositoryException)
+	GetAllByProductID(ProductID) ([]*entity.Watchlist, RepositoryException)
+	GetAllWatchedProductPublicIDs() ([]ProductPublicID, RepositoryException)
+	// TakePriceChecks removes up to the given number of the price checks written by
+	// the product updates, and returns the products among them still watched.
+	TakePriceChecks(int64) ([]ProductPublicID, RepositoryException)
+	ExistsBySubscriberToken(string, ProductID) (bool, RepositoryException)
+	CreateOne(*entity.Watchlist) RepositoryException
+	UpdateLastNotifiedPrice(*entity.Watchlist) RepositoryException
+	DeleteOne(*entity.Watchlist) RepositoryException
+	CreateOneDelivery(*entity.WatchlistDelivery) RepositoryException
+	GetAllDeliveries(int64, WatchlistDeliveryStatus, entity.PaginatorInput) ([]*entity.WatchlistDelivery, entity.PaginatorOutput, RepositoryException)
+}
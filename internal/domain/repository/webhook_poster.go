@@ -0,0 +1,12 @@
+package repository
+
+import (
+	. "project/internal/domain/exception"
+)
+
+// WebhookPoster makes a single attempt to post an event to a subscription URL,
+// signing the payload with the subscription secret. Retries are up to the caller.
+// The status code is 0 when the URL could not be reached.
+type WebhookPoster interface {
+	Post(url string, secret string, eventID int64, payload []byte) (statusCode int64, err RepositoryException)
+}
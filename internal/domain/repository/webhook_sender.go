@@ -0,0 +1,12 @@
+package repository
+
+import (
+	. "project/internal/domain/exception"
+)
+
+// WebhookSender posts JSON payloads to the configured webhook, retrying with backoff.
+// It reports the attempts made and the status code of the last one, 0 when the
+// webhook could not be reached.
+type WebhookSender interface {
+	Send(payload []byte) (attempts int64, statusCode int64, err RepositoryException)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"project/internal/domain/entity"
+	. "project/internal/domain/exception"
+	. "project/internal/domain/types"
+	"time"
+)
+
+type WebhookSubscription interface {
+	GetOneByPublicID(WebhookSubscriptionPublicID) (*entity.WebhookSubscription, RepositoryException)
+	GetAll() ([]*entity.WebhookSubscription, RepositoryException)
+	ExistsByURL(string) (bool, RepositoryException)
+	CreateOne(*entity.WebhookSubscription) RepositoryException
+	DeleteOne(*entity.WebhookSubscription) RepositoryException
+	// ClaimDueDeliveries makes the deliveries due at the given time sending and returns
+	// them with their events. No other dispatcher gets them before the lock time.
+	ClaimDueDeliveries(now time.Time, lockedUntil time.Time, limit int64) ([]*entity.WebhookDelivery, RepositoryException)
+	UpdateOneDelivery(*entity.WebhookDelivery) RepositoryException
+	GetAllDeliveries(int64, WebhookDeliveryStatus, entity.PaginatorInput) ([]*entity.WebhookDelivery, entity.PaginatorOutput, RepositoryException)
+	// ReplayDeliveries makes the deliveries of the events to the subscription pending
+	// again, creating the ones that never existed.
+	ReplayDeliveries(*entity.WebhookSubscription, []*entity.OutboxEvent) RepositoryException
+	// ReplayDeadDeliveries makes the dead deliveries pending again, it returns how many.
+	ReplayDeadDeliveries(*entity.WebhookSubscription) (int64, RepositoryException)
+}
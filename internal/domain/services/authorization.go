@@ -0,0 +1,13 @@
+package services
+
+import (
+	"project/internal/domain/constants"
+	. "project/internal/domain/types"
+	"slices"
+)
+
+// RoleHasPermission tells if role may do what permission covers, an empty or
+// unknown role may do nothing.
+func RoleHasPermission(role Role, permission Permission) bool {
+	return slices.Contains(constants.RolePermissions[role], permission)
+}
@@ -2,7 +2,9 @@ package services
 
 import (
 	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"math"
 	"strings"
 )
 
@@ -45,6 +47,20 @@ func newNanoID(size int) (string, error) {
 	return string(id[:size]), nil
 }
 
+// GenerateSecret returns a random hex secret twice as long as size, used to sign the
+// webhook payloads.
+func GenerateSecret(size int) (string, error) {
+	bytes := make([]byte, size)
+
+	_, err := rand.Read(bytes)
+
+	if err != nil {
+		return "", fmt.Errorf("error generating secret: %w", err)
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
+
 func FormatCentsToBRL(cents int64) string {
 	var brl int64
 	if cents < 0 {
@@ -73,3 +89,18 @@ func FormatCentsToBRL(cents int64) string {
 
 	return fmt.Sprintf("R$ %s,%02d", string(bytes), cents%100)
 }
+
+// ConvertAmountToCents turns a decimal amount (e.g. 109.95) into cents, rounding
+// to the nearest cent to absorb floating point noise.
+func ConvertAmountToCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// ConvertStarsToRating turns a 0-5 star rate (e.g. 3.9) into the 0-50 rating scale.
+func ConvertStarsToRating(stars float64) (int8, error) {
+	if math.IsNaN(stars) || stars < 0 || stars > 5 {
+		return 0, fmt.Errorf("rate must be between 0 and 5, got %v", stars)
+	}
+
+	return int8(math.Round(stars * 10)), nil
+}
@@ -0,0 +1,5 @@
+package types
+
+type Actor string
+type AuditEntityType string
+type AuditOperation string
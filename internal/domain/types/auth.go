@@ -0,0 +1,6 @@
+package types
+
+type ApiKeyPublicID string
+type AuthMethod string
+type Role string
+type Permission string
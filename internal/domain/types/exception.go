@@ -9,3 +9,5 @@ type Stack string
 type StackIndentSpaces int
 
 type ExceptionErr string
+
+type RequestID string
@@ -0,0 +1,6 @@
+package types
+
+type OutboxEventType string
+type OutboxAggregateType string
+type WebhookSubscriptionPublicID string
+type WebhookDeliveryStatus string
@@ -0,0 +1,4 @@
+package types
+
+type ProductOfferPublicID string
+type ProductOfferAvailability string
@@ -0,0 +1,4 @@
+package types
+
+type ProductReviewPublicID string
+type ProductReviewStatus string
@@ -0,0 +1,4 @@
+package types
+
+type RetailerID int64
+type RetailerPublicID string
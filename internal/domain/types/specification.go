@@ -3,3 +3,4 @@ package types
 type SpecificationPublicID string
 type SpecificationType string
 type SpecificationID int64
+type SpecificationRequirement string
@@ -0,0 +1,5 @@
+package types
+
+type WatchlistPublicID string
+type WatchlistTrigger string
+type WatchlistDeliveryStatus string
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"fmt"
+	"project/internal/domain/repository"
+	"project/internal/infra/config/environment"
+)
+
+type Auth struct {
+	// Verifier is nil when no JWT key is configured, only API keys are accepted.
+	Verifier repository.TokenVerifier
+}
+
+func NewAuthInstance(config *environment.Auth) *Auth {
+	auth := &Auth{}
+
+	if len(config.JWTSecret) == 0 && len(config.JWTPublicKey) == 0 {
+		return auth
+	}
+
+	verifier := &JWTVerifier{
+		Secret:   config.JWTSecret,
+		Issuer:   config.JWTIssuer,
+		Audience: config.JWTAudience,
+		Leeway:   config.JWTLeeway,
+	}
+
+	if len(config.JWTPublicKey) > 0 {
+		publicKey, err := ParseRSAPublicKey(config.JWTPublicKey)
+
+		if err != nil {
+			panic(fmt.Errorf("can't read the RS256 public key. error: %v", err))
+		}
+
+		verifier.PublicKey = publicKey
+	}
+
+	auth.Verifier = verifier
+
+	return auth
+}
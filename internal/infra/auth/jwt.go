@@ -0,0 +1,237 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/types"
+	"strings"
+	"time"
+)
+
+const (
+	algHS256 = "HS256"
+	algRS256 = "RS256"
+)
+
+// JWTVerifier verifies the tokens signed with the keys configured on this server, no
+// key is ever fetched. The alg of the header must be one a key is configured for,
+// so a token signed with "none" or with the public key as an HMAC secret is rejected.
+type JWTVerifier struct {
+	Secret    []byte
+	PublicKey *rsa.PublicKey
+	Issuer    string
+	Audience  string
+	Leeway    time.Duration
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+type jwtClaims struct {
+	Subject   string       `json:"sub"`
+	Role      string       `json:"role"`
+	Issuer    string       `json:"iss"`
+	Audience  jwtAudience  `json:"aud"`
+	ExpiresAt *json.Number `json:"exp"`
+	NotBefore *json.Number `json:"nbf"`
+}
+
+// jwtAudience is either a string or an array of strings.
+type jwtAudience []string
+
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+		return nil
+	}
+
+	var many []string
+
+	if err := json.Unmarshal(data, &many); err != nil {
+		return errors.New("aud must be a string or an array of strings")
+	}
+
+	*a = many
+
+	return nil
+}
+
+// ParseRSAPublicKey reads a PEM "PUBLIC KEY" (PKIX) or "RSA PUBLIC KEY" (PKCS #1).
+func ParseRSAPublicKey(content []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(content)
+
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey, ok := key.(*rsa.PublicKey)
+
+		if !ok {
+			return nil, errors.New("public key is not an RSA key")
+		}
+
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+func (v *JWTVerifier) Verify(token string, now time.Time) (*entity.Principal, exceptions.RepositoryException) {
+	claims, err := v.verify(token, now)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryInvalidTokenError,
+		})
+	}
+
+	role := types.Role(claims.Role)
+
+	if role == "" {
+		role = constants.JWTDefaultRole
+	}
+
+	principal, entityErr := entity.NewPrincipal(entity.PrincipalProps{
+		Subject: types.Actor(claims.Subject),
+		Method:  constants.AuthMethodJWT,
+		Role:    role,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryInvalidTokenError,
+		})
+	}
+
+	return principal, nil
+}
+
+func (v *JWTVerifier) verify(token string, now time.Time) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+
+	if len(parts) != 3 {
+		return nil, errors.New("token is not a JWT")
+	}
+
+	var header jwtHeader
+
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid header: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+
+	if err != nil {
+		return nil, errors.New("invalid signature encoding")
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+
+	switch {
+	case header.Alg == algHS256 && len(v.Secret) > 0:
+		mac := hmac.New(sha256.New, v.Secret)
+		mac.Write(signed)
+
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return nil, errors.New("invalid signature")
+		}
+	case header.Alg == algRS256 && v.PublicKey != nil:
+		digest := sha256.Sum256(signed)
+
+		if err := rsa.VerifyPKCS1v15(v.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+			return nil, errors.New("invalid signature")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported alg %q", header.Alg)
+	}
+
+	var claims jwtClaims
+
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("invalid claims: %w", err)
+	}
+
+	if claims.ExpiresAt == nil {
+		return nil, errors.New("token has no exp")
+	}
+
+	expiresAt, err := claims.ExpiresAt.Int64()
+
+	if err != nil {
+		return nil, errors.New("exp must be a unix time")
+	}
+
+	if now.After(time.Unix(expiresAt, 0).Add(v.Leeway)) {
+		return nil, errors.New("token is expired")
+	}
+
+	if claims.NotBefore != nil {
+		notBefore, err := claims.NotBefore.Int64()
+
+		if err != nil {
+			return nil, errors.New("nbf must be a unix time")
+		}
+
+		if now.Add(v.Leeway).Before(time.Unix(notBefore, 0)) {
+			return nil, errors.New("token is not valid yet")
+		}
+	}
+
+	if v.Issuer != "" && claims.Issuer != v.Issuer {
+		return nil, fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+
+	if v.Audience != "" && !containsAudience(claims.Audience, v.Audience) {
+		return nil, errors.New("token is not meant for this audience")
+	}
+
+	return &claims, nil
+}
+
+func decodeSegment(segment string, target any) error {
+	content, err := base64.RawURLEncoding.DecodeString(segment)
+
+	if err != nil {
+		return errors.New("segment is not base64url")
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(content)))
+	decoder.UseNumber()
+
+	return decoder.Decode(target)
+}
+
+func containsAudience(audience jwtAudience, expected string) bool {
+	for _, candidate := range audience {
+		if candidate == expected {
+			return true
+		}
+	}
+
+	return false
+}
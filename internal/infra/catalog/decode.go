@@ -0,0 +1,193 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"project/internal/application/dto"
+	"slices"
+	"strings"
+
+	json "github.com/goccy/go-json"
+)
+
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatJSON   Format = "json"
+	FormatNDJSON Format = "ndjson"
+)
+
+// productColumns are the CSV columns and JSON keys read as product fields; any other
+// CSV column is a specification, keyed by its public ID or title. public_id and
+// category_public_id are written by the export and ignored on import.
+var productColumns = []string{"public_id", "name", "description", "price", "rating", "image_url", "category", "category_public_id"}
+
+// ParseFormat validates a format name, falling back to the file extension when empty.
+func ParseFormat(format string, filename string) (Format, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), ".")
+	}
+
+	switch Format(strings.ToLower(format)) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatNDJSON, "jsonl":
+		return FormatNDJSON, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q, use csv, json or ndjson", format)
+	}
+}
+
+// Decode reads every product row of the file. Rows are numbered from 1 in file order,
+// which is also what import offsets count.
+func Decode(format Format, reader io.Reader) ([]*dto.ImportProductRow, error) {
+	switch format {
+	case FormatCSV:
+		return decodeCSV(reader)
+	case FormatJSON:
+		return decodeJSON(reader)
+	case FormatNDJSON:
+		return decodeNDJSON(reader)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func decodeCSV(reader io.Reader) ([]*dto.ImportProductRow, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+
+	if err == io.EOF {
+		return []*dto.ImportProductRow{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv header: %w", err)
+	}
+
+	for i, column := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+	}
+
+	rows := []*dto.ImportProductRow{}
+
+	for {
+		record, err := csvReader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+
+		fields := make(map[string]any, len(record))
+
+		for i, value := range record {
+			fields[header[i]] = value
+		}
+
+		rows = append(rows, newRow(len(rows)+1, fields, nil))
+	}
+
+	return rows, nil
+}
+
+func decodeJSON(reader io.Reader) ([]*dto.ImportProductRow, error) {
+	items := []map[string]any{}
+
+	if err := json.NewDecoder(reader).Decode(&items); err != nil {
+		return nil, fmt.Errorf("invalid json, expected an array of products: %w", err)
+	}
+
+	rows := make([]*dto.ImportProductRow, 0, len(items))
+
+	for _, item := range items {
+		rows = append(rows, newJSONRow(len(rows)+1, item))
+	}
+
+	return rows, nil
+}
+
+func decodeNDJSON(reader io.Reader) ([]*dto.ImportProductRow, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	rows := []*dto.ImportProductRow{}
+	line := 0
+
+	for scanner.Scan() {
+		line++
+		content := bytes.TrimSpace(scanner.Bytes())
+
+		if len(content) == 0 {
+			continue
+		}
+
+		item := map[string]any{}
+
+		if err := json.Unmarshal(content, &item); err != nil {
+			return nil, fmt.Errorf("invalid ndjson on line %d: %w", line, err)
+		}
+
+		rows = append(rows, newJSONRow(len(rows)+1, item))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("invalid ndjson: %w", err)
+	}
+
+	return rows, nil
+}
+
+func newJSONRow(number int, item map[string]any) *dto.ImportProductRow {
+	specifications, ok := item["specifications"].(map[string]any)
+
+	if !ok {
+		specifications = map[string]any{}
+	}
+
+	return newRow(number, item, specifications)
+}
+
+// newRow splits the decoded fields into product fields and specifications. JSON rows
+// carry their specifications in a nested object, CSV rows as extra columns.
+func newRow(number int, fields map[string]any, specifications map[string]any) *dto.ImportProductRow {
+	text := func(key string) string {
+		value, _ := fields[key].(string)
+		return strings.TrimSpace(value)
+	}
+
+	row := &dto.ImportProductRow{
+		Row:            number,
+		Name:           text("name"),
+		Description:    text("description"),
+		Price:          fields["price"],
+		Rating:         fields["rating"],
+		ImageURL:       text("image_url"),
+		Category:       text("category"),
+		Specifications: specifications,
+	}
+
+	if row.Specifications == nil {
+		row.Specifications = map[string]any{}
+
+		for key, value := range fields {
+			if !slices.Contains(productColumns, key) {
+				row.Specifications[key] = value
+			}
+		}
+	}
+
+	return row
+}
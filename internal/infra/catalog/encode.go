@@ -0,0 +1,143 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"project/internal/application/dto"
+	"strconv"
+
+	json "github.com/goccy/go-json"
+)
+
+// Encoder writes exported products one at a time; Close must be called once every
+// product was written to finish the document.
+type Encoder interface {
+	Encode(*dto.ExportProductUnit) error
+	Close() error
+}
+
+// ContentType is the MIME type served for each export format.
+func ContentType(format Format) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	default:
+		return "application/json"
+	}
+}
+
+// NewEncoder starts a document in the given format. CSV gets one column per
+// specification, JSON and NDJSON carry only the filled values in "specifications".
+func NewEncoder(format Format, writer io.Writer, columns []*dto.ExportSpecificationColumn) (Encoder, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVEncoder(writer, columns)
+	case FormatJSON:
+		_, err := io.WriteString(writer, "[")
+		return &jsonEncoder{writer: writer, array: true}, err
+	case FormatNDJSON:
+		return &jsonEncoder{writer: writer}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+type csvEncoder struct {
+	writer  *csv.Writer
+	columns []*dto.ExportSpecificationColumn
+	record  []string
+}
+
+func newCSVEncoder(writer io.Writer, columns []*dto.ExportSpecificationColumn) (*csvEncoder, error) {
+	header := []string{"public_id", "name", "description", "price", "rating", "image_url", "category", "category_public_id"}
+
+	for _, column := range columns {
+		header = append(header, column.Key)
+	}
+
+	encoder := &csvEncoder{
+		writer:  csv.NewWriter(writer),
+		columns: columns,
+		record:  make([]string, len(header)),
+	}
+
+	return encoder, encoder.writer.Write(header)
+}
+
+func (e *csvEncoder) Encode(product *dto.ExportProductUnit) error {
+	e.record[0] = string(product.PublicID)
+	e.record[1] = string(product.Name)
+	e.record[2] = product.Description
+	e.record[3] = strconv.FormatInt(product.Price, 10)
+	e.record[4] = strconv.Itoa(int(product.Rating))
+	e.record[5] = product.ImageURL
+	e.record[6] = product.Category
+	e.record[7] = string(product.CategoryPublicID)
+
+	for i, column := range e.columns {
+		e.record[8+i] = formatValue(product.Specifications[column.Key])
+	}
+
+	return e.writer.Write(e.record)
+}
+
+func (e *csvEncoder) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+type jsonEncoder struct {
+	writer  io.Writer
+	array   bool
+	written int
+}
+
+func (e *jsonEncoder) Encode(product *dto.ExportProductUnit) error {
+	content, err := json.Marshal(product)
+
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !e.array:
+		content = append(content, '\n')
+	case e.written > 0:
+		content = append([]byte(",\n"), content...)
+	default:
+		content = append([]byte("\n"), content...)
+	}
+
+	e.written++
+	_, err = e.writer.Write(content)
+
+	return err
+}
+
+func (e *jsonEncoder) Close() error {
+	if !e.array {
+		return nil
+	}
+
+	_, err := io.WriteString(e.writer, "\n]\n")
+
+	return err
+}
+
+func formatValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case int64:
+		return strconv.FormatInt(typed, 10)
+	case bool:
+		return strconv.FormatBool(typed)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
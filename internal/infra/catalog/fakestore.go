@@ -0,0 +1,40 @@
+package catalog
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"project/internal/application/dto"
+
+	json "github.com/goccy/go-json"
+)
+
+// DecodeFakeStore reads a file in the fakestoreapi.com format: either the array
+// returned by /products or a single product as returned by /products/:id.
+func DecodeFakeStore(reader io.Reader) ([]*dto.FakeStoreProduct, error) {
+	content, err := io.ReadAll(reader)
+
+	if err != nil {
+		return nil, fmt.Errorf("can't read fake store file: %w", err)
+	}
+
+	content = bytes.TrimSpace(content)
+
+	if len(content) > 0 && content[0] == '{' {
+		product := &dto.FakeStoreProduct{}
+
+		if err := json.Unmarshal(content, product); err != nil {
+			return nil, fmt.Errorf("invalid fake store product: %w", err)
+		}
+
+		return []*dto.FakeStoreProduct{product}, nil
+	}
+
+	products := []*dto.FakeStoreProduct{}
+
+	if err := json.Unmarshal(content, &products); err != nil {
+		return nil, fmt.Errorf("invalid json, expected an array of fake store products: %w", err)
+	}
+
+	return products, nil
+}
@@ -9,8 +9,14 @@ import (
 )
 
 type BaseConfig struct {
-	Fiber  *environment.Fiber
-	Sqlite *environment.Sqlite
+	Logger       *environment.Logger
+	Fiber        *environment.Fiber
+	CacheControl *environment.CacheControl
+	Sqlite       *environment.Sqlite
+	Storage      *environment.Storage
+	Webhook      *environment.Webhook
+	Auth         *environment.Auth
+	RateLimit    *environment.RateLimit
 }
 
 func NewBaseConfig(envFilePath string) *BaseConfig {
@@ -25,7 +31,13 @@ func NewBaseConfig(envFilePath string) *BaseConfig {
 	}
 
 	return &BaseConfig{
-		Fiber:  environment.NewFiberConfig(),
-		Sqlite: environment.NewSqliteConfig(),
+		Logger:       environment.NewLoggerConfig(),
+		Fiber:        environment.NewFiberConfig(),
+		CacheControl: environment.NewCacheControlConfig(),
+		Sqlite:       environment.NewSqliteConfig(),
+		Storage:      environment.NewStorageConfig(),
+		Webhook:      environment.NewWebhookConfig(),
+		Auth:         environment.NewAuthConfig(),
+		RateLimit:    environment.NewRateLimitConfig(),
 	}
 }
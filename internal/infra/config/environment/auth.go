@@ -0,0 +1,49 @@
+package environment
+
+import (
+	"fmt"
+	"os"
+	"project/internal/infra/config/services"
+	"time"
+)
+
+type Auth struct {
+	// JWTSecret verifies the HS256 tokens, empty rejects them.
+	JWTSecret []byte
+	// JWTPublicKey is the PEM of the key that verifies the RS256 tokens, empty
+	// rejects them.
+	JWTPublicKey []byte
+	// JWTIssuer and JWTAudience are checked against the iss and aud claims when set.
+	JWTIssuer   string
+	JWTAudience string
+	// JWTLeeway is the clock skew allowed on exp and nbf.
+	JWTLeeway time.Duration
+}
+
+func NewAuthConfig() *Auth {
+	secret := services.GetEnvironmentVariableWithDefault("AUTH_JWT_HS256_SECRET", "")
+
+	if secret != "" && len(secret) < 32 {
+		panic("Invalid value for 'AUTH_JWT_HS256_SECRET' env, value: <hidden> (at least 32 characters)")
+	}
+
+	var publicKey []byte
+
+	if publicKeyFile := services.GetEnvironmentVariableWithDefault("AUTH_JWT_RS256_PUBLIC_KEY_FILE", ""); publicKeyFile != "" {
+		content, err := os.ReadFile(publicKeyFile)
+
+		if err != nil {
+			panic(fmt.Sprintf("Invalid value for 'AUTH_JWT_RS256_PUBLIC_KEY_FILE' env, value: %s (%v)", publicKeyFile, err))
+		}
+
+		publicKey = content
+	}
+
+	return &Auth{
+		JWTSecret:    []byte(secret),
+		JWTPublicKey: publicKey,
+		JWTIssuer:    services.GetEnvironmentVariableWithDefault("AUTH_JWT_ISSUER", ""),
+		JWTAudience:  services.GetEnvironmentVariableWithDefault("AUTH_JWT_AUDIENCE", ""),
+		JWTLeeway:    durationFromEnv("AUTH_JWT_LEEWAY", "30s"),
+	}
+}
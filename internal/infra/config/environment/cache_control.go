@@ -0,0 +1,24 @@
+package environment
+
+import (
+	"project/internal/infra/config/services"
+)
+
+// CacheControl holds the Cache-Control of the reads that answer conditional requests,
+// the clients and proxies revalidate them with the ETag once they go stale.
+type CacheControl struct {
+	// Catalog is sent on the category and specification group listings.
+	Catalog string
+	// Product is sent on a product with its specifications.
+	Product string
+	// Compare is sent on a comparison, a POST shared caches do not keep.
+	Compare string
+}
+
+func NewCacheControlConfig() *CacheControl {
+	return &CacheControl{
+		Catalog: services.GetEnvironmentVariableWithDefault("CACHE_CONTROL_CATALOG", "public, max-age=60"),
+		Product: services.GetEnvironmentVariableWithDefault("CACHE_CONTROL_PRODUCT", "public, max-age=30"),
+		Compare: services.GetEnvironmentVariableWithDefault("CACHE_CONTROL_COMPARE", "private, no-cache"),
+	}
+}
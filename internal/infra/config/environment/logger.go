@@ -0,0 +1,42 @@
+package environment
+
+import (
+	"fmt"
+	"log/slog"
+	"project/internal/infra/config/services"
+)
+
+// Log formats, JSON for the log collectors and text to read on a terminal.
+const (
+	LogFormatJSON = "json"
+	LogFormatText = "text"
+)
+
+type Logger struct {
+	// Level is the lowest level written, debug, info, warn or error.
+	Level  slog.Level
+	Format string
+}
+
+func NewLoggerConfig() *Logger {
+	levelEnv := services.GetEnvironmentVariableWithDefault("LOG_LEVEL", "info")
+
+	var level slog.Level
+
+	if err := level.UnmarshalText([]byte(levelEnv)); err != nil {
+		panic(fmt.Sprintf("Invalid value for 'LOG_LEVEL' env, value: %s (debug, info, warn or error)", levelEnv))
+	}
+
+	format := services.GetEnvironmentVariableWithDefault("LOG_FORMAT", LogFormatJSON)
+
+	switch format {
+	case LogFormatJSON, LogFormatText:
+	default:
+		panic(fmt.Sprintf("Invalid value for 'LOG_FORMAT' env, value: %s (json or text)", format))
+	}
+
+	return &Logger{
+		Level:  level,
+		Format: format,
+	}
+}
@@ -0,0 +1,61 @@
+package environment
+
+import (
+	"fmt"
+	"project/internal/infra/config/services"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimitPolicy lets a client make Limit requests at once, given back over Window.
+// A zero Limit disables the policy.
+type RateLimitPolicy struct {
+	Limit  int64
+	Window time.Duration
+}
+
+type RateLimit struct {
+	// Read applies to the safe methods, Write to the requests that change the
+	// catalog and Compare to the product comparison, each client has a bucket of
+	// each one. Auth applies to the credentials sent from each IP address, valid or
+	// not.
+	Read    RateLimitPolicy
+	Write   RateLimitPolicy
+	Compare RateLimitPolicy
+	Auth    RateLimitPolicy
+	// PruneInterval is how often the refilled buckets are removed, 0 disables the
+	// prune.
+	PruneInterval time.Duration
+}
+
+func NewRateLimitConfig() *RateLimit {
+	return &RateLimit{
+		Read:          rateLimitPolicyFromEnv("RATE_LIMIT_READ", "300/1m"),
+		Write:         rateLimitPolicyFromEnv("RATE_LIMIT_WRITE", "60/1m"),
+		Compare:       rateLimitPolicyFromEnv("RATE_LIMIT_COMPARE", "20/1m"),
+		Auth:          rateLimitPolicyFromEnv("RATE_LIMIT_AUTH", "600/1m"),
+		PruneInterval: durationFromEnv("RATE_LIMIT_PRUNE_INTERVAL", "10m"),
+	}
+}
+
+// rateLimitPolicyFromEnv reads a policy written as limit/window, like 60/1m, or 0.
+func rateLimitPolicyFromEnv(environmentVariable string, defaultValue string) RateLimitPolicy {
+	value := services.GetEnvironmentVariableWithDefault(environmentVariable, defaultValue)
+
+	if value == "0" {
+		return RateLimitPolicy{}
+	}
+
+	limitValue, windowValue, found := strings.Cut(value, "/")
+
+	limit, limitErr := strconv.ParseInt(limitValue, 10, 64)
+
+	window, windowErr := time.ParseDuration(windowValue)
+
+	if !found || limitErr != nil || windowErr != nil || limit < 1 || window < time.Second {
+		panic(fmt.Sprintf("Invalid value for '%s' env, value: %s (limit/window like 60/1m, or 0)", environmentVariable, value))
+	}
+
+	return RateLimitPolicy{Limit: limit, Window: window}
+}
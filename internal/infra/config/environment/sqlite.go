@@ -2,14 +2,30 @@ package environment
 
 import (
 	"fmt"
+	"project/internal/domain/constants"
 	"project/internal/infra/config/services"
 	"strconv"
+	"time"
+)
+
+// Migration policies, what to do on boot when the database is behind the
+// migrations embedded in the binary.
+const (
+	MigrationPolicyAuto = "auto"
+	MigrationPolicyFail = "fail"
+	MigrationPolicyWarn = "warn"
 )
 
 type Sqlite struct {
-	Path        string
-	BusyTimeout int64
-	Dsn         string
+	Path            string
+	BusyTimeout     int64
+	Dsn             string
+	MigrationPolicy string
+	// TrashRetention is how long a deleted entity stays restorable before a purge
+	// removes it for good.
+	TrashRetention time.Duration
+	// TrashPurgeInterval is how often the trash is purged, 0 disables the purge.
+	TrashPurgeInterval time.Duration
 }
 
 func NewSqliteConfig() *Sqlite {
@@ -23,9 +39,20 @@ func NewSqliteConfig() *Sqlite {
 
 	path := services.GetEnvironmentVariable("SQLITE_PATH", false)
 
+	migrationPolicy := services.GetEnvironmentVariableWithDefault("SQLITE_MIGRATION_POLICY", MigrationPolicyAuto)
+
+	switch migrationPolicy {
+	case MigrationPolicyAuto, MigrationPolicyFail, MigrationPolicyWarn:
+	default:
+		panic(fmt.Sprintf("Invalid value for 'SQLITE_MIGRATION_POLICY' env, value: %s (auto, fail or warn)", migrationPolicy))
+	}
+
 	return &Sqlite{
-		BusyTimeout: timeoutInt,
-		Path:        path,
+		BusyTimeout:        timeoutInt,
+		Path:               path,
+		MigrationPolicy:    migrationPolicy,
+		TrashRetention:     durationFromEnv("TRASH_RETENTION", constants.TrashDefaultRetention.String()),
+		TrashPurgeInterval: durationFromEnv("TRASH_PURGE_INTERVAL", "1h"),
 		Dsn: fmt.Sprintf(
 			"file:%s?_busy_timeout=%d&_fk=1", path, timeoutInt,
 		),
@@ -0,0 +1,39 @@
+package environment
+
+import (
+	"fmt"
+	"project/internal/infra/config/services"
+	"strings"
+)
+
+// Storage drivers, where the uploaded images are kept.
+const (
+	StorageDriverLocal = "local"
+)
+
+type Storage struct {
+	Driver string
+	// LocalPath is the directory of the local driver, served by the API under PublicPath.
+	LocalPath  string
+	PublicPath string
+}
+
+func NewStorageConfig() *Storage {
+	driver := services.GetEnvironmentVariableWithDefault("STORAGE_DRIVER", StorageDriverLocal)
+
+	if driver != StorageDriverLocal {
+		panic(fmt.Sprintf("Invalid value for 'STORAGE_DRIVER' env, value: %s (local)", driver))
+	}
+
+	publicPath := services.GetEnvironmentVariableWithDefault("STORAGE_PUBLIC_PATH", "/uploads")
+
+	if !strings.HasPrefix(publicPath, "/") {
+		panic(fmt.Sprintf("Invalid value for 'STORAGE_PUBLIC_PATH' env, value: %s (must start with /)", publicPath))
+	}
+
+	return &Storage{
+		Driver:     driver,
+		LocalPath:  services.GetEnvironmentVariableWithDefault("STORAGE_LOCAL_PATH", "./uploads"),
+		PublicPath: strings.TrimSuffix(publicPath, "/"),
+	}
+}
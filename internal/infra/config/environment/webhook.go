@@ -0,0 +1,82 @@
+package environment
+
+import (
+	"fmt"
+	"net/url"
+	"project/internal/infra/config/services"
+	"strconv"
+	"time"
+)
+
+type Webhook struct {
+	// URL receives the price drop alerts, empty disables them.
+	URL         string
+	Timeout     time.Duration
+	MaxAttempts int
+	// Backoff is the wait before the first retry, doubled on each one.
+	Backoff time.Duration
+	// SweepInterval is how often every watchlist is checked, 0 disables the sweep.
+	SweepInterval time.Duration
+	// CheckInterval is how often the watchlists of the products whose price was
+	// updated are checked, 0 disables the check and leaves them to the sweep.
+	CheckInterval time.Duration
+	// OutboxDispatchInterval is how often the outbox events are sent to the webhook
+	// subscriptions, 0 disables the dispatcher.
+	OutboxDispatchInterval time.Duration
+	// OutboxMaxAttempts is how many times a delivery is tried before it is
+	// dead-lettered, OutboxBackoff the wait before the second, doubled on each one.
+	OutboxMaxAttempts int
+	OutboxBackoff     time.Duration
+}
+
+func NewWebhookConfig() *Webhook {
+	webhookURL := services.GetEnvironmentVariableWithDefault("WEBHOOK_URL", "")
+
+	if webhookURL != "" {
+		parsed, err := url.ParseRequestURI(webhookURL)
+
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			panic(fmt.Sprintf("Invalid value for 'WEBHOOK_URL' env, value: %s (http or https URL)", webhookURL))
+		}
+	}
+
+	maxAttemptsEnv := services.GetEnvironmentVariableWithDefault("WEBHOOK_MAX_ATTEMPTS", "3")
+
+	maxAttempts, err := strconv.Atoi(maxAttemptsEnv)
+
+	if err != nil || maxAttempts < 1 {
+		panic(fmt.Sprintf("Invalid value for 'WEBHOOK_MAX_ATTEMPTS' env, value: %s", maxAttemptsEnv))
+	}
+
+	outboxMaxAttemptsEnv := services.GetEnvironmentVariableWithDefault("OUTBOX_MAX_ATTEMPTS", "8")
+
+	outboxMaxAttempts, err := strconv.Atoi(outboxMaxAttemptsEnv)
+
+	if err != nil || outboxMaxAttempts < 1 {
+		panic(fmt.Sprintf("Invalid value for 'OUTBOX_MAX_ATTEMPTS' env, value: %s", outboxMaxAttemptsEnv))
+	}
+
+	return &Webhook{
+		URL:                    webhookURL,
+		Timeout:                durationFromEnv("WEBHOOK_TIMEOUT", "5s"),
+		MaxAttempts:            maxAttempts,
+		Backoff:                durationFromEnv("WEBHOOK_BACKOFF", "500ms"),
+		SweepInterval:          durationFromEnv("WATCHLIST_SWEEP_INTERVAL", "15m"),
+		CheckInterval:          durationFromEnv("WATCHLIST_CHECK_INTERVAL", "5s"),
+		OutboxDispatchInterval: durationFromEnv("OUTBOX_DISPATCH_INTERVAL", "5s"),
+		OutboxMaxAttempts:      outboxMaxAttempts,
+		OutboxBackoff:          durationFromEnv("OUTBOX_BACKOFF", "30s"),
+	}
+}
+
+func durationFromEnv(environmentVariable string, defaultValue string) time.Duration {
+	value := services.GetEnvironmentVariableWithDefault(environmentVariable, defaultValue)
+
+	duration, err := time.ParseDuration(value)
+
+	if err != nil || duration < 0 {
+		panic(fmt.Sprintf("Invalid value for '%s' env, value: %s (duration like 500ms or 15m)", environmentVariable, value))
+	}
+
+	return duration
+}
@@ -1,31 +1,77 @@
 package config
 
 import (
+	"project/internal/infra/auth"
 	"project/internal/infra/fiber"
+	"project/internal/infra/logger"
+	"project/internal/infra/ratelimit"
+	"project/internal/infra/scheduler"
 	"project/internal/infra/sqlite"
+	"project/internal/infra/storage"
+	"project/internal/infra/webhook"
 )
 
 type Server struct {
-	Fiber  *fiber.Fiber
-	Sqlite *sqlite.Sqlite
+	Fiber            *fiber.Fiber
+	Sqlite           *sqlite.Sqlite
+	WatchlistSweep   *scheduler.WatchlistSweep
+	OutboxDispatcher *scheduler.OutboxDispatcher
+	TrashPurge       *scheduler.TrashPurge
+	RateLimitPrune   *scheduler.RateLimitPrune
 }
 
 func NewServerInstances(config *BaseConfig) *Server {
 	// order is relevant
 
+	logger.NewLoggerInstance(config.Logger)
+
 	sqlite := sqlite.NewSqliteInstance(config.Sqlite)
 
-	fiber := fiber.NewFiberInstance(config.Fiber, sqlite)
+	storage := storage.NewStorageInstance(config.Storage)
+
+	webhook := webhook.NewWebhookInstance(config.Webhook)
+
+	auth := auth.NewAuthInstance(config.Auth)
+
+	rateLimit := ratelimit.NewRateLimitInstance(config.RateLimit)
+
+	fiber := fiber.NewFiberInstance(config.Fiber, config.CacheControl, sqlite, storage, auth, rateLimit)
+
+	watchlistSweep := scheduler.NewWatchlistSweep(sqlite, webhook)
+
+	outboxDispatcher := scheduler.NewOutboxDispatcher(sqlite, webhook)
+
+	trashPurge := scheduler.NewTrashPurge(sqlite, storage)
+
+	rateLimitPrune := scheduler.NewRateLimitPrune(sqlite, rateLimit)
 
 	return &Server{
-		Fiber: fiber,
+		Fiber:            fiber,
+		WatchlistSweep:   watchlistSweep,
+		OutboxDispatcher: outboxDispatcher,
+		TrashPurge:       trashPurge,
+		RateLimitPrune:   rateLimitPrune,
 	}
 }
 
 func (s *Server) Start() {
+	// with prefork every child boots too, the jobs on the shared database only run
+	// in the parent so that one of each runs
+	if !s.Fiber.IsChild() {
+		s.WatchlistSweep.Start()
+		s.OutboxDispatcher.Start()
+		s.TrashPurge.Start()
+	}
+
+	// the memory buckets belong to each process, so each one prunes its own
+	s.RateLimitPrune.Start()
 	s.Fiber.Start()
 }
 
 func (s *Server) Stop() {
+	s.WatchlistSweep.Stop()
+	s.OutboxDispatcher.Stop()
+	s.TrashPurge.Stop()
+	s.RateLimitPrune.Stop()
 	s.Fiber.App.Shutdown()
 }
@@ -20,6 +20,18 @@ func GetEnvironmentVariable(environmentVariable string, omitEmpty bool) string {
 	return value
 }
 
+// GetEnvironmentVariableWithDefault is for optional settings, returning defaultValue
+// when the variable is missing or empty.
+func GetEnvironmentVariableWithDefault(environmentVariable string, defaultValue string) string {
+	value, exists := os.LookupEnv(environmentVariable)
+
+	if !exists || value == "" {
+		return defaultValue
+	}
+
+	return value
+}
+
 func GetEnvironmentVariableAsBool(environmentVariable string, omitEmpty bool) bool {
 	value := GetEnvironmentVariable(environmentVariable, omitEmpty)
 
@@ -1,15 +1,19 @@
 package fiber
 
 import (
+	"errors"
 	"fmt"
+	"project/internal/domain/constants"
+	"project/internal/infra/auth"
 	"project/internal/infra/config/environment"
 	"project/internal/infra/fiber/route"
 	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/ratelimit"
 	"project/internal/infra/sqlite"
+	"project/internal/infra/storage"
 
 	json "github.com/goccy/go-json"
 	"github.com/gofiber/fiber/v3"
-	"github.com/gofiber/fiber/v3/log"
 )
 
 type Fiber struct {
@@ -20,15 +24,26 @@ type Fiber struct {
 
 func NewFiberInstance(
 	config *environment.Fiber,
+	cacheControl *environment.CacheControl,
 	sqlite *sqlite.Sqlite,
+	storage *storage.Storage,
+	auth *auth.Auth,
+	rateLimit *ratelimit.RateLimit,
 ) *Fiber {
 	app := fiber.New(
 		fiber.Config{
 			AppName:     "Edge DNS API",
 			JSONEncoder: json.Marshal,
 			JSONDecoder: json.Unmarshal,
+			// room for a product image of constants.ProductImageMaxSize plus the
+			// rest of the multipart form
+			BodyLimit: int(constants.ProductImageMaxSize) + 1<<20,
+			// the error is logged by the response with the request ID
 			ErrorHandler: func(c fiber.Ctx, err error) error {
-				log.Error(err)
+				if errors.Is(err, fiber.ErrRequestEntityTooLarge) {
+					return response.SendRequestEntityTooLarge(c, "request body is too large", err)
+				}
+
 				if c.Response().StatusCode() == fiber.StatusNotFound {
 					return response.SendNotFound(c, "sorry, endpoint not found", err)
 				}
@@ -38,7 +53,7 @@ func NewFiberInstance(
 		},
 	)
 
-	router := route.NewRouter(app, sqlite)
+	router := route.NewRouter(app, cacheControl, sqlite, storage, auth, rateLimit)
 
 	router.Load()
 
@@ -51,6 +66,12 @@ func NewFiberInstance(
 	return &Fiber{App: app, prefork: config.Prefork, address: fmt.Sprintf("%s:%d", config.Host, config.Port)}
 }
 
+// IsChild tells if this process is a prefork child, the parent forks them and
+// only they serve requests.
+func (f *Fiber) IsChild() bool {
+	return fiber.IsChild()
+}
+
 func (f *Fiber) Start() {
 	listenErr := f.App.Listen(
 		f.address,
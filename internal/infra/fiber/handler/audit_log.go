@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type AuditLog struct {
+	GetAllAuditEntriesUsecase *usecase.GetAllAuditEntries
+}
+
+func NewAuditLog(sqlite *sqlite.Sqlite) *AuditLog {
+	auditLogRepository := repository.NewAuditLogSqlite(sqlite.DB)
+
+	return &AuditLog{
+		GetAllAuditEntriesUsecase: usecase.NewGetAllAuditEntries(auditLogRepository),
+	}
+}
+
+// GetAllAuditEntriesHandler func to query the audit log.
+// @Description Lists the audit entries of every catalog entity, newest first. Every filter is optional.
+// @Summary gets all audit entries
+// @Tags AuditLog
+// @Accept json
+// @Produce json
+// @Param actor query string false "Actor"
+// @Param entity_type query string false "product, category, specification, specification_group or retailer"
+// @Param entity_public_id query string false "Public ID of the entity"
+// @Param operation query string false "create, update, delete, restore or purge"
+// @Param from query string false "Entries created at or after, RFC 3339 or YYYY-MM-DD"
+// @Param to query string false "Entries created at or before, RFC 3339 or YYYY-MM-DD"
+// @Param skip query int true "Entries to skip"
+// @Param limit query int true "Maximum number of entries"
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllAuditEntriesOutput}
+// @Failure 500,422,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /audit-log [get]
+func (a *AuditLog) GetAllAuditEntriesHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetAllAuditEntriesInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := a.GetAllAuditEntriesUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
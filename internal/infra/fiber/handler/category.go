@@ -1,8 +1,10 @@
 package handler
 
 import (
-	_ "project/internal/application/dto"
+	"project/internal/application/dto"
 	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/etag"
 	"project/internal/infra/fiber/utils/response"
 	"project/internal/infra/sqlite"
 	"project/internal/infra/sqlite/repository"
@@ -11,28 +13,130 @@ import (
 )
 
 type Category struct {
-	GetAllCategoriesUsecase *usecase.GetAllCategories
+	CreateOneCategoryUsecase                    *usecase.CreateOneCategory
+	DeleteOneCategoryUsecase                    *usecase.DeleteOneCategory
+	GetAllCategoriesUsecase                     *usecase.GetAllCategories
+	GetCategoriesRevisionUsecase                *usecase.GetCategoriesRevision
+	GetCategorySpecificationTemplateUsecase     *usecase.GetCategorySpecificationTemplate
+	ReplaceCategorySpecificationTemplateUsecase *usecase.ReplaceCategorySpecificationTemplate
+	RestoreOneCategoryUsecase                   *usecase.RestoreOneCategory
+	UpdateOneCategoryUsecase                    *usecase.UpdateOneCategory
 }
 
 func NewCategory(sqlite *sqlite.Sqlite) *Category {
 	categoryRepository := repository.NewCategorySqlite(sqlite.DB)
+	specificationRepository := repository.NewSpecificationqlite(sqlite.DB)
+	categorySpecificationRepository := repository.NewCategorySpecificationSqlite(sqlite.DB)
+	auditLogRepository := repository.NewAuditLogSqlite(sqlite.DB)
 
 	return &Category{
-		GetAllCategoriesUsecase: usecase.NewGetAllCategories(categoryRepository),
+		CreateOneCategoryUsecase:     usecase.NewCreateOneCategory(categoryRepository, auditLogRepository),
+		DeleteOneCategoryUsecase:     usecase.NewDeleteOneCategory(categoryRepository, auditLogRepository),
+		GetAllCategoriesUsecase:      usecase.NewGetAllCategories(categoryRepository),
+		GetCategoriesRevisionUsecase: usecase.NewGetCategoriesRevision(categoryRepository),
+		GetCategorySpecificationTemplateUsecase: usecase.NewGetCategorySpecificationTemplate(
+			categoryRepository,
+			categorySpecificationRepository,
+		),
+		ReplaceCategorySpecificationTemplateUsecase: usecase.NewReplaceCategorySpecificationTemplate(
+			categoryRepository,
+			specificationRepository,
+			categorySpecificationRepository,
+			auditLogRepository,
+		),
+		RestoreOneCategoryUsecase: usecase.NewRestoreOneCategory(categoryRepository, auditLogRepository),
+		UpdateOneCategoryUsecase:  usecase.NewUpdateOneCategory(categoryRepository, auditLogRepository),
 	}
 
 }
 
+// CreateOneCategoryHandler func to create one category.
+// @Description Creates one category.
+// @Summary creates one category
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateOneCategoryInput true "Body"
+// @Success 201 {object} response.JSONResponse{data=dto.CreateOneCategoryOutput}
+// @Failure 500,409,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /categories [post]
+func (cat *Category) CreateOneCategoryHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.CreateOneCategoryInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := cat.CreateOneCategoryUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendCreated(c, result)
+}
+
+// DeleteOneCategoryHandler func to delete one category.
+// @Description Soft deletes one category by ID. Categories that still contain products cannot be deleted.
+// @Summary deletes one category
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.DeleteOneCategoryOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /categories/{public_id} [delete]
+func (cat *Category) DeleteOneCategoryHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.DeleteOneCategoryInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := cat.DeleteOneCategoryUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
 // GetAllCategoriesHandler func to get all categories.
-// @Description Gets all available categories.
+// @Description Gets all available categories. Send the ETag back in If-None-Match, or the Last-Modified in If-Modified-Since, to get a 304 while they did not change.
 // @Summary gets all categories
 // @Tags Category
 // @Accept json
 // @Produce json
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Param If-Modified-Since header string false "Last-Modified of a previous response"
 // @Success 200 {object} response.JSONResponse{data=dto.GetAllCategoriesOutput}
+// @Success 304 "Not Modified"
+// @Header 200,304 {string} ETag "Revision of the categories"
+// @Header 200,304 {string} Last-Modified "Last time a category changed"
 // @Failure 500 {object} response.ErrorJSONResponse "Error"
 // @Router /categories [get]
 func (cat *Category) GetAllCategoriesHandler(c fiber.Ctx) error {
+	revision, err := cat.GetCategoriesRevisionUsecase.Execute()
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	etag.SetRevision(c, revision.Tag, revision.ModifiedAt)
+
+	if etag.NotModified(c) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
 	result, err := cat.GetAllCategoriesUsecase.Execute()
 
 	if err != nil {
@@ -41,3 +145,123 @@ func (cat *Category) GetAllCategoriesHandler(c fiber.Ctx) error {
 
 	return response.SendOk(c, result)
 }
+
+// GetCategorySpecificationTemplateHandler func to get the specification template of a category.
+// @Description Gets the specifications allowed, required or recommended for products of the category, sorted by display order.
+// @Summary gets category specification template
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.GetCategorySpecificationTemplateOutput}
+// @Failure 500,404,400 {object} response.ErrorJSONResponse "Error"
+// @Router /categories/{public_id}/specifications [get]
+func (cat *Category) GetCategorySpecificationTemplateHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetCategorySpecificationTemplateInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	result, err := cat.GetCategorySpecificationTemplateUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// ReplaceCategorySpecificationTemplateHandler func to replace the specification template of a category.
+// @Description Replaces the specification template of a category. Requirement must be required, recommended or allowed.
+// @Description An empty list removes the template, so the category accepts any specification again.
+// @Summary replaces category specification template
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param request body dto.ReplaceCategorySpecificationTemplateInput true "Body"
+// @Success 200 {object} response.JSONResponse{data=dto.ReplaceCategorySpecificationTemplateOutput}
+// @Failure 422 {object} response.ErrorJSONResponse{data=dto.ReplaceCategorySpecificationTemplateOutput} "Invalid entries"
+// @Failure 500,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /categories/{public_id}/specifications [put]
+func (cat *Category) ReplaceCategorySpecificationTemplateHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.ReplaceCategorySpecificationTemplateInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := cat.ReplaceCategorySpecificationTemplateUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, result)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// RestoreOneCategoryHandler func to restore one deleted category.
+// @Description Restores one soft deleted category by ID.
+// @Summary restores one category
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.RestoreOneCategoryOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /categories/{public_id}/restore [post]
+func (cat *Category) RestoreOneCategoryHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.RestoreOneCategoryInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := cat.RestoreOneCategoryUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// UpdateOneCategoryHandler func to update one category.
+// @Description Updates one category.
+// @Summary updates one category
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param request body dto.UpdateOneCategoryInput true "Body"
+// @Success 200 {object} response.JSONResponse{data=dto.UpdateOneCategoryOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /categories/{public_id} [put]
+func (cat *Category) UpdateOneCategoryHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.UpdateOneCategoryInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := cat.UpdateOneCategoryUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
@@ -1,8 +1,14 @@
 package handler
 
 import (
+	"bufio"
+	"fmt"
+	"log/slog"
 	"project/internal/application/dto"
 	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/catalog"
+	"project/internal/infra/fiber/utils/etag"
 	"project/internal/infra/fiber/utils/response"
 	"project/internal/infra/sqlite"
 	"project/internal/infra/sqlite/repository"
@@ -13,27 +19,48 @@ import (
 type Product struct {
 	CompareProductsUsecase                           *usecase.CompareProducts
 	CreateOneProductUsecase                          *usecase.CreateOneProduct
+	CreateOneProductVariantUsecase                   *usecase.CreateOneProductVariant
 	DeleteOneProductUsecase                          *usecase.DeleteOneProduct
+	ExportProductsUsecase                            *usecase.ExportProducts
 	GetAllProductsByCategoryIdUsecase                *usecase.GetAllProductsByCategoryId
+	GetAllProductVariantsUsecase                     *usecase.GetAllProductVariants
 	GetAllProductsUsecase                            *usecase.GetAllProducts
 	GetOneProductByPublicIdUsecase                   *usecase.GetOneProductByPublicId
 	GetOneProductWithSpecificationsByPublicIdUsecase *usecase.GetOneProductWithSpecificationsByPublicId
+	GetProductHistoryUsecase                         *usecase.GetProductHistory
+	GetProductsRevisionUsecase                       *usecase.GetProductsRevision
+	ImportProductsUsecase                            *usecase.ImportProducts
+	PublishOneProductUsecase                         *usecase.PublishOneProduct
+	RestoreOneProductUsecase                         *usecase.RestoreOneProduct
 	UpdateOneProductUsecase                          *usecase.UpdateOneProduct
 }
 
 func NewProduct(sqlite *sqlite.Sqlite) *Product {
 	productRepository := repository.NewProductSqlite(sqlite.DB)
 	categoryRepository := repository.NewCategorySqlite(sqlite.DB)
+	specificationRepository := repository.NewSpecificationqlite(sqlite.DB)
+	categorySpecificationRepository := repository.NewCategorySpecificationSqlite(sqlite.DB)
+	productSpecificationValueRepository := repository.NewProductSpecificationValueSqlite(sqlite.DB)
+	productOfferRepository := repository.NewProductOfferSqlite(sqlite.DB)
+	auditLogRepository := repository.NewAuditLogSqlite(sqlite.DB)
 
 	return &Product{
-		CompareProductsUsecase:                           usecase.NewCompareProducts(productRepository),
-		CreateOneProductUsecase:                          usecase.NewCreateOneProduct(productRepository, categoryRepository),
-		DeleteOneProductUsecase:                          usecase.NewDeleteOneProduct(productRepository),
-		GetAllProductsByCategoryIdUsecase:                usecase.NewGetAllProductsByCategoryId(productRepository, categoryRepository),
-		GetAllProductsUsecase:                            usecase.NewGetAllProducts(productRepository),
-		GetOneProductByPublicIdUsecase:                   usecase.NewGetOneProductByPublicId(productRepository),
-		GetOneProductWithSpecificationsByPublicIdUsecase: usecase.NewGetOneProductWithSpecificationsByPublicId(productRepository),
-		UpdateOneProductUsecase:                          usecase.NewUpdateOneProduct(productRepository, categoryRepository),
+		CompareProductsUsecase:                           usecase.NewCompareProducts(productRepository, specificationRepository, productOfferRepository),
+		CreateOneProductUsecase:                          usecase.NewCreateOneProduct(productRepository, categoryRepository, auditLogRepository),
+		CreateOneProductVariantUsecase:                   usecase.NewCreateOneProductVariant(productRepository, auditLogRepository),
+		DeleteOneProductUsecase:                          usecase.NewDeleteOneProduct(productRepository, auditLogRepository),
+		ExportProductsUsecase:                            usecase.NewExportProducts(productRepository, categoryRepository, specificationRepository, productSpecificationValueRepository),
+		GetAllProductsByCategoryIdUsecase:                usecase.NewGetAllProductsByCategoryId(productRepository, categoryRepository, productOfferRepository),
+		GetAllProductVariantsUsecase:                     usecase.NewGetAllProductVariants(productRepository),
+		GetAllProductsUsecase:                            usecase.NewGetAllProducts(productRepository, productOfferRepository),
+		GetOneProductByPublicIdUsecase:                   usecase.NewGetOneProductByPublicId(productRepository, productOfferRepository),
+		GetOneProductWithSpecificationsByPublicIdUsecase: usecase.NewGetOneProductWithSpecificationsByPublicId(productRepository, productOfferRepository),
+		GetProductHistoryUsecase:                         usecase.NewGetProductHistory(productRepository, auditLogRepository),
+		GetProductsRevisionUsecase:                       usecase.NewGetProductsRevision(productRepository),
+		ImportProductsUsecase:                            usecase.NewImportProducts(productRepository, categoryRepository, specificationRepository, categorySpecificationRepository, auditLogRepository),
+		PublishOneProductUsecase:                         usecase.NewPublishOneProduct(productRepository, categorySpecificationRepository, auditLogRepository),
+		RestoreOneProductUsecase:                         usecase.NewRestoreOneProduct(productRepository, categoryRepository, auditLogRepository),
+		UpdateOneProductUsecase:                          usecase.NewUpdateOneProduct(productRepository, categoryRepository, auditLogRepository),
 	}
 }
 
@@ -45,7 +72,8 @@ func NewProduct(sqlite *sqlite.Sqlite) *Product {
 // @Produce json
 // @Param request body dto.CreateOneProductInput true "Body"
 // @Success 201 {object} response.JSONResponse{data=dto.CreateOneProductOutput}
-// @Failure 500,400 {object} response.ErrorJSONResponse "Error"
+// @Failure 500,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
 // @Router /products [post]
 func (p *Product) CreateOneProductHandler(c fiber.Ctx) error {
 	input, ok := c.Locals("validated-data").(*dto.CreateOneProductInput)
@@ -54,6 +82,9 @@ func (p *Product) CreateOneProductHandler(c fiber.Ctx) error {
 		return response.SendBadRequest(c, "failed to check access control name existence, try again")
 	}
 
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
 	result, err := p.CreateOneProductUsecase.Execute(input)
 
 	if err != nil {
@@ -63,15 +94,105 @@ func (p *Product) CreateOneProductHandler(c fiber.Ctx) error {
 	return response.SendCreated(c, result)
 }
 
+// CreateOneProductVariantHandler func to create a variant of a product.
+// @Description Creates a variant of a product family. The variant keeps the family category and inherits its specification values; name and description default to the family ones.
+// @Summary creates one product variant
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Family product public ID"
+// @Param request body dto.CreateOneProductVariantInput true "Body"
+// @Success 201 {object} response.JSONResponse{data=dto.CreateOneProductVariantOutput}
+// @Failure 500,422,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/variants [post]
+func (p *Product) CreateOneProductVariantHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.CreateOneProductVariantInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := p.CreateOneProductVariantUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendCreated(c, result)
+}
+
+// GetAllProductVariantsHandler func to list the variants of a product family.
+// @Description Lists the variants of a product family. Given a variant, lists the variants of its family.
+// @Summary gets all product variants
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllProductVariantsOutput}
+// @Failure 500,404,400 {object} response.ErrorJSONResponse "Error"
+// @Router /products/{public_id}/variants [get]
+func (p *Product) GetAllProductVariantsHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetAllProductVariantsInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	result, err := p.GetAllProductVariantsUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// GetProductHistoryHandler func to list the changes made to a product.
+// @Description Lists the audit entries of a product, newest first. Specification values, offers and images are recorded as changes to the product.
+// @Summary gets the history of a product
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param skip query int true "Entries to skip"
+// @Param limit query int true "Maximum number of entries"
+// @Success 200 {object} response.JSONResponse{data=dto.GetProductHistoryOutput}
+// @Failure 500,404,400 {object} response.ErrorJSONResponse "Error"
+// @Router /products/{public_id}/history [get]
+func (p *Product) GetProductHistoryHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetProductHistoryInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	result, err := p.GetProductHistoryUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
 // CompareProductsHandler func to compare two products.
-// @Description Compares two products by ID.
+// @Description Compares two products by ID. It is a read even though it is a POST: the ETag combines both sides, so send it back in If-None-Match, or the Last-Modified in If-Modified-Since, to get a 304 while neither changed.
 // @Summary compares two products
 // @Tags Product
 // @Accept json
 // @Produce json
 // @Param request body dto.CompareProductsInput true "Body"
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Param If-Modified-Since header string false "Last-Modified of a previous response"
 // @Success 200 {object} response.JSONResponse{data=dto.CompareProductsOutput}
-// @Failure 500,400 {object} response.ErrorJSONResponse "Error"
+// @Success 304 "Not Modified"
+// @Header 200,304 {string} ETag "Revision of both products"
+// @Header 200,304 {string} Last-Modified "Last time either product changed"
+// @Failure 500,429,404,400 {object} response.ErrorJSONResponse "Error"
 // @Router /products/compare [post]
 func (p *Product) CompareProductsHandler(c fiber.Ctx) error {
 	input, ok := c.Locals("validated-data").(*dto.CompareProductsInput)
@@ -80,6 +201,20 @@ func (p *Product) CompareProductsHandler(c fiber.Ctx) error {
 		return response.SendBadRequest(c, "failed to parse input data, try again")
 	}
 
+	revision, err := p.GetProductsRevisionUsecase.Execute(&dto.GetProductsRevisionInput{
+		PublicIDs: []types.ProductPublicID{input.LeftPublicID, input.RightPublicID},
+	})
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	etag.SetRevision(c, revision.Tag, revision.ModifiedAt)
+
+	if etag.NotModified(c) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
 	result, err := p.CompareProductsUsecase.Execute(input)
 
 	if err != nil {
@@ -90,14 +225,17 @@ func (p *Product) CompareProductsHandler(c fiber.Ctx) error {
 }
 
 // DeleteOneProductHandler func to delete one product.
-// @Description Deletes one product by ID.
+// @Description Deletes one product by ID. The version it was read at goes in If-Match, or in the version query param.
 // @Summary deletes one product
 // @Tags Product
 // @Accept json
 // @Produce json
 // @Param public_id path string true "Public ID"
+// @Param If-Match header string false "ETag of the product"
+// @Param version query int false "Version of the product, when If-Match is not sent"
 // @Success 200 {object} response.JSONResponse{data=dto.DeleteOneProductOutput}
-// @Failure 500,400 {object} response.ErrorJSONResponse "Error"
+// @Failure 500,428,412,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
 // @Router /products/{public_id} [delete]
 func (p *Product) DeleteOneProductHandler(c fiber.Ctx) error {
 	input, ok := c.Locals("validated-data").(*dto.DeleteOneProductInput)
@@ -106,6 +244,19 @@ func (p *Product) DeleteOneProductHandler(c fiber.Ctx) error {
 		return response.SendBadRequest(c, "failed to parse input data, try again")
 	}
 
+	if ifMatch := c.Get(fiber.HeaderIfMatch); ifMatch != "" {
+		version, ok := etag.ParseVersion(ifMatch)
+
+		if !ok {
+			return response.SendPreconditionFailed(c, "If-Match does not hold a product version")
+		}
+
+		input.Version = version
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
 	result, err := p.DeleteOneProductUsecase.Execute(input)
 
 	if err != nil {
@@ -115,6 +266,80 @@ func (p *Product) DeleteOneProductHandler(c fiber.Ctx) error {
 	return response.SendOk(c, result)
 }
 
+// RestoreOneProductHandler func to restore one deleted product.
+// @Description Restores one soft deleted product by ID, together with the variants deleted with it. Its category, and the family of a variant, must not be deleted.
+// @Summary restores one product
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.RestoreOneProductOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/restore [post]
+func (p *Product) RestoreOneProductHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.RestoreOneProductInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := p.RestoreOneProductUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// PublishOneProductHandler func to publish one product.
+// @Description Publishes one draft product by ID. It is refused while a required specification of the category template has no value,
+// @Description the missing ones are returned in data. The version it was read at goes in If-Match, or in the version query param.
+// @Summary publishes one product
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param If-Match header string false "ETag of the product"
+// @Param version query int false "Version of the product, when If-Match is not sent"
+// @Success 200 {object} response.JSONResponse{data=dto.PublishOneProductOutput}
+// @Failure 422 {object} response.ErrorJSONResponse{data=dto.PublishOneProductOutput} "Missing required specifications"
+// @Failure 500,428,412,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/publish [post]
+func (p *Product) PublishOneProductHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.PublishOneProductInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	if ifMatch := c.Get(fiber.HeaderIfMatch); ifMatch != "" {
+		version, ok := etag.ParseVersion(ifMatch)
+
+		if !ok {
+			return response.SendPreconditionFailed(c, "If-Match does not hold a product version")
+		}
+
+		input.Version = version
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := p.PublishOneProductUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, result)
+	}
+
+	return response.SendOk(c, result)
+}
+
 // GetAllProductsByCategoryIdHandler func to get products by category.
 // @Description Gets all products associated with a specific category ID.
 // @Summary gets products by category
@@ -143,7 +368,7 @@ func (p *Product) GetAllProductsByCategoryIdHandler(c fiber.Ctx) error {
 }
 
 // GetAllProductsHandler func to get all products.
-// @Description Gets all products with pagination.
+// @Description Gets all products with pagination, optionally filtered by a search term matched against name and description.
 // @Summary gets all products
 // @Tags Product
 // @Accept json
@@ -169,13 +394,14 @@ func (p *Product) GetAllProductsHandler(c fiber.Ctx) error {
 }
 
 // GetOneProductByPublicIdHandler func to get one product.
-// @Description Gets one product by its public ID.
+// @Description Gets one product by its public ID. The ETag holds its version, send it back in If-Match to update or delete it.
 // @Summary gets one product
 // @Tags Product
 // @Accept json
 // @Produce json
 // @Param public_id path string true "Public ID"
 // @Success 200 {object} response.JSONResponse{data=dto.GetOneProductByPublicIdOutput}
+// @Header 200 {string} ETag "Version of the product"
 // @Failure 500,400 {object} response.ErrorJSONResponse "Error"
 // @Router /products/{public_id} [get]
 func (p *Product) GetOneProductByPublicIdHandler(c fiber.Ctx) error {
@@ -191,18 +417,25 @@ func (p *Product) GetOneProductByPublicIdHandler(c fiber.Ctx) error {
 		return response.SendErrJson(c, err, nil)
 	}
 
+	c.Set(fiber.HeaderETag, etag.Version(result.Version))
+
 	return response.SendOk(c, result)
 }
 
 // GetOneProductWithSpecificationsByPublicIdHandler func to get one product with specs.
-// @Description Gets one product by its public ID including specifications.
+// @Description Gets one product by its public ID including specifications. Send the ETag back in If-None-Match, or the Last-Modified in If-Modified-Since, to get a 304 while it did not change.
 // @Summary gets one product with specifications
 // @Tags Product
 // @Accept json
 // @Produce json
 // @Param public_id path string true "Public ID"
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Param If-Modified-Since header string false "Last-Modified of a previous response"
 // @Success 200 {object} response.JSONResponse{data=dto.GetOneProductWithSpecificationsByPublicIdOutput}
-// @Failure 500,400 {object} response.ErrorJSONResponse "Error"
+// @Success 304 "Not Modified"
+// @Header 200,304 {string} ETag "Revision of the product with its specifications, variants and offers"
+// @Header 200,304 {string} Last-Modified "Last time any of them changed"
+// @Failure 500,404,400 {object} response.ErrorJSONResponse "Error"
 // @Router /products/{public_id}/specifications [get]
 func (p *Product) GetOneProductWithSpecificationsByPublicIdHandler(c fiber.Ctx) error {
 	input, ok := c.Locals("validated-data").(*dto.GetOneProductWithSpecificationsByPublicIdInput)
@@ -211,6 +444,20 @@ func (p *Product) GetOneProductWithSpecificationsByPublicIdHandler(c fiber.Ctx)
 		return response.SendBadRequest(c, "failed to parse input data, try again")
 	}
 
+	revision, err := p.GetProductsRevisionUsecase.Execute(&dto.GetProductsRevisionInput{
+		PublicIDs: []types.ProductPublicID{input.PublicID},
+	})
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	etag.SetRevision(c, revision.Tag, revision.ModifiedAt)
+
+	if etag.NotModified(c) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
 	result, err := p.GetOneProductWithSpecificationsByPublicIdUsecase.Execute(input)
 
 	if err != nil {
@@ -221,15 +468,18 @@ func (p *Product) GetOneProductWithSpecificationsByPublicIdHandler(c fiber.Ctx)
 }
 
 // UpdateOneProductHandler func to update one product.
-// @Description Updates one product.
+// @Description Updates one product. The version it was read at goes in If-Match, or in the version field.
 // @Summary updates one product
 // @Tags Product
 // @Accept json
 // @Produce json
 // @Param public_id path string true "Public ID"
+// @Param If-Match header string false "ETag of the product"
 // @Param request body dto.UpdateOneProductInput true "Body"
 // @Success 200 {object} response.JSONResponse{data=dto.UpdateOneProductOutput}
-// @Failure 500,400 {object} response.ErrorJSONResponse "Error"
+// @Header 200 {string} ETag "New version of the product"
+// @Failure 500,428,412,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
 // @Router /products/{public_id} [put]
 func (p *Product) UpdateOneProductHandler(c fiber.Ctx) error {
 	input, ok := c.Locals("validated-data").(*dto.UpdateOneProductInput)
@@ -238,11 +488,162 @@ func (p *Product) UpdateOneProductHandler(c fiber.Ctx) error {
 		return response.SendBadRequest(c, "failed to parse input data, try again")
 	}
 
+	if ifMatch := c.Get(fiber.HeaderIfMatch); ifMatch != "" {
+		version, ok := etag.ParseVersion(ifMatch)
+
+		if !ok {
+			return response.SendPreconditionFailed(c, "If-Match does not hold a product version")
+		}
+
+		input.Version = version
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
 	result, err := p.UpdateOneProductUsecase.Execute(input)
 
 	if err != nil {
 		return response.SendErrJson(c, err, nil)
 	}
 
+	c.Set(fiber.HeaderETag, etag.Version(result.Version))
+
 	return response.SendOk(c, result)
 }
+
+// ImportProductsHandler func to import products from a file.
+// @Description Imports products with their specification values from a CSV, JSON or NDJSON upload.
+// @Description CSV columns name, description, price, rating, image_url and category are product fields,
+// @Description every other column is a specification (public ID or title). Each call processes one chunk
+// @Description starting at offset; call again with next_offset until done to resume a large import.
+// @Summary imports products from a file
+// @Tags Product
+// @Accept mpfd
+// @Produce json
+// @Param file formData file true "Import file"
+// @Param format query string false "csv, json or ndjson (defaults to the file extension)"
+// @Param dry_run query bool false "Validate only, nothing is written"
+// @Param offset query int false "Rows to skip"
+// @Param chunk_size query int false "Rows to process (default 100, max 1000)"
+// @Success 200 {object} response.JSONResponse{data=dto.ImportProductsOutput}
+// @Failure 500,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/import [post]
+func (p *Product) ImportProductsHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.ImportProductsInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	fileHeader, err := c.FormFile("file")
+
+	if err != nil {
+		return response.SendBadRequest(c, "Missing import file", err)
+	}
+
+	format, err := catalog.ParseFormat(input.Format, fileHeader.Filename)
+
+	if err != nil {
+		return response.SendBadRequest(c, err.Error(), err)
+	}
+
+	file, err := fileHeader.Open()
+
+	if err != nil {
+		return response.SendBadRequest(c, "Error reading import file", err)
+	}
+
+	defer file.Close()
+
+	rows, err := catalog.Decode(format, file)
+
+	if err != nil {
+		return response.SendBadRequest(c, err.Error(), err)
+	}
+
+	input.Rows = rows
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, usecaseErr := p.ImportProductsUsecase.Execute(input)
+
+	if usecaseErr != nil {
+		return response.SendErrJson(c, usecaseErr, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// ExportProductsHandler func to export products.
+// @Description Streams products with their category and every specification value as CSV (one column per
+// @Description specification), JSON or NDJSON. Accepts the same filters as the product listing.
+// @Summary exports products
+// @Tags Product
+// @Produce json
+// @Produce text/csv
+// @Param format query string false "csv, json or ndjson (default csv)"
+// @Param category_public_id query string false "Only products of this category"
+// @Param skip query int false "Products to skip"
+// @Param limit query int false "Maximum number of products (0 exports all)"
+// @Success 200 {array} dto.ExportProductUnit
+// @Failure 500,400,404 {object} response.ErrorJSONResponse "Error"
+// @Router /products/export [get]
+func (p *Product) ExportProductsHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.ExportProductsInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	if input.Format == "" {
+		input.Format = string(catalog.FormatCSV)
+	}
+
+	format, err := catalog.ParseFormat(input.Format, "")
+
+	if err != nil {
+		return response.SendBadRequest(c, err.Error(), err)
+	}
+
+	result, usecaseErr := p.ExportProductsUsecase.Execute(input)
+
+	if usecaseErr != nil {
+		return response.SendErrJson(c, usecaseErr, nil)
+	}
+
+	c.Attachment(fmt.Sprintf("products.%s", format))
+	c.Set(fiber.HeaderContentType, catalog.ContentType(format))
+
+	// the stream is written once the handler returned, out of the fiber context
+	ctx := c.Context()
+	requestID, _ := c.Locals("request-id").(types.RequestID)
+
+	return c.SendStreamWriter(func(w *bufio.Writer) {
+		encoder, err := catalog.NewEncoder(format, w, result.Columns)
+
+		if err != nil {
+			slog.ErrorContext(ctx, "error encoding the export", slog.String("error", err.Error()))
+			return
+		}
+
+		for product, usecaseErr := range result.Products {
+			if usecaseErr != nil {
+				usecaseErr.SetRequestID(requestID)
+				slog.ErrorContext(ctx, "error reading the export", slog.Any("exception", usecaseErr))
+				return
+			}
+
+			if err := encoder.Encode(product); err != nil {
+				slog.ErrorContext(ctx, "error encoding the export", slog.String("error", err.Error()))
+				return
+			}
+		}
+
+		if err := encoder.Close(); err != nil {
+			slog.ErrorContext(ctx, "error encoding the export", slog.String("error", err.Error()))
+		}
+	})
+}
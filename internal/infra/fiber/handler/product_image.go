@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"project/internal/infra/storage"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type ProductImage struct {
+	CreateOneProductImageUsecase *usecase.CreateOneProductImage
+	DeleteOneProductImageUsecase *usecase.DeleteOneProductImage
+	GetAllProductImagesUsecase   *usecase.GetAllProductImages
+	UpdateOneProductImageUsecase *usecase.UpdateOneProductImage
+}
+
+func NewProductImage(sqlite *sqlite.Sqlite, storage *storage.Storage) *ProductImage {
+	productRepository := repository.NewProductSqlite(sqlite.DB)
+	productImageRepository := repository.NewProductImageSqlite(sqlite.DB)
+	auditLogRepository := repository.NewAuditLogSqlite(sqlite.DB)
+
+	return &ProductImage{
+		CreateOneProductImageUsecase: usecase.NewCreateOneProductImage(
+			productRepository,
+			productImageRepository,
+			storage.Images,
+			auditLogRepository,
+		),
+		DeleteOneProductImageUsecase: usecase.NewDeleteOneProductImage(
+			productRepository,
+			productImageRepository,
+			storage.Images,
+			auditLogRepository,
+		),
+		GetAllProductImagesUsecase: usecase.NewGetAllProductImages(
+			productRepository,
+			productImageRepository,
+			storage.Images,
+		),
+		UpdateOneProductImageUsecase: usecase.NewUpdateOneProductImage(
+			productRepository,
+			productImageRepository,
+			auditLogRepository,
+		),
+	}
+}
+
+// CreateOneProductImageHandler func to upload an image to the product gallery.
+// @Description Uploads a JPEG, PNG or GIF image (up to 5 MB) to the product gallery and generates its thumbnail.
+// @Description The content is checked, not only the declared content type. Without position the image goes last.
+// @Summary uploads one product image
+// @Tags ProductImage
+// @Accept mpfd
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param file formData file true "Image file"
+// @Param alt_text formData string false "Alternative text"
+// @Param position formData int false "Position in the gallery, starting at 0"
+// @Success 201 {object} response.JSONResponse{data=dto.CreateOneProductImageOutput}
+// @Failure 500,422,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/images [post]
+func (pi *ProductImage) CreateOneProductImageHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.CreateOneProductImageInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := pi.CreateOneProductImageUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendCreated(c, result)
+}
+
+// GetAllProductImagesHandler func to list the product gallery.
+// @Description Lists the product images in gallery order, with the URLs of the image and its thumbnail.
+// @Summary gets all product images
+// @Tags ProductImage
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllProductImagesOutput}
+// @Failure 500,404,400 {object} response.ErrorJSONResponse "Error"
+// @Router /products/{public_id}/images [get]
+func (pi *ProductImage) GetAllProductImagesHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetAllProductImagesInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	result, err := pi.GetAllProductImagesUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// UpdateOneProductImageHandler func to update one product image.
+// @Description Changes the alternative text and/or moves the image in the gallery, the other images are shifted.
+// @Summary updates one product image
+// @Tags ProductImage
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param image_public_id path string true "Image Public ID"
+// @Param request body dto.UpdateOneProductImageInput true "Body"
+// @Success 200 {object} response.JSONResponse{data=dto.UpdateOneProductImageOutput}
+// @Failure 500,422,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/images/{image_public_id} [patch]
+func (pi *ProductImage) UpdateOneProductImageHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.UpdateOneProductImageInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := pi.UpdateOneProductImageUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// DeleteOneProductImageHandler func to delete one product image.
+// @Description Removes the image and its thumbnail, the following images move up one position.
+// @Summary deletes one product image
+// @Tags ProductImage
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param image_public_id path string true "Image Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.DeleteOneProductImageOutput}
+// @Failure 500,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/images/{image_public_id} [delete]
+func (pi *ProductImage) DeleteOneProductImageHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.DeleteOneProductImageInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := pi.DeleteOneProductImageUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type ProductOffer struct {
+	DeleteOneProductOfferUsecase *usecase.DeleteOneProductOffer
+	GetAllProductOffersUsecase   *usecase.GetAllProductOffers
+	UpsertOneProductOfferUsecase *usecase.UpsertOneProductOffer
+}
+
+func NewProductOffer(sqlite *sqlite.Sqlite) *ProductOffer {
+	productRepository := repository.NewProductSqlite(sqlite.DB)
+	retailerRepository := repository.NewRetailerSqlite(sqlite.DB)
+	productOfferRepository := repository.NewProductOfferSqlite(sqlite.DB)
+	auditLogRepository := repository.NewAuditLogSqlite(sqlite.DB)
+
+	return &ProductOffer{
+		DeleteOneProductOfferUsecase: usecase.NewDeleteOneProductOffer(
+			productRepository,
+			retailerRepository,
+			productOfferRepository,
+			auditLogRepository,
+		),
+		GetAllProductOffersUsecase: usecase.NewGetAllProductOffers(
+			productRepository,
+			productOfferRepository,
+		),
+		UpsertOneProductOfferUsecase: usecase.NewUpsertOneProductOffer(
+			productRepository,
+			retailerRepository,
+			productOfferRepository,
+			auditLogRepository,
+		),
+	}
+}
+
+// DeleteOneProductOfferHandler func to remove the offer of a retailer.
+// @Description Removes the offer of the retailer for the product.
+// @Summary deletes one product offer
+// @Tags ProductOffer
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param retailer_public_id path string true "Retailer Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.DeleteOneProductOfferOutput}
+// @Failure 500,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/offers/{retailer_public_id} [delete]
+func (po *ProductOffer) DeleteOneProductOfferHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.DeleteOneProductOfferInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := po.DeleteOneProductOfferUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// GetAllProductOffersHandler func to list the offers of a product.
+// @Description Lists the offers of every retailer, the cheapest first, with the best current offer and the price spread.
+// @Description Offers out of stock or not seen for 7 days are not current.
+// @Summary gets all product offers
+// @Tags ProductOffer
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllProductOffersOutput}
+// @Failure 500,404,400 {object} response.ErrorJSONResponse "Error"
+// @Router /products/{public_id}/offers [get]
+func (po *ProductOffer) GetAllProductOffersHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetAllProductOffersInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	result, err := po.GetAllProductOffersUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// UpsertOneProductOfferHandler func to record the offer of a retailer.
+// @Description Creates or updates the offer of the retailer for the product. Without last_seen_at the offer is seen now.
+// @Summary upserts one product offer
+// @Tags ProductOffer
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param retailer_public_id path string true "Retailer Public ID"
+// @Param request body dto.UpsertOneProductOfferInput true "Body"
+// @Success 200,201 {object} response.JSONResponse{data=dto.UpsertOneProductOfferOutput}
+// @Failure 500,422,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/offers/{retailer_public_id} [put]
+func (po *ProductOffer) UpsertOneProductOfferHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.UpsertOneProductOfferInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := po.UpsertOneProductOfferUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	if result.Created {
+		return response.SendCreated(c, result)
+	}
+
+	return response.SendOk(c, result)
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type ProductReview struct {
+	CreateOneProductReviewUsecase   *usecase.CreateOneProductReview
+	GetAllProductReviewsUsecase     *usecase.GetAllProductReviews
+	ModerateOneProductReviewUsecase *usecase.ModerateOneProductReview
+	VoteProductReviewHelpfulUsecase *usecase.VoteProductReviewHelpful
+}
+
+func NewProductReview(sqlite *sqlite.Sqlite) *ProductReview {
+	productRepository := repository.NewProductSqlite(sqlite.DB)
+	productReviewRepository := repository.NewProductReviewSqlite(sqlite.DB)
+
+	return &ProductReview{
+		CreateOneProductReviewUsecase: usecase.NewCreateOneProductReview(
+			productRepository,
+			productReviewRepository,
+		),
+		GetAllProductReviewsUsecase: usecase.NewGetAllProductReviews(
+			productRepository,
+			productReviewRepository,
+		),
+		ModerateOneProductReviewUsecase: usecase.NewModerateOneProductReview(
+			productRepository,
+			productReviewRepository,
+		),
+		VoteProductReviewHelpfulUsecase: usecase.NewVoteProductReviewHelpful(
+			productRepository,
+			productReviewRepository,
+		),
+	}
+}
+
+// CreateOneProductReviewHandler func to review a product.
+// @Description Creates a review with 1 to 5 stars. The review starts pending and only counts for the rating after it is approved.
+// @Summary creates one product review
+// @Tags ProductReview
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param request body dto.CreateOneProductReviewInput true "Body"
+// @Success 201 {object} response.JSONResponse{data=dto.CreateOneProductReviewOutput}
+// @Failure 500,422,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/reviews [post]
+func (pr *ProductReview) CreateOneProductReviewHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.CreateOneProductReviewInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := pr.CreateOneProductReviewUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendCreated(c, result)
+}
+
+// GetAllProductReviewsHandler func to list the product reviews.
+// @Description Lists the approved reviews, the most helpful first, with the rating summary of the product.
+// @Description Moderation lists the pending or rejected reviews with the status filter.
+// @Summary gets all product reviews
+// @Tags ProductReview
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param status query string false "pending, approved or rejected (default approved)"
+// @Param skip query int true "Reviews to skip"
+// @Param limit query int true "Maximum number of reviews"
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllProductReviewsOutput}
+// @Failure 500,422,404,400 {object} response.ErrorJSONResponse "Error"
+// @Router /products/{public_id}/reviews [get]
+func (pr *ProductReview) GetAllProductReviewsHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetAllProductReviewsInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	result, err := pr.GetAllProductReviewsUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// ModerateOneProductReviewHandler func to approve or reject a review.
+// @Description Approves or rejects a review and recomputes the product rating, review count and distribution.
+// @Summary moderates one product review
+// @Tags ProductReview
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param review_public_id path string true "Review Public ID"
+// @Param request body dto.ModerateOneProductReviewInput true "Body"
+// @Success 200 {object} response.JSONResponse{data=dto.ModerateOneProductReviewOutput}
+// @Failure 500,422,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/reviews/{review_public_id} [patch]
+func (pr *ProductReview) ModerateOneProductReviewHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.ModerateOneProductReviewInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := pr.ModerateOneProductReviewUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// VoteProductReviewHelpfulHandler func to vote a review as helpful.
+// @Description Adds one helpful vote to an approved review.
+// @Summary votes one product review as helpful
+// @Tags ProductReview
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param review_public_id path string true "Review Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.VoteProductReviewHelpfulOutput}
+// @Failure 500,422,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/reviews/{review_public_id}/helpful [post]
+func (pr *ProductReview) VoteProductReviewHelpfulHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.VoteProductReviewHelpfulInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := pr.VoteProductReviewHelpfulUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
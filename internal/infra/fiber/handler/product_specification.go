@@ -3,6 +3,7 @@ package handler
 import (
 	"project/internal/application/dto"
 	"project/internal/application/usecase"
+	"project/internal/domain/types"
 	"project/internal/infra/fiber/utils/response"
 	"project/internal/infra/sqlite"
 	"project/internal/infra/sqlite/repository"
@@ -12,18 +13,58 @@ import (
 
 type ProductSpecification struct {
 	CreateOneProductSpecificationValueUsecase *usecase.CreateOneProductSpecificationValue
+	DeleteOneProductSpecificationValueUsecase *usecase.DeleteOneProductSpecificationValue
+	GetProductCompletenessUsecase             *usecase.GetProductCompleteness
+	ReplaceProductSpecificationValuesUsecase  *usecase.ReplaceProductSpecificationValues
+	UpdateOneProductSpecificationValueUsecase *usecase.UpdateOneProductSpecificationValue
+	UpsertOneProductSpecificationValueUsecase *usecase.UpsertOneProductSpecificationValue
 }
 
 func NewProductSpecification(sqlite *sqlite.Sqlite) *ProductSpecification {
 	productRepository := repository.NewProductSqlite(sqlite.DB)
 	specificationRepository := repository.NewSpecificationqlite(sqlite.DB)
 	productSpecificationValueRepository := repository.NewProductSpecificationValueSqlite(sqlite.DB)
+	categorySpecificationRepository := repository.NewCategorySpecificationSqlite(sqlite.DB)
+	auditLogRepository := repository.NewAuditLogSqlite(sqlite.DB)
 
 	return &ProductSpecification{
 		CreateOneProductSpecificationValueUsecase: usecase.NewCreateOneProductSpecificationValue(
 			productRepository,
 			specificationRepository,
 			productSpecificationValueRepository,
+			categorySpecificationRepository,
+			auditLogRepository,
+		),
+		DeleteOneProductSpecificationValueUsecase: usecase.NewDeleteOneProductSpecificationValue(
+			productRepository,
+			specificationRepository,
+			productSpecificationValueRepository,
+			auditLogRepository,
+		),
+		GetProductCompletenessUsecase: usecase.NewGetProductCompleteness(
+			productRepository,
+			categorySpecificationRepository,
+		),
+		ReplaceProductSpecificationValuesUsecase: usecase.NewReplaceProductSpecificationValues(
+			productRepository,
+			specificationRepository,
+			productSpecificationValueRepository,
+			categorySpecificationRepository,
+			auditLogRepository,
+		),
+		UpdateOneProductSpecificationValueUsecase: usecase.NewUpdateOneProductSpecificationValue(
+			productRepository,
+			specificationRepository,
+			productSpecificationValueRepository,
+			categorySpecificationRepository,
+			auditLogRepository,
+		),
+		UpsertOneProductSpecificationValueUsecase: usecase.NewUpsertOneProductSpecificationValue(
+			productRepository,
+			specificationRepository,
+			productSpecificationValueRepository,
+			categorySpecificationRepository,
+			auditLogRepository,
 		),
 	}
 }
@@ -36,7 +77,8 @@ func NewProductSpecification(sqlite *sqlite.Sqlite) *ProductSpecification {
 // @Produce json
 // @Param request body dto.CreateOneProductSpecificationValueInput true "Body"
 // @Success 201 {object} response.JSONResponse{data=dto.CreateOneProductSpecificationValueOutput}
-// @Failure 500,400 {object} response.ErrorJSONResponse "Error"
+// @Failure 500,422,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
 // @Router /products/specifications [post]
 func (ps *ProductSpecification) CreateOneProductSpecificationValueHandler(c fiber.Ctx) error {
 	input, ok := c.Locals("validated-data").(*dto.CreateOneProductSpecificationValueInput)
@@ -45,6 +87,9 @@ func (ps *ProductSpecification) CreateOneProductSpecificationValueHandler(c fibe
 		return response.SendBadRequest(c, "failed to parse input data, try again")
 	}
 
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
 	result, err := ps.CreateOneProductSpecificationValueUsecase.Execute(input)
 
 	if err != nil {
@@ -53,3 +98,159 @@ func (ps *ProductSpecification) CreateOneProductSpecificationValueHandler(c fibe
 
 	return response.SendCreated(c, result)
 }
+
+// DeleteOneProductSpecificationValueHandler func to delete a value for a product specification.
+// @Description Removes the value of a specification from a product.
+// @Summary deletes product specification value
+// @Tags ProductSpecification
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param specification_public_id path string true "Specification Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.DeleteOneProductSpecificationValueOutput}
+// @Failure 500,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/specifications/{specification_public_id} [delete]
+func (ps *ProductSpecification) DeleteOneProductSpecificationValueHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.DeleteOneProductSpecificationValueInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := ps.DeleteOneProductSpecificationValueUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// GetProductCompletenessHandler func to get the specification completeness of a product.
+// @Description Reports the required and recommended specifications of the product category template that the product does not fill.
+// @Description complete is false while any required specification is missing, and such a product cannot be published.
+// @Summary gets product completeness report
+// @Tags ProductSpecification
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.GetProductCompletenessOutput}
+// @Failure 500,404,400 {object} response.ErrorJSONResponse "Error"
+// @Router /products/{public_id}/completeness [get]
+func (ps *ProductSpecification) GetProductCompletenessHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetProductCompletenessInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	result, err := ps.GetProductCompletenessUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// ReplaceProductSpecificationValuesHandler func to replace the whole specification sheet of a product.
+// @Description Replaces every specification value of a product with the given sheet (specification public ID -> value).
+// @Description Values must match the specification type and belong to the category template, when the category has one. When any entry is invalid nothing is written and the per-entry errors are returned in data.
+// @Summary replaces product specification sheet
+// @Tags ProductSpecification
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param request body dto.ReplaceProductSpecificationValuesInput true "Body"
+// @Success 200 {object} response.JSONResponse{data=dto.ReplaceProductSpecificationValuesOutput}
+// @Failure 422 {object} response.ErrorJSONResponse{data=dto.ReplaceProductSpecificationValuesOutput} "Invalid entries"
+// @Failure 500,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/specifications [put]
+func (ps *ProductSpecification) ReplaceProductSpecificationValuesHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.ReplaceProductSpecificationValuesInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := ps.ReplaceProductSpecificationValuesUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, result)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// UpdateOneProductSpecificationValueHandler func to update a value for a product specification.
+// @Description Updates the existing value of a specification for a product. Fails with 404 when the product has no value for it,
+// @Description and with 412 when a version is sent and the value is no longer at it.
+// @Summary updates product specification value
+// @Tags ProductSpecification
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param specification_public_id path string true "Specification Public ID"
+// @Param request body dto.UpdateOneProductSpecificationValueInput true "Body"
+// @Success 200 {object} response.JSONResponse{data=dto.UpdateOneProductSpecificationValueOutput}
+// @Failure 500,422,412,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/specifications/{specification_public_id} [patch]
+func (ps *ProductSpecification) UpdateOneProductSpecificationValueHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.UpdateOneProductSpecificationValueInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := ps.UpdateOneProductSpecificationValueUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// UpsertOneProductSpecificationValueHandler func to upsert a value for a product specification.
+// @Description Creates or replaces the value of a specification for a product.
+// @Summary upserts product specification value
+// @Tags ProductSpecification
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Product Public ID"
+// @Param specification_public_id path string true "Specification Public ID"
+// @Param request body dto.UpsertOneProductSpecificationValueInput true "Body"
+// @Success 200 {object} response.JSONResponse{data=dto.UpsertOneProductSpecificationValueOutput}
+// @Failure 500,422,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /products/{public_id}/specifications/{specification_public_id} [put]
+func (ps *ProductSpecification) UpsertOneProductSpecificationValueHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.UpsertOneProductSpecificationValueInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := ps.UpsertOneProductSpecificationValueUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type Retailer struct {
+	CreateOneRetailerUsecase *usecase.CreateOneRetailer
+	DeleteOneRetailerUsecase *usecase.DeleteOneRetailer
+	GetAllRetailersUsecase   *usecase.GetAllRetailers
+	UpdateOneRetailerUsecase *usecase.UpdateOneRetailer
+}
+
+func NewRetailer(sqlite *sqlite.Sqlite) *Retailer {
+	retailerRepository := repository.NewRetailerSqlite(sqlite.DB)
+	auditLogRepository := repository.NewAuditLogSqlite(sqlite.DB)
+
+	return &Retailer{
+		CreateOneRetailerUsecase: usecase.NewCreateOneRetailer(retailerRepository, auditLogRepository),
+		DeleteOneRetailerUsecase: usecase.NewDeleteOneRetailer(retailerRepository, auditLogRepository),
+		GetAllRetailersUsecase:   usecase.NewGetAllRetailers(retailerRepository),
+		UpdateOneRetailerUsecase: usecase.NewUpdateOneRetailer(retailerRepository, auditLogRepository),
+	}
+}
+
+// CreateOneRetailerHandler func to create one retailer.
+// @Description Creates one retailer. Retailer names are unique.
+// @Summary creates one retailer
+// @Tags Retailer
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateOneRetailerInput true "Body"
+// @Success 201 {object} response.JSONResponse{data=dto.CreateOneRetailerOutput}
+// @Failure 500,422,409,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /retailers [post]
+func (r *Retailer) CreateOneRetailerHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.CreateOneRetailerInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := r.CreateOneRetailerUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendCreated(c, result)
+}
+
+// DeleteOneRetailerHandler func to delete one retailer.
+// @Description Soft deletes one retailer. Its offers stop counting for the best offer of the products.
+// @Summary deletes one retailer
+// @Tags Retailer
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.DeleteOneRetailerOutput}
+// @Failure 500,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /retailers/{public_id} [delete]
+func (r *Retailer) DeleteOneRetailerHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.DeleteOneRetailerInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := r.DeleteOneRetailerUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// GetAllRetailersHandler func to get all retailers.
+// @Description Gets all retailers sorted by name.
+// @Summary gets all retailers
+// @Tags Retailer
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllRetailersOutput}
+// @Failure 500 {object} response.ErrorJSONResponse "Error"
+// @Router /retailers [get]
+func (r *Retailer) GetAllRetailersHandler(c fiber.Ctx) error {
+	result, err := r.GetAllRetailersUsecase.Execute()
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// UpdateOneRetailerHandler func to update one retailer.
+// @Description Updates the name and website of one retailer.
+// @Summary updates one retailer
+// @Tags Retailer
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param request body dto.UpdateOneRetailerInput true "Body"
+// @Success 200 {object} response.JSONResponse{data=dto.UpdateOneRetailerOutput}
+// @Failure 500,422,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /retailers/{public_id} [put]
+func (r *Retailer) UpdateOneRetailerHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.UpdateOneRetailerInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := r.UpdateOneRetailerUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"project/internal/application/dto"
 	"project/internal/application/usecase"
+	"project/internal/domain/types"
 	"project/internal/infra/fiber/utils/response"
 	"project/internal/infra/sqlite"
 	"project/internal/infra/sqlite/repository"
@@ -11,19 +12,132 @@ import (
 )
 
 type Specification struct {
-	GetAllSpecificationsUsecase *usecase.GetAllSpecifications
+	CreateOneSpecificationUsecase  *usecase.CreateOneSpecification
+	DeleteOneSpecificationUsecase  *usecase.DeleteOneSpecification
+	GetAllSpecificationsUsecase    *usecase.GetAllSpecifications
+	RestoreOneSpecificationUsecase *usecase.RestoreOneSpecification
+	UpdateOneSpecificationUsecase  *usecase.UpdateOneSpecification
 }
 
 func NewSpecification(sqlite *sqlite.Sqlite) *Specification {
 	specificationRepository := repository.NewSpecificationqlite(sqlite.DB)
 	specificationGroupRepository := repository.NewSpecificationGroupSqlite(sqlite.DB)
+	productSpecificationValueRepository := repository.NewProductSpecificationValueSqlite(sqlite.DB)
+	auditLogRepository := repository.NewAuditLogSqlite(sqlite.DB)
 
 	return &Specification{
+		CreateOneSpecificationUsecase: usecase.NewCreateOneSpecification(
+			specificationRepository,
+			specificationGroupRepository,
+			auditLogRepository,
+		),
+		DeleteOneSpecificationUsecase: usecase.NewDeleteOneSpecification(specificationRepository, auditLogRepository),
 		GetAllSpecificationsUsecase: usecase.NewGetAllSpecifications(
 			specificationRepository,
 			specificationGroupRepository,
 		),
+		RestoreOneSpecificationUsecase: usecase.NewRestoreOneSpecification(
+			specificationRepository,
+			specificationGroupRepository,
+			auditLogRepository,
+		),
+		UpdateOneSpecificationUsecase: usecase.NewUpdateOneSpecification(
+			specificationRepository,
+			specificationGroupRepository,
+			productSpecificationValueRepository,
+			auditLogRepository,
+		),
+	}
+}
+
+// CreateOneSpecificationHandler func to create one specification.
+// @Description Creates one specification inside a specification group. Type must be string, int or bool.
+// @Summary creates one specification
+// @Tags Specification
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateOneSpecificationInput true "Body"
+// @Success 201 {object} response.JSONResponse{data=dto.CreateOneSpecificationOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /specifications [post]
+func (s *Specification) CreateOneSpecificationHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.CreateOneSpecificationInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := s.CreateOneSpecificationUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendCreated(c, result)
+}
+
+// DeleteOneSpecificationHandler func to delete one specification.
+// @Description Soft deletes one specification by ID. Specifications that still have product values cannot be deleted.
+// @Summary deletes one specification
+// @Tags Specification
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.DeleteOneSpecificationOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /specifications/{public_id} [delete]
+func (s *Specification) DeleteOneSpecificationHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.DeleteOneSpecificationInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := s.DeleteOneSpecificationUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// RestoreOneSpecificationHandler func to restore one deleted specification.
+// @Description Restores one soft deleted specification by ID. Its group must not be deleted.
+// @Summary restores one specification
+// @Tags Specification
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.RestoreOneSpecificationOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /specifications/{public_id}/restore [post]
+func (s *Specification) RestoreOneSpecificationHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.RestoreOneSpecificationInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := s.RestoreOneSpecificationUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
 	}
+
+	return response.SendOk(c, result)
 }
 
 // GetAllSpecificationsHandler func to get all specifications by group.
@@ -51,3 +165,34 @@ func (s *Specification) GetAllSpecificationsHandler(c fiber.Ctx) error {
 
 	return response.SendOk(c, result)
 }
+
+// UpdateOneSpecificationHandler func to update one specification.
+// @Description Updates one specification. Changing the type of a specification with product values requires convert_values=true.
+// @Summary updates one specification
+// @Tags Specification
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param request body dto.UpdateOneSpecificationInput true "Body"
+// @Success 200 {object} response.JSONResponse{data=dto.UpdateOneSpecificationOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /specifications/{public_id} [put]
+func (s *Specification) UpdateOneSpecificationHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.UpdateOneSpecificationInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := s.UpdateOneSpecificationUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
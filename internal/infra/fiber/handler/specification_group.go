@@ -1,8 +1,10 @@
 package handler
 
 import (
-	_ "project/internal/application/dto"
+	"project/internal/application/dto"
 	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/etag"
 	"project/internal/infra/fiber/utils/response"
 	"project/internal/infra/sqlite"
 	"project/internal/infra/sqlite/repository"
@@ -11,27 +13,146 @@ import (
 )
 
 type SpecificationGroup struct {
-	GetAllSpecificationGroupsUsecase *usecase.GetAllSpecificationGroups
+	CreateOneSpecificationGroupUsecase    *usecase.CreateOneSpecificationGroup
+	DeleteOneSpecificationGroupUsecase    *usecase.DeleteOneSpecificationGroup
+	GetAllSpecificationGroupsUsecase      *usecase.GetAllSpecificationGroups
+	GetSpecificationGroupsRevisionUsecase *usecase.GetSpecificationGroupsRevision
+	RestoreOneSpecificationGroupUsecase   *usecase.RestoreOneSpecificationGroup
+	UpdateOneSpecificationGroupUsecase    *usecase.UpdateOneSpecificationGroup
 }
 
 func NewSpecificationGroup(sqlite *sqlite.Sqlite) *SpecificationGroup {
 	specificationGroupRepository := repository.NewSpecificationGroupSqlite(sqlite.DB)
+	specificationRepository := repository.NewSpecificationqlite(sqlite.DB)
+	auditLogRepository := repository.NewAuditLogSqlite(sqlite.DB)
 
 	return &SpecificationGroup{
-		GetAllSpecificationGroupsUsecase: usecase.NewGetAllSpecificationGroups(specificationGroupRepository),
+		CreateOneSpecificationGroupUsecase:    usecase.NewCreateOneSpecificationGroup(specificationGroupRepository, auditLogRepository),
+		DeleteOneSpecificationGroupUsecase:    usecase.NewDeleteOneSpecificationGroup(specificationGroupRepository, specificationRepository, auditLogRepository),
+		GetAllSpecificationGroupsUsecase:      usecase.NewGetAllSpecificationGroups(specificationGroupRepository),
+		GetSpecificationGroupsRevisionUsecase: usecase.NewGetSpecificationGroupsRevision(specificationGroupRepository),
+		RestoreOneSpecificationGroupUsecase:   usecase.NewRestoreOneSpecificationGroup(specificationGroupRepository, specificationRepository, auditLogRepository),
+		UpdateOneSpecificationGroupUsecase:    usecase.NewUpdateOneSpecificationGroup(specificationGroupRepository, auditLogRepository),
 	}
 }
 
+// CreateOneSpecificationGroupHandler func to create one specification group.
+// @Description Creates one specification group.
+// @Summary creates one specification group
+// @Tags SpecificationGroup
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateOneSpecificationGroupInput true "Body"
+// @Success 201 {object} response.JSONResponse{data=dto.CreateOneSpecificationGroupOutput}
+// @Failure 500,409,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /specifications/groups [post]
+func (sg *SpecificationGroup) CreateOneSpecificationGroupHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.CreateOneSpecificationGroupInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := sg.CreateOneSpecificationGroupUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendCreated(c, result)
+}
+
+// DeleteOneSpecificationGroupHandler func to delete one specification group.
+// @Description Soft deletes one specification group by ID together with its specifications. Groups with a specification still used by a product cannot be deleted.
+// @Summary deletes one specification group
+// @Tags SpecificationGroup
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.DeleteOneSpecificationGroupOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /specifications/groups/{public_id} [delete]
+func (sg *SpecificationGroup) DeleteOneSpecificationGroupHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.DeleteOneSpecificationGroupInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := sg.DeleteOneSpecificationGroupUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// RestoreOneSpecificationGroupHandler func to restore one deleted specification group.
+// @Description Restores one soft deleted specification group by ID, together with the specifications deleted with it.
+// @Summary restores one specification group
+// @Tags SpecificationGroup
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.RestoreOneSpecificationGroupOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /specifications/groups/{public_id}/restore [post]
+func (sg *SpecificationGroup) RestoreOneSpecificationGroupHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.RestoreOneSpecificationGroupInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := sg.RestoreOneSpecificationGroupUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
 // GetAllSpecificationGroupsHandler func to get all specification groups.
-// @Description Gets all available specification groups.
+// @Description Gets all available specification groups. Send the ETag back in If-None-Match, or the Last-Modified in If-Modified-Since, to get a 304 while they did not change.
 // @Summary gets all specification groups
 // @Tags SpecificationGroup
 // @Accept json
 // @Produce json
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Param If-Modified-Since header string false "Last-Modified of a previous response"
 // @Success 200 {object} response.JSONResponse{data=dto.GetAllSpecificationGroupsOutput}
+// @Success 304 "Not Modified"
+// @Header 200,304 {string} ETag "Revision of the specification groups"
+// @Header 200,304 {string} Last-Modified "Last time a specification group changed"
 // @Failure 500 {object} response.ErrorJSONResponse "Error"
 // @Router /specifications/groups [get]
 func (sg *SpecificationGroup) GetAllSpecificationGroupsHandler(c fiber.Ctx) error {
+	revision, err := sg.GetSpecificationGroupsRevisionUsecase.Execute()
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	etag.SetRevision(c, revision.Tag, revision.ModifiedAt)
+
+	if etag.NotModified(c) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
 	result, err := sg.GetAllSpecificationGroupsUsecase.Execute()
 
 	if err != nil {
@@ -40,3 +161,34 @@ func (sg *SpecificationGroup) GetAllSpecificationGroupsHandler(c fiber.Ctx) erro
 
 	return response.SendOk(c, result)
 }
+
+// UpdateOneSpecificationGroupHandler func to update one specification group.
+// @Description Updates one specification group.
+// @Summary updates one specification group
+// @Tags SpecificationGroup
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param request body dto.UpdateOneSpecificationGroupInput true "Body"
+// @Success 200 {object} response.JSONResponse{data=dto.UpdateOneSpecificationGroupOutput}
+// @Failure 500,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /specifications/groups/{public_id} [put]
+func (sg *SpecificationGroup) UpdateOneSpecificationGroupHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.UpdateOneSpecificationGroupInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := sg.UpdateOneSpecificationGroupUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"project/internal/infra/storage"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type Trash struct {
+	GetAllTrashItemsUsecase *usecase.GetAllTrashItems
+	PurgeTrashUsecase       *usecase.PurgeTrash
+}
+
+func NewTrash(sqlite *sqlite.Sqlite, storage *storage.Storage) *Trash {
+	trashRepository := repository.NewTrashSqlite(sqlite.DB)
+	auditLogRepository := repository.NewAuditLogSqlite(sqlite.DB)
+
+	return &Trash{
+		GetAllTrashItemsUsecase: usecase.NewGetAllTrashItems(trashRepository, sqlite.TrashRetention),
+		PurgeTrashUsecase: usecase.NewPurgeTrash(
+			trashRepository,
+			storage.Images,
+			auditLogRepository,
+			sqlite.TrashRetention,
+		),
+	}
+}
+
+// GetAllTrashItemsHandler func to list the trash.
+// @Description Lists the deleted products, categories, specifications and specification groups, latest deleted first, with when each one is purged.
+// @Summary gets all trash items
+// @Tags Trash
+// @Accept json
+// @Produce json
+// @Param entity_type query string false "product, category, specification or specification_group"
+// @Param skip query int true "Items to skip"
+// @Param limit query int true "Maximum number of items"
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllTrashItemsOutput}
+// @Failure 500,422,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /trash [get]
+func (t *Trash) GetAllTrashItemsHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetAllTrashItemsInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := t.GetAllTrashItemsUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// PurgeTrashHandler func to purge the trash.
+// @Description Removes for good what was deleted before the retention, or before the given time, with the rows and image files attached to it.
+// @Summary purges the trash
+// @Tags Trash
+// @Accept json
+// @Produce json
+// @Param before query string false "Purge what was deleted before, RFC 3339 or YYYY-MM-DD"
+// @Success 200 {object} response.JSONResponse{data=dto.PurgeTrashOutput}
+// @Failure 500,422,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /trash [delete]
+func (t *Trash) PurgeTrashHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.PurgeTrashInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.Actor, _ = c.Locals("actor").(types.Actor)
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := t.PurgeTrashUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
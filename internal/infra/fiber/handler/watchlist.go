@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type Watchlist struct {
+	CreateOneWatchlistUsecase        *usecase.CreateOneWatchlist
+	DeleteOneWatchlistUsecase        *usecase.DeleteOneWatchlist
+	GetAllWatchlistDeliveriesUsecase *usecase.GetAllWatchlistDeliveries
+	GetAllWatchlistsUsecase          *usecase.GetAllWatchlists
+}
+
+func NewWatchlist(sqlite *sqlite.Sqlite) *Watchlist {
+	productRepository := repository.NewProductSqlite(sqlite.DB)
+	productOfferRepository := repository.NewProductOfferSqlite(sqlite.DB)
+	watchlistRepository := repository.NewWatchlistSqlite(sqlite.DB)
+
+	return &Watchlist{
+		CreateOneWatchlistUsecase: usecase.NewCreateOneWatchlist(
+			productRepository,
+			productOfferRepository,
+			watchlistRepository,
+		),
+		DeleteOneWatchlistUsecase:        usecase.NewDeleteOneWatchlist(watchlistRepository),
+		GetAllWatchlistDeliveriesUsecase: usecase.NewGetAllWatchlistDeliveries(watchlistRepository),
+		GetAllWatchlistsUsecase:          usecase.NewGetAllWatchlists(watchlistRepository),
+	}
+}
+
+// CreateOneWatchlistHandler func to watch the price of a product.
+// @Description Watches the product for a subscriber, who is alerted through the webhook when the price reaches target_price or drops by drop_percent from the current price.
+// @Description Set either target_price or drop_percent.
+// @Summary creates one watchlist
+// @Tags Watchlist
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateOneWatchlistInput true "Body"
+// @Success 201 {object} response.JSONResponse{data=dto.CreateOneWatchlistOutput}
+// @Failure 500,422,409,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /watchlists [post]
+func (w *Watchlist) CreateOneWatchlistHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.CreateOneWatchlistInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := w.CreateOneWatchlistUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendCreated(c, result)
+}
+
+// DeleteOneWatchlistHandler func to stop watching a product.
+// @Description Deletes one watchlist of the subscriber.
+// @Summary deletes one watchlist
+// @Tags Watchlist
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param subscriber_token query string true "Subscriber token"
+// @Success 200 {object} response.JSONResponse{data=dto.DeleteOneWatchlistOutput}
+// @Failure 500,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /watchlists/{public_id} [delete]
+func (w *Watchlist) DeleteOneWatchlistHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.DeleteOneWatchlistInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := w.DeleteOneWatchlistUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// GetAllWatchlistDeliveriesHandler func to list the alerts of a watchlist.
+// @Description Lists the alerts sent to the webhook for the watchlist, the latest first, with the attempts and the webhook answer.
+// @Summary gets all watchlist deliveries
+// @Tags Watchlist
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param subscriber_token query string true "Subscriber token"
+// @Param status query string false "delivered or failed"
+// @Param skip query int true "Deliveries to skip"
+// @Param limit query int true "Maximum number of deliveries"
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllWatchlistDeliveriesOutput}
+// @Failure 500,422,404,400 {object} response.ErrorJSONResponse "Error"
+// @Router /watchlists/{public_id}/deliveries [get]
+func (w *Watchlist) GetAllWatchlistDeliveriesHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetAllWatchlistDeliveriesInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	result, err := w.GetAllWatchlistDeliveriesUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// GetAllWatchlistsHandler func to list the watchlists of a subscriber.
+// @Description Lists the watchlists of the subscriber token.
+// @Summary gets all watchlists
+// @Tags Watchlist
+// @Accept json
+// @Produce json
+// @Param subscriber_token query string true "Subscriber token"
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllWatchlistsOutput}
+// @Failure 500,400 {object} response.ErrorJSONResponse "Error"
+// @Router /watchlists [get]
+func (w *Watchlist) GetAllWatchlistsHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetAllWatchlistsInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	result, err := w.GetAllWatchlistsUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type WebhookSubscription struct {
+	CreateOneWebhookSubscriptionUsecase *usecase.CreateOneWebhookSubscription
+	DeleteOneWebhookSubscriptionUsecase *usecase.DeleteOneWebhookSubscription
+	GetAllWebhookDeliveriesUsecase      *usecase.GetAllWebhookDeliveries
+	GetAllWebhookSubscriptionsUsecase   *usecase.GetAllWebhookSubscriptions
+	ReplayWebhookDeliveriesUsecase      *usecase.ReplayWebhookDeliveries
+}
+
+func NewWebhookSubscription(sqlite *sqlite.Sqlite) *WebhookSubscription {
+	outboxRepository := repository.NewOutboxSqlite(sqlite.DB)
+	webhookSubscriptionRepository := repository.NewWebhookSubscriptionSqlite(sqlite.DB)
+
+	return &WebhookSubscription{
+		CreateOneWebhookSubscriptionUsecase: usecase.NewCreateOneWebhookSubscription(webhookSubscriptionRepository),
+		DeleteOneWebhookSubscriptionUsecase: usecase.NewDeleteOneWebhookSubscription(webhookSubscriptionRepository),
+		GetAllWebhookDeliveriesUsecase:      usecase.NewGetAllWebhookDeliveries(webhookSubscriptionRepository),
+		GetAllWebhookSubscriptionsUsecase:   usecase.NewGetAllWebhookSubscriptions(webhookSubscriptionRepository),
+		ReplayWebhookDeliveriesUsecase: usecase.NewReplayWebhookDeliveries(
+			outboxRepository,
+			webhookSubscriptionRepository,
+		),
+	}
+}
+
+// CreateOneWebhookSubscriptionHandler func to subscribe a URL to the catalog events.
+// @Description Subscribes the URL to the product and category change events, all of them or the event_types given, like product.updated or product.*.
+// @Description The events are posted signed with the secret, generated when not given and only shown in this response.
+// @Summary creates one webhook subscription
+// @Tags Webhook Subscription
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateOneWebhookSubscriptionInput true "Body"
+// @Success 201 {object} response.JSONResponse{data=dto.CreateOneWebhookSubscriptionOutput}
+// @Failure 500,422,409,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /webhook-subscriptions [post]
+func (w *WebhookSubscription) CreateOneWebhookSubscriptionHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.CreateOneWebhookSubscriptionInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := w.CreateOneWebhookSubscriptionUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendCreated(c, result)
+}
+
+// DeleteOneWebhookSubscriptionHandler func to unsubscribe a URL.
+// @Description Deletes one webhook subscription, its pending deliveries are not sent.
+// @Summary deletes one webhook subscription
+// @Tags Webhook Subscription
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Success 200 {object} response.JSONResponse{data=dto.DeleteOneWebhookSubscriptionOutput}
+// @Failure 500,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /webhook-subscriptions/{public_id} [delete]
+func (w *WebhookSubscription) DeleteOneWebhookSubscriptionHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.DeleteOneWebhookSubscriptionInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := w.DeleteOneWebhookSubscriptionUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// GetAllWebhookDeliveriesHandler func to list the deliveries of a subscription.
+// @Description Lists the events sent to the subscription, the latest first, with the attempts and the last answer. The dead letters have the dead status.
+// @Summary gets all webhook deliveries
+// @Tags Webhook Subscription
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param status query string false "pending, sending, delivered or dead"
+// @Param skip query int true "Deliveries to skip"
+// @Param limit query int true "Maximum number of deliveries"
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllWebhookDeliveriesOutput}
+// @Failure 500,422,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /webhook-subscriptions/{public_id}/deliveries [get]
+func (w *WebhookSubscription) GetAllWebhookDeliveriesHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.GetAllWebhookDeliveriesInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := w.GetAllWebhookDeliveriesUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// GetAllWebhookSubscriptionsHandler func to list the webhook subscriptions.
+// @Description Lists the webhook subscriptions, without their secrets.
+// @Summary gets all webhook subscriptions
+// @Tags Webhook Subscription
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.JSONResponse{data=dto.GetAllWebhookSubscriptionsOutput}
+// @Failure 500,403,401 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /webhook-subscriptions [get]
+func (w *WebhookSubscription) GetAllWebhookSubscriptionsHandler(c fiber.Ctx) error {
+	input := &dto.GetAllWebhookSubscriptionsInput{}
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := w.GetAllWebhookSubscriptionsUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
+
+// ReplayWebhookDeliveriesHandler func to send events to a subscription again.
+// @Description Without since, the dead deliveries are tried again. With since, every event the subscription accepts from then on is sent again, including the ones from before the subscription.
+// @Summary replays webhook deliveries
+// @Tags Webhook Subscription
+// @Accept json
+// @Produce json
+// @Param public_id path string true "Public ID"
+// @Param since query string false "RFC 3339 timestamp"
+// @Success 200 {object} response.JSONResponse{data=dto.ReplayWebhookDeliveriesOutput}
+// @Failure 500,422,404,403,401,400 {object} response.ErrorJSONResponse "Error"
+// @Security ApiKeyAuth
+// @Router /webhook-subscriptions/{public_id}/replay [post]
+func (w *WebhookSubscription) ReplayWebhookDeliveriesHandler(c fiber.Ctx) error {
+	input, ok := c.Locals("validated-data").(*dto.ReplayWebhookDeliveriesInput)
+
+	if !ok {
+		return response.SendBadRequest(c, "failed to parse input data, try again")
+	}
+
+	input.ActorRole, _ = c.Locals("role").(types.Role)
+
+	result, err := w.ReplayWebhookDeliveriesUsecase.Execute(input)
+
+	if err != nil {
+		return response.SendErrJson(c, err, nil)
+	}
+
+	return response.SendOk(c, result)
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/infra/auth"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"slices"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type Auth struct {
+	AuthenticateUsecase *usecase.Authenticate
+}
+
+func NewAuth(sqlite *sqlite.Sqlite, auth *auth.Auth) *Auth {
+	return &Auth{
+		AuthenticateUsecase: usecase.NewAuthenticate(
+			repository.NewApiKeySqlite(sqlite.DB),
+			auth.Verifier,
+		),
+	}
+}
+
+// Authenticate reads the API key or the JWT of the Authorization header and attaches
+// the principal to the context, its subject replacing the X-Actor header as the actor
+// and its role passed on to the use cases, which decide what it may do.
+// A credential that is sent must be valid. Without one only the reads go through: the
+// safe methods and the POST routes in readOnlyPaths, which send their query as a body.
+func (m *Auth) Authenticate(readOnlyPaths ...string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		credential := c.Get(fiber.HeaderAuthorization)
+
+		if credential == "" {
+			if isSafeMethod(c.Method()) || (c.Method() == fiber.MethodPost && slices.Contains(readOnlyPaths, c.Path())) {
+				return c.Next()
+			}
+
+			return response.SendUnauthorized(c, "authentication required, send an API key or a JWT as a Bearer token")
+		}
+
+		principal, err := m.AuthenticateUsecase.Execute(&dto.AuthenticateInput{
+			Credential: credential,
+		})
+
+		if err != nil {
+			if err.Instance().StatusCode == fiber.StatusUnauthorized {
+				c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+			}
+
+			return response.SendErrJson(c, err, nil)
+		}
+
+		c.Locals("principal", principal)
+		c.Locals("actor", principal.Subject)
+		c.Locals("role", principal.Role)
+
+		return c.Next()
+	}
+}
+
+// RequirePrincipal refuses the reads that are not of the catalog, like the audit log
+// or the trash, when Authenticate let them through without a credential.
+func RequirePrincipal() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if c.Locals("principal") == nil {
+			c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+
+			return response.SendUnauthorized(c, "authentication required, send an API key or a JWT as a Bearer token")
+		}
+
+		return c.Next()
+	}
+}
+
+func isSafeMethod(method string) bool {
+	return method == fiber.MethodGet || method == fiber.MethodHead || method == fiber.MethodOptions
+}
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v3"
+)
+
+// CacheControl sets the Cache-Control of a route on its 200 and 304 responses, an
+// error is never meant to be kept.
+func CacheControl(value string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+
+		if err == nil && (status == fiber.StatusOK || status == fiber.StatusNotModified) {
+			c.Set(fiber.HeaderCacheControl, value)
+		}
+
+		return err
+	}
+}
@@ -2,12 +2,18 @@ package middleware
 
 import (
 	"fmt"
-	"log"
+	"log/slog"
+	"project/internal/domain/constants"
 	exceptions "project/internal/domain/exception"
+	domainServices "project/internal/domain/services"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/logger"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/middleware/cors"
-	"github.com/gofiber/fiber/v3/middleware/logger"
 )
 
 type Default struct{}
@@ -16,6 +22,31 @@ func NewDefault() *Default {
 	return &Default{}
 }
 
+// RequestID takes the X-Request-ID of the request, or makes a new one, and sends it
+// back. The ID goes on the context of the request, so the lines logged with it carry
+// it, and on the error responses, so a client can point to them.
+func (m *Default) RequestID() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		requestID := types.RequestID(c.Get(fiber.HeaderXRequestID))
+
+		if !isValidRequestID(requestID) {
+			generated, err := domainServices.GenerateSecret(constants.RequestIDSize)
+
+			if err != nil {
+				return err
+			}
+
+			requestID = types.RequestID(generated)
+		}
+
+		c.Set(fiber.HeaderXRequestID, string(requestID))
+		c.Locals("request-id", requestID)
+		c.SetContext(logger.WithRequestID(c.Context(), requestID))
+
+		return c.Next()
+	}
+}
+
 func (m *Default) Recoverer() fiber.Handler {
 	return func(c fiber.Ctx) (err error) {
 		defer func() {
@@ -27,7 +58,7 @@ func (m *Default) Recoverer() fiber.Handler {
 					err = fmt.Errorf("%v", r)
 				}
 
-				log.Print(err)
+				slog.ErrorContext(c.Context(), "panic recovered", slog.String("error", err.Error()))
 
 				err = exceptions.Usecase(err, exceptions.UsecaseOpts{
 					Code:        "Recoverer",
@@ -46,6 +77,68 @@ func (m *Default) Cors() fiber.Handler {
 	return cors.New()
 }
 
+// Logger writes a line for each request once its response is ready, the errors are
+// handled first so the line has their status.
 func (m *Default) Logger() fiber.Handler {
-	return logger.New()
+	return func(c fiber.Ctx) error {
+		start := time.Now()
+
+		if err := c.Next(); err != nil {
+			if handlerErr := c.App().ErrorHandler(c, err); handlerErr != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		status := c.Response().StatusCode()
+
+		level := slog.LevelInfo
+
+		if status >= fiber.StatusInternalServerError {
+			level = slog.LevelError
+		}
+
+		slog.LogAttrs(c.Context(), level, "request",
+			slog.String("method", c.Method()),
+			slog.String("path", c.Path()),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("ip", c.IP()),
+		)
+
+		return nil
+	}
+}
+
+// Actor reads who is making the request from the X-Actor header, the write handlers
+// pass it to the use cases so the audit log can record it.
+func (m *Default) Actor() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		actor := strings.TrimSpace(c.Get("X-Actor"))
+
+		if len(actor) > constants.AuditActorMaxLength {
+			return response.SendBadRequest(c, fmt.Sprintf("X-Actor header cannot be longer than %d characters", constants.AuditActorMaxLength))
+		}
+
+		c.Locals("actor", types.Actor(actor))
+
+		return c.Next()
+	}
+}
+
+// isValidRequestID keeps what a client sends out of the logs when it does not look
+// like an ID: empty, too long or with other than letters, digits and -_.:
+func isValidRequestID(requestID types.RequestID) bool {
+	if requestID == "" || len(requestID) > constants.RequestIDMaxLength {
+		return false
+	}
+
+	for _, char := range requestID {
+		isAlphanumeric := (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z') || (char >= '0' && char <= '9')
+
+		if !isAlphanumeric && !strings.ContainsRune("-_.:", char) {
+			return false
+		}
+	}
+
+	return true
 }
@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	"project/internal/domain/types"
+	"project/internal/infra/fiber/utils/response"
+	"project/internal/infra/ratelimit"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type RateLimit struct {
+	TakeRateLimitTokenUsecase *usecase.TakeRateLimitToken
+}
+
+func NewRateLimit(sqlite *sqlite.Sqlite, rateLimit *ratelimit.RateLimit) *RateLimit {
+	return &RateLimit{
+		TakeRateLimitTokenUsecase: usecase.NewTakeRateLimitToken(
+			rateLimit.Buckets,
+			repository.NewRateLimitSqlite(sqlite.DB),
+			rateLimit.Policies,
+		),
+	}
+}
+
+// Limit spends a token of the client's bucket for the policy of the route: the one of
+// its path in routePolicies, or the read policy for the safe methods and the write
+// policy for the others. It sets the RateLimit headers and answers 429 when the bucket
+// is empty. It goes after Authenticate, an authenticated client is limited by its
+// API key or its JWT subject and an anonymous one by its IP address.
+// The database failing does not stop the requests, the memory of this process still
+// limits them.
+func (m *RateLimit) Limit(routePolicies map[string]types.RateLimitPolicyName) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		policy, found := routePolicies[c.Path()]
+
+		if !found {
+			policy = constants.RateLimitPolicyWrite
+
+			if isSafeMethod(c.Method()) {
+				policy = constants.RateLimitPolicyRead
+			}
+		}
+
+		return m.take(c, policy, rateLimitClient(c))
+	}
+}
+
+// LimitCredentials spends a token of the IP address's bucket for the auth policy on
+// each request that sends a credential. It goes before Authenticate, so guessing
+// credentials is limited too, while Limit only sees the requests that got through.
+func (m *RateLimit) LimitCredentials() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if c.Get(fiber.HeaderAuthorization) == "" {
+			return c.Next()
+		}
+
+		return m.take(c, constants.RateLimitPolicyAuth, "ip:"+c.IP())
+	}
+}
+
+func (m *RateLimit) take(c fiber.Ctx, policy types.RateLimitPolicyName, client string) error {
+	if _, enabled := m.TakeRateLimitTokenUsecase.Policies[policy]; !enabled {
+		return c.Next()
+	}
+
+	result, err := m.TakeRateLimitTokenUsecase.Execute(&dto.TakeRateLimitTokenInput{
+		Policy: policy,
+		Client: client,
+	})
+
+	if err != nil {
+		requestID, _ := c.Locals("request-id").(types.RequestID)
+
+		err.SetRequestID(requestID)
+		slog.ErrorContext(c.Context(), "rate limit store failed, limited in memory only", slog.Any("exception", err))
+
+		return c.Next()
+	}
+
+	c.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", result.Limit, int64(result.Window.Seconds())))
+	c.Set("RateLimit-Limit", strconv.FormatInt(result.Limit, 10))
+	c.Set("RateLimit-Remaining", strconv.FormatInt(result.Remaining, 10))
+	c.Set("RateLimit-Reset", strconv.FormatInt(ceilSeconds(result.ResetIn), 10))
+
+	if !result.Taken {
+		return response.SendTooManyRequests(c, fmt.Sprintf("rate limit of the %s requests exceeded, retry in %d seconds", policy, ceilSeconds(result.RetryIn)), result.RetryIn)
+	}
+
+	return c.Next()
+}
+
+func rateLimitClient(c fiber.Ctx) string {
+	principal, _ := c.Locals("principal").(*dto.PrincipalOutput)
+
+	switch {
+	case principal == nil:
+		return "ip:" + c.IP()
+	case principal.ApiKeyPublicID != "":
+		return "api-key:" + string(principal.ApiKeyPublicID)
+	default:
+		return "jwt:" + string(principal.Subject)
+	}
+}
+
+func ceilSeconds(duration time.Duration) int64 {
+	return int64(math.Ceil(duration.Seconds()))
+}
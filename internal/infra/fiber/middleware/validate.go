@@ -30,6 +30,35 @@ func handleJsonBody(c fiber.Ctx) (validator.MapAny, error) {
 	return nil, nil
 }
 
+// handleMultipartBody reads a multipart form as the body: the first value of each
+// field as a string and the first file of each file field as *multipart.FileHeader.
+func handleMultipartBody(c fiber.Ctx) (validator.MapAny, error) {
+	if !contentTypeContains(c, fiber.MIMEMultipartForm) {
+		return nil, nil
+	}
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		return nil, response.SendBadRequest(c, "Error reading body: Invalid multipart form", err)
+	}
+
+	body := make(validator.MapAny)
+
+	for key, values := range form.Value {
+		if len(values) > 0 {
+			body[key] = values[0]
+		}
+	}
+
+	for key, files := range form.File {
+		if len(files) > 0 {
+			body[key] = files[0]
+		}
+	}
+
+	return body, nil
+}
+
 func handleParams(c fiber.Ctx) (validator.MapAny, error) {
 	params := make(validator.MapAny)
 	for _, paramKey := range c.Route().Params {
@@ -93,6 +122,13 @@ func Validate[IStruct any](httpSchema *validator.HttpValidator) fiber.Handler {
 			return err
 		}
 
+		if body == nil {
+			body, err = handleMultipartBody(c)
+			if err != nil {
+				return err
+			}
+		}
+
 		params, err := handleParams(c)
 		if err != nil {
 			return err
@@ -0,0 +1,20 @@
+package route
+
+import (
+	"project/internal/application/dto"
+	"project/internal/infra/fiber/handler"
+	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/fiber/schemas"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) loadAuditLogRoutes(router fiber.Router) {
+	handler := handler.NewAuditLog(r.Sqlite)
+
+	router.Get("/audit-log",
+		middleware.RequirePrincipal(),
+		middleware.Validate[dto.GetAllAuditEntriesInput](schemas.GetAllAuditEntriesSchema),
+		handler.GetAllAuditEntriesHandler,
+	)
+}
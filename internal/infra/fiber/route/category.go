@@ -1,7 +1,10 @@
 package route
 
 import (
+	"project/internal/application/dto"
 	"project/internal/infra/fiber/handler"
+	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/fiber/schemas"
 
 	"github.com/gofiber/fiber/v3"
 )
@@ -10,6 +13,37 @@ func (r *Router) loadCategoryRoutes(router fiber.Router) {
 	handler := handler.NewCategory(r.Sqlite)
 
 	router.Get("/categories",
+		middleware.CacheControl(r.CacheControl.Catalog),
 		handler.GetAllCategoriesHandler,
 	)
+
+	router.Post("/categories",
+		middleware.Validate[dto.CreateOneCategoryInput](schemas.CreateOneCategorySchema),
+		handler.CreateOneCategoryHandler,
+	)
+
+	router.Put("/categories/:public_id",
+		middleware.Validate[dto.UpdateOneCategoryInput](schemas.UpdateOneCategorySchema),
+		handler.UpdateOneCategoryHandler,
+	)
+
+	router.Delete("/categories/:public_id",
+		middleware.Validate[dto.DeleteOneCategoryInput](schemas.DeleteOneCategorySchema),
+		handler.DeleteOneCategoryHandler,
+	)
+
+	router.Post("/categories/:public_id/restore",
+		middleware.Validate[dto.RestoreOneCategoryInput](schemas.RestoreOneCategorySchema),
+		handler.RestoreOneCategoryHandler,
+	)
+
+	router.Get("/categories/:public_id/specifications",
+		middleware.Validate[dto.GetCategorySpecificationTemplateInput](schemas.GetCategorySpecificationTemplateSchema),
+		handler.GetCategorySpecificationTemplateHandler,
+	)
+
+	router.Put("/categories/:public_id/specifications",
+		middleware.Validate[dto.ReplaceCategorySpecificationTemplateInput](schemas.ReplaceCategorySpecificationTemplateSchema),
+		handler.ReplaceCategorySpecificationTemplateHandler,
+	)
 }
@@ -13,42 +13,79 @@ func (r *Router) loadProductRoutes(router fiber.Router) {
 	handler := handler.NewProduct(r.Sqlite)
 
 	router.Post("/products",
-		handler.CreateOneProductHandler,
 		middleware.Validate[dto.CreateOneProductInput](schemas.CreateOneProductSchema),
+		handler.CreateOneProductHandler,
+	)
+
+	router.Post("/products/import",
+		middleware.Validate[dto.ImportProductsInput](schemas.ImportProductsSchema),
+		handler.ImportProductsHandler,
 	)
 
 	router.Post("/products/compare",
-		handler.CompareProductsHandler,
+		middleware.CacheControl(r.CacheControl.Compare),
 		middleware.Validate[dto.CompareProductsInput](schemas.CompareProductsSchema),
+		handler.CompareProductsHandler,
 	)
 
 	router.Delete("/products/:public_id",
-		handler.DeleteOneProductHandler,
 		middleware.Validate[dto.DeleteOneProductInput](schemas.DeleteOneProductSchema),
+		handler.DeleteOneProductHandler,
+	)
+
+	router.Post("/products/:public_id/restore",
+		middleware.Validate[dto.RestoreOneProductInput](schemas.RestoreOneProductSchema),
+		handler.RestoreOneProductHandler,
+	)
+
+	router.Post("/products/:public_id/publish",
+		middleware.Validate[dto.PublishOneProductInput](schemas.PublishOneProductSchema),
+		handler.PublishOneProductHandler,
 	)
 
 	router.Get("/categories/:category_public_id/products",
-		handler.GetAllProductsByCategoryIdHandler,
 		middleware.Validate[dto.GetAllProductsByCategoryIdInput](schemas.GetAllProductsByCategoryIdSchema),
+		handler.GetAllProductsByCategoryIdHandler,
 	)
 
 	router.Get("/products",
-		handler.GetAllProductsHandler,
 		middleware.Validate[dto.GetAllProductsInput](schemas.GetAllProductsSchema),
+		handler.GetAllProductsHandler,
+	)
+
+	router.Get("/products/export",
+		middleware.Validate[dto.ExportProductsInput](schemas.ExportProductsSchema),
+		handler.ExportProductsHandler,
 	)
 
 	router.Get("/products/:public_id",
-		handler.GetOneProductByPublicIdHandler,
 		middleware.Validate[dto.GetOneProductByPublicIdInput](schemas.GetOneProductByPublicIdSchema),
+		handler.GetOneProductByPublicIdHandler,
 	)
 
 	router.Get("/products/:public_id/specifications",
-		handler.GetOneProductWithSpecificationsByPublicIdHandler,
+		middleware.CacheControl(r.CacheControl.Product),
 		middleware.Validate[dto.GetOneProductWithSpecificationsByPublicIdInput](schemas.GetOneProductWithSpecificationsByPublicIdSchema),
+		handler.GetOneProductWithSpecificationsByPublicIdHandler,
+	)
+
+	router.Post("/products/:public_id/variants",
+		middleware.Validate[dto.CreateOneProductVariantInput](schemas.CreateOneProductVariantSchema),
+		handler.CreateOneProductVariantHandler,
+	)
+
+	router.Get("/products/:public_id/variants",
+		middleware.Validate[dto.GetAllProductVariantsInput](schemas.GetAllProductVariantsSchema),
+		handler.GetAllProductVariantsHandler,
+	)
+
+	router.Get("/products/:public_id/history",
+		middleware.Validate[dto.GetProductHistoryInput](schemas.GetProductHistorySchema),
+		handler.GetProductHistoryHandler,
 	)
 
 	router.Put("/products/:public_id",
-		handler.UpdateOneProductHandler,
 		middleware.Validate[dto.UpdateOneProductInput](schemas.UpdateOneProductSchema),
+		handler.UpdateOneProductHandler,
 	)
 }
@@ -0,0 +1,34 @@
+package route
+
+import (
+	"project/internal/application/dto"
+	"project/internal/infra/fiber/handler"
+	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/fiber/schemas"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) loadProductImageRoutes(router fiber.Router) {
+	handler := handler.NewProductImage(r.Sqlite, r.Storage)
+
+	router.Post("/products/:public_id/images",
+		middleware.Validate[dto.CreateOneProductImageInput](schemas.CreateOneProductImageSchema),
+		handler.CreateOneProductImageHandler,
+	)
+
+	router.Get("/products/:public_id/images",
+		middleware.Validate[dto.GetAllProductImagesInput](schemas.GetAllProductImagesSchema),
+		handler.GetAllProductImagesHandler,
+	)
+
+	router.Patch("/products/:public_id/images/:image_public_id",
+		middleware.Validate[dto.UpdateOneProductImageInput](schemas.UpdateOneProductImageSchema),
+		handler.UpdateOneProductImageHandler,
+	)
+
+	router.Delete("/products/:public_id/images/:image_public_id",
+		middleware.Validate[dto.DeleteOneProductImageInput](schemas.DeleteOneProductImageSchema),
+		handler.DeleteOneProductImageHandler,
+	)
+}
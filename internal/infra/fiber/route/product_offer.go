@@ -0,0 +1,29 @@
+package route
+
+import (
+	"project/internal/application/dto"
+	"project/internal/infra/fiber/handler"
+	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/fiber/schemas"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) loadProductOfferRoutes(router fiber.Router) {
+	handler := handler.NewProductOffer(r.Sqlite)
+
+	router.Get("/products/:public_id/offers",
+		middleware.Validate[dto.GetAllProductOffersInput](schemas.GetAllProductOffersSchema),
+		handler.GetAllProductOffersHandler,
+	)
+
+	router.Put("/products/:public_id/offers/:retailer_public_id",
+		middleware.Validate[dto.UpsertOneProductOfferInput](schemas.UpsertOneProductOfferSchema),
+		handler.UpsertOneProductOfferHandler,
+	)
+
+	router.Delete("/products/:public_id/offers/:retailer_public_id",
+		middleware.Validate[dto.DeleteOneProductOfferInput](schemas.DeleteOneProductOfferSchema),
+		handler.DeleteOneProductOfferHandler,
+	)
+}
@@ -0,0 +1,34 @@
+package route
+
+import (
+	"project/internal/application/dto"
+	"project/internal/infra/fiber/handler"
+	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/fiber/schemas"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) loadProductReviewRoutes(router fiber.Router) {
+	handler := handler.NewProductReview(r.Sqlite)
+
+	router.Post("/products/:public_id/reviews",
+		middleware.Validate[dto.CreateOneProductReviewInput](schemas.CreateOneProductReviewSchema),
+		handler.CreateOneProductReviewHandler,
+	)
+
+	router.Get("/products/:public_id/reviews",
+		middleware.Validate[dto.GetAllProductReviewsInput](schemas.GetAllProductReviewsSchema),
+		handler.GetAllProductReviewsHandler,
+	)
+
+	router.Patch("/products/:public_id/reviews/:review_public_id",
+		middleware.Validate[dto.ModerateOneProductReviewInput](schemas.ModerateOneProductReviewSchema),
+		handler.ModerateOneProductReviewHandler,
+	)
+
+	router.Post("/products/:public_id/reviews/:review_public_id/helpful",
+		middleware.Validate[dto.VoteProductReviewHelpfulInput](schemas.VoteProductReviewHelpfulSchema),
+		handler.VoteProductReviewHelpfulHandler,
+	)
+}
@@ -12,9 +12,33 @@ import (
 func (r *Router) loadProductSpecificationRoutes(router fiber.Router) {
 	handler := handler.NewProductSpecification(r.Sqlite)
 
-	router.Get("/products/specifications",
-		handler.CreateOneProductSpecificationValueHandler,
+	router.Post("/products/specifications",
 		middleware.Validate[dto.CreateOneProductSpecificationValueInput](schemas.CreateOneProductSpecificationValueSchema),
+		handler.CreateOneProductSpecificationValueHandler,
+	)
+
+	router.Get("/products/:public_id/completeness",
+		middleware.Validate[dto.GetProductCompletenessInput](schemas.GetProductCompletenessSchema),
+		handler.GetProductCompletenessHandler,
+	)
+
+	router.Put("/products/:public_id/specifications",
+		middleware.Validate[dto.ReplaceProductSpecificationValuesInput](schemas.ReplaceProductSpecificationValuesSchema),
+		handler.ReplaceProductSpecificationValuesHandler,
 	)
 
+	router.Put("/products/:public_id/specifications/:specification_public_id",
+		middleware.Validate[dto.UpsertOneProductSpecificationValueInput](schemas.UpsertOneProductSpecificationValueSchema),
+		handler.UpsertOneProductSpecificationValueHandler,
+	)
+
+	router.Patch("/products/:public_id/specifications/:specification_public_id",
+		middleware.Validate[dto.UpdateOneProductSpecificationValueInput](schemas.UpdateOneProductSpecificationValueSchema),
+		handler.UpdateOneProductSpecificationValueHandler,
+	)
+
+	router.Delete("/products/:public_id/specifications/:specification_public_id",
+		middleware.Validate[dto.DeleteOneProductSpecificationValueInput](schemas.DeleteOneProductSpecificationValueSchema),
+		handler.DeleteOneProductSpecificationValueHandler,
+	)
 }
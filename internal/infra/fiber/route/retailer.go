@@ -0,0 +1,33 @@
+package route
+
+import (
+	"project/internal/application/dto"
+	"project/internal/infra/fiber/handler"
+	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/fiber/schemas"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) loadRetailerRoutes(router fiber.Router) {
+	handler := handler.NewRetailer(r.Sqlite)
+
+	router.Get("/retailers",
+		handler.GetAllRetailersHandler,
+	)
+
+	router.Post("/retailers",
+		middleware.Validate[dto.CreateOneRetailerInput](schemas.CreateOneRetailerSchema),
+		handler.CreateOneRetailerHandler,
+	)
+
+	router.Put("/retailers/:public_id",
+		middleware.Validate[dto.UpdateOneRetailerInput](schemas.UpdateOneRetailerSchema),
+		handler.UpdateOneRetailerHandler,
+	)
+
+	router.Delete("/retailers/:public_id",
+		middleware.Validate[dto.DeleteOneRetailerInput](schemas.DeleteOneRetailerSchema),
+		handler.DeleteOneRetailerHandler,
+	)
+}
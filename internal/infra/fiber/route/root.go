@@ -1,28 +1,47 @@
 package route
 
 import (
-	"log"
+	"log/slog"
+	"project/internal/domain/constants"
+	"project/internal/domain/types"
+	"project/internal/infra/auth"
+	"project/internal/infra/config/environment"
 	"project/internal/infra/config/services"
 	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/ratelimit"
 	"project/internal/infra/sqlite"
+	"project/internal/infra/storage"
 
 	"github.com/Flussen/swagger-fiber-v3"
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/middleware/basicauth"
+	"github.com/gofiber/fiber/v3/middleware/static"
 )
 
 type Router struct {
-	App    *fiber.App
-	Sqlite *sqlite.Sqlite
+	App          *fiber.App
+	CacheControl *environment.CacheControl
+	Sqlite       *sqlite.Sqlite
+	Storage      *storage.Storage
+	Auth         *auth.Auth
+	RateLimit    *ratelimit.RateLimit
 }
 
 func NewRouter(
 	app *fiber.App,
+	cacheControl *environment.CacheControl,
 	sqlite *sqlite.Sqlite,
+	storage *storage.Storage,
+	auth *auth.Auth,
+	rateLimit *ratelimit.RateLimit,
 ) *Router {
 	return &Router{
-		App:    app,
-		Sqlite: sqlite,
+		App:          app,
+		CacheControl: cacheControl,
+		Sqlite:       sqlite,
+		Storage:      storage,
+		Auth:         auth,
+		RateLimit:    rateLimit,
 	}
 }
 
@@ -37,6 +56,7 @@ func (r *Router) registerMiddlewares() {
 
 func (r *Router) registerRoutes() {
 	r.loadSwaggerRoutes()
+	r.loadStaticRoutes()
 	r.loadMainRoutes()
 }
 
@@ -44,14 +64,16 @@ func (r *Router) loadDefaultMiddlewares() {
 	defaultMiddleware := middleware.NewDefault()
 
 	r.App.Use(
+		defaultMiddleware.RequestID(),
+		defaultMiddleware.Logger(),
 		defaultMiddleware.Recoverer(),
 		defaultMiddleware.Cors(),
-		defaultMiddleware.Logger(),
+		defaultMiddleware.Actor(),
 	)
 }
 
 func (r *Router) loadSwaggerRoutes() {
-	log.Print(services.GetEnvironmentVariable("SWAGGER_ROUTE_ACCESS_USER", false), services.GetEnvironmentVariable("SWAGGER_ROUTE_ACCESS_PASSWORD", false))
+	slog.Info("swagger protected by basic auth", slog.String("user", services.GetEnvironmentVariable("SWAGGER_ROUTE_ACCESS_USER", false)))
 	swaggerGroup := r.App.Group("/swagger")
 	swaggerGroup.Use(basicauth.New(basicauth.Config{
 		Users: map[string]string{
@@ -61,12 +83,47 @@ func (r *Router) loadSwaggerRoutes() {
 	swaggerGroup.Get("*", swagger.New())
 }
 
+// loadStaticRoutes serves the uploaded images when the storage keeps them on this
+// server. Keys carry the image public ID, so a file never changes under its URL.
+func (r *Router) loadStaticRoutes() {
+	if r.Storage.StaticRoot == "" {
+		return
+	}
+
+	r.App.Get(r.Storage.StaticPath+"*", static.New(r.Storage.StaticRoot, static.Config{
+		MaxAge: 86400,
+	}))
+}
+
 func (r *Router) loadMainRoutes() {
 	privateGroup := r.App.Group("/")
 
+	authMiddleware := middleware.NewAuth(r.Sqlite, r.Auth)
+	rateLimitMiddleware := middleware.NewRateLimit(r.Sqlite, r.RateLimit)
+
+	// the credentials are limited by IP address before they are checked, the
+	// failed ones never reach the limit of their client
+	privateGroup.Use(rateLimitMiddleware.LimitCredentials())
+
+	// compare reads two products, its POST only carries the query
+	privateGroup.Use(authMiddleware.Authenticate("/products/compare"))
+
+	// compare runs several queries and every comparator on each request
+	privateGroup.Use(rateLimitMiddleware.Limit(map[string]types.RateLimitPolicyName{
+		"/products/compare": constants.RateLimitPolicyCompare,
+	}))
+
+	r.loadAuditLogRoutes(privateGroup)
 	r.loadCategoryRoutes(privateGroup)
 	r.loadProductRoutes(privateGroup)
+	r.loadProductImageRoutes(privateGroup)
+	r.loadProductOfferRoutes(privateGroup)
+	r.loadProductReviewRoutes(privateGroup)
 	r.loadProductSpecificationRoutes(privateGroup)
+	r.loadRetailerRoutes(privateGroup)
 	r.loadSpecificationRoutes(privateGroup)
 	r.loadSpecificationGroupRoutes(privateGroup)
+	r.loadTrashRoutes(privateGroup)
+	r.loadWatchlistRoutes(privateGroup)
+	r.loadWebhookSubscriptionRoutes(privateGroup)
 }
@@ -13,7 +13,27 @@ func (r *Router) loadSpecificationRoutes(router fiber.Router) {
 	handler := handler.NewSpecification(r.Sqlite)
 
 	router.Get("/specifications",
-		handler.GetAllSpecificationsHandler,
 		middleware.Validate[dto.GetAllSpecificationsInput](schemas.GetAllSpecificationsSchema),
+		handler.GetAllSpecificationsHandler,
+	)
+
+	router.Post("/specifications",
+		middleware.Validate[dto.CreateOneSpecificationInput](schemas.CreateOneSpecificationSchema),
+		handler.CreateOneSpecificationHandler,
+	)
+
+	router.Put("/specifications/:public_id",
+		middleware.Validate[dto.UpdateOneSpecificationInput](schemas.UpdateOneSpecificationSchema),
+		handler.UpdateOneSpecificationHandler,
+	)
+
+	router.Delete("/specifications/:public_id",
+		middleware.Validate[dto.DeleteOneSpecificationInput](schemas.DeleteOneSpecificationSchema),
+		handler.DeleteOneSpecificationHandler,
+	)
+
+	router.Post("/specifications/:public_id/restore",
+		middleware.Validate[dto.RestoreOneSpecificationInput](schemas.RestoreOneSpecificationSchema),
+		handler.RestoreOneSpecificationHandler,
 	)
 }
@@ -1,7 +1,10 @@
 package route
 
 import (
+	"project/internal/application/dto"
 	"project/internal/infra/fiber/handler"
+	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/fiber/schemas"
 
 	"github.com/gofiber/fiber/v3"
 )
@@ -10,6 +13,27 @@ func (r *Router) loadSpecificationGroupRoutes(router fiber.Router) {
 	handler := handler.NewSpecificationGroup(r.Sqlite)
 
 	router.Get("/specifications/groups",
+		middleware.CacheControl(r.CacheControl.Catalog),
 		handler.GetAllSpecificationGroupsHandler,
 	)
+
+	router.Post("/specifications/groups",
+		middleware.Validate[dto.CreateOneSpecificationGroupInput](schemas.CreateOneSpecificationGroupSchema),
+		handler.CreateOneSpecificationGroupHandler,
+	)
+
+	router.Put("/specifications/groups/:public_id",
+		middleware.Validate[dto.UpdateOneSpecificationGroupInput](schemas.UpdateOneSpecificationGroupSchema),
+		handler.UpdateOneSpecificationGroupHandler,
+	)
+
+	router.Delete("/specifications/groups/:public_id",
+		middleware.Validate[dto.DeleteOneSpecificationGroupInput](schemas.DeleteOneSpecificationGroupSchema),
+		handler.DeleteOneSpecificationGroupHandler,
+	)
+
+	router.Post("/specifications/groups/:public_id/restore",
+		middleware.Validate[dto.RestoreOneSpecificationGroupInput](schemas.RestoreOneSpecificationGroupSchema),
+		handler.RestoreOneSpecificationGroupHandler,
+	)
 }
@@ -0,0 +1,25 @@
+package route
+
+import (
+	"project/internal/application/dto"
+	"project/internal/infra/fiber/handler"
+	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/fiber/schemas"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) loadTrashRoutes(router fiber.Router) {
+	handler := handler.NewTrash(r.Sqlite, r.Storage)
+
+	router.Get("/trash",
+		middleware.RequirePrincipal(),
+		middleware.Validate[dto.GetAllTrashItemsInput](schemas.GetAllTrashItemsSchema),
+		handler.GetAllTrashItemsHandler,
+	)
+
+	router.Delete("/trash",
+		middleware.Validate[dto.PurgeTrashInput](schemas.PurgeTrashSchema),
+		handler.PurgeTrashHandler,
+	)
+}
@@ -0,0 +1,34 @@
+package route
+
+import (
+	"project/internal/application/dto"
+	"project/internal/infra/fiber/handler"
+	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/fiber/schemas"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) loadWatchlistRoutes(router fiber.Router) {
+	handler := handler.NewWatchlist(r.Sqlite)
+
+	router.Get("/watchlists",
+		middleware.Validate[dto.GetAllWatchlistsInput](schemas.GetAllWatchlistsSchema),
+		handler.GetAllWatchlistsHandler,
+	)
+
+	router.Post("/watchlists",
+		middleware.Validate[dto.CreateOneWatchlistInput](schemas.CreateOneWatchlistSchema),
+		handler.CreateOneWatchlistHandler,
+	)
+
+	router.Delete("/watchlists/:public_id",
+		middleware.Validate[dto.DeleteOneWatchlistInput](schemas.DeleteOneWatchlistSchema),
+		handler.DeleteOneWatchlistHandler,
+	)
+
+	router.Get("/watchlists/:public_id/deliveries",
+		middleware.Validate[dto.GetAllWatchlistDeliveriesInput](schemas.GetAllWatchlistDeliveriesSchema),
+		handler.GetAllWatchlistDeliveriesHandler,
+	)
+}
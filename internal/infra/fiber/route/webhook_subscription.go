@@ -0,0 +1,40 @@
+package route
+
+import (
+	"project/internal/application/dto"
+	"project/internal/infra/fiber/handler"
+	"project/internal/infra/fiber/middleware"
+	"project/internal/infra/fiber/schemas"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) loadWebhookSubscriptionRoutes(router fiber.Router) {
+	handler := handler.NewWebhookSubscription(r.Sqlite)
+
+	router.Get("/webhook-subscriptions",
+		middleware.RequirePrincipal(),
+		handler.GetAllWebhookSubscriptionsHandler,
+	)
+
+	router.Post("/webhook-subscriptions",
+		middleware.Validate[dto.CreateOneWebhookSubscriptionInput](schemas.CreateOneWebhookSubscriptionSchema),
+		handler.CreateOneWebhookSubscriptionHandler,
+	)
+
+	router.Delete("/webhook-subscriptions/:public_id",
+		middleware.Validate[dto.DeleteOneWebhookSubscriptionInput](schemas.DeleteOneWebhookSubscriptionSchema),
+		handler.DeleteOneWebhookSubscriptionHandler,
+	)
+
+	router.Get("/webhook-subscriptions/:public_id/deliveries",
+		middleware.RequirePrincipal(),
+		middleware.Validate[dto.GetAllWebhookDeliveriesInput](schemas.GetAllWebhookDeliveriesSchema),
+		handler.GetAllWebhookDeliveriesHandler,
+	)
+
+	router.Post("/webhook-subscriptions/:public_id/replay",
+		middleware.Validate[dto.ReplayWebhookDeliveriesInput](schemas.ReplayWebhookDeliveriesSchema),
+		handler.ReplayWebhookDeliveriesHandler,
+	)
+}
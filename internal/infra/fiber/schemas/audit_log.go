@@ -0,0 +1,15 @@
+package schemas
+
+import "project/pkg/validator"
+
+var GetAllAuditEntriesSchema *validator.HttpValidator = validator.
+	Http().
+	Query(validator.Schema(validator.Map{
+		"actor":            validator.String(),
+		"entity_type":      validator.String(),
+		"entity_public_id": validator.String(),
+		"operation":        validator.String(),
+		"from":             validator.String().Timestamp(),
+		"to":               validator.String().Timestamp(),
+		"pagination":       CommonPaginationSchema,
+	}))
@@ -0,0 +1,51 @@
+package schemas
+
+import "project/pkg/validator"
+
+var CreateOneCategorySchema *validator.HttpValidator = validator.
+	Http().
+	Body(validator.Schema(validator.Map{
+		"name":        validator.String().Required(),
+		"description": validator.String(),
+	}))
+
+var UpdateOneCategorySchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"name":        validator.String().Required(),
+		"description": validator.String(),
+	}))
+
+var DeleteOneCategorySchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
+
+var RestoreOneCategorySchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
+
+var GetCategorySpecificationTemplateSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
+
+var ReplaceCategorySpecificationTemplateSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"specifications": validator.Slice().Required().Items(validator.Schema(validator.Map{
+			"specification_public_id": validator.String().Required(),
+			"requirement":             validator.String().Required(),
+			"display_order":           validator.Int(),
+		})),
+	}))
@@ -12,16 +12,26 @@ var CreateOneProductSchema *validator.HttpValidator = validator.
 		"category_public_id": validator.String().Required(),
 	}))
 
+// ProductPaginatorSchema is the common pagination plus search, which the Validate
+// middleware already turns into a LIKE pattern.
+var ProductPaginatorSchema = validator.Schema(validator.Map{
+	"pagination": validator.Schema(validator.Map{
+		"limit":  validator.String().ParseInt().Required(),
+		"skip":   validator.String().ParseInt().Required(),
+		"search": validator.String(),
+	}),
+})
+
 var GetAllProductsSchema *validator.HttpValidator = validator.
 	Http().
-	Query(PaginatorSchema)
+	Query(ProductPaginatorSchema)
 
 var GetAllProductsByCategoryIdSchema *validator.HttpValidator = validator.
 	Http().
 	URI(validator.Schema(validator.Map{
 		"category_public_id": validator.String().Required(),
 	})).
-	Query(PaginatorSchema)
+	Query(ProductPaginatorSchema)
 
 var GetOneProductByPublicIdSchema *validator.HttpValidator = validator.
 	Http().
@@ -46,12 +56,32 @@ var UpdateOneProductSchema *validator.HttpValidator = validator.
 		"price":              validator.Int(),
 		"image_url":          validator.String(),
 		"category_public_id": validator.String(),
+		"variant_label":      validator.String(),
+		"version":            validator.Int(),
 	}))
 
 var DeleteOneProductSchema *validator.HttpValidator = validator.
 	Http().
 	URI(validator.Schema(validator.Map{
 		"public_id": validator.String().Required(),
+	})).
+	Query(validator.Schema(validator.Map{
+		"version": validator.String().ParseInt(),
+	}))
+
+var RestoreOneProductSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
+
+var PublishOneProductSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Query(validator.Schema(validator.Map{
+		"version": validator.String().ParseInt(),
 	}))
 
 var CompareProductsSchema *validator.HttpValidator = validator.
@@ -60,3 +90,49 @@ var CompareProductsSchema *validator.HttpValidator = validator.
 		"left_public_id":  validator.String().Required(),
 		"right_public_id": validator.String().Required(),
 	}))
+
+var ImportProductsSchema *validator.HttpValidator = validator.
+	Http().
+	Query(validator.Schema(validator.Map{
+		"format":     validator.String(),
+		"dry_run":    validator.String().ParseBool(),
+		"offset":     validator.String().ParseInt(),
+		"chunk_size": validator.String().ParseInt(),
+	}))
+
+var ExportProductsSchema *validator.HttpValidator = validator.
+	Http().
+	Query(validator.Schema(validator.Map{
+		"format":             validator.String(),
+		"category_public_id": validator.String(),
+		"pagination": validator.Schema(validator.Map{
+			"limit": validator.String().ParseInt(),
+			"skip":  validator.String().ParseInt(),
+		}),
+	}))
+
+var CreateOneProductVariantSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"variant_label": validator.String().Required(),
+		"name":          validator.String(),
+		"description":   validator.String(),
+		"price":         validator.Int().Required(),
+		"image_url":     validator.String(),
+	}))
+
+var GetAllProductVariantsSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
+
+var GetProductHistorySchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Query(PaginatorSchema)
@@ -0,0 +1,42 @@
+package schemas
+
+import (
+	"project/internal/domain/constants"
+	"project/pkg/validator"
+)
+
+// CreateOneProductImageSchema reads a multipart form, so position arrives as text.
+var CreateOneProductImageSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"file":     validator.File().Required().MaxSize(constants.ProductImageMaxSize).ContentType(constants.ProductImageContentTypes...),
+		"alt_text": validator.String().Max(255),
+		"position": validator.String().ParseInt(),
+	}))
+
+var GetAllProductImagesSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
+
+var UpdateOneProductImageSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id":       validator.String().Required(),
+		"image_public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"alt_text": validator.String().Max(255),
+		"position": validator.Int().GTE(0),
+	}))
+
+var DeleteOneProductImageSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id":       validator.String().Required(),
+		"image_public_id": validator.String().Required(),
+	}))
@@ -0,0 +1,29 @@
+package schemas
+
+import "project/pkg/validator"
+
+var GetAllProductOffersSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
+
+var UpsertOneProductOfferSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id":          validator.String().Required(),
+		"retailer_public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"price":        validator.Int().Required().GT(0),
+		"url":          validator.String().Required().Max(2048),
+		"availability": validator.String(),
+		"last_seen_at": validator.String().Timestamp(),
+	}))
+
+var DeleteOneProductOfferSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id":          validator.String().Required(),
+		"retailer_public_id": validator.String().Required(),
+	}))
@@ -0,0 +1,44 @@
+package schemas
+
+import "project/pkg/validator"
+
+var CreateOneProductReviewSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"author": validator.String().Required().Max(100),
+		"stars":  validator.Int().Required().GTE(1).LTE(5),
+		"text":   validator.String().Max(5000),
+	}))
+
+var GetAllProductReviewsSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Query(validator.Schema(validator.Map{
+		"status": validator.String(),
+		"pagination": validator.Schema(validator.Map{
+			"limit": validator.String().ParseInt().Required(),
+			"skip":  validator.String().ParseInt().Required(),
+		}),
+	}))
+
+var ModerateOneProductReviewSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id":        validator.String().Required(),
+		"review_public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"status": validator.String().Required(),
+	}))
+
+var VoteProductReviewHelpfulSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id":        validator.String().Required(),
+		"review_public_id": validator.String().Required(),
+	}))
@@ -11,3 +11,50 @@ var CreateOneProductSpecificationValueSchema *validator.HttpValidator = validato
 		"int_value":               validator.Int(),
 		"bool_value":              validator.Bool(),
 	}))
+
+var UpdateOneProductSpecificationValueSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id":               validator.String().Required(),
+		"specification_public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"string_value": validator.String(),
+		"int_value":    validator.Int(),
+		"bool_value":   validator.Bool(),
+		"version":      validator.Int(),
+	}))
+
+var UpsertOneProductSpecificationValueSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id":               validator.String().Required(),
+		"specification_public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"string_value": validator.String(),
+		"int_value":    validator.Int(),
+		"bool_value":   validator.Bool(),
+	}))
+
+var DeleteOneProductSpecificationValueSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id":               validator.String().Required(),
+		"specification_public_id": validator.String().Required(),
+	}))
+
+var ReplaceProductSpecificationValuesSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"specifications": validator.Record().Required(),
+	}))
+
+var GetProductCompletenessSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
@@ -0,0 +1,26 @@
+package schemas
+
+import "project/pkg/validator"
+
+var CreateOneRetailerSchema *validator.HttpValidator = validator.
+	Http().
+	Body(validator.Schema(validator.Map{
+		"name":        validator.String().Required().Max(255),
+		"website_url": validator.String().Max(2048),
+	}))
+
+var UpdateOneRetailerSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"name":        validator.String().Required().Max(255),
+		"website_url": validator.String().Max(2048),
+	}))
+
+var DeleteOneRetailerSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
@@ -7,3 +7,35 @@ var GetAllSpecificationsSchema *validator.HttpValidator = validator.
 	Query(validator.Schema(validator.Map{
 		"specification_group_public_id": validator.String().Required(),
 	}))
+
+var CreateOneSpecificationSchema *validator.HttpValidator = validator.
+	Http().
+	Body(validator.Schema(validator.Map{
+		"title":                         validator.String().Required(),
+		"type":                          validator.String().Required(),
+		"specification_group_public_id": validator.String().Required(),
+	}))
+
+var UpdateOneSpecificationSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"title":                         validator.String().Required(),
+		"type":                          validator.String().Required(),
+		"specification_group_public_id": validator.String().Required(),
+		"convert_values":                validator.Bool(),
+	}))
+
+var DeleteOneSpecificationSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
+
+var RestoreOneSpecificationSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
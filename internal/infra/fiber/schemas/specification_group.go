@@ -0,0 +1,32 @@
+package schemas
+
+import "project/pkg/validator"
+
+var CreateOneSpecificationGroupSchema *validator.HttpValidator = validator.
+	Http().
+	Body(validator.Schema(validator.Map{
+		"name":        validator.String().Required(),
+		"description": validator.String(),
+	}))
+
+var UpdateOneSpecificationGroupSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Body(validator.Schema(validator.Map{
+		"name":        validator.String().Required(),
+		"description": validator.String(),
+	}))
+
+var DeleteOneSpecificationGroupSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
+
+var RestoreOneSpecificationGroupSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
@@ -0,0 +1,16 @@
+package schemas
+
+import "project/pkg/validator"
+
+var GetAllTrashItemsSchema *validator.HttpValidator = validator.
+	Http().
+	Query(validator.Schema(validator.Map{
+		"entity_type": validator.String(),
+		"pagination":  CommonPaginationSchema,
+	}))
+
+var PurgeTrashSchema *validator.HttpValidator = validator.
+	Http().
+	Query(validator.Schema(validator.Map{
+		"before": validator.String().Timestamp(),
+	}))
@@ -0,0 +1,41 @@
+package schemas
+
+import "project/pkg/validator"
+
+var CreateOneWatchlistSchema *validator.HttpValidator = validator.
+	Http().
+	Body(validator.Schema(validator.Map{
+		"subscriber_token":  validator.String().Required().Min(16).Max(255),
+		"product_public_id": validator.String().Required(),
+		"target_price":      validator.Int().GT(0),
+		"drop_percent":      validator.Int().GTE(1).LTE(99),
+	}))
+
+var GetAllWatchlistsSchema *validator.HttpValidator = validator.
+	Http().
+	Query(validator.Schema(validator.Map{
+		"subscriber_token": validator.String().Required(),
+	}))
+
+var DeleteOneWatchlistSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Query(validator.Schema(validator.Map{
+		"subscriber_token": validator.String().Required(),
+	}))
+
+var GetAllWatchlistDeliveriesSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Query(validator.Schema(validator.Map{
+		"subscriber_token": validator.String().Required(),
+		"status":           validator.String(),
+		"pagination": validator.Schema(validator.Map{
+			"limit": validator.String().ParseInt().Required(),
+			"skip":  validator.String().ParseInt().Required(),
+		}),
+	}))
@@ -0,0 +1,39 @@
+package schemas
+
+import "project/pkg/validator"
+
+var CreateOneWebhookSubscriptionSchema *validator.HttpValidator = validator.
+	Http().
+	Body(validator.Schema(validator.Map{
+		"url":         validator.String().Required().Max(2048),
+		"secret":      validator.String().Min(16).Max(255),
+		"event_types": validator.Slice().Items(validator.String().Required()),
+	}))
+
+var DeleteOneWebhookSubscriptionSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	}))
+
+var GetAllWebhookDeliveriesSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Query(validator.Schema(validator.Map{
+		"status": validator.String(),
+		"pagination": validator.Schema(validator.Map{
+			"limit": validator.String().ParseInt().Required(),
+			"skip":  validator.String().ParseInt().Required(),
+		}),
+	}))
+
+var ReplayWebhookDeliveriesSchema *validator.HttpValidator = validator.
+	Http().
+	URI(validator.Schema(validator.Map{
+		"public_id": validator.String().Required(),
+	})).
+	Query(validator.Schema(validator.Map{
+		"since": validator.String().Timestamp(),
+	}))
@@ -0,0 +1,70 @@
+package etag
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Revision formats the tag of a revision as a weak entity tag, the same revision can
+// be written out with other bytes, like whether an offer is still current.
+func Revision(tag string) string {
+	return `W/"` + tag + `"`
+}
+
+// SetRevision sets the ETag and the Last-Modified of the response, a revision with
+// no rows behind it has no Last-Modified.
+func SetRevision(c fiber.Ctx, tag string, modifiedAt time.Time) {
+	c.Set(fiber.HeaderETag, Revision(tag))
+
+	if !modifiedAt.IsZero() {
+		c.Set(fiber.HeaderLastModified, modifiedAt.UTC().Format(http.TimeFormat))
+	}
+}
+
+// NotModified tells if the client already holds what SetRevision set on the response.
+// If-None-Match is compared weakly and, when it is sent, If-Modified-Since is ignored.
+func NotModified(c fiber.Ctx) bool {
+	if noneMatch := c.Get(fiber.HeaderIfNoneMatch); noneMatch != "" {
+		return matchesAny(noneMatch, c.GetRespHeader(fiber.HeaderETag))
+	}
+
+	modifiedSince := c.Get(fiber.HeaderIfModifiedSince)
+	lastModified := c.GetRespHeader(fiber.HeaderLastModified)
+
+	if modifiedSince == "" || lastModified == "" {
+		return false
+	}
+
+	since, err := http.ParseTime(modifiedSince)
+
+	if err != nil {
+		return false
+	}
+
+	modifiedAt, err := http.ParseTime(lastModified)
+
+	if err != nil {
+		return false
+	}
+
+	return !modifiedAt.After(since)
+}
+
+func matchesAny(noneMatch string, current string) bool {
+	if strings.TrimSpace(noneMatch) == "*" {
+		return true
+	}
+
+	current = strings.TrimPrefix(current, "W/")
+
+	for _, candidate := range strings.Split(noneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == current {
+			return true
+		}
+	}
+
+	return false
+}
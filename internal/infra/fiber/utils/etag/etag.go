@@ -0,0 +1,29 @@
+package etag
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Version formats the version of an entity as a strong entity tag.
+func Version(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ParseVersion reads the version out of an If-Match value. A weak tag is accepted,
+// anything that is not a single tag holding a positive version gives false.
+func ParseVersion(value string) (int64, bool) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return 0, false
+	}
+
+	version, err := strconv.ParseInt(value[1:len(value)-1], 10, 64)
+
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+
+	return version, true
+}
@@ -2,8 +2,12 @@ package response
 
 import (
 	"errors"
-	"log"
+	"log/slog"
+	"math"
 	exceptions "project/internal/domain/exception"
+	"project/internal/domain/types"
+	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 )
@@ -17,6 +21,8 @@ type ErrorJSONResponse struct {
 	Message string `json:"message"`
 	Status  string `json:"status"`
 	Data    any    `json:"data"`
+	// RequestID is the X-Request-ID of the request, the logs of the error carry it.
+	RequestID types.RequestID `json:"request_id,omitempty"`
 }
 
 type ResOpts struct {
@@ -56,15 +62,32 @@ func SendJSON(c fiber.Ctx, opts ...ResOpts) error {
 	return c.Status(statusCode).JSON(resp)
 }
 
+// SendErrJson logs the exception with the ID of the request, which is set on it and
+// on the exceptions it wraps, and sends the ID back in the body.
 func SendErrJson(c fiber.Ctx, err exceptions.UsecaseException, data any) error {
-	log.Print(err.Error())
+	requestID, _ := c.Locals("request-id").(types.RequestID)
+
+	err.SetRequestID(requestID)
 
 	httErr := err.Instance()
 
+	level := slog.LevelWarn
+
+	if httErr.StatusCode >= fiber.StatusInternalServerError {
+		level = slog.LevelError
+	}
+
+	slog.Log(c.Context(), level, httErr.Message, slog.Any("exception", err))
+
+	if data == nil {
+		data = httErr.Data
+	}
+
 	resp := ErrorJSONResponse{
-		Status:  errorStatus,
-		Data:    data,
-		Message: httErr.Message,
+		Status:    errorStatus,
+		Data:      data,
+		Message:   httErr.Message,
+		RequestID: requestID,
 	}
 
 	return c.Status(httErr.StatusCode).JSON(resp)
@@ -90,6 +113,20 @@ func SendInternalServerError(c fiber.Ctx, message string, errs ...error) error {
 	}), nil)
 }
 
+// SendUnauthorized tells the client how to authenticate, the challenge of the
+// WWW-Authenticate header covers both the API keys and the JWTs.
+func SendUnauthorized(c fiber.Ctx, message string, errs ...error) error {
+	err := getErrFromMessageOrErrs(message, errs)
+
+	c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+
+	return SendErrJson(c, exceptions.Usecase(err, exceptions.UsecaseOpts{
+		StatusCode: fiber.StatusUnauthorized,
+		Code:       "#SendUnauthorizedResponse",
+		Message:    message,
+	}), nil)
+}
+
 func SendNotFound(c fiber.Ctx, message string, errs ...error) error {
 	err := getErrFromMessageOrErrs(message, errs)
 
@@ -100,6 +137,40 @@ func SendNotFound(c fiber.Ctx, message string, errs ...error) error {
 	}), nil)
 }
 
+func SendRequestEntityTooLarge(c fiber.Ctx, message string, errs ...error) error {
+	err := getErrFromMessageOrErrs(message, errs)
+
+	return SendErrJson(c, exceptions.Usecase(err, exceptions.UsecaseOpts{
+		StatusCode: fiber.StatusRequestEntityTooLarge,
+		Code:       "#SendRequestEntityTooLargeResponse",
+		Message:    message,
+	}), nil)
+}
+
+func SendPreconditionFailed(c fiber.Ctx, message string, errs ...error) error {
+	err := getErrFromMessageOrErrs(message, errs)
+
+	return SendErrJson(c, exceptions.Usecase(err, exceptions.UsecaseOpts{
+		StatusCode: fiber.StatusPreconditionFailed,
+		Code:       "#SendPreconditionFailedResponse",
+		Message:    message,
+	}), nil)
+}
+
+// SendTooManyRequests tells the client when to retry, the rate limit headers are set
+// before it.
+func SendTooManyRequests(c fiber.Ctx, message string, retryAfter time.Duration, errs ...error) error {
+	err := getErrFromMessageOrErrs(message, errs)
+
+	c.Set(fiber.HeaderRetryAfter, strconv.FormatInt(int64(math.Ceil(retryAfter.Seconds())), 10))
+
+	return SendErrJson(c, exceptions.Usecase(err, exceptions.UsecaseOpts{
+		StatusCode: fiber.StatusTooManyRequests,
+		Code:       "#SendTooManyRequestsResponse",
+		Message:    message,
+	}), nil)
+}
+
 func SendOk(c fiber.Ctx, data any) error {
 	return SendJSON(c, ResOpts{StatusCode: fiber.StatusOK, Data: data})
 }
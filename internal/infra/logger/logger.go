@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"project/internal/domain/types"
+	"project/internal/infra/config/environment"
+)
+
+type requestIDKey struct{}
+
+type Logger struct {
+	Logger *slog.Logger
+}
+
+// NewLoggerInstance makes the logger the slog default, the lines of the log package
+// go through it too.
+func NewLoggerInstance(config *environment.Logger) *Logger {
+	options := &slog.HandlerOptions{Level: config.Level}
+
+	var handler slog.Handler = slog.NewJSONHandler(os.Stdout, options)
+
+	if config.Format == environment.LogFormatText {
+		handler = slog.NewTextHandler(os.Stdout, options)
+	}
+
+	logger := slog.New(&ContextHandler{Handler: handler})
+
+	slog.SetDefault(logger)
+
+	return &Logger{Logger: logger}
+}
+
+// ContextHandler adds the request ID of the context to each record, a line logged
+// with the context of a request can be matched to it.
+type ContextHandler struct {
+	slog.Handler
+}
+
+func (h *ContextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if requestID := RequestID(ctx); requestID != "" {
+		record.AddAttrs(slog.String("request_id", string(requestID)))
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &ContextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	return &ContextHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+func WithRequestID(ctx context.Context, requestID types.RequestID) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID is the request ID the context carries, empty outside of a request.
+func RequestID(ctx context.Context) types.RequestID {
+	requestID, _ := ctx.Value(requestIDKey{}).(types.RequestID)
+
+	return requestID
+}
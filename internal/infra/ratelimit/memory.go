@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"sync"
+	"time"
+)
+
+// MemoryBuckets keeps the buckets of this process. The other prefork workers spend
+// tokens it does not see, so it never has fewer tokens than the shared store: when it
+// refuses a request, the store would too.
+type MemoryBuckets struct {
+	mutex   sync.Mutex
+	buckets map[string]*entity.RateLimitBucket
+}
+
+func NewMemoryBuckets() repository.RateLimitCache {
+	return &MemoryBuckets{
+		buckets: make(map[string]*entity.RateLimitBucket),
+	}
+}
+
+func (m *MemoryBuckets) Take(key string, policy *entity.RateLimitPolicy, now time.Time) (*entity.RateLimitBucket, bool, exceptions.RepositoryException) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	bucket, found := m.buckets[key]
+
+	if !found {
+		bucket = entity.NewRateLimitBucket(key, policy, now)
+		m.buckets[key] = bucket
+	}
+
+	taken := bucket.Take(policy, now)
+
+	copied := *bucket
+
+	return &copied, taken, nil
+}
+
+func (m *MemoryBuckets) Save(bucket *entity.RateLimitBucket) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	copied := *bucket
+
+	m.buckets[bucket.Key] = &copied
+}
+
+func (m *MemoryBuckets) DeleteFull(now time.Time) (int64, exceptions.RepositoryException) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var deleted int64
+
+	for key, bucket := range m.buckets {
+		if bucket.IsFull(now) {
+			delete(m.buckets, key)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
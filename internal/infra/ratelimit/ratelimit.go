@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"fmt"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/config/environment"
+	"time"
+)
+
+type RateLimit struct {
+	// Policies has the enabled policies, a route whose policy is missing is not
+	// limited.
+	Policies map[types.RateLimitPolicyName]*entity.RateLimitPolicy
+	// Buckets is this process's copy of the buckets kept in SQLite.
+	Buckets       repository.RateLimitCache
+	PruneInterval time.Duration
+}
+
+func NewRateLimitInstance(config *environment.RateLimit) *RateLimit {
+	rateLimit := &RateLimit{
+		Policies:      make(map[types.RateLimitPolicyName]*entity.RateLimitPolicy),
+		Buckets:       NewMemoryBuckets(),
+		PruneInterval: config.PruneInterval,
+	}
+
+	configured := map[types.RateLimitPolicyName]environment.RateLimitPolicy{
+		constants.RateLimitPolicyRead:    config.Read,
+		constants.RateLimitPolicyWrite:   config.Write,
+		constants.RateLimitPolicyCompare: config.Compare,
+		constants.RateLimitPolicyAuth:    config.Auth,
+	}
+
+	for name, policyConfig := range configured {
+		if policyConfig.Limit == 0 {
+			continue
+		}
+
+		policy, err := entity.NewRateLimitPolicy(entity.RateLimitPolicyProps{
+			Name:   name,
+			Limit:  policyConfig.Limit,
+			Window: policyConfig.Window,
+		})
+
+		if err != nil {
+			panic(fmt.Errorf("can't create the %s rate limit policy. error: %v", name, err.Error()))
+		}
+
+		rateLimit.Policies[name] = policy
+	}
+
+	return rateLimit
+}
@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"log/slog"
+	"project/internal/application/usecase"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"project/internal/infra/webhook"
+	"time"
+)
+
+// OutboxDispatcher sends the outbox events to the webhook subscriptions each
+// Interval, retrying the failed deliveries once their backoff is over.
+type OutboxDispatcher struct {
+	DispatchOutboxEventsUsecase *usecase.DispatchOutboxEvents
+	Interval                    time.Duration
+	done                        chan struct{}
+}
+
+func NewOutboxDispatcher(sqlite *sqlite.Sqlite, webhook *webhook.Webhook) *OutboxDispatcher {
+	return &OutboxDispatcher{
+		DispatchOutboxEventsUsecase: usecase.NewDispatchOutboxEvents(
+			repository.NewOutboxSqlite(sqlite.DB),
+			repository.NewWebhookSubscriptionSqlite(sqlite.DB),
+			webhook.Poster,
+			int64(webhook.OutboxMaxAttempts),
+			webhook.OutboxBackoff,
+			webhook.OutboxLock,
+		),
+		Interval: webhook.OutboxDispatchInterval,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the dispatcher in the background, it does nothing without an interval.
+// The events keep piling up in the outbox until a dispatcher runs.
+func (d *OutboxDispatcher) Start() {
+	if d.Interval == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(d.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-d.done:
+				return
+			case <-ticker.C:
+				result, err := d.DispatchOutboxEventsUsecase.Execute()
+
+				if err != nil {
+					slog.Error("outbox dispatch failed", slog.Any("exception", err))
+					continue
+				}
+
+				if result.Delivered > 0 || result.Failed > 0 || result.DeadLettered > 0 {
+					slog.Info("outbox dispatch",
+						slog.Int("events", result.Events),
+						slog.Int("delivered", result.Delivered),
+						slog.Int("failed", result.Failed),
+						slog.Int("dead_lettered", result.DeadLettered),
+					)
+				}
+			}
+		}
+	}()
+}
+
+func (d *OutboxDispatcher) Stop() {
+	close(d.done)
+}
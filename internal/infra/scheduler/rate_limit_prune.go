@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"log/slog"
+	"project/internal/application/usecase"
+	"project/internal/infra/ratelimit"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"time"
+)
+
+// RateLimitPrune removes, each Interval, the rate limit buckets that are full again,
+// the ones of the clients that stopped making requests.
+type RateLimitPrune struct {
+	PruneRateLimitBucketsUsecase *usecase.PruneRateLimitBuckets
+	Interval                     time.Duration
+	done                         chan struct{}
+}
+
+func NewRateLimitPrune(sqlite *sqlite.Sqlite, rateLimit *ratelimit.RateLimit) *RateLimitPrune {
+	return &RateLimitPrune{
+		PruneRateLimitBucketsUsecase: usecase.NewPruneRateLimitBuckets(
+			rateLimit.Buckets,
+			repository.NewRateLimitSqlite(sqlite.DB),
+		),
+		Interval: rateLimit.PruneInterval,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the prune in the background, it does nothing without an interval.
+// The buckets of every client ever seen are then kept.
+func (p *RateLimitPrune) Start() {
+	if p.Interval == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(p.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.done:
+				return
+			case <-ticker.C:
+				result, err := p.PruneRateLimitBucketsUsecase.Execute()
+
+				if err != nil {
+					slog.Error("rate limit prune failed", slog.Any("exception", err))
+					continue
+				}
+
+				if result.Pruned > 0 {
+					slog.Info("rate limit prune", slog.Int64("pruned", result.Pruned))
+				}
+			}
+		}
+	}()
+}
+
+func (p *RateLimitPrune) Stop() {
+	close(p.done)
+}
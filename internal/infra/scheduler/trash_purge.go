@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"log/slog"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"project/internal/infra/storage"
+	"time"
+)
+
+// TrashPurge removes for good, each Interval, what stayed in the trash longer than
+// the retention.
+type TrashPurge struct {
+	PurgeTrashUsecase *usecase.PurgeTrash
+	Interval          time.Duration
+	done              chan struct{}
+}
+
+func NewTrashPurge(sqlite *sqlite.Sqlite, storage *storage.Storage) *TrashPurge {
+	return &TrashPurge{
+		PurgeTrashUsecase: usecase.NewPurgeTrash(
+			repository.NewTrashSqlite(sqlite.DB),
+			storage.Images,
+			repository.NewAuditLogSqlite(sqlite.DB),
+			sqlite.TrashRetention,
+		),
+		Interval: sqlite.TrashPurgeInterval,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the purge in the background, it does nothing without an interval.
+// The trash then keeps growing until it is purged through the API.
+func (p *TrashPurge) Start() {
+	if p.Interval == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(p.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.done:
+				return
+			case <-ticker.C:
+				result, err := p.PurgeTrashUsecase.Execute(&dto.PurgeTrashInput{Actor: constants.TrashPurgeActor, ActorRole: constants.RoleSystem})
+
+				if err != nil {
+					slog.Error("trash purge failed", slog.Any("exception", err))
+					continue
+				}
+
+				if len(result.Purged) > 0 {
+					slog.Info("trash purge", slog.Int("purged", len(result.Purged)))
+				}
+			}
+		}
+	}()
+}
+
+func (p *TrashPurge) Stop() {
+	close(p.done)
+}
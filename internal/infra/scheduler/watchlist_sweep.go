@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"log/slog"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	exceptions "project/internal/domain/exception"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"project/internal/infra/webhook"
+	"time"
+)
+
+// WatchlistSweep checks every watchlist each Interval, for the price drops that do
+// not come from a product update and the alerts that failed, and the watchlists of
+// the products whose price was updated each CheckInterval.
+type WatchlistSweep struct {
+	SweepWatchlistsUsecase            *usecase.SweepWatchlists
+	CheckWatchlistPriceUpdatesUsecase *usecase.CheckWatchlistPriceUpdates
+	Interval                          time.Duration
+	CheckInterval                     time.Duration
+	done                              chan struct{}
+}
+
+func NewWatchlistSweep(sqlite *sqlite.Sqlite, webhook *webhook.Webhook) *WatchlistSweep {
+	productRepository := repository.NewProductSqlite(sqlite.DB)
+	productOfferRepository := repository.NewProductOfferSqlite(sqlite.DB)
+	watchlistRepository := repository.NewWatchlistSqlite(sqlite.DB)
+
+	return &WatchlistSweep{
+		SweepWatchlistsUsecase:            usecase.NewSweepWatchlists(productRepository, productOfferRepository, watchlistRepository, webhook.Sender),
+		CheckWatchlistPriceUpdatesUsecase: usecase.NewCheckWatchlistPriceUpdates(productRepository, productOfferRepository, watchlistRepository, webhook.Sender),
+		Interval:                          webhook.SweepInterval,
+		CheckInterval:                     webhook.CheckInterval,
+		done:                              make(chan struct{}),
+	}
+}
+
+// Start runs the sweep and the check in the background. It does nothing without a
+// webhook to send the alerts to, and runs neither of them without its interval.
+func (s *WatchlistSweep) Start() {
+	if s.SweepWatchlistsUsecase.WebhookSender == nil {
+		return
+	}
+
+	if s.Interval > 0 {
+		go s.run("watchlist sweep", s.Interval, s.SweepWatchlistsUsecase.Execute)
+	}
+
+	if s.CheckInterval > 0 {
+		go s.run("watchlist price check", s.CheckInterval, s.CheckWatchlistPriceUpdatesUsecase.Execute)
+	}
+}
+
+func (s *WatchlistSweep) run(name string, interval time.Duration, execute func() (*dto.SweepWatchlistsOutput, exceptions.UsecaseException)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			result, err := execute()
+
+			if err != nil {
+				slog.Error(name+" failed", slog.Any("exception", err))
+				continue
+			}
+
+			if result.Delivered > 0 || result.Failed > 0 {
+				slog.Info(name,
+					slog.Int("products", result.Products),
+					slog.Int("delivered", result.Delivered),
+					slog.Int("failed", result.Failed),
+				)
+			}
+		}
+	}
+}
+
+func (s *WatchlistSweep) Stop() {
+	close(s.done)
+}
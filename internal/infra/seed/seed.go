@@ -0,0 +1,102 @@
+package seed
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"project/internal/application/dto"
+	"slices"
+	"strings"
+
+	json "github.com/goccy/go-json"
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed fixtures
+var embedded embed.FS
+
+// Fixtures holds the built-in profiles, one file per profile.
+var Fixtures, _ = fs.Sub(embedded, "fixtures")
+
+// Profiles are the built-in profiles meant to be loaded directly; base only holds
+// the specifications and is included by all of them.
+var Profiles = []string{"dev", "demo", "test"}
+
+var extensions = []string{".yaml", ".yml", ".json"}
+
+// Load reads the profile file (<profile>.yaml, .yml or .json) from fsys, after the
+// profiles it includes. Rows of included profiles come first, so a profile can
+// extend another one by adding rows with new IDs.
+func Load(fsys fs.FS, profile string) (*dto.SeedFixture, error) {
+	fixture := &dto.SeedFixture{}
+
+	if err := load(fsys, profile, fixture, []string{}, map[string]bool{}); err != nil {
+		return nil, err
+	}
+
+	return fixture, nil
+}
+
+func load(fsys fs.FS, profile string, fixture *dto.SeedFixture, chain []string, loaded map[string]bool) error {
+	if slices.Contains(chain, profile) {
+		return fmt.Errorf("profile include cycle: %s -> %s", strings.Join(chain, " -> "), profile)
+	}
+
+	if loaded[profile] {
+		return nil
+	}
+
+	current, err := read(fsys, profile)
+
+	if err != nil {
+		return err
+	}
+
+	for _, include := range current.Include {
+		if err := load(fsys, include, fixture, append(chain, profile), loaded); err != nil {
+			return err
+		}
+	}
+
+	loaded[profile] = true
+
+	fixture.SpecificationGroups = append(fixture.SpecificationGroups, current.SpecificationGroups...)
+	fixture.Specifications = append(fixture.Specifications, current.Specifications...)
+	fixture.Categories = append(fixture.Categories, current.Categories...)
+	fixture.Products = append(fixture.Products, current.Products...)
+
+	return nil
+}
+
+func read(fsys fs.FS, profile string) (*dto.SeedFixture, error) {
+	for _, extension := range extensions {
+		filename := profile + extension
+		content, err := fs.ReadFile(fsys, filename)
+
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("can't read fixture %s: %w", filename, err)
+		}
+
+		fixture := &dto.SeedFixture{}
+
+		if path.Ext(filename) == ".json" {
+			err = json.Unmarshal(content, fixture)
+		} else {
+			err = yaml.UnmarshalStrict(content, fixture)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid fixture %s: %w", filename, err)
+		}
+
+		return fixture, nil
+	}
+
+	return nil, fmt.Errorf("profile %q not found, expected %s.yaml, %s.yml or %s.json", profile, profile, profile, profile)
+}
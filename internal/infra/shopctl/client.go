@@ -0,0 +1,58 @@
+package shopctl
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/domain/types"
+)
+
+// Client is what shopctl needs from the catalog. Local runs the use cases against a
+// sqlite file and HTTP calls a running API; both return the use case outputs.
+type Client interface {
+	ListProducts(*dto.GetAllProductsInput) (*dto.GetAllProductsOutput, error)
+	ListProductsByCategory(*dto.GetAllProductsByCategoryIdInput) (*dto.GetAllProductsByCategoryIdOutput, error)
+	GetProductSpecifications(types.ProductPublicID) (*dto.GetOneProductWithSpecificationsByPublicIdOutput, error)
+	CompareProducts(*dto.CompareProductsInput) (*dto.CompareProductsOutput, error)
+
+	ListCategories() (*dto.GetAllCategoriesOutput, error)
+	CreateCategory(*dto.CreateOneCategoryInput) (*dto.CreateOneCategoryOutput, error)
+	UpdateCategory(*dto.UpdateOneCategoryInput) (*dto.UpdateOneCategoryOutput, error)
+	DeleteCategory(types.CategoryPublicID) (*dto.DeleteOneCategoryOutput, error)
+	RestoreCategory(types.CategoryPublicID) (*dto.RestoreOneCategoryOutput, error)
+
+	ListSpecifications(*dto.GetAllSpecificationsInput) (*dto.GetAllSpecificationsOutput, error)
+	CreateSpecification(*dto.CreateOneSpecificationInput) (*dto.CreateOneSpecificationOutput, error)
+	UpdateSpecification(*dto.UpdateOneSpecificationInput) (*dto.UpdateOneSpecificationOutput, error)
+	DeleteSpecification(types.SpecificationPublicID) (*dto.DeleteOneSpecificationOutput, error)
+
+	ListSpecificationGroups() (*dto.GetAllSpecificationGroupsOutput, error)
+	CreateSpecificationGroup(*dto.CreateOneSpecificationGroupInput) (*dto.CreateOneSpecificationGroupOutput, error)
+	UpdateSpecificationGroup(*dto.UpdateOneSpecificationGroupInput) (*dto.UpdateOneSpecificationGroupOutput, error)
+	DeleteSpecificationGroup(types.SpecificationGroupPublicID) (*dto.DeleteOneSpecificationGroupOutput, error)
+
+	CreateApiKey(*dto.CreateOneApiKeyInput) (*dto.CreateOneApiKeyOutput, error)
+	ListApiKeys() (*dto.GetAllApiKeysOutput, error)
+	RevokeApiKey(types.ApiKeyPublicID) (*dto.RevokeOneApiKeyOutput, error)
+}
+
+// actor is who the audit log records for the writes made through shopctl.
+const actor types.Actor = "shopctl"
+
+// Error is a failed call, with the status code and message the API would answer.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// deniedMessage tells what the role lacked, a 403 message alone does not.
+func deniedMessage(message string, denied *dto.PermissionDeniedOutput) string {
+	if denied == nil || denied.Permission == "" {
+		return message
+	}
+
+	return fmt.Sprintf("%s, role %q does not have the %s permission", message, denied.Role, denied.Permission)
+}
@@ -0,0 +1,243 @@
+package shopctl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"project/internal/application/dto"
+	"project/internal/domain/types"
+	"strconv"
+	"strings"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+// HTTP calls a running API. Responses are read from the data field of the API
+// envelope; error responses become an Error with the API message.
+type HTTP struct {
+	BaseURL string
+	// ApiKey authenticates the writes, the reads need none.
+	ApiKey string
+	Client *http.Client
+}
+
+func NewHTTP(baseURL string, apiKey string) Client {
+	return &HTTP{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		ApiKey:  apiKey,
+		Client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// errApiKeysLocalOnly is returned by the key commands, the API has no routes for
+// them: a key that could create keys would never really be revoked.
+var errApiKeysLocalOnly = &Error{Message: "api keys are managed on the database, run shopctl without -url"}
+
+type envelope struct {
+	Status  string          `json:"status"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// call sends body (when not nil) as JSON and decodes the response data into a new T.
+func call[T any](h *HTTP, method string, path string, query url.Values, body any) (*T, error) {
+	endpoint := h.BaseURL + path
+
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+
+	if body != nil {
+		content, err := json.Marshal(body)
+
+		if err != nil {
+			return nil, fmt.Errorf("can't encode request: %w", err)
+		}
+
+		reader = bytes.NewReader(content)
+	}
+
+	request, err := http.NewRequest(method, endpoint, reader)
+
+	if err != nil {
+		return nil, fmt.Errorf("can't build request: %w", err)
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-Actor", string(actor))
+
+	if h.ApiKey != "" {
+		request.Header.Set("Authorization", "Bearer "+h.ApiKey)
+	}
+
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := h.Client.Do(request)
+
+	if err != nil {
+		return nil, fmt.Errorf("can't reach %s: %w", h.BaseURL, err)
+	}
+
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("can't read response: %w", err)
+	}
+
+	payload := &envelope{}
+
+	if err := json.Unmarshal(content, payload); err != nil {
+		return nil, &Error{
+			StatusCode: response.StatusCode,
+			Message:    fmt.Sprintf("unexpected response from %s %s: %s", method, path, strings.TrimSpace(string(content))),
+		}
+	}
+
+	if response.StatusCode >= 400 {
+		message := payload.Message
+
+		if response.StatusCode == http.StatusForbidden {
+			denied := &dto.PermissionDeniedOutput{}
+			json.Unmarshal(payload.Data, denied)
+			message = deniedMessage(message, denied)
+		}
+
+		return nil, &Error{
+			StatusCode: response.StatusCode,
+			Message:    message,
+		}
+	}
+
+	output := new(T)
+
+	if len(payload.Data) > 0 {
+		if err := json.Unmarshal(payload.Data, output); err != nil {
+			return nil, fmt.Errorf("can't decode response data: %w", err)
+		}
+	}
+
+	return output, nil
+}
+
+func paginationQuery(input *dto.PaginatorInput) url.Values {
+	query := url.Values{}
+
+	if input == nil {
+		input = &dto.PaginatorInput{}
+	}
+
+	query.Set("skip", strconv.FormatInt(input.Skip, 10))
+	query.Set("limit", strconv.FormatInt(input.Limit, 10))
+
+	// the API wraps the term in a LIKE pattern itself
+	if search := strings.Trim(input.Search, "%"); search != "" {
+		query.Set("search", search)
+	}
+
+	return query
+}
+
+func (h *HTTP) ListProducts(input *dto.GetAllProductsInput) (*dto.GetAllProductsOutput, error) {
+	return call[dto.GetAllProductsOutput](h, http.MethodGet, "/products", paginationQuery(input.PaginatorInput), nil)
+}
+
+func (h *HTTP) ListProductsByCategory(input *dto.GetAllProductsByCategoryIdInput) (*dto.GetAllProductsByCategoryIdOutput, error) {
+	path := "/categories/" + url.PathEscape(string(input.CategoryPublicID)) + "/products"
+
+	return call[dto.GetAllProductsByCategoryIdOutput](h, http.MethodGet, path, paginationQuery(input.PaginatorInput), nil)
+}
+
+func (h *HTTP) GetProductSpecifications(publicId types.ProductPublicID) (*dto.GetOneProductWithSpecificationsByPublicIdOutput, error) {
+	path := "/products/" + url.PathEscape(string(publicId)) + "/specifications"
+
+	return call[dto.GetOneProductWithSpecificationsByPublicIdOutput](h, http.MethodGet, path, nil, nil)
+}
+
+func (h *HTTP) CompareProducts(input *dto.CompareProductsInput) (*dto.CompareProductsOutput, error) {
+	return call[dto.CompareProductsOutput](h, http.MethodPost, "/products/compare", nil, input)
+}
+
+func (h *HTTP) ListCategories() (*dto.GetAllCategoriesOutput, error) {
+	return call[dto.GetAllCategoriesOutput](h, http.MethodGet, "/categories", nil, nil)
+}
+
+func (h *HTTP) CreateCategory(input *dto.CreateOneCategoryInput) (*dto.CreateOneCategoryOutput, error) {
+	return call[dto.CreateOneCategoryOutput](h, http.MethodPost, "/categories", nil, input)
+}
+
+func (h *HTTP) UpdateCategory(input *dto.UpdateOneCategoryInput) (*dto.UpdateOneCategoryOutput, error) {
+	path := "/categories/" + url.PathEscape(string(input.PublicID))
+
+	return call[dto.UpdateOneCategoryOutput](h, http.MethodPut, path, nil, input)
+}
+
+func (h *HTTP) DeleteCategory(publicId types.CategoryPublicID) (*dto.DeleteOneCategoryOutput, error) {
+	return call[dto.DeleteOneCategoryOutput](h, http.MethodDelete, "/categories/"+url.PathEscape(string(publicId)), nil, nil)
+}
+
+func (h *HTTP) RestoreCategory(publicId types.CategoryPublicID) (*dto.RestoreOneCategoryOutput, error) {
+	return call[dto.RestoreOneCategoryOutput](h, http.MethodPost, "/categories/"+url.PathEscape(string(publicId))+"/restore", nil, nil)
+}
+
+func (h *HTTP) ListSpecifications(input *dto.GetAllSpecificationsInput) (*dto.GetAllSpecificationsOutput, error) {
+	query := url.Values{}
+
+	if input.SpecificationGroupPublicID != "" {
+		query.Set("specification_group_public_id", string(input.SpecificationGroupPublicID))
+	}
+
+	return call[dto.GetAllSpecificationsOutput](h, http.MethodGet, "/specifications", query, nil)
+}
+
+func (h *HTTP) CreateSpecification(input *dto.CreateOneSpecificationInput) (*dto.CreateOneSpecificationOutput, error) {
+	return call[dto.CreateOneSpecificationOutput](h, http.MethodPost, "/specifications", nil, input)
+}
+
+func (h *HTTP) UpdateSpecification(input *dto.UpdateOneSpecificationInput) (*dto.UpdateOneSpecificationOutput, error) {
+	path := "/specifications/" + url.PathEscape(string(input.PublicID))
+
+	return call[dto.UpdateOneSpecificationOutput](h, http.MethodPut, path, nil, input)
+}
+
+func (h *HTTP) DeleteSpecification(publicId types.SpecificationPublicID) (*dto.DeleteOneSpecificationOutput, error) {
+	return call[dto.DeleteOneSpecificationOutput](h, http.MethodDelete, "/specifications/"+url.PathEscape(string(publicId)), nil, nil)
+}
+
+func (h *HTTP) ListSpecificationGroups() (*dto.GetAllSpecificationGroupsOutput, error) {
+	return call[dto.GetAllSpecificationGroupsOutput](h, http.MethodGet, "/specifications/groups", nil, nil)
+}
+
+func (h *HTTP) CreateSpecificationGroup(input *dto.CreateOneSpecificationGroupInput) (*dto.CreateOneSpecificationGroupOutput, error) {
+	return call[dto.CreateOneSpecificationGroupOutput](h, http.MethodPost, "/specifications/groups", nil, input)
+}
+
+func (h *HTTP) UpdateSpecificationGroup(input *dto.UpdateOneSpecificationGroupInput) (*dto.UpdateOneSpecificationGroupOutput, error) {
+	path := "/specifications/groups/" + url.PathEscape(string(input.PublicID))
+
+	return call[dto.UpdateOneSpecificationGroupOutput](h, http.MethodPut, path, nil, input)
+}
+
+func (h *HTTP) DeleteSpecificationGroup(publicId types.SpecificationGroupPublicID) (*dto.DeleteOneSpecificationGroupOutput, error) {
+	return call[dto.DeleteOneSpecificationGroupOutput](h, http.MethodDelete, "/specifications/groups/"+url.PathEscape(string(publicId)), nil, nil)
+}
+
+func (h *HTTP) CreateApiKey(*dto.CreateOneApiKeyInput) (*dto.CreateOneApiKeyOutput, error) {
+	return nil, errApiKeysLocalOnly
+}
+
+func (h *HTTP) ListApiKeys() (*dto.GetAllApiKeysOutput, error) {
+	return nil, errApiKeysLocalOnly
+}
+
+func (h *HTTP) RevokeApiKey(types.ApiKeyPublicID) (*dto.RevokeOneApiKeyOutput, error) {
+	return nil, errApiKeysLocalOnly
+}
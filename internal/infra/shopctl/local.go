@@ -0,0 +1,206 @@
+package shopctl
+
+import (
+	"database/sql"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite/repository"
+	"strings"
+)
+
+// Local runs the use cases directly against a sqlite database, as the system
+// unless an API key is given: then with the name and the role of the key, under
+// the same rules as the API.
+type Local struct {
+	actor                                     types.Actor
+	role                                      types.Role
+	compareProducts                           *usecase.CompareProducts
+	getAllProducts                            *usecase.GetAllProducts
+	getAllProductsByCategoryId                *usecase.GetAllProductsByCategoryId
+	getOneProductWithSpecificationsByPublicId *usecase.GetOneProductWithSpecificationsByPublicId
+	getAllCategories                          *usecase.GetAllCategories
+	createOneCategory                         *usecase.CreateOneCategory
+	updateOneCategory                         *usecase.UpdateOneCategory
+	deleteOneCategory                         *usecase.DeleteOneCategory
+	restoreOneCategory                        *usecase.RestoreOneCategory
+	getAllSpecifications                      *usecase.GetAllSpecifications
+	createOneSpecification                    *usecase.CreateOneSpecification
+	updateOneSpecification                    *usecase.UpdateOneSpecification
+	deleteOneSpecification                    *usecase.DeleteOneSpecification
+	getAllSpecificationGroups                 *usecase.GetAllSpecificationGroups
+	createOneSpecificationGroup               *usecase.CreateOneSpecificationGroup
+	updateOneSpecificationGroup               *usecase.UpdateOneSpecificationGroup
+	deleteOneSpecificationGroup               *usecase.DeleteOneSpecificationGroup
+	createOneApiKey                           *usecase.CreateOneApiKey
+	getAllApiKeys                             *usecase.GetAllApiKeys
+	revokeOneApiKey                           *usecase.RevokeOneApiKey
+}
+
+func NewLocal(db *sql.DB, apiKey string) (Client, error) {
+	productRepository := repository.NewProductSqlite(db)
+	categoryRepository := repository.NewCategorySqlite(db)
+	specificationRepository := repository.NewSpecificationqlite(db)
+	specificationGroupRepository := repository.NewSpecificationGroupSqlite(db)
+	productSpecificationValueRepository := repository.NewProductSpecificationValueSqlite(db)
+	productOfferRepository := repository.NewProductOfferSqlite(db)
+	auditLogRepository := repository.NewAuditLogSqlite(db)
+	apiKeyRepository := repository.NewApiKeySqlite(db)
+
+	local := &Local{
+		actor:                      actor,
+		role:                       constants.RoleSystem,
+		compareProducts:            usecase.NewCompareProducts(productRepository, specificationRepository, productOfferRepository),
+		getAllProducts:             usecase.NewGetAllProducts(productRepository, productOfferRepository),
+		getAllProductsByCategoryId: usecase.NewGetAllProductsByCategoryId(productRepository, categoryRepository, productOfferRepository),
+		getOneProductWithSpecificationsByPublicId: usecase.NewGetOneProductWithSpecificationsByPublicId(productRepository, productOfferRepository),
+		getAllCategories:            usecase.NewGetAllCategories(categoryRepository),
+		createOneCategory:           usecase.NewCreateOneCategory(categoryRepository, auditLogRepository),
+		updateOneCategory:           usecase.NewUpdateOneCategory(categoryRepository, auditLogRepository),
+		deleteOneCategory:           usecase.NewDeleteOneCategory(categoryRepository, auditLogRepository),
+		restoreOneCategory:          usecase.NewRestoreOneCategory(categoryRepository, auditLogRepository),
+		getAllSpecifications:        usecase.NewGetAllSpecifications(specificationRepository, specificationGroupRepository),
+		createOneSpecification:      usecase.NewCreateOneSpecification(specificationRepository, specificationGroupRepository, auditLogRepository),
+		updateOneSpecification:      usecase.NewUpdateOneSpecification(specificationRepository, specificationGroupRepository, productSpecificationValueRepository, auditLogRepository),
+		deleteOneSpecification:      usecase.NewDeleteOneSpecification(specificationRepository, auditLogRepository),
+		getAllSpecificationGroups:   usecase.NewGetAllSpecificationGroups(specificationGroupRepository),
+		createOneSpecificationGroup: usecase.NewCreateOneSpecificationGroup(specificationGroupRepository, auditLogRepository),
+		updateOneSpecificationGroup: usecase.NewUpdateOneSpecificationGroup(specificationGroupRepository, auditLogRepository),
+		deleteOneSpecificationGroup: usecase.NewDeleteOneSpecificationGroup(specificationGroupRepository, specificationRepository, auditLogRepository),
+		createOneApiKey:             usecase.NewCreateOneApiKey(apiKeyRepository),
+		getAllApiKeys:               usecase.NewGetAllApiKeys(apiKeyRepository),
+		revokeOneApiKey:             usecase.NewRevokeOneApiKey(apiKeyRepository),
+	}
+
+	if apiKey == "" {
+		return local, nil
+	}
+
+	// no JWT key is configured here, the database only knows the API keys
+	principal, err := result(usecase.NewAuthenticate(apiKeyRepository, nil).Execute(&dto.AuthenticateInput{
+		Credential: apiKey,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	local.actor, local.role = principal.Subject, principal.Role
+
+	return local, nil
+}
+
+// result converts a use case exception into an Error, keeping the output untouched.
+func result[T any](output *T, err exceptions.UsecaseException) (*T, error) {
+	if err != nil {
+		denied, _ := err.Instance().Data.(*dto.PermissionDeniedOutput)
+
+		return nil, &Error{
+			StatusCode: err.Instance().StatusCode,
+			Message:    deniedMessage(err.Instance().Message, denied),
+		}
+	}
+
+	return output, nil
+}
+
+// searchPattern wraps the search term the same way the API validation does.
+func searchPattern(input *dto.PaginatorInput) {
+	if input != nil && input.Search != "" && !strings.HasPrefix(input.Search, "%") {
+		input.Search = "%" + input.Search + "%"
+	}
+}
+
+func (l *Local) ListProducts(input *dto.GetAllProductsInput) (*dto.GetAllProductsOutput, error) {
+	searchPattern(input.PaginatorInput)
+
+	return result(l.getAllProducts.Execute(input))
+}
+
+func (l *Local) ListProductsByCategory(input *dto.GetAllProductsByCategoryIdInput) (*dto.GetAllProductsByCategoryIdOutput, error) {
+	searchPattern(input.PaginatorInput)
+
+	return result(l.getAllProductsByCategoryId.Execute(input))
+}
+
+func (l *Local) GetProductSpecifications(publicId types.ProductPublicID) (*dto.GetOneProductWithSpecificationsByPublicIdOutput, error) {
+	return result(l.getOneProductWithSpecificationsByPublicId.Execute(&dto.GetOneProductWithSpecificationsByPublicIdInput{
+		PublicID: publicId,
+	}))
+}
+
+func (l *Local) CompareProducts(input *dto.CompareProductsInput) (*dto.CompareProductsOutput, error) {
+	return result(l.compareProducts.Execute(input))
+}
+
+func (l *Local) ListCategories() (*dto.GetAllCategoriesOutput, error) {
+	return result(l.getAllCategories.Execute())
+}
+
+func (l *Local) CreateCategory(input *dto.CreateOneCategoryInput) (*dto.CreateOneCategoryOutput, error) {
+	input.Actor, input.ActorRole = l.actor, l.role
+	return result(l.createOneCategory.Execute(input))
+}
+
+func (l *Local) UpdateCategory(input *dto.UpdateOneCategoryInput) (*dto.UpdateOneCategoryOutput, error) {
+	input.Actor, input.ActorRole = l.actor, l.role
+	return result(l.updateOneCategory.Execute(input))
+}
+
+func (l *Local) DeleteCategory(publicId types.CategoryPublicID) (*dto.DeleteOneCategoryOutput, error) {
+	return result(l.deleteOneCategory.Execute(&dto.DeleteOneCategoryInput{PublicID: publicId, Actor: l.actor, ActorRole: l.role}))
+}
+
+func (l *Local) RestoreCategory(publicId types.CategoryPublicID) (*dto.RestoreOneCategoryOutput, error) {
+	return result(l.restoreOneCategory.Execute(&dto.RestoreOneCategoryInput{PublicID: publicId, Actor: l.actor, ActorRole: l.role}))
+}
+
+func (l *Local) ListSpecifications(input *dto.GetAllSpecificationsInput) (*dto.GetAllSpecificationsOutput, error) {
+	return result(l.getAllSpecifications.Execute(input))
+}
+
+func (l *Local) CreateSpecification(input *dto.CreateOneSpecificationInput) (*dto.CreateOneSpecificationOutput, error) {
+	input.Actor, input.ActorRole = l.actor, l.role
+	return result(l.createOneSpecification.Execute(input))
+}
+
+func (l *Local) UpdateSpecification(input *dto.UpdateOneSpecificationInput) (*dto.UpdateOneSpecificationOutput, error) {
+	input.Actor, input.ActorRole = l.actor, l.role
+	return result(l.updateOneSpecification.Execute(input))
+}
+
+func (l *Local) DeleteSpecification(publicId types.SpecificationPublicID) (*dto.DeleteOneSpecificationOutput, error) {
+	return result(l.deleteOneSpecification.Execute(&dto.DeleteOneSpecificationInput{PublicID: publicId, Actor: l.actor, ActorRole: l.role}))
+}
+
+func (l *Local) ListSpecificationGroups() (*dto.GetAllSpecificationGroupsOutput, error) {
+	return result(l.getAllSpecificationGroups.Execute())
+}
+
+func (l *Local) CreateSpecificationGroup(input *dto.CreateOneSpecificationGroupInput) (*dto.CreateOneSpecificationGroupOutput, error) {
+	input.Actor, input.ActorRole = l.actor, l.role
+	return result(l.createOneSpecificationGroup.Execute(input))
+}
+
+func (l *Local) UpdateSpecificationGroup(input *dto.UpdateOneSpecificationGroupInput) (*dto.UpdateOneSpecificationGroupOutput, error) {
+	input.Actor, input.ActorRole = l.actor, l.role
+	return result(l.updateOneSpecificationGroup.Execute(input))
+}
+
+func (l *Local) DeleteSpecificationGroup(publicId types.SpecificationGroupPublicID) (*dto.DeleteOneSpecificationGroupOutput, error) {
+	return result(l.deleteOneSpecificationGroup.Execute(&dto.DeleteOneSpecificationGroupInput{PublicID: publicId, Actor: l.actor, ActorRole: l.role}))
+}
+
+func (l *Local) CreateApiKey(input *dto.CreateOneApiKeyInput) (*dto.CreateOneApiKeyOutput, error) {
+	input.ActorRole = l.role
+	return result(l.createOneApiKey.Execute(input))
+}
+
+func (l *Local) ListApiKeys() (*dto.GetAllApiKeysOutput, error) {
+	return result(l.getAllApiKeys.Execute(&dto.GetAllApiKeysInput{ActorRole: l.role}))
+}
+
+func (l *Local) RevokeApiKey(publicId types.ApiKeyPublicID) (*dto.RevokeOneApiKeyOutput, error) {
+	return result(l.revokeOneApiKey.Execute(&dto.RevokeOneApiKeyInput{PublicID: publicId, ActorRole: l.role}))
+}
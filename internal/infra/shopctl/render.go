@@ -0,0 +1,451 @@
+package shopctl
+
+import (
+	"fmt"
+	"io"
+	"project/internal/application/dto"
+	"project/internal/domain/constants"
+	"project/internal/domain/services"
+	"project/internal/domain/types"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+type Format string
+
+const (
+	FormatTable    Format = "table"
+	FormatJSON     Format = "json"
+	FormatMarkdown Format = "markdown"
+)
+
+var Formats = []Format{FormatTable, FormatJSON, FormatMarkdown}
+
+func ParseFormat(value string) (Format, error) {
+	for _, format := range Formats {
+		if string(format) == value {
+			return format, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown output format %q, use table, json or markdown", value)
+}
+
+// Renderer writes use case outputs. JSON prints the output as the API would return
+// it in the data field; table and markdown print the same rows.
+type Renderer struct {
+	Format Format
+	Writer io.Writer
+}
+
+func NewRenderer(format Format, writer io.Writer) *Renderer {
+	return &Renderer{
+		Format: format,
+		Writer: writer,
+	}
+}
+
+type table struct {
+	title   string
+	headers []string
+	rows    [][]string
+}
+
+func (r *Renderer) render(output any, tables ...*table) error {
+	if r.Format == FormatJSON {
+		encoder := json.NewEncoder(r.Writer)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(output)
+	}
+
+	for i, t := range tables {
+		if i > 0 {
+			fmt.Fprintln(r.Writer)
+		}
+
+		var err error
+
+		if r.Format == FormatMarkdown {
+			err = r.markdown(t)
+		} else {
+			err = r.table(t)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Renderer) table(t *table) error {
+	if t.title != "" {
+		fmt.Fprintln(r.Writer, t.title)
+	}
+
+	writer := tabwriter.NewWriter(r.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(writer, strings.ToUpper(strings.Join(t.headers, "\t")))
+
+	for _, row := range t.rows {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+
+	return writer.Flush()
+}
+
+func (r *Renderer) markdown(t *table) error {
+	if t.title != "" {
+		fmt.Fprintf(r.Writer, "### %s\n\n", t.title)
+	}
+
+	separators := make([]string, len(t.headers))
+
+	for i := range separators {
+		separators[i] = "---"
+	}
+
+	fmt.Fprintf(r.Writer, "| %s |\n", strings.Join(t.headers, " | "))
+	fmt.Fprintf(r.Writer, "| %s |\n", strings.Join(separators, " | "))
+
+	for _, row := range t.rows {
+		cells := make([]string, len(row))
+
+		for i, cell := range row {
+			cells[i] = strings.ReplaceAll(cell, "|", `\|`)
+		}
+
+		fmt.Fprintf(r.Writer, "| %s |\n", strings.Join(cells, " | "))
+	}
+
+	return nil
+}
+
+func formatRating(rating int8) string {
+	return fmt.Sprintf("%.1f", float64(rating)/10)
+}
+
+func formatInsights(insights []*dto.InsightOutput) string {
+	messages := make([]string, 0, len(insights))
+
+	for _, insight := range insights {
+		mark := "-"
+
+		if insight.Neutral {
+			mark = "~"
+		} else if insight.Favorable {
+			mark = "+"
+		}
+
+		messages = append(messages, mark+" "+insight.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func formatValue(specType types.SpecificationType, stringValue string, intValue int64, boolValue bool) string {
+	switch specType {
+	case constants.SpecificationTypeInt:
+		return strconv.FormatInt(intValue, 10)
+	case constants.SpecificationTypeBool:
+		if boolValue {
+			return "yes"
+		}
+
+		return "no"
+	default:
+		return stringValue
+	}
+}
+
+func formatComparedValue(value *dto.SpecificationComparisonOutput) string {
+	switch {
+	case value == nil:
+		return ""
+	case value.StringValue != nil:
+		return *value.StringValue
+	case value.IntValue != nil:
+		return strconv.FormatInt(*value.IntValue, 10)
+	case value.BoolValue != nil:
+		return formatValue(constants.SpecificationTypeBool, "", 0, *value.BoolValue)
+	}
+
+	return ""
+}
+
+func (r *Renderer) Products(output *dto.GetAllProductsOutput) error {
+	rows := make([][]string, 0, len(output.Products))
+
+	for _, product := range output.Products {
+		rows = append(rows, []string{
+			string(product.PublicID), string(product.Name), services.FormatCentsToBRL(product.Price), formatRating(product.Rating),
+		})
+
+		rows = append(rows, variantRows(product.Variants)...)
+	}
+
+	return r.render(output, &table{
+		title:   fmt.Sprintf("%d of %d products", len(output.Products), output.PaginatorOutput.Total),
+		headers: []string{"public id", "name", "price", "rating"},
+		rows:    rows,
+	})
+}
+
+func (r *Renderer) ProductsByCategory(output *dto.GetAllProductsByCategoryIdOutput) error {
+	rows := make([][]string, 0, len(output.Products))
+
+	for _, product := range output.Products {
+		rows = append(rows, []string{
+			string(product.PublicID), string(product.Name), services.FormatCentsToBRL(product.Price), formatRating(product.Rating),
+		})
+
+		rows = append(rows, variantRows(product.Variants)...)
+	}
+
+	return r.render(output, &table{
+		title:   fmt.Sprintf("%d of %d products", len(output.Products), output.PaginatorOutput.Total),
+		headers: []string{"public id", "name", "price", "rating"},
+		rows:    rows,
+	})
+}
+
+// variantRows lists the variants right below their family.
+func variantRows(variants []*dto.ProductVariantOutput) [][]string {
+	rows := make([][]string, 0, len(variants))
+
+	for _, variant := range variants {
+		rows = append(rows, []string{
+			string(variant.PublicID), "  └ " + variant.VariantLabel, services.FormatCentsToBRL(variant.Price), formatRating(variant.Rating),
+		})
+	}
+
+	return rows
+}
+
+// productLabel is the product name followed by the variant label, if any.
+func productLabel(name types.ProductName, variantLabel string) string {
+	if variantLabel == "" {
+		return string(name)
+	}
+
+	return fmt.Sprintf("%s (%s)", name, variantLabel)
+}
+
+func (r *Renderer) ProductSpecifications(publicId types.ProductPublicID, output *dto.GetOneProductWithSpecificationsByPublicIdOutput) error {
+	fields := [][]string{
+		{"public id", string(publicId)},
+		{"price", services.FormatCentsToBRL(output.Price)},
+		{"rating", formatRating(output.Rating)},
+		{"reviews", strconv.FormatInt(output.ReviewCount, 10)},
+		{"description", output.Description},
+	}
+
+	if output.ParentPublicID != "" {
+		fields = append(fields, []string{"family", string(output.ParentPublicID)})
+	}
+
+	tables := []*table{{
+		title:   productLabel(output.Name, output.VariantLabel),
+		headers: []string{"field", "value"},
+		rows:    fields,
+	}}
+
+	for _, group := range output.SpecificationsGroups {
+		rows := make([][]string, 0, len(group.Specifications))
+
+		for _, spec := range group.Specifications {
+			value := formatValue(spec.Type, spec.StringValue, spec.IntValue, spec.BoolValue)
+
+			if spec.Inherited {
+				value += " (family)"
+			}
+
+			rows = append(rows, []string{spec.Title, value})
+		}
+
+		tables = append(tables, &table{
+			title:   group.Name,
+			headers: []string{"specification", "value"},
+			rows:    rows,
+		})
+	}
+
+	if len(output.Offers) > 0 {
+		rows := make([][]string, 0, len(output.Offers))
+
+		for _, offer := range output.Offers {
+			rows = append(rows, []string{
+				offer.RetailerName, services.FormatCentsToBRL(offer.Price), string(offer.Availability), strconv.FormatBool(offer.Current),
+			})
+		}
+
+		tables = append(tables, &table{
+			title:   "Offers",
+			headers: []string{"retailer", "price", "availability", "current"},
+			rows:    rows,
+		})
+	}
+
+	if len(output.Variants) > 0 {
+		tables = append(tables, &table{
+			title:   "Variants",
+			headers: []string{"public id", "variant", "price", "rating"},
+			rows:    variantRows(output.Variants),
+		})
+	}
+
+	return r.render(output, tables...)
+}
+
+// comparison is the comparison as shopctl prints it in JSON, the API sends the title
+// of a compared specification under "name".
+type comparison struct {
+	Left           *dto.ComparedProductOutput  `json:"left"`
+	Right          *dto.ComparedProductOutput  `json:"right"`
+	Price          *dto.PriceComparisonOutput  `json:"price"`
+	Rating         *dto.RatingComparisonOutput `json:"rating"`
+	Specifications []*comparedSpecification    `json:"specifications"`
+}
+
+type comparedSpecification struct {
+	PublicID types.SpecificationPublicID        `json:"public_id"`
+	Title    string                             `json:"title"`
+	Left     *dto.SpecificationComparisonOutput `json:"left"`
+	Right    *dto.SpecificationComparisonOutput `json:"right"`
+	Type     types.SpecificationType            `json:"type"`
+	Insights []*dto.InsightOutput               `json:"insights"`
+}
+
+func (r *Renderer) Compare(output *dto.CompareProductsOutput) error {
+	left, right := productLabel(output.Left.Name, output.Left.VariantLabel), productLabel(output.Right.Name, output.Right.VariantLabel)
+
+	rows := [][]string{
+		{"price", services.FormatCentsToBRL(output.Price.Left), services.FormatCentsToBRL(output.Price.Right), formatInsights(output.Price.Insights)},
+		{"rating", formatRating(output.Rating.Left), formatRating(output.Rating.Right), formatInsights(output.Rating.Insights)},
+		{"store", offerRetailer(output.Price.LeftOffer), offerRetailer(output.Price.RightOffer), ""},
+		{"reviews", strconv.FormatInt(output.Rating.LeftReviewCount, 10), strconv.FormatInt(output.Rating.RightReviewCount, 10), ""},
+	}
+
+	view := &comparison{
+		Left:           output.Left,
+		Right:          output.Right,
+		Price:          output.Price,
+		Rating:         output.Rating,
+		Specifications: make([]*comparedSpecification, 0, len(output.Specifications)),
+	}
+
+	for _, spec := range output.Specifications {
+		rows = append(rows, []string{
+			spec.Title, formatComparedValue(spec.Left), formatComparedValue(spec.Right), formatInsights(spec.Insights),
+		})
+
+		view.Specifications = append(view.Specifications, &comparedSpecification{
+			PublicID: spec.PublicID,
+			Title:    spec.Title,
+			Left:     spec.Left,
+			Right:    spec.Right,
+			Type:     spec.Type,
+			Insights: spec.Insights,
+		})
+	}
+
+	return r.render(view, &table{
+		title:   fmt.Sprintf("%s vs %s", left, right),
+		headers: []string{"", left, right, "insights"},
+		rows:    rows,
+	})
+}
+
+func (r *Renderer) Categories(output *dto.GetAllCategoriesOutput) error {
+	rows := make([][]string, 0, len(output.Categories))
+
+	for _, category := range output.Categories {
+		rows = append(rows, []string{string(category.PublicID), category.Name, category.Description})
+	}
+
+	return r.render(output, &table{
+		headers: []string{"public id", "name", "description"},
+		rows:    rows,
+	})
+}
+
+func (r *Renderer) Specifications(output *dto.GetAllSpecificationsOutput) error {
+	rows := make([][]string, 0, len(output.Specifications))
+
+	for _, spec := range output.Specifications {
+		rows = append(rows, []string{string(spec.PublicID), spec.Title, string(spec.Type)})
+	}
+
+	return r.render(output, &table{
+		headers: []string{"public id", "title", "type"},
+		rows:    rows,
+	})
+}
+
+func (r *Renderer) SpecificationGroups(output *dto.GetAllSpecificationGroupsOutput) error {
+	rows := make([][]string, 0, len(output.Groups))
+
+	for _, group := range output.Groups {
+		rows = append(rows, []string{string(group.PublicID), group.Name, group.Description})
+	}
+
+	return r.render(output, &table{
+		headers: []string{"public id", "name", "description"},
+		rows:    rows,
+	})
+}
+
+// ApiKeys lists the keys, never the keys themselves: only their hashes are kept.
+func (r *Renderer) ApiKeys(output *dto.GetAllApiKeysOutput) error {
+	rows := make([][]string, 0, len(output.ApiKeys))
+
+	for _, apiKey := range output.ApiKeys {
+		rows = append(rows, []string{
+			string(apiKey.PublicID),
+			apiKey.Name,
+			string(apiKey.Role),
+			apiKey.CreatedAt.Format(time.DateTime),
+			optionalTime(apiKey.LastUsedAt),
+			optionalTime(apiKey.RevokedAt),
+		})
+	}
+
+	return r.render(output, &table{
+		headers: []string{"public id", "name", "role", "created at", "last used at", "revoked at"},
+		rows:    rows,
+	})
+}
+
+// Result prints the output of a write command, a created public id or a message.
+func (r *Renderer) Result(output any, message string) error {
+	if r.Format == FormatJSON {
+		return r.render(output)
+	}
+
+	_, err := fmt.Fprintln(r.Writer, message)
+
+	return err
+}
+
+// offerRetailer names the store of the compared price, the listed price has no store.
+func offerRetailer(offer *dto.ProductOfferOutput) string {
+	if offer == nil {
+		return "-"
+	}
+
+	return offer.RetailerName
+}
+
+func optionalTime(value *time.Time) string {
+	if value == nil {
+		return "-"
+	}
+
+	return value.Format(time.DateTime)
+}
@@ -0,0 +1,103 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"project/internal/infra/config/environment"
+
+	"github.com/pressly/goose/v3"
+)
+
+//go:embed migrations/*.sql
+var embeddedMigrations embed.FS
+
+// Migrator runs the migrations embedded in the binary. It keeps goose's version
+// table, so databases migrated with the goose CLI are picked up as they are.
+type Migrator struct {
+	Provider *goose.Provider
+}
+
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	migrations, err := fs.Sub(embeddedMigrations, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := goose.NewProvider(goose.DialectSQLite3, db, migrations)
+	if err != nil {
+		return nil, fmt.Errorf("can't load the embedded migrations: %w", err)
+	}
+
+	return &Migrator{Provider: provider}, nil
+}
+
+// Up applies every pending migration.
+func (m *Migrator) Up() ([]*goose.MigrationResult, error) {
+	ctx := context.Background()
+
+	return m.Provider.Up(ctx)
+}
+
+// Down rolls back the last applied migration.
+func (m *Migrator) Down() (*goose.MigrationResult, error) {
+	ctx := context.Background()
+
+	return m.Provider.Down(ctx)
+}
+
+func (m *Migrator) Status() ([]*goose.MigrationStatus, error) {
+	ctx := context.Background()
+
+	return m.Provider.Status(ctx)
+}
+
+// Versions returns the database version and the last embedded migration.
+func (m *Migrator) Versions() (current int64, target int64, err error) {
+	ctx := context.Background()
+
+	return m.Provider.GetVersions(ctx)
+}
+
+// Check compares the database with the embedded migrations and applies the
+// configured policy when there are pending ones.
+func (m *Migrator) Check(policy string) error {
+	ctx := context.Background()
+
+	pending, err := m.Provider.HasPending(ctx)
+	if err != nil {
+		return fmt.Errorf("can't read the schema version: %w", err)
+	}
+
+	if !pending {
+		return nil
+	}
+
+	current, target, err := m.Versions()
+	if err != nil {
+		return fmt.Errorf("can't read the schema version: %w", err)
+	}
+
+	switch policy {
+	case environment.MigrationPolicyFail:
+		return fmt.Errorf("database schema is at version %d but this binary expects %d, run `migrate up` first", current, target)
+	case environment.MigrationPolicyWarn:
+		slog.Warn("database schema is behind this binary, run `migrate up`", slog.Int64("version", current), slog.Int64("expected_version", target))
+
+		return nil
+	}
+
+	results, err := m.Up()
+	if err != nil {
+		return fmt.Errorf("can't migrate the database from version %d to %d: %w", current, target, err)
+	}
+
+	for _, result := range results {
+		slog.Info("applied migration", slog.String("path", result.Source.Path), slog.Duration("duration", result.Duration))
+	}
+
+	return nil
+}
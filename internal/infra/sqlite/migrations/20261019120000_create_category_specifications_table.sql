@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS category_specifications (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    category_id INTEGER NOT NULL,
+    specification_id INTEGER NOT NULL,
+    requirement TEXT NOT NULL,
+    display_order INTEGER NOT NULL DEFAULT 0,
+    created_at TEXT NOT NULL DEFAULT (datetime('now')),
+    updated_at TEXT NOT NULL DEFAULT (datetime('now')),
+    UNIQUE (category_id, specification_id),
+    FOREIGN KEY (category_id) REFERENCES categories (id),
+    FOREIGN KEY (specification_id) REFERENCES specifications (id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS category_specifications;
@@ -0,0 +1,16 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN parent_id INTEGER REFERENCES products (id);
+ALTER TABLE products ADD COLUMN variant_label TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_products_parent_id ON products (parent_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_product_variant_label
+    ON products (parent_id, variant_label)
+    WHERE parent_id IS NOT NULL AND deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS unique_product_variant_label;
+DROP INDEX IF EXISTS idx_products_parent_id;
+
+ALTER TABLE products DROP COLUMN variant_label;
+ALTER TABLE products DROP COLUMN parent_id;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS product_reviews (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    public_id TEXT NOT NULL UNIQUE,
+    product_id INTEGER NOT NULL,
+    author TEXT NOT NULL,
+    stars INTEGER NOT NULL CHECK (stars BETWEEN 1 AND 5),
+    text TEXT NOT NULL DEFAULT '',
+    helpful_votes INTEGER NOT NULL DEFAULT 0,
+    status TEXT NOT NULL DEFAULT 'pending',
+    created_at TEXT NOT NULL DEFAULT (datetime('now')),
+    updated_at TEXT NOT NULL DEFAULT (datetime('now')),
+    FOREIGN KEY (product_id) REFERENCES products (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_reviews_product_id ON product_reviews (product_id, status);
+
+ALTER TABLE products ADD COLUMN review_count INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE products DROP COLUMN review_count;
+
+DROP INDEX IF EXISTS idx_product_reviews_product_id;
+DROP TABLE IF EXISTS product_reviews;
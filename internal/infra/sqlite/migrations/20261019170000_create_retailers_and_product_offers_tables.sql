@@ -0,0 +1,36 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS retailers (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    public_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    website_url TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL DEFAULT (datetime('now')),
+    updated_at TEXT NOT NULL DEFAULT (datetime('now')),
+    deleted_at TEXT,
+    CONSTRAINT unique_public_id
+        UNIQUE (public_id)
+);
+
+CREATE TABLE IF NOT EXISTS product_offers (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    public_id TEXT NOT NULL UNIQUE,
+    product_id INTEGER NOT NULL,
+    retailer_id INTEGER NOT NULL,
+    price INTEGER NOT NULL CHECK (price > 0),
+    url TEXT NOT NULL,
+    availability TEXT NOT NULL DEFAULT 'in_stock',
+    last_seen_at TEXT NOT NULL,
+    created_at TEXT NOT NULL DEFAULT (datetime('now')),
+    updated_at TEXT NOT NULL DEFAULT (datetime('now')),
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    FOREIGN KEY (retailer_id) REFERENCES retailers (id),
+    CONSTRAINT unique_product_retailer
+        UNIQUE (product_id, retailer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_offers_retailer_id ON product_offers (retailer_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_product_offers_retailer_id;
+DROP TABLE IF EXISTS product_offers;
+DROP TABLE IF EXISTS retailers;
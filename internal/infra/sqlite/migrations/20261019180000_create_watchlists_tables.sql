@@ -0,0 +1,42 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS watchlists (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    public_id TEXT NOT NULL UNIQUE,
+    product_id INTEGER NOT NULL,
+    subscriber_token TEXT NOT NULL,
+    target_price INTEGER NOT NULL DEFAULT 0,
+    drop_percent INTEGER NOT NULL DEFAULT 0,
+    base_price INTEGER NOT NULL,
+    last_notified_price INTEGER NOT NULL DEFAULT 0,
+    created_at TEXT NOT NULL DEFAULT (datetime('now')),
+    updated_at TEXT NOT NULL DEFAULT (datetime('now')),
+    deleted_at TEXT,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    CHECK ((target_price > 0) <> (drop_percent > 0))
+);
+
+CREATE INDEX IF NOT EXISTS idx_watchlists_product_id ON watchlists (product_id);
+CREATE INDEX IF NOT EXISTS idx_watchlists_subscriber_token ON watchlists (subscriber_token);
+
+CREATE TABLE IF NOT EXISTS watchlist_deliveries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    watchlist_id INTEGER NOT NULL,
+    trigger TEXT NOT NULL,
+    price INTEGER NOT NULL,
+    threshold_price INTEGER NOT NULL,
+    status TEXT NOT NULL,
+    attempts INTEGER NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL DEFAULT (datetime('now')),
+    FOREIGN KEY (watchlist_id) REFERENCES watchlists (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_watchlist_deliveries_watchlist_id ON watchlist_deliveries (watchlist_id, status);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_watchlist_deliveries_watchlist_id;
+DROP TABLE IF EXISTS watchlist_deliveries;
+DROP INDEX IF EXISTS idx_watchlists_subscriber_token;
+DROP INDEX IF EXISTS idx_watchlists_product_id;
+DROP TABLE IF EXISTS watchlists;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE product_specifications ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE product_specifications DROP COLUMN version;
+ALTER TABLE products DROP COLUMN version;
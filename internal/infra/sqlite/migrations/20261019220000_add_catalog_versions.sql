@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE categories ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE specification_groups ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE specifications ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE specifications DROP COLUMN version;
+ALTER TABLE specification_groups DROP COLUMN version;
+ALTER TABLE categories DROP COLUMN version;
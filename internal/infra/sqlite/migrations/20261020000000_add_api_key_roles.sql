@@ -0,0 +1,6 @@
+-- +goose Up
+-- the keys created before the roles could make every write
+ALTER TABLE api_keys ADD COLUMN role TEXT NOT NULL DEFAULT 'catalog-admin';
+
+-- +goose Down
+ALTER TABLE api_keys DROP COLUMN role;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- updated_at is in unix seconds with the fraction, the refill is computed in SQL
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key TEXT PRIMARY KEY,
+    tokens REAL NOT NULL,
+    capacity REAL NOT NULL,
+    refill_rate REAL NOT NULL,
+    taken INTEGER NOT NULL,
+    updated_at REAL NOT NULL
+) WITHOUT ROWID;
+
+-- +goose Down
+DROP TABLE IF EXISTS rate_limit_buckets;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- a sending delivery is claimed by one dispatcher until locked_until
+ALTER TABLE webhook_deliveries ADD COLUMN locked_until TEXT;
+
+-- +goose Down
+ALTER TABLE webhook_deliveries DROP COLUMN locked_until;
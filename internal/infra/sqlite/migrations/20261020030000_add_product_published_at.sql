@@ -0,0 +1,7 @@
+-- +goose Up
+-- a product is a draft until it is published, it can only be once its category
+-- template has no required specification left without a value
+ALTER TABLE products ADD COLUMN published_at TEXT;
+
+-- +goose Down
+ALTER TABLE products DROP COLUMN published_at;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- a watched product whose price an update changed, written with the update and taken
+-- by the watchlist check, which sends the alerts out of the request
+CREATE TABLE IF NOT EXISTS watchlist_price_checks (
+    product_id INTEGER PRIMARY KEY,
+    created_at TEXT NOT NULL DEFAULT (datetime('now')),
+    FOREIGN KEY (product_id) REFERENCES products (id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS watchlist_price_checks;
@@ -0,0 +1,58 @@
+-- name: GetOneApiKeyByPublicID :one
+SELECT
+    k.id,
+    k.public_id,
+    k.name,
+    k.key_hash,
+    k.role,
+    k.created_at,
+    k.last_used_at,
+    k.revoked_at
+FROM api_keys k
+WHERE
+    k.public_id = ?
+LIMIT 1;
+
+-- name: GetAllApiKeys :many
+SELECT
+    k.id,
+    k.public_id,
+    k.name,
+    k.key_hash,
+    k.role,
+    k.created_at,
+    k.last_used_at,
+    k.revoked_at
+FROM api_keys k
+ORDER BY
+    k.id;
+
+-- name: CreateOneApiKey :execresult
+INSERT INTO api_keys (
+    public_id,
+    name,
+    key_hash,
+    role
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: RevokeOneApiKey :exec
+UPDATE api_keys
+SET
+    revoked_at = (datetime('now'))
+WHERE
+    id = ?
+    AND revoked_at IS NULL;
+
+-- name: TouchOneApiKey :exec
+-- written at most once a minute, not on every request made with the key
+UPDATE api_keys
+SET
+    last_used_at = (datetime('now'))
+WHERE
+    id = ?
+    AND (last_used_at IS NULL OR last_used_at < datetime('now', '-1 minute'));
@@ -0,0 +1,54 @@
+-- name: CreateOneAuditEntry :exec
+INSERT INTO audit_log (
+    actor,
+    entity_type,
+    entity_public_id,
+    operation,
+    changes
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: GetAllAuditEntries :many
+SELECT
+    a.id,
+    a.actor,
+    a.entity_type,
+    a.entity_public_id,
+    a.operation,
+    a.changes,
+    a.created_at,
+    COUNT(a.id)      OVER () AS entries_quantity
+FROM audit_log a
+WHERE
+    (
+		sqlc.narg ('actor') IS NULL
+		OR a.actor = sqlc.narg ('actor')
+	)
+    AND (
+		sqlc.narg ('entity_type') IS NULL
+		OR a.entity_type = sqlc.narg ('entity_type')
+	)
+    AND (
+		sqlc.narg ('entity_public_id') IS NULL
+		OR a.entity_public_id = sqlc.narg ('entity_public_id')
+	)
+    AND (
+		sqlc.narg ('operation') IS NULL
+		OR a.operation = sqlc.narg ('operation')
+	)
+    AND (
+		sqlc.narg ('from') IS NULL
+		OR a.created_at >= sqlc.narg ('from')
+	)
+    AND (
+		sqlc.narg ('to') IS NULL
+		OR a.created_at <= sqlc.narg ('to')
+	)
+ORDER BY
+    a.id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
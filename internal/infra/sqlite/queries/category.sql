@@ -18,4 +18,87 @@ SELECT
     c.description
 FROM categories c
 WHERE 
-    c.deleted_at IS NULL;   
\ No newline at end of file
+    c.deleted_at IS NULL;   
+
+-- name: GetOneDeletedCategoryByPublicID :one
+SELECT 
+    c.id,
+    c.public_id,
+    c.name,
+    c.description
+FROM categories c
+WHERE 
+    c.public_id = ?
+    AND c.deleted_at IS NOT NULL
+LIMIT 1;
+
+-- name: CheckIfCategoryExists :one
+SELECT
+    c.id 
+FROM categories c
+WHERE
+    c.name = ?
+    AND c.deleted_at IS NULL
+    AND (
+		sqlc.narg ('public_id') IS NULL
+		OR c.public_id != sqlc.narg ('public_id')
+	)
+LIMIT
+	1;
+
+-- name: CountProductsByCategoryId :one
+SELECT
+    COUNT(p.id) AS products_quantity
+FROM products p
+WHERE
+    p.category_id = ?
+    AND p.deleted_at IS NULL;
+
+-- name: CreateOneCategory :execresult
+INSERT INTO categories (
+    public_id,
+    name,
+    description
+) VALUES (
+    ?,
+    ?,
+    ?
+);
+
+-- name: UpdateOneCategory :exec
+UPDATE categories
+SET
+    name = ?,
+    description = ?,
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: DeleteOneCategory :exec
+UPDATE categories
+SET
+    deleted_at = (datetime('now')),
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: RestoreOneCategory :exec
+UPDATE categories
+SET
+    deleted_at = NULL,
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: GetCategoriesRevision :many
+-- the deleted categories are read too, a delete or a restore has to change the revision
+SELECT
+    c.id,
+    c.version,
+    COALESCE(c.deleted_at, c.updated_at) AS changed_at
+FROM categories c
+ORDER BY
+    c.id;
@@ -0,0 +1,37 @@
+-- name: GetAllCategorySpecificationsByCategoryID :many
+SELECT
+    cs.id,
+    cs.category_id,
+    cs.specification_id,
+    cs.requirement,
+    cs.display_order,
+    s.public_id,
+    s.title,
+    s.type,
+    s.specification_group_id
+FROM category_specifications cs
+INNER JOIN specifications s ON s.id = cs.specification_id
+WHERE
+    cs.category_id = ?
+    AND s.deleted_at IS NULL
+ORDER BY
+    cs.display_order ASC,
+    cs.id ASC;
+
+-- name: CreateOneCategorySpecification :execresult
+INSERT INTO category_specifications (
+    category_id,
+    specification_id,
+    requirement,
+    display_order
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: DeleteAllCategorySpecificationsByCategoryID :exec
+DELETE FROM category_specifications
+WHERE
+    category_id = ?;
@@ -0,0 +1,24 @@
+-- name: GetOneExternalReferenceBySourceAndExternalID :one
+SELECT
+    er.id,
+    er.source,
+    er.external_id,
+    er.product_id,
+    p.public_id AS product_public_id
+FROM external_references er
+INNER JOIN products p ON p.id = er.product_id
+WHERE
+    er.source = ?
+    AND er.external_id = ?
+LIMIT 1;
+
+-- name: CreateOneExternalReference :execresult
+INSERT INTO external_references (
+    source,
+    external_id,
+    product_id
+) VALUES (
+    ?,
+    ?,
+    ?
+);
@@ -0,0 +1,50 @@
+-- name: CreateOneOutboxEvent :exec
+INSERT INTO outbox_events (
+    event_type,
+    aggregate_type,
+    aggregate_public_id,
+    payload
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: GetAllUndispatchedOutboxEvents :many
+SELECT
+    e.id,
+    e.event_type,
+    e.aggregate_type,
+    e.aggregate_public_id,
+    e.payload,
+    e.created_at
+FROM outbox_events e
+WHERE
+    e.dispatched_at IS NULL
+ORDER BY
+    e.id
+LIMIT ?;
+
+-- name: GetAllOutboxEventsSince :many
+SELECT
+    e.id,
+    e.event_type,
+    e.aggregate_type,
+    e.aggregate_public_id,
+    e.payload,
+    e.created_at
+FROM outbox_events e
+WHERE
+    e.created_at >= ?
+ORDER BY
+    e.id;
+
+-- name: MarkOutboxEventDispatched :execresult
+-- no row is updated when another dispatcher marked the event first
+UPDATE outbox_events
+SET
+    dispatched_at = (datetime('now'))
+WHERE
+    id = ?
+    AND dispatched_at IS NULL;
@@ -6,13 +6,28 @@ SELECT
     p.description,
     p.price,
     p.rating,
+    p.review_count,
     p.image_url,
     COUNT(p.id)       OVER () AS products_quantity 
 FROM products p
 WHERE 
-    p.category_id = ?
+    p.category_id = sqlc.arg(category_id)
     AND p.deleted_at IS NULL
-LIMIT ? OFFSET ?;
+    AND p.parent_id IS NULL
+    AND (
+        sqlc.narg(search) IS NULL
+        OR p.name LIKE sqlc.narg(search)
+        OR p.description LIKE sqlc.narg(search)
+        OR EXISTS (
+            SELECT 1
+            FROM products v
+            WHERE
+                v.parent_id = p.id
+                AND v.deleted_at IS NULL
+                AND (v.name LIKE sqlc.narg(search) OR v.variant_label LIKE sqlc.narg(search))
+        )
+    )
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
 
 -- name: GetAllProducts :many
 SELECT
@@ -22,13 +37,28 @@ SELECT
     p.description,
     p.price,
     p.rating,
+    p.review_count,
     p.image_url,
     p.category_id,
     COUNT(p.id)       OVER () AS products_quantity 
 FROM products p
 WHERE 
     p.deleted_at IS NULL
-LIMIT ? OFFSET ?;
+    AND p.parent_id IS NULL
+    AND (
+        sqlc.narg(search) IS NULL
+        OR p.name LIKE sqlc.narg(search)
+        OR p.description LIKE sqlc.narg(search)
+        OR EXISTS (
+            SELECT 1
+            FROM products v
+            WHERE
+                v.parent_id = p.id
+                AND v.deleted_at IS NULL
+                AND (v.name LIKE sqlc.narg(search) OR v.variant_label LIKE sqlc.narg(search))
+        )
+    )
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
 
 -- name: GetOneProductByPublicId :one
 SELECT
@@ -36,16 +66,49 @@ SELECT
     p.public_id,
     p.name,
     p.category_id,
+    c.public_id AS category_public_id,
     p.description,
     p.price,
     p.rating,
-    p.image_url
+    p.review_count,
+    p.image_url,
+    p.parent_id,
+    pp.public_id AS parent_public_id,
+    p.variant_label,
+    p.version,
+    p.published_at
 FROM products p
+INNER JOIN categories c ON c.id = p.category_id
+LEFT JOIN products pp ON pp.id = p.parent_id
 WHERE 
     p.public_id = ?
     AND p.deleted_at IS NULL
 LIMIT 1;
 
+-- name: GetOneDeletedProductByPublicId :one
+SELECT
+    p.id,
+    p.public_id,
+    p.name,
+    p.category_id,
+    c.public_id AS category_public_id,
+    p.description,
+    p.price,
+    p.rating,
+    p.review_count,
+    p.image_url,
+    p.parent_id,
+    pp.public_id AS parent_public_id,
+    p.variant_label,
+    p.version
+FROM products p
+INNER JOIN categories c ON c.id = p.category_id
+LEFT JOIN products pp ON pp.id = p.parent_id
+WHERE 
+    p.public_id = ?
+    AND p.deleted_at IS NOT NULL
+LIMIT 1;
+
 -- name: CreateOneProduct :execresult
 INSERT INTO products (
     public_id,
@@ -54,7 +117,9 @@ INSERT INTO products (
     price,
     rating,
     image_url,
-    category_id
+    category_id,
+    parent_id,
+    variant_label
 ) VALUES (
     ?,
     ?,
@@ -62,33 +127,99 @@ INSERT INTO products (
     ?,
     ?,
     ?,
+    ?,
+    ?,
     ?
 );
 
--- name: UpdateOneProduct :exec
+-- name: UpdateOneProduct :execresult
+-- no row is updated when the product changed since it was read. The category is
+-- written too, a product can be moved to another one.
 UPDATE products
 SET
+    category_id = ?,
     name = ?,
     description = ?,
     price = ?,
     rating = ?,
-    image_url = ?
+    image_url = ?,
+    variant_label = ?,
+    version = version + 1,
+    updated_at = (datetime('now'))
 WHERE
-    id = ?;
+    id = ?
+    AND version = ?;
+
+-- name: UpdateProductVariantsCategory :exec
+UPDATE products
+SET
+    category_id = ?,
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    parent_id = ?
+    AND deleted_at IS NULL;
+
+-- name: PublishOneProduct :execresult
+-- no row is published when the product changed since it was read
+UPDATE products
+SET
+    published_at = (datetime('now')),
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?
+    AND version = ?
+    AND deleted_at IS NULL;
+
+-- name: DeleteProductVariants :exec
+UPDATE products
+SET
+    deleted_at = (datetime('now'))
+WHERE
+    parent_id = ?
+    AND deleted_at IS NULL;
 
--- name: DeleteOneProduct :exec
+-- name: DeleteOneProduct :execresult
+-- no row is deleted when the product changed since it was read
 UPDATE products
 SET
     deleted_at = (datetime('now'))
+WHERE
+    id = ?
+    AND version = ?
+    AND deleted_at IS NULL;
+
+-- name: RestoreOneProduct :exec
+UPDATE products
+SET
+    deleted_at = NULL,
+    updated_at = (datetime('now'))
 WHERE
     id = ?;
 
+-- name: RestoreProductVariants :exec
+-- the variants deleted with their family were deleted at the same time or after it,
+-- no variant can be deleted once its family is
+UPDATE products
+SET
+    deleted_at = NULL,
+    updated_at = (datetime('now'))
+WHERE
+    parent_id = sqlc.arg(parent_id)
+    AND deleted_at >= (
+        SELECT pp.deleted_at
+        FROM products pp
+        WHERE pp.id = sqlc.arg(parent_id)
+    );
+
 -- name: CheckIfProductExists :one
 SELECT
     p.id 
 FROM products p
 WHERE
     p.name = ?
+    AND p.parent_id IS NULL
     AND p.deleted_at IS NULL
     AND (
 		sqlc.narg ('public_id') IS NULL
@@ -105,8 +236,12 @@ SELECT
     p.description AS product_description,
     p.price AS product_price,
     p.rating AS product_rating,
+    p.review_count AS product_review_count,
     p.image_url AS product_image_url,
     p.category_id AS product_category_id,
+    p.parent_id AS product_parent_id,
+    pp.public_id AS product_parent_public_id,
+    p.variant_label AS product_variant_label,
     sg.id AS specification_group_id,
     sg.public_id AS specification_group_public_id,
     sg.name AS specification_group_name,
@@ -117,13 +252,165 @@ SELECT
     s.type AS specification_type,
     ps.string_value AS specification_string_value,
     ps.int_value AS specification_int_value,
-    ps.bool_value AS specification_bool_value
-FROM product_specifications ps
-INNER JOIN products p ON p.id = ps.product_id 
+    ps.bool_value AS specification_bool_value,
+    CAST(ps.product_id != p.id AS INTEGER) AS specification_inherited,
+    ps.version AS specification_version
+FROM products p
+LEFT JOIN products pp ON pp.id = p.parent_id
+-- a variant reads the values of its family too, unless it overrides them
+INNER JOIN product_specifications ps ON ps.product_id = p.id OR ps.product_id = p.parent_id
 INNER JOIN specifications s ON ps.specification_id = s.id
 INNER JOIN specification_groups sg ON s.specification_group_id = sg.id
 WHERE 
 	p.public_id = ?
 	AND p.deleted_at IS NULL
 	AND sg.deleted_at IS NULL
-	AND s.deleted_at IS NULL;
\ No newline at end of file
+	AND s.deleted_at IS NULL
+	AND (
+		ps.product_id = p.id
+		OR NOT EXISTS (
+			SELECT 1
+			FROM product_specifications own
+			WHERE own.product_id = p.id AND own.specification_id = ps.specification_id
+		)
+	);
+
+-- name: GetProductVariantsByParentIDs :many
+SELECT
+    p.id,
+    p.public_id,
+    p.name,
+    p.description,
+    p.price,
+    p.rating,
+    p.review_count,
+    p.image_url,
+    p.category_id,
+    p.parent_id,
+    pp.public_id AS parent_public_id,
+    p.variant_label
+FROM products p
+INNER JOIN products pp ON pp.id = p.parent_id
+WHERE
+    p.parent_id IN (SELECT value FROM json_each(sqlc.arg(parent_ids)))
+    AND p.deleted_at IS NULL
+ORDER BY
+    p.parent_id,
+    p.id;
+
+-- name: GetProductsAfterID :many
+SELECT
+    p.id,
+    p.public_id,
+    p.name,
+    p.description,
+    p.price,
+    p.rating,
+    p.image_url,
+    p.category_id
+FROM products p
+WHERE
+    p.deleted_at IS NULL
+    AND p.id > sqlc.arg ('after_id')
+    AND (
+        sqlc.narg ('category_id') IS NULL
+        OR p.category_id = sqlc.narg ('category_id')
+    )
+ORDER BY
+    p.id
+LIMIT sqlc.arg ('limit') OFFSET sqlc.arg ('offset');
+
+-- name: GetProductPublicIdById :one
+SELECT
+    p.public_id
+FROM products p
+WHERE
+    p.id = ?
+LIMIT 1;
+
+-- name: TouchOneProduct :exec
+-- the specification values and the offers are read with the product, writing
+-- them moves its Last-Modified
+UPDATE products
+SET
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: GetProductRevisionParts :many
+-- every row the product is read from: the product with its family and variants,
+-- the specification values with their specifications and groups, and the offers.
+-- The deleted rows are read too, a delete or a restore has to change the revision
+WITH target AS (
+    SELECT
+        p.id,
+        p.parent_id
+    FROM products p
+    WHERE
+        p.public_id = sqlc.arg(public_id)
+        AND p.deleted_at IS NULL
+),
+family_values AS (
+    SELECT
+        ps.id,
+        ps.specification_id,
+        ps.version
+    FROM product_specifications ps
+    INNER JOIN target t ON ps.product_id = t.id OR ps.product_id = t.parent_id
+)
+SELECT
+    'product' AS part,
+    p.id,
+    p.version,
+    '' AS state,
+    COALESCE(p.deleted_at, p.updated_at) AS changed_at
+FROM products p
+INNER JOIN target t ON p.id = t.id OR p.id = t.parent_id OR p.parent_id = t.id
+UNION ALL
+SELECT
+    'product_specification' AS part,
+    fv.id,
+    fv.version,
+    '' AS state,
+    '' AS changed_at
+FROM family_values fv
+UNION ALL
+SELECT
+    'specification' AS part,
+    s.id,
+    s.version,
+    '' AS state,
+    COALESCE(s.deleted_at, s.updated_at) AS changed_at
+FROM specifications s
+WHERE
+    s.id IN (SELECT fv.specification_id FROM family_values fv)
+UNION ALL
+SELECT
+    'specification_group' AS part,
+    sg.id,
+    sg.version,
+    '' AS state,
+    COALESCE(sg.deleted_at, sg.updated_at) AS changed_at
+FROM specification_groups sg
+WHERE
+    sg.id IN (
+        SELECT s.specification_group_id
+        FROM specifications s
+        WHERE s.id IN (SELECT fv.specification_id FROM family_values fv)
+    )
+UNION ALL
+-- the offers have no version, what is shown of them is the state, a current offer
+-- goes stale with no write so it is part of it too
+SELECT
+    'product_offer' AS part,
+    po.id,
+    0 AS version,
+    po.price || '|' || po.url || '|' || po.availability || '|' || po.last_seen_at || '|'
+        || (po.last_seen_at >= sqlc.arg(current_since)) || '|' || r.name || '|' || COALESCE(r.deleted_at, '') AS state,
+    MAX(po.updated_at, r.updated_at, COALESCE(r.deleted_at, '')) AS changed_at
+FROM product_offers po
+INNER JOIN retailers r ON r.id = po.retailer_id
+INNER JOIN target t ON po.product_id = t.id
+ORDER BY
+    part,
+    id;
@@ -0,0 +1,58 @@
+-- name: GetAllProductImagesByProductID :many
+SELECT
+    pi.id,
+    pi.public_id,
+    pi.product_id,
+    pi.position,
+    pi.alt_text,
+    pi.content_type,
+    pi.size,
+    pi.width,
+    pi.height,
+    pi.storage_key,
+    pi.thumbnail_key
+FROM product_images pi
+WHERE
+    pi.product_id = ?
+ORDER BY
+    pi.position,
+    pi.id;
+
+-- name: CreateOneProductImage :execresult
+INSERT INTO product_images (
+    public_id,
+    product_id,
+    position,
+    alt_text,
+    content_type,
+    size,
+    width,
+    height,
+    storage_key,
+    thumbnail_key
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: UpdateOneProductImage :exec
+UPDATE product_images
+SET
+    position = ?,
+    alt_text = ?,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: DeleteOneProductImage :exec
+DELETE FROM product_images
+WHERE
+    id = ?;
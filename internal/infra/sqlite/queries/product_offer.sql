@@ -0,0 +1,75 @@
+-- name: GetProductOffersByProductIDs :many
+SELECT
+    po.id,
+    po.public_id,
+    po.product_id,
+    po.retailer_id,
+    r.public_id AS retailer_public_id,
+    r.name AS retailer_name,
+    po.price,
+    po.url,
+    po.availability,
+    po.last_seen_at
+FROM product_offers po
+INNER JOIN retailers r ON r.id = po.retailer_id
+WHERE
+    po.product_id IN (SELECT value FROM json_each(sqlc.arg(product_ids)))
+    AND r.deleted_at IS NULL
+ORDER BY
+    po.product_id,
+    po.price,
+    po.id;
+
+-- name: GetOneProductOfferByRetailerID :one
+SELECT
+    po.id,
+    po.public_id,
+    po.product_id,
+    po.retailer_id,
+    r.public_id AS retailer_public_id,
+    r.name AS retailer_name,
+    po.price,
+    po.url,
+    po.availability,
+    po.last_seen_at
+FROM product_offers po
+INNER JOIN retailers r ON r.id = po.retailer_id
+WHERE
+    po.product_id = ?
+    AND po.retailer_id = ?
+LIMIT 1;
+
+-- name: CreateOneProductOffer :execresult
+INSERT INTO product_offers (
+    public_id,
+    product_id,
+    retailer_id,
+    price,
+    url,
+    availability,
+    last_seen_at
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: UpdateOneProductOffer :exec
+UPDATE product_offers
+SET
+    price = ?,
+    url = ?,
+    availability = ?,
+    last_seen_at = ?,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: DeleteOneProductOffer :exec
+DELETE FROM product_offers
+WHERE
+    id = ?;
@@ -0,0 +1,93 @@
+-- name: GetAllProductReviewsByProductID :many
+SELECT
+    pr.id,
+    pr.public_id,
+    pr.product_id,
+    pr.author,
+    pr.stars,
+    pr.text,
+    pr.helpful_votes,
+    pr.status,
+    pr.created_at,
+    COUNT(pr.id)      OVER () AS reviews_quantity
+FROM product_reviews pr
+WHERE
+    pr.product_id = sqlc.arg(product_id)
+    AND pr.status = sqlc.arg(status)
+ORDER BY
+    pr.helpful_votes DESC,
+    pr.created_at DESC,
+    pr.id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: GetOneProductReviewByPublicID :one
+SELECT
+    pr.id,
+    pr.public_id,
+    pr.product_id,
+    pr.author,
+    pr.stars,
+    pr.text,
+    pr.helpful_votes,
+    pr.status,
+    pr.created_at
+FROM product_reviews pr
+WHERE
+    pr.product_id = ?
+    AND pr.public_id = ?
+LIMIT 1;
+
+-- name: CreateOneProductReview :execresult
+INSERT INTO product_reviews (
+    public_id,
+    product_id,
+    author,
+    stars,
+    text,
+    status
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: UpdateProductReviewStatus :exec
+UPDATE product_reviews
+SET
+    status = ?,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: AddProductReviewHelpfulVote :one
+UPDATE product_reviews
+SET
+    helpful_votes = helpful_votes + 1
+WHERE
+    id = ?
+    AND status = 'approved'
+RETURNING helpful_votes;
+
+-- name: GetProductReviewDistribution :many
+SELECT
+    pr.stars,
+    COUNT(pr.id) AS reviews_quantity
+FROM product_reviews pr
+WHERE
+    pr.product_id = ?
+    AND pr.status = 'approved'
+GROUP BY
+    pr.stars;
+
+-- name: UpdateProductRatingSummary :exec
+UPDATE products
+SET
+    rating = ?,
+    review_count = ?,
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
@@ -26,4 +26,97 @@ FROM product_specifications ps
 INNER JOIN specifications s ON s.id = ps.specification_id
 WHERE 
     ps.product_id = ?
-    AND s.deleted_at IS NULL;
\ No newline at end of file
+    AND s.deleted_at IS NULL;
+
+-- name: GetAllProductSpecificationValuesBySpecificationID :many
+SELECT 
+    ps.id,
+    ps.product_id,
+    ps.specification_id,
+    ps.string_value,
+    ps.int_value,
+    ps.bool_value,
+    s.type,
+    ps.version
+FROM product_specifications ps
+INNER JOIN specifications s ON s.id = ps.specification_id
+WHERE 
+    ps.specification_id = ?;
+
+-- name: UpdateOneProductSpecificationValue :execresult
+-- no row is updated when the value changed since it was read
+UPDATE product_specifications
+SET
+    string_value = ?,
+    int_value = ?,
+    bool_value = ?,
+    version = version + 1
+WHERE
+    id = ?
+    AND version = ?;
+
+-- name: GetOneProductSpecificationValueByProductIDAndSpecificationID :one
+SELECT 
+    ps.id,
+    ps.product_id,
+    ps.specification_id,
+    ps.string_value,
+    ps.int_value,
+    ps.bool_value,
+    s.type,
+    ps.version
+FROM product_specifications ps
+INNER JOIN specifications s ON s.id = ps.specification_id
+WHERE 
+    ps.product_id = ?
+    AND ps.specification_id = ?
+    AND s.deleted_at IS NULL;
+
+-- name: UpsertOneProductSpecificationValue :one
+INSERT INTO product_specifications (
+    product_id,
+    specification_id,
+    string_value,
+    int_value,
+    bool_value
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+)
+ON CONFLICT (product_id, specification_id) DO UPDATE SET
+    string_value = excluded.string_value,
+    int_value = excluded.int_value,
+    bool_value = excluded.bool_value,
+    version = product_specifications.version + 1
+RETURNING id, version;
+
+-- name: DeleteOneProductSpecificationValue :exec
+DELETE FROM product_specifications
+WHERE
+    id = ?;
+
+-- name: DeleteAllProductSpecificationValuesByProductID :exec
+DELETE FROM product_specifications
+WHERE
+    product_id = ?;
+
+-- name: GetAllProductSpecificationValuesByProductIDs :many
+SELECT
+    ps.id,
+    ps.product_id,
+    ps.specification_id,
+    ps.string_value,
+    ps.int_value,
+    ps.bool_value,
+    s.type
+FROM product_specifications ps
+INNER JOIN specifications s ON s.id = ps.specification_id
+WHERE
+    ps.product_id IN (sqlc.slice ('product_ids'))
+    AND s.deleted_at IS NULL
+ORDER BY
+    ps.product_id,
+    ps.specification_id;
@@ -0,0 +1,32 @@
+-- name: TakeRateLimitToken :one
+-- the refill and the spend are one statement, so the prefork workers share a bucket
+-- without losing an update. The SET expressions read the row from before the update
+INSERT INTO rate_limit_buckets (
+    key,
+    tokens,
+    capacity,
+    refill_rate,
+    taken,
+    updated_at
+) VALUES (?, ?, ?, ?, 1, ?)
+ON CONFLICT (key) DO UPDATE
+SET
+    tokens = MIN(excluded.capacity, tokens + MAX(0, excluded.updated_at - updated_at) * excluded.refill_rate)
+        - (MIN(excluded.capacity, tokens + MAX(0, excluded.updated_at - updated_at) * excluded.refill_rate) >= 1),
+    taken = MIN(excluded.capacity, tokens + MAX(0, excluded.updated_at - updated_at) * excluded.refill_rate) >= 1,
+    capacity = excluded.capacity,
+    refill_rate = excluded.refill_rate,
+    updated_at = MAX(updated_at, excluded.updated_at)
+RETURNING
+    key,
+    tokens,
+    capacity,
+    refill_rate,
+    taken,
+    updated_at;
+
+-- name: DeleteFullRateLimitBuckets :execresult
+-- a bucket left alone for as long as it takes to refill is full, the same as none
+DELETE FROM rate_limit_buckets
+WHERE
+    updated_at + (capacity - tokens) / refill_rate <= ?;
@@ -0,0 +1,64 @@
+-- name: GetOneRetailerByPublicID :one
+SELECT
+    r.id,
+    r.public_id,
+    r.name,
+    r.website_url
+FROM retailers r
+WHERE
+    r.public_id = ?
+    AND r.deleted_at IS NULL
+LIMIT 1;
+
+-- name: GetAllRetailers :many
+SELECT
+    r.id,
+    r.public_id,
+    r.name,
+    r.website_url
+FROM retailers r
+WHERE
+    r.deleted_at IS NULL
+ORDER BY
+    r.name;
+
+-- name: CheckIfRetailerExists :one
+SELECT
+    r.id
+FROM retailers r
+WHERE
+    r.name = ?
+    AND r.deleted_at IS NULL
+    AND (
+		sqlc.narg ('public_id') IS NULL
+		OR r.public_id != sqlc.narg ('public_id')
+	)
+LIMIT
+	1;
+
+-- name: CreateOneRetailer :execresult
+INSERT INTO retailers (
+    public_id,
+    name,
+    website_url
+) VALUES (
+    ?,
+    ?,
+    ?
+);
+
+-- name: UpdateOneRetailer :exec
+UPDATE retailers
+SET
+    name = ?,
+    website_url = ?,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: DeleteOneRetailer :exec
+UPDATE retailers
+SET
+    deleted_at = (datetime('now'))
+WHERE
+    id = ?;
@@ -0,0 +1,140 @@
+-- name: UpsertSeedSpecificationGroup :execrows
+INSERT INTO specification_groups (
+    id,
+    public_id,
+    name,
+    description
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?
+)
+ON CONFLICT (id) DO UPDATE SET
+    name = excluded.name,
+    description = excluded.description,
+    version = specification_groups.version + 1,
+    updated_at = (datetime('now')),
+    deleted_at = NULL
+WHERE
+    specification_groups.public_id = excluded.public_id;
+
+-- name: UpsertSeedSpecification :execrows
+INSERT INTO specifications (
+    id,
+    public_id,
+    specification_group_id,
+    title,
+    type
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+)
+ON CONFLICT (id) DO UPDATE SET
+    specification_group_id = excluded.specification_group_id,
+    title = excluded.title,
+    type = excluded.type,
+    version = specifications.version + 1,
+    updated_at = (datetime('now')),
+    deleted_at = NULL
+WHERE
+    specifications.public_id = excluded.public_id;
+
+-- name: UpsertSeedCategory :execrows
+INSERT INTO categories (
+    id,
+    public_id,
+    name,
+    description
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?
+)
+ON CONFLICT (id) DO UPDATE SET
+    name = excluded.name,
+    description = excluded.description,
+    version = categories.version + 1,
+    updated_at = (datetime('now')),
+    deleted_at = NULL
+WHERE
+    categories.public_id = excluded.public_id;
+
+-- name: UpsertSeedProduct :execrows
+INSERT INTO products (
+    id,
+    public_id,
+    category_id,
+    name,
+    description,
+    price,
+    rating,
+    image_url
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+)
+ON CONFLICT (id) DO UPDATE SET
+    category_id = excluded.category_id,
+    name = excluded.name,
+    description = excluded.description,
+    price = excluded.price,
+    rating = excluded.rating,
+    image_url = excluded.image_url,
+    version = products.version + 1,
+    updated_at = (datetime('now')),
+    deleted_at = NULL
+WHERE
+    products.public_id = excluded.public_id;
+
+-- name: DeleteAllExternalReferences :exec
+DELETE FROM external_references;
+
+-- name: DeleteAllProductReviews :exec
+DELETE FROM product_reviews;
+
+-- name: DeleteAllWatchlistDeliveries :exec
+DELETE FROM watchlist_deliveries;
+
+-- name: DeleteAllWatchlistPriceChecks :exec
+DELETE FROM watchlist_price_checks;
+
+-- name: DeleteAllWatchlists :exec
+DELETE FROM watchlists;
+
+-- name: DeleteAllProductOffers :exec
+DELETE FROM product_offers;
+
+-- name: DeleteAllRetailers :exec
+DELETE FROM retailers;
+
+-- name: DeleteAllProductImages :exec
+DELETE FROM product_images;
+
+-- name: DeleteAllProductSpecificationValues :exec
+DELETE FROM product_specifications;
+
+-- name: DeleteAllCategorySpecifications :exec
+DELETE FROM category_specifications;
+
+-- name: DeleteAllProducts :exec
+DELETE FROM products;
+
+-- name: DeleteAllCategories :exec
+DELETE FROM categories;
+
+-- name: DeleteAllSpecifications :exec
+DELETE FROM specifications;
+
+-- name: DeleteAllSpecificationGroups :exec
+DELETE FROM specification_groups;
@@ -19,4 +19,113 @@ FROM specification_groups sg
 WHERE 
     sg.public_id = ?
     AND sg.deleted_at IS NULL
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+
+-- name: CheckIfSpecificationGroupExists :one
+SELECT
+    sg.id 
+FROM specification_groups sg
+WHERE
+    sg.name = ?
+    AND sg.deleted_at IS NULL
+    AND (
+		sqlc.narg ('public_id') IS NULL
+		OR sg.public_id != sqlc.narg ('public_id')
+	)
+LIMIT
+	1;
+
+-- name: CountSpecificationsByGroupId :one
+SELECT
+    COUNT(s.id) AS specifications_quantity
+FROM specifications s
+WHERE
+    s.specification_group_id = ?
+    AND s.deleted_at IS NULL;
+
+-- name: CreateOneSpecificationGroup :execresult
+INSERT INTO specification_groups (
+    public_id,
+    name,
+    description
+) VALUES (
+    ?,
+    ?,
+    ?
+);
+
+-- name: UpdateOneSpecificationGroup :exec
+UPDATE specification_groups
+SET
+    name = ?,
+    description = ?,
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: DeleteOneSpecificationGroup :exec
+UPDATE specification_groups
+SET
+    deleted_at = (datetime('now')),
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: GetOneDeletedSpecificationGroupByPublicID :one
+SELECT 
+    sg.id,
+    sg.public_id,
+    sg.name,
+    sg.description
+FROM specification_groups sg
+WHERE 
+    sg.public_id = ?
+    AND sg.deleted_at IS NOT NULL
+LIMIT 1;
+
+-- name: DeleteSpecificationsByGroupId :exec
+UPDATE specifications
+SET
+    deleted_at = (datetime('now')),
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    specification_group_id = ?
+    AND deleted_at IS NULL;
+
+-- name: RestoreOneSpecificationGroup :exec
+UPDATE specification_groups
+SET
+    deleted_at = NULL,
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: RestoreSpecificationsByGroupId :exec
+-- the specifications deleted with their group were deleted at the same time or
+-- after it, no specification can be deleted once its group is
+UPDATE specifications
+SET
+    deleted_at = NULL,
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    specification_group_id = sqlc.arg(specification_group_id)
+    AND deleted_at >= (
+        SELECT sg.deleted_at
+        FROM specification_groups sg
+        WHERE sg.id = sqlc.arg(specification_group_id)
+    );
+
+-- name: GetSpecificationGroupsRevision :many
+-- the deleted groups are read too, a delete or a restore has to change the revision
+SELECT
+    sg.id,
+    sg.version,
+    COALESCE(sg.deleted_at, sg.updated_at) AS changed_at
+FROM specification_groups sg
+ORDER BY
+    sg.id;
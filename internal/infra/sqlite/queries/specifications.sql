@@ -16,11 +16,120 @@ SELECT
     s.public_id,
     s.title,
     s.type,
-    sg.id
+    sg.id,
+    sg.public_id
 FROM specifications s
 INNER JOIN specification_groups sg ON s.specification_group_id = sg.id
 WHERE 
     s.public_id = ?
     AND sg.deleted_at IS NULL
     AND s.deleted_at IS NULL
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+
+-- name: GetOneDeletedSpecificationByPublicID :one
+SELECT 
+    s.id,
+    s.public_id,
+    s.title,
+    s.type,
+    sg.id,
+    sg.public_id
+FROM specifications s
+INNER JOIN specification_groups sg ON s.specification_group_id = sg.id
+WHERE 
+    s.public_id = ?
+    AND s.deleted_at IS NOT NULL
+LIMIT 1;
+
+-- name: CheckIfSpecificationExists :one
+SELECT
+    s.id 
+FROM specifications s
+WHERE
+    s.title = ?
+    AND s.specification_group_id = ?
+    AND s.deleted_at IS NULL
+    AND (
+		sqlc.narg ('public_id') IS NULL
+		OR s.public_id != sqlc.narg ('public_id')
+	)
+LIMIT
+	1;
+
+-- name: CountProductSpecificationValuesBySpecificationId :one
+SELECT
+    COUNT(ps.id) AS values_quantity
+FROM product_specifications ps
+WHERE
+    ps.specification_id = ?;
+
+-- name: CreateOneSpecification :execresult
+INSERT INTO specifications (
+    public_id,
+    specification_group_id,
+    title,
+    type
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: UpdateOneSpecification :exec
+UPDATE specifications
+SET
+    specification_group_id = ?,
+    title = ?,
+    type = ?,
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: DeleteOneSpecification :exec
+UPDATE specifications
+SET
+    deleted_at = (datetime('now')),
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: RestoreOneSpecification :exec
+UPDATE specifications
+SET
+    deleted_at = NULL,
+    version = version + 1,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: GetManySpecificationsByPublicIDs :many
+SELECT 
+    s.id,
+    s.public_id,
+    s.title,
+    s.type,
+    sg.id
+FROM specifications s
+INNER JOIN specification_groups sg ON s.specification_group_id = sg.id
+WHERE 
+    s.public_id IN (sqlc.slice('public_ids'))
+    AND sg.deleted_at IS NULL
+    AND s.deleted_at IS NULL;
+
+-- name: ListAllSpecifications :many
+SELECT
+    s.id,
+    s.public_id,
+    s.title,
+    s.type,
+    s.specification_group_id
+FROM specifications s
+INNER JOIN specification_groups sg ON s.specification_group_id = sg.id
+WHERE
+    sg.deleted_at IS NULL
+    AND s.deleted_at IS NULL
+ORDER BY
+    s.id;
@@ -0,0 +1,181 @@
+-- name: GetAllTrashItems :many
+SELECT
+    t.entity_type,
+    t.public_id,
+    t.name,
+    t.deleted_at,
+    COUNT(*) OVER () AS items_quantity
+FROM (
+    SELECT 'product' AS entity_type, p.public_id, p.name, p.deleted_at
+    FROM products p
+    WHERE p.deleted_at IS NOT NULL
+    UNION ALL
+    SELECT 'category' AS entity_type, c.public_id, c.name, c.deleted_at
+    FROM categories c
+    WHERE c.deleted_at IS NOT NULL
+    UNION ALL
+    SELECT 'specification' AS entity_type, s.public_id, s.title AS name, s.deleted_at
+    FROM specifications s
+    WHERE s.deleted_at IS NOT NULL
+    UNION ALL
+    SELECT 'specification_group' AS entity_type, sg.public_id, sg.name, sg.deleted_at
+    FROM specification_groups sg
+    WHERE sg.deleted_at IS NOT NULL
+) t
+WHERE
+    sqlc.narg(entity_type) IS NULL
+    OR t.entity_type = sqlc.narg(entity_type)
+ORDER BY
+    t.deleted_at DESC,
+    t.entity_type,
+    t.public_id
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: GetPurgeableProducts :many
+-- a variant goes with its family even when it was deleted a moment after it
+SELECT
+    p.id,
+    p.public_id,
+    p.name,
+    p.deleted_at
+FROM products p
+WHERE
+    p.deleted_at IS NOT NULL
+    AND (
+        p.deleted_at < sqlc.arg(deleted_before)
+        OR EXISTS (
+            SELECT 1
+            FROM products pp
+            WHERE pp.id = p.parent_id AND pp.deleted_at < sqlc.arg(deleted_before)
+        )
+    )
+ORDER BY
+    p.id;
+
+-- name: GetProductImageKeysByProductIDs :many
+SELECT
+    pi.storage_key,
+    pi.thumbnail_key
+FROM product_images pi
+WHERE
+    pi.product_id IN (SELECT value FROM json_each(sqlc.arg(product_ids)));
+
+-- name: PurgeProductSpecificationValues :exec
+DELETE FROM product_specifications
+WHERE product_id IN (SELECT value FROM json_each(sqlc.arg(product_ids)));
+
+-- name: PurgeProductImages :exec
+DELETE FROM product_images
+WHERE product_id IN (SELECT value FROM json_each(sqlc.arg(product_ids)));
+
+-- name: PurgeProductReviews :exec
+DELETE FROM product_reviews
+WHERE product_id IN (SELECT value FROM json_each(sqlc.arg(product_ids)));
+
+-- name: PurgeProductOffers :exec
+DELETE FROM product_offers
+WHERE product_id IN (SELECT value FROM json_each(sqlc.arg(product_ids)));
+
+-- name: PurgeWatchlistDeliveries :exec
+DELETE FROM watchlist_deliveries
+WHERE watchlist_id IN (
+    SELECT w.id
+    FROM watchlists w
+    WHERE w.product_id IN (SELECT value FROM json_each(sqlc.arg(product_ids)))
+);
+
+-- name: PurgeWatchlistPriceChecks :exec
+DELETE FROM watchlist_price_checks
+WHERE product_id IN (SELECT value FROM json_each(sqlc.arg(product_ids)));
+
+-- name: PurgeWatchlists :exec
+DELETE FROM watchlists
+WHERE product_id IN (SELECT value FROM json_each(sqlc.arg(product_ids)));
+
+-- name: PurgeExternalReferences :exec
+DELETE FROM external_references
+WHERE product_id IN (SELECT value FROM json_each(sqlc.arg(product_ids)));
+
+-- name: PurgeProducts :exec
+DELETE FROM products
+WHERE id IN (SELECT value FROM json_each(sqlc.arg(product_ids)));
+
+-- name: GetPurgeableCategories :many
+SELECT
+    c.id,
+    c.public_id,
+    c.name,
+    c.deleted_at
+FROM categories c
+WHERE
+    c.deleted_at < sqlc.arg(deleted_before)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM products p
+        WHERE p.category_id = c.id
+    )
+ORDER BY
+    c.id;
+
+-- name: PurgeCategorySpecificationsByCategoryIDs :exec
+DELETE FROM category_specifications
+WHERE category_id IN (SELECT value FROM json_each(sqlc.arg(category_ids)));
+
+-- name: PurgeCategories :exec
+DELETE FROM categories
+WHERE id IN (SELECT value FROM json_each(sqlc.arg(category_ids)));
+
+-- name: GetPurgeableSpecifications :many
+-- a specification goes with its group even when it was deleted a moment after it
+SELECT
+    s.id,
+    s.public_id,
+    s.title,
+    s.deleted_at
+FROM specifications s
+WHERE
+    s.deleted_at IS NOT NULL
+    AND (
+        s.deleted_at < sqlc.arg(deleted_before)
+        OR EXISTS (
+            SELECT 1
+            FROM specification_groups sg
+            WHERE sg.id = s.specification_group_id AND sg.deleted_at < sqlc.arg(deleted_before)
+        )
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM product_specifications ps
+        WHERE ps.specification_id = s.id
+    )
+ORDER BY
+    s.id;
+
+-- name: PurgeCategorySpecificationsBySpecificationIDs :exec
+DELETE FROM category_specifications
+WHERE specification_id IN (SELECT value FROM json_each(sqlc.arg(specification_ids)));
+
+-- name: PurgeSpecifications :exec
+DELETE FROM specifications
+WHERE id IN (SELECT value FROM json_each(sqlc.arg(specification_ids)));
+
+-- name: GetPurgeableSpecificationGroups :many
+SELECT
+    sg.id,
+    sg.public_id,
+    sg.name,
+    sg.deleted_at
+FROM specification_groups sg
+WHERE
+    sg.deleted_at < sqlc.arg(deleted_before)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM specifications s
+        WHERE s.specification_group_id = sg.id
+    )
+ORDER BY
+    sg.id;
+
+-- name: PurgeSpecificationGroups :exec
+DELETE FROM specification_groups
+WHERE id IN (SELECT value FROM json_each(sqlc.arg(specification_group_ids)));
@@ -0,0 +1,201 @@
+-- name: GetOneWatchlistByPublicID :one
+SELECT
+    w.id,
+    w.public_id,
+    w.product_id,
+    p.public_id AS product_public_id,
+    w.subscriber_token,
+    w.target_price,
+    w.drop_percent,
+    w.base_price,
+    w.last_notified_price,
+    w.created_at
+FROM watchlists w
+INNER JOIN products p ON p.id = w.product_id
+WHERE
+    w.public_id = ?
+    AND w.subscriber_token = ?
+    AND w.deleted_at IS NULL
+LIMIT 1;
+
+-- name: GetAllWatchlistsBySubscriberToken :many
+SELECT
+    w.id,
+    w.public_id,
+    w.product_id,
+    p.public_id AS product_public_id,
+    w.subscriber_token,
+    w.target_price,
+    w.drop_percent,
+    w.base_price,
+    w.last_notified_price,
+    w.created_at
+FROM watchlists w
+INNER JOIN products p ON p.id = w.product_id
+WHERE
+    w.subscriber_token = ?
+    AND w.deleted_at IS NULL
+ORDER BY
+    w.id;
+
+-- name: GetAllWatchlistsByProductID :many
+SELECT
+    w.id,
+    w.public_id,
+    w.product_id,
+    p.public_id AS product_public_id,
+    w.subscriber_token,
+    w.target_price,
+    w.drop_percent,
+    w.base_price,
+    w.last_notified_price,
+    w.created_at
+FROM watchlists w
+INNER JOIN products p ON p.id = w.product_id
+WHERE
+    w.product_id = ?
+    AND w.deleted_at IS NULL
+ORDER BY
+    w.id;
+
+-- name: GetAllWatchedProductPublicIDs :many
+SELECT DISTINCT
+    p.public_id
+FROM watchlists w
+INNER JOIN products p ON p.id = w.product_id
+WHERE
+    w.deleted_at IS NULL
+    AND p.deleted_at IS NULL
+ORDER BY
+    p.public_id;
+
+-- name: CheckIfWatchlistExists :one
+SELECT
+    w.id
+FROM watchlists w
+WHERE
+    w.subscriber_token = ?
+    AND w.product_id = ?
+    AND w.deleted_at IS NULL
+LIMIT
+	1;
+
+-- name: CreateOneWatchlist :execresult
+INSERT INTO watchlists (
+    public_id,
+    product_id,
+    subscriber_token,
+    target_price,
+    drop_percent,
+    base_price
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: UpdateWatchlistLastNotifiedPrice :exec
+UPDATE watchlists
+SET
+    last_notified_price = ?,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: DeleteOneWatchlist :exec
+UPDATE watchlists
+SET
+    deleted_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: CreateOneWatchlistDelivery :execresult
+INSERT INTO watchlist_deliveries (
+    watchlist_id,
+    trigger,
+    price,
+    threshold_price,
+    status,
+    attempts,
+    status_code,
+    error
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: GetAllWatchlistDeliveriesByWatchlistID :many
+SELECT
+    wd.id,
+    wd.watchlist_id,
+    wd.trigger,
+    wd.price,
+    wd.threshold_price,
+    wd.status,
+    wd.attempts,
+    wd.status_code,
+    wd.error,
+    wd.created_at,
+    COUNT(wd.id)      OVER () AS deliveries_quantity
+FROM watchlist_deliveries wd
+WHERE
+    wd.watchlist_id = sqlc.arg(watchlist_id)
+    AND (
+		sqlc.narg ('status') IS NULL
+		OR wd.status = sqlc.narg ('status')
+	)
+ORDER BY
+    wd.id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: CreateWatchlistPriceCheck :exec
+-- run before the product is written, only a watched product whose price changes is
+-- checked, and once however many updates come before the check
+INSERT INTO watchlist_price_checks (product_id)
+SELECT
+    p.id
+FROM products p
+WHERE
+    p.id = sqlc.arg(product_id)
+    AND p.price != sqlc.arg(price)
+    AND EXISTS (
+        SELECT 1
+        FROM watchlists w
+        WHERE w.product_id = p.id AND w.deleted_at IS NULL
+    )
+ON CONFLICT (product_id) DO NOTHING;
+
+-- name: DeleteWatchlistPriceChecks :many
+DELETE FROM watchlist_price_checks
+WHERE
+    product_id IN (
+        SELECT
+            c.product_id
+        FROM watchlist_price_checks c
+        ORDER BY
+            c.created_at,
+            c.product_id
+        LIMIT sqlc.arg(limit)
+    )
+RETURNING product_id;
+
+-- name: GetWatchedProductPublicIDsByIDs :many
+SELECT DISTINCT
+    p.public_id
+FROM watchlists w
+INNER JOIN products p ON p.id = w.product_id
+WHERE
+    p.id IN (SELECT value FROM json_each(sqlc.arg(product_ids)))
+    AND w.deleted_at IS NULL
+    AND p.deleted_at IS NULL
+ORDER BY
+    p.public_id;
@@ -0,0 +1,196 @@
+-- name: GetOneWebhookSubscriptionByPublicID :one
+SELECT
+    s.id,
+    s.public_id,
+    s.url,
+    s.secret,
+    s.event_types,
+    s.created_at
+FROM webhook_subscriptions s
+WHERE
+    s.public_id = ?
+    AND s.deleted_at IS NULL
+LIMIT 1;
+
+-- name: GetAllWebhookSubscriptions :many
+SELECT
+    s.id,
+    s.public_id,
+    s.url,
+    s.secret,
+    s.event_types,
+    s.created_at
+FROM webhook_subscriptions s
+WHERE
+    s.deleted_at IS NULL
+ORDER BY
+    s.id;
+
+-- name: CheckIfWebhookSubscriptionExists :one
+SELECT
+    s.id
+FROM webhook_subscriptions s
+WHERE
+    s.url = ?
+    AND s.deleted_at IS NULL
+LIMIT
+	1;
+
+-- name: CreateOneWebhookSubscription :execresult
+INSERT INTO webhook_subscriptions (
+    public_id,
+    url,
+    secret,
+    event_types
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?
+);
+
+-- name: DeleteOneWebhookSubscription :exec
+UPDATE webhook_subscriptions
+SET
+    deleted_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: CreateOneWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+    event_id,
+    subscription_id
+) VALUES (
+    ?,
+    ?
+)
+ON CONFLICT (event_id, subscription_id) DO NOTHING;
+
+-- name: ReplayOneWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+    event_id,
+    subscription_id
+) VALUES (
+    ?,
+    ?
+)
+ON CONFLICT (event_id, subscription_id) DO UPDATE
+SET
+    status = 'pending',
+    attempts = 0,
+    next_attempt_at = (datetime('now')),
+    last_status_code = 0,
+    last_error = '',
+    delivered_at = NULL,
+    locked_until = NULL,
+    updated_at = (datetime('now'));
+
+-- name: ReplayDeadWebhookDeliveries :execresult
+UPDATE webhook_deliveries
+SET
+    status = 'pending',
+    attempts = 0,
+    next_attempt_at = (datetime('now')),
+    last_status_code = 0,
+    last_error = '',
+    locked_until = NULL,
+    updated_at = (datetime('now'))
+WHERE
+    subscription_id = ?
+    AND status = 'dead';
+
+-- name: ClaimDueWebhookDeliveries :many
+-- the due deliveries are taken by a single dispatcher until locked_until, a sending
+-- one whose lock is over was left by a dispatcher that stopped and is due again
+UPDATE webhook_deliveries
+SET
+    status = 'sending',
+    locked_until = sqlc.arg(locked_until),
+    updated_at = (datetime('now'))
+WHERE
+    id IN (
+        SELECT
+            d.id
+        FROM webhook_deliveries d
+        INNER JOIN webhook_subscriptions s ON s.id = d.subscription_id
+        WHERE
+            (
+                (d.status = 'pending' AND d.next_attempt_at <= sqlc.arg(now))
+                OR (d.status = 'sending' AND d.locked_until <= sqlc.arg(now))
+            )
+            AND s.deleted_at IS NULL
+        ORDER BY
+            d.next_attempt_at,
+            d.id
+        LIMIT sqlc.arg(limit)
+    )
+RETURNING id;
+
+-- name: GetManyWebhookDeliveriesByIDs :many
+SELECT
+    d.id,
+    d.event_id,
+    d.subscription_id,
+    d.status,
+    d.attempts,
+    d.next_attempt_at,
+    d.last_status_code,
+    d.last_error,
+    d.delivered_at,
+    d.created_at,
+    e.event_type,
+    e.aggregate_type,
+    e.aggregate_public_id,
+    e.payload,
+    e.created_at AS event_created_at
+FROM webhook_deliveries d
+INNER JOIN outbox_events e ON e.id = d.event_id
+WHERE
+    d.id IN (sqlc.slice(ids))
+ORDER BY
+    d.next_attempt_at,
+    d.id;
+
+-- name: UpdateOneWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET
+    status = ?,
+    attempts = ?,
+    next_attempt_at = ?,
+    last_status_code = ?,
+    last_error = ?,
+    delivered_at = ?,
+    locked_until = NULL,
+    updated_at = (datetime('now'))
+WHERE
+    id = ?;
+
+-- name: GetAllWebhookDeliveriesBySubscriptionID :many
+SELECT
+    d.id,
+    d.event_id,
+    d.subscription_id,
+    d.status,
+    d.attempts,
+    d.next_attempt_at,
+    d.last_status_code,
+    d.last_error,
+    d.delivered_at,
+    d.created_at,
+    e.event_type,
+    e.aggregate_type,
+    e.aggregate_public_id,
+    e.payload,
+    e.created_at AS event_created_at,
+    COUNT(d.id)      OVER () AS deliveries_quantity
+FROM webhook_deliveries d
+INNER JOIN outbox_events e ON e.id = d.event_id
+WHERE
+    d.subscription_id = sqlc.arg(subscription_id)
+    AND (
+		sqlc.narg ('status') IS NULL
+		OR d.status = sqlc.narg ('status')
+	)
+ORDER BY
+    d.id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
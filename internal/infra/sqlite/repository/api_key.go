@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+	"time"
+)
+
+type ApiKeySqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewApiKeySqlite(dbConn *sql.DB) repository.ApiKey {
+	return &ApiKeySqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (a *ApiKeySqlite) GetOneByPublicID(publicID types.ApiKeyPublicID) (*entity.ApiKey, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	apiKeyOutput, err := a.DB.GetOneApiKeyByPublicID(ctx, string(publicID))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return toApiKeyEntity(apiKeyOutput)
+}
+
+func (a *ApiKeySqlite) GetAll() ([]*entity.ApiKey, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	apiKeysOutput, err := a.DB.GetAllApiKeys(ctx)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	apiKeys := make([]*entity.ApiKey, 0, len(apiKeysOutput))
+
+	for _, apiKeyOutput := range apiKeysOutput {
+		apiKey, repoErr := toApiKeyEntity(apiKeyOutput)
+
+		if repoErr != nil {
+			return nil, repoErr
+		}
+
+		apiKeys = append(apiKeys, apiKey)
+	}
+
+	return apiKeys, nil
+}
+
+func (a *ApiKeySqlite) CreateOne(apiKey *entity.ApiKey) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	result, err := a.DB.CreateOneApiKey(ctx, sqlite.CreateOneApiKeyParams{
+		PublicID: string(apiKey.PublicID),
+		Name:     apiKey.Name,
+		KeyHash:  apiKey.Hash,
+		Role:     string(apiKey.Role),
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	apiKey.ID = id
+
+	if apiKey.CreatedAt.IsZero() {
+		apiKey.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	}
+
+	return nil
+}
+
+func (a *ApiKeySqlite) RevokeOne(apiKey *entity.ApiKey) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := a.DB.RevokeOneApiKey(ctx, apiKey.ID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if apiKey.RevokedAt.IsZero() {
+		apiKey.RevokedAt = time.Now().UTC().Truncate(time.Second)
+	}
+
+	return nil
+}
+
+func (a *ApiKeySqlite) TouchOne(apiKey *entity.ApiKey) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := a.DB.TouchOneApiKey(ctx, apiKey.ID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+func toApiKeyEntity(apiKeyOutput sqlite.ApiKey) (*entity.ApiKey, exceptions.RepositoryException) {
+	apiKey, entityErr := entity.NewApiKey(entity.ApiKeyProps{
+		ID:         apiKeyOutput.ID,
+		PublicID:   types.ApiKeyPublicID(apiKeyOutput.PublicID),
+		Name:       apiKeyOutput.Name,
+		Hash:       apiKeyOutput.KeyHash,
+		Role:       types.Role(apiKeyOutput.Role),
+		CreatedAt:  parseDateTime(apiKeyOutput.CreatedAt),
+		LastUsedAt: parseDateTime(apiKeyOutput.LastUsedAt.String),
+		RevokedAt:  parseDateTime(apiKeyOutput.RevokedAt.String),
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+// auditChangeRecord is how a change is stored in the changes column, a JSON array.
+type auditChangeRecord struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+type AuditLogSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewAuditLogSqlite(dbConn *sql.DB) repository.AuditLog {
+	return &AuditLogSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (a *AuditLogSqlite) CreateOne(entry *entity.AuditEntry) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := createAuditEntry(ctx, a.DB, entry)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+// CreateMany writes the entries in a single transaction.
+func (a *AuditLogSqlite) CreateMany(entries []*entity.AuditEntry) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := a.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := a.DB.WithTx(tx)
+
+	for _, entry := range entries {
+		err = createAuditEntry(ctx, qtx, entry)
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+func (a *AuditLogSqlite) GetAll(filter entity.AuditLogFilter, paginationInput entity.PaginatorInput) ([]*entity.AuditEntry, entity.PaginatorOutput, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	paginatorOutput := &entity.PaginatorOutput{Total: 0}
+
+	params := sqlite.GetAllAuditEntriesParams{
+		Limit:  paginationInput.Limit,
+		Offset: paginationInput.Skip,
+	}
+
+	if filter.Actor != "" {
+		params.Actor = string(filter.Actor)
+	}
+
+	if filter.EntityType != "" {
+		params.EntityType = string(filter.EntityType)
+	}
+
+	if filter.EntityPublicID != "" {
+		params.EntityPublicID = filter.EntityPublicID
+	}
+
+	if filter.Operation != "" {
+		params.Operation = string(filter.Operation)
+	}
+
+	if !filter.From.IsZero() {
+		params.From = filter.From.UTC().Format(time.DateTime)
+	}
+
+	if !filter.To.IsZero() {
+		params.To = filter.To.UTC().Format(time.DateTime)
+	}
+
+	entriesOutput, err := a.DB.GetAllAuditEntries(ctx, params)
+
+	if err != nil {
+		return nil, *paginatorOutput, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	entries := make([]*entity.AuditEntry, 0, len(entriesOutput))
+
+	for _, entryOutput := range entriesOutput {
+		var records []auditChangeRecord
+
+		err = json.Unmarshal([]byte(entryOutput.Changes), &records)
+
+		if err != nil {
+			return nil, *paginatorOutput, exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		changes := make([]entity.AuditChange, len(records))
+
+		for i, record := range records {
+			changes[i] = entity.AuditChange(record)
+		}
+
+		entry, entityErr := entity.NewAuditEntry(entity.AuditEntryProps{
+			ID:             entryOutput.ID,
+			Actor:          types.Actor(entryOutput.Actor),
+			EntityType:     types.AuditEntityType(entryOutput.EntityType),
+			EntityPublicID: entryOutput.EntityPublicID,
+			Operation:      types.AuditOperation(entryOutput.Operation),
+			Changes:        changes,
+			CreatedAt:      parseDateTime(entryOutput.CreatedAt),
+		})
+
+		if entityErr != nil {
+			return nil, *paginatorOutput, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		if paginatorOutput.Total == 0 {
+			paginatorOutput.Total = entryOutput.EntriesQuantity
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, *paginatorOutput, nil
+}
+
+func createAuditEntry(ctx context.Context, q *sqlite.Queries, entry *entity.AuditEntry) error {
+	records := make([]auditChangeRecord, len(entry.Changes))
+
+	for i, change := range entry.Changes {
+		records[i] = auditChangeRecord(change)
+	}
+
+	changes, err := json.Marshal(records)
+
+	if err != nil {
+		return err
+	}
+
+	return q.CreateOneAuditEntry(ctx, sqlite.CreateOneAuditEntryParams{
+		Actor:          string(entry.Actor),
+		EntityType:     string(entry.EntityType),
+		EntityPublicID: entry.EntityPublicID,
+		Operation:      string(entry.Operation),
+		Changes:        string(changes),
+	})
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"project/internal/domain/constants"
 	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
@@ -55,6 +56,31 @@ func (c *CategorySqlite) GetAll() ([]*entity.Category, exceptions.RepositoryExce
 	return categories, nil
 }
 
+func (c *CategorySqlite) GetRevision() (*entity.Revision, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	revisionOutput, err := c.DB.GetCategoriesRevision(ctx)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	parts := make([]entity.RevisionPart, len(revisionOutput))
+
+	for i, row := range revisionOutput {
+		parts[i] = entity.RevisionPart{
+			Kind:      "category",
+			ID:        row.ID,
+			Version:   row.Version,
+			ChangedAt: parseDateTime(row.ChangedAt),
+		}
+	}
+
+	return entity.NewRevision(parts...), nil
+}
+
 func (c *CategorySqlite) GetOneByPublicID(publicId types.CategoryPublicID) (*entity.Category, exceptions.RepositoryException) {
 	ctx := context.Background()
 
@@ -81,3 +107,248 @@ func (c *CategorySqlite) GetOneByPublicID(publicId types.CategoryPublicID) (*ent
 
 	return categoryEntity, nil
 }
+
+func (c *CategorySqlite) GetOneDeletedByPublicID(publicId types.CategoryPublicID) (*entity.Category, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	categoryOutput, err := c.DB.GetOneDeletedCategoryByPublicID(ctx, string(publicId))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	categoryEntity, entityErr := entity.NewCategory(entity.CategoryProps{
+		ID:          types.CategoryID(categoryOutput.ID),
+		PublicID:    types.CategoryPublicID(categoryOutput.PublicID),
+		Name:        categoryOutput.Name,
+		Description: categoryOutput.Description.String,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return categoryEntity, nil
+}
+
+func (c *CategorySqlite) ExistsByName(name string, publicId types.CategoryPublicID) (bool, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	id, err := c.DB.CheckIfCategoryExists(ctx, sqlite.CheckIfCategoryExistsParams{
+		Name:     name,
+		PublicID: publicId,
+	})
+
+	if err != nil {
+		if sqlite.Reason(err) == constants.RepositoryNotFoundError {
+			return false, nil
+		}
+
+		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if id == 0 {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (c *CategorySqlite) CountProducts(categoryId types.CategoryID) (int64, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	total, err := c.DB.CountProductsByCategoryId(ctx, int64(categoryId))
+
+	if err != nil {
+		return 0, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return total, nil
+}
+
+// CreateOne inserts the category and its outbox event in a single transaction.
+func (c *CategorySqlite) CreateOne(category *entity.Category) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := c.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := c.DB.WithTx(tx)
+
+	result, err := qtx.CreateOneCategory(ctx, sqlite.CreateOneCategoryParams{
+		PublicID:    string(category.PublicID),
+		Name:        category.Name,
+		Description: sql.NullString{String: category.Description, Valid: category.Description != ""},
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	category.ID = types.CategoryID(id)
+
+	err = createOutboxEvent(ctx, qtx, constants.OutboxEventCategoryCreated, constants.OutboxAggregateCategory, string(category.PublicID), toCategoryEventPayload(category))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+func (c *CategorySqlite) UpdateOne(category *entity.Category) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := c.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := c.DB.WithTx(tx)
+
+	err = qtx.UpdateOneCategory(ctx, sqlite.UpdateOneCategoryParams{
+		ID:          int64(category.ID),
+		Name:        category.Name,
+		Description: sql.NullString{String: category.Description, Valid: category.Description != ""},
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = createOutboxEvent(ctx, qtx, constants.OutboxEventCategoryUpdated, constants.OutboxAggregateCategory, string(category.PublicID), toCategoryEventPayload(category))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+func (c *CategorySqlite) DeleteOne(category *entity.Category) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := c.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := c.DB.WithTx(tx)
+
+	err = qtx.DeleteOneCategory(ctx, int64(category.ID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = createOutboxEvent(ctx, qtx, constants.OutboxEventCategoryDeleted, constants.OutboxAggregateCategory, string(category.PublicID), publicIDEventPayload{PublicID: string(category.PublicID)})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+func (c *CategorySqlite) RestoreOne(category *entity.Category) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := c.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := c.DB.WithTx(tx)
+
+	err = qtx.RestoreOneCategory(ctx, int64(category.ID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = createOutboxEvent(ctx, qtx, constants.OutboxEventCategoryRestored, constants.OutboxAggregateCategory, string(category.PublicID), toCategoryEventPayload(category))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
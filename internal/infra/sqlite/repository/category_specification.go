@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+)
+
+type CategorySpecificationSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewCategorySpecificationSqlite(dbConn *sql.DB) repository.CategorySpecification {
+	return &CategorySpecificationSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (c *CategorySpecificationSqlite) GetTemplateByCategoryID(categoryID types.CategoryID) (*entity.CategoryTemplate, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	outputs, err := c.DB.GetAllCategorySpecificationsByCategoryID(ctx, int64(categoryID))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	specifications := []*entity.CategorySpecification{}
+
+	for _, output := range outputs {
+		categorySpecification, entityErr := entity.NewCategorySpecification(entity.CategorySpecificationProps{
+			ID:              output.ID,
+			CategoryID:      types.CategoryID(output.CategoryID),
+			SpecificationID: types.SpecificationID(output.SpecificationID),
+			Requirement:     types.SpecificationRequirement(output.Requirement),
+			DisplayOrder:    output.DisplayOrder,
+			Specification: &entity.Specification{
+				ID:                    types.SpecificationID(output.SpecificationID),
+				PublicID:              types.SpecificationPublicID(output.PublicID),
+				Title:                 output.Title,
+				EspecificationGroupID: types.SpecificationGroupID(output.SpecificationGroupID),
+				Type:                  types.SpecificationType(output.Type),
+			},
+		})
+
+		if entityErr != nil {
+			return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		specifications = append(specifications, categorySpecification)
+	}
+
+	template, entityErr := entity.NewCategoryTemplate(categoryID, specifications)
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return template, nil
+}
+
+func (c *CategorySpecificationSqlite) ReplaceTemplate(template *entity.CategoryTemplate) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := c.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := c.DB.WithTx(tx)
+
+	err = qtx.DeleteAllCategorySpecificationsByCategoryID(ctx, int64(template.CategoryID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	for _, specification := range template.Specifications {
+		result, err := qtx.CreateOneCategorySpecification(ctx, sqlite.CreateOneCategorySpecificationParams{
+			CategoryID:      int64(template.CategoryID),
+			SpecificationID: int64(specification.SpecificationID),
+			Requirement:     string(specification.Requirement),
+			DisplayOrder:    specification.DisplayOrder,
+		})
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		id, err := result.LastInsertId()
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		specification.ID = id
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
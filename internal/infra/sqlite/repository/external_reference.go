@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+)
+
+type ExternalReferenceSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewExternalReferenceSqlite(dbConn *sql.DB) repository.ExternalReference {
+	return &ExternalReferenceSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (r *ExternalReferenceSqlite) GetOneBySourceAndExternalID(source types.ExternalSource, externalId string) (*entity.ExternalReference, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	externalReferenceOutput, err := r.DB.GetOneExternalReferenceBySourceAndExternalID(ctx, sqlite.GetOneExternalReferenceBySourceAndExternalIDParams{
+		Source:     string(source),
+		ExternalID: externalId,
+	})
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	externalReference, entityErr := entity.NewExternalReference(entity.ExternalReferenceProps{
+		ID:              externalReferenceOutput.ID,
+		Source:          types.ExternalSource(externalReferenceOutput.Source),
+		ExternalID:      externalReferenceOutput.ExternalID,
+		ProductID:       types.ProductID(externalReferenceOutput.ProductID),
+		ProductPublicID: types.ProductPublicID(externalReferenceOutput.ProductPublicID),
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return externalReference, nil
+}
@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+type OutboxSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewOutboxSqlite(dbConn *sql.DB) repository.Outbox {
+	return &OutboxSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (o *OutboxSqlite) GetAllUndispatched(limit int64) ([]*entity.OutboxEvent, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	eventsOutput, err := o.DB.GetAllUndispatchedOutboxEvents(ctx, limit)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	events := make([]*entity.OutboxEvent, 0, len(eventsOutput))
+
+	for _, eventOutput := range eventsOutput {
+		event, repoErr := toOutboxEventEntity(sqlite.GetAllOutboxEventsSinceRow(eventOutput))
+
+		if repoErr != nil {
+			return nil, repoErr
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (o *OutboxSqlite) GetAllSince(since time.Time) ([]*entity.OutboxEvent, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	eventsOutput, err := o.DB.GetAllOutboxEventsSince(ctx, since.UTC().Format(time.DateTime))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	events := make([]*entity.OutboxEvent, 0, len(eventsOutput))
+
+	for _, eventOutput := range eventsOutput {
+		event, repoErr := toOutboxEventEntity(eventOutput)
+
+		if repoErr != nil {
+			return nil, repoErr
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (o *OutboxSqlite) Dispatch(event *entity.OutboxEvent, subscriptions []*entity.WebhookSubscription) (bool, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	tx, err := o.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := o.DB.WithTx(tx)
+
+	// marked first, so the transaction holds the write lock before the deliveries
+	// are created and a second dispatcher waits for it
+	result, err := qtx.MarkOutboxEventDispatched(ctx, event.ID)
+
+	if err != nil {
+		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	rows, err := result.RowsAffected()
+
+	if err != nil {
+		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if rows == 0 {
+		return false, nil
+	}
+
+	for _, subscription := range subscriptions {
+		err = qtx.CreateOneWebhookDelivery(ctx, sqlite.CreateOneWebhookDeliveryParams{
+			EventID:        event.ID,
+			SubscriptionID: subscription.ID,
+		})
+
+		if err != nil {
+			return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return true, nil
+}
+
+// productEventPayload is the product as sent in the outbox events.
+type productEventPayload struct {
+	PublicID       types.ProductPublicID `json:"public_id"`
+	Name           types.ProductName     `json:"name"`
+	Description    string                `json:"description"`
+	Price          int64                 `json:"price"`
+	Rating         int8                  `json:"rating"`
+	ImageURL       string                `json:"image_url"`
+	ParentPublicID types.ProductPublicID `json:"parent_public_id,omitempty"`
+	VariantLabel   string                `json:"variant_label,omitempty"`
+}
+
+type categoryEventPayload struct {
+	PublicID    types.CategoryPublicID `json:"public_id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+}
+
+// publicIDEventPayload is sent when the aggregate is gone or when the change is
+// better read again from the API, like a new specification sheet.
+type publicIDEventPayload struct {
+	PublicID string `json:"public_id"`
+}
+
+func toProductEventPayload(product *entity.Product) productEventPayload {
+	return productEventPayload{
+		PublicID:       product.PublicID,
+		Name:           product.Name,
+		Description:    product.Description,
+		Price:          product.Price,
+		Rating:         product.Rating,
+		ImageURL:       product.ImageURL,
+		ParentPublicID: product.ParentPublicID,
+		VariantLabel:   product.VariantLabel,
+	}
+}
+
+func toCategoryEventPayload(category *entity.Category) categoryEventPayload {
+	return categoryEventPayload{
+		PublicID:    category.PublicID,
+		Name:        category.Name,
+		Description: category.Description,
+	}
+}
+
+// createOutboxEvent writes the event with the queries of the transaction of the
+// change, so the event exists if and only if the change was committed.
+func createOutboxEvent(ctx context.Context, qtx *sqlite.Queries, eventType types.OutboxEventType, aggregateType types.OutboxAggregateType, aggregatePublicID string, payload any) error {
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	return qtx.CreateOneOutboxEvent(ctx, sqlite.CreateOneOutboxEventParams{
+		EventType:         string(eventType),
+		AggregateType:     string(aggregateType),
+		AggregatePublicID: aggregatePublicID,
+		Payload:           string(data),
+	})
+}
+
+// createProductSpecificationsOutboxEvent tells that the specification sheet of the
+// product changed, the spec values only know the internal id of their product.
+func createProductSpecificationsOutboxEvent(ctx context.Context, qtx *sqlite.Queries, productID types.ProductID) error {
+	publicID, err := qtx.GetProductPublicIdById(ctx, int64(productID))
+
+	if err != nil {
+		return err
+	}
+
+	return createOutboxEvent(ctx, qtx, constants.OutboxEventProductSpecificationsUpdated, constants.OutboxAggregateProduct, publicID, publicIDEventPayload{PublicID: publicID})
+}
+
+func toOutboxEventEntity(eventOutput sqlite.GetAllOutboxEventsSinceRow) (*entity.OutboxEvent, exceptions.RepositoryException) {
+	event, entityErr := entity.NewOutboxEvent(entity.OutboxEventProps{
+		ID:                eventOutput.ID,
+		Type:              types.OutboxEventType(eventOutput.EventType),
+		AggregateType:     types.OutboxAggregateType(eventOutput.AggregateType),
+		AggregatePublicID: eventOutput.AggregatePublicID,
+		Payload:           []byte(eventOutput.Payload),
+		CreatedAt:         parseDateTime(eventOutput.CreatedAt),
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return event, nil
+}
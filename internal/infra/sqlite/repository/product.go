@@ -4,12 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"project/internal/domain/aggregate"
+	"project/internal/domain/constants"
 	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
 	"project/internal/domain/types"
 	"project/internal/infra/sqlite"
+	"time"
+
+	json "github.com/goccy/go-json"
 )
 
 type ProductSqlite struct {
@@ -24,17 +29,32 @@ func NewProductSqlite(dbConn *sql.DB) repository.Product {
 	}
 }
 
+// CreateOne inserts the product and its outbox event in a single transaction.
 func (p *ProductSqlite) CreateOne(product *entity.Product) exceptions.RepositoryException {
 	ctx := context.Background()
 
-	result, err := p.DB.CreateOneProduct(ctx, sqlite.CreateOneProductParams{
-		PublicID:    string(product.PublicID),
-		Name:        string(product.Name),
-		Description: sql.NullString{String: product.Description, Valid: product.Description != ""},
-		Price:       product.Price,
-		Rating:      int64(product.Rating),
-		ImageUrl:    sql.NullString{String: product.ImageURL, Valid: product.ImageURL != ""},
-		CategoryID:  int64(product.CategoryID),
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	result, err := qtx.CreateOneProduct(ctx, sqlite.CreateOneProductParams{
+		PublicID:     string(product.PublicID),
+		Name:         string(product.Name),
+		Description:  sql.NullString{String: product.Description, Valid: product.Description != ""},
+		Price:        product.Price,
+		Rating:       int64(product.Rating),
+		ImageUrl:     sql.NullString{String: product.ImageURL, Valid: product.ImageURL != ""},
+		CategoryID:   int64(product.CategoryID),
+		ParentID:     sql.NullInt64{Int64: int64(product.ParentID), Valid: product.IsVariant()},
+		VariantLabel: sql.NullString{String: product.VariantLabel, Valid: product.IsVariant()},
 	})
 
 	if err != nil {
@@ -53,13 +73,246 @@ func (p *ProductSqlite) CreateOne(product *entity.Product) exceptions.Repository
 
 	product.ID = types.ProductID(id)
 
+	err = createOutboxEvent(ctx, qtx, constants.OutboxEventProductCreated, constants.OutboxAggregateProduct, string(product.PublicID), toProductEventPayload(product))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+// CreateMany inserts the products and their specification values in a single
+// transaction, so a batch is either fully written or not written at all.
+func (p *ProductSqlite) CreateMany(products []*entity.Product) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	for _, product := range products {
+		result, err := qtx.CreateOneProduct(ctx, sqlite.CreateOneProductParams{
+			PublicID:     string(product.PublicID),
+			Name:         string(product.Name),
+			Description:  sql.NullString{String: product.Description, Valid: product.Description != ""},
+			Price:        product.Price,
+			Rating:       int64(product.Rating),
+			ImageUrl:     sql.NullString{String: product.ImageURL, Valid: product.ImageURL != ""},
+			CategoryID:   int64(product.CategoryID),
+			ParentID:     sql.NullInt64{Int64: int64(product.ParentID), Valid: product.IsVariant()},
+			VariantLabel: sql.NullString{String: product.VariantLabel, Valid: product.IsVariant()},
+		})
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		id, err := result.LastInsertId()
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		product.ID = types.ProductID(id)
+
+		for _, productSpec := range product.SpecificationValues {
+			productSpec.ProductID = product.ID
+
+			stringVal, intVal, boolVal := toNullSpecValue(productSpec.Value)
+
+			result, err := qtx.CreateOneProductSpecificationValue(ctx, sqlite.CreateOneProductSpecificationValueParams{
+				ProductID:       int64(productSpec.ProductID),
+				SpecificationID: int64(productSpec.SpecificationID),
+				StringValue:     stringVal,
+				IntValue:        intVal,
+				BoolValue:       boolVal,
+			})
+
+			if err != nil {
+				return exceptions.Repo(err, exceptions.RepositoryOpts{
+					Reason: sqlite.Reason(err),
+				})
+			}
+
+			specId, err := result.LastInsertId()
+
+			if err != nil {
+				return exceptions.Repo(err, exceptions.RepositoryOpts{
+					Reason: sqlite.Reason(err),
+				})
+			}
+
+			productSpec.ID = specId
+		}
+
+		err = createOutboxEvent(ctx, qtx, constants.OutboxEventProductCreated, constants.OutboxAggregateProduct, string(product.PublicID), toProductEventPayload(product))
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+// CreateOneWithExternalReference inserts the product and the reference linking it to
+// its external catalog in a single transaction.
+func (p *ProductSqlite) CreateOneWithExternalReference(product *entity.Product, externalReference *entity.ExternalReference) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	result, err := qtx.CreateOneProduct(ctx, sqlite.CreateOneProductParams{
+		PublicID:     string(product.PublicID),
+		Name:         string(product.Name),
+		Description:  sql.NullString{String: product.Description, Valid: product.Description != ""},
+		Price:        product.Price,
+		Rating:       int64(product.Rating),
+		ImageUrl:     sql.NullString{String: product.ImageURL, Valid: product.ImageURL != ""},
+		CategoryID:   int64(product.CategoryID),
+		ParentID:     sql.NullInt64{Int64: int64(product.ParentID), Valid: product.IsVariant()},
+		VariantLabel: sql.NullString{String: product.VariantLabel, Valid: product.IsVariant()},
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	product.ID = types.ProductID(id)
+	externalReference.ProductID = product.ID
+	externalReference.ProductPublicID = product.PublicID
+
+	result, err = qtx.CreateOneExternalReference(ctx, sqlite.CreateOneExternalReferenceParams{
+		Source:     string(externalReference.Source),
+		ExternalID: externalReference.ExternalID,
+		ProductID:  int64(externalReference.ProductID),
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err = result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	externalReference.ID = id
+
+	err = createOutboxEvent(ctx, qtx, constants.OutboxEventProductCreated, constants.OutboxAggregateProduct, string(product.PublicID), toProductEventPayload(product))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
 	return nil
 }
 
+// DeleteOne deletes the product together with its variants, as long as it is
+// still at the version it was read at.
 func (p *ProductSqlite) DeleteOne(product *entity.Product) exceptions.RepositoryException {
 	ctx := context.Background()
 
-	err := p.DB.DeleteOneProduct(ctx, int64(product.ID))
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	parentIdsJson, err := json.Marshal([]int64{int64(product.ID)})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryUnknownError,
+		})
+	}
+
+	variantsOutput, err := qtx.GetProductVariantsByParentIDs(ctx, string(parentIdsJson))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	result, err := qtx.DeleteOneProduct(ctx, sqlite.DeleteOneProductParams{
+		ID:      int64(product.ID),
+		Version: product.Version,
+	})
+
+	if repoErr := versionedWriteError(result, err, "product", int64(product.ID)); repoErr != nil {
+		return repoErr
+	}
+
+	err = qtx.DeleteProductVariants(ctx, sql.NullInt64{Int64: int64(product.ID), Valid: true})
 
 	if err != nil {
 		return exceptions.Repo(err, exceptions.RepositoryOpts{
@@ -67,6 +320,114 @@ func (p *ProductSqlite) DeleteOne(product *entity.Product) exceptions.Repository
 		})
 	}
 
+	// the variants go with the product, each one gets its own event
+	publicIds := []string{string(product.PublicID)}
+
+	for _, variantOutput := range variantsOutput {
+		publicIds = append(publicIds, variantOutput.PublicID)
+	}
+
+	for _, publicId := range publicIds {
+		err = createOutboxEvent(ctx, qtx, constants.OutboxEventProductDeleted, constants.OutboxAggregateProduct, publicId, publicIDEventPayload{PublicID: publicId})
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+// RestoreOne restores the product, and the variants deleted with it, together with
+// their outbox events in a single transaction.
+func (p *ProductSqlite) RestoreOne(product *entity.Product) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	// the variants are restored first, they are matched against the deletion time of
+	// the product
+	err = qtx.RestoreProductVariants(ctx, sql.NullInt64{Int64: int64(product.ID), Valid: true})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = qtx.RestoreOneProduct(ctx, int64(product.ID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	parentIdsJson, err := json.Marshal([]int64{int64(product.ID)})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryUnknownError,
+		})
+	}
+
+	variantsOutput, err := qtx.GetProductVariantsByParentIDs(ctx, string(parentIdsJson))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	payloads := []productEventPayload{toProductEventPayload(product)}
+
+	for _, variantOutput := range variantsOutput {
+		payloads = append(payloads, productEventPayload{
+			PublicID:       types.ProductPublicID(variantOutput.PublicID),
+			Name:           types.ProductName(variantOutput.Name),
+			Description:    variantOutput.Description.String,
+			Price:          variantOutput.Price,
+			Rating:         int8(variantOutput.Rating),
+			ImageURL:       variantOutput.ImageUrl.String,
+			ParentPublicID: types.ProductPublicID(variantOutput.ParentPublicID),
+			VariantLabel:   variantOutput.VariantLabel.String,
+		})
+	}
+
+	for _, payload := range payloads {
+		err = createOutboxEvent(ctx, qtx, constants.OutboxEventProductRestored, constants.OutboxAggregateProduct, string(payload.PublicID), payload)
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
 	return nil
 }
 
@@ -78,6 +439,10 @@ func (p *ProductSqlite) ExistsByName(name types.ProductName, publicId types.Prod
 		PublicID: publicId,
 	})
 
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
 	if err != nil {
 		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
 			Reason: sqlite.Reason(err),
@@ -96,7 +461,14 @@ func (p *ProductSqlite) GetAll(paginationInput entity.PaginatorInput) ([]*entity
 
 	paginatorOutput := &entity.PaginatorOutput{Total: 0}
 
+	var search interface{}
+
+	if paginationInput.Search != "" {
+		search = paginationInput.Search
+	}
+
 	productsOutput, err := p.DB.GetAllProducts(ctx, sqlite.GetAllProductsParams{
+		Search: search,
 		Limit:  paginationInput.Limit,
 		Offset: paginationInput.Skip,
 	})
@@ -118,6 +490,7 @@ func (p *ProductSqlite) GetAll(paginationInput entity.PaginatorInput) ([]*entity
 			Description:         productOutput.Description.String,
 			Price:               productOutput.Price,
 			Rating:              int8(productOutput.Rating),
+			ReviewCount:         productOutput.ReviewCount,
 			ImageURL:            productOutput.ImageUrl.String,
 			SpecificationValues: []*entity.ProductSpecificationValue{},
 		})
@@ -135,6 +508,10 @@ func (p *ProductSqlite) GetAll(paginationInput entity.PaginatorInput) ([]*entity
 		productsList = append(productsList, product)
 	}
 
+	if repoErr := p.attachVariants(ctx, productsList); repoErr != nil {
+		return nil, *paginatorOutput, repoErr
+	}
+
 	return productsList, *paginatorOutput, nil
 }
 
@@ -143,8 +520,15 @@ func (p *ProductSqlite) GetAllByCategoryID(categoryId types.CategoryID, paginati
 
 	paginatorOutput := &entity.PaginatorOutput{Total: 0}
 
+	var search interface{}
+
+	if paginationInput.Search != "" {
+		search = paginationInput.Search
+	}
+
 	productsOutput, err := p.DB.GetAllProductsByCategoryId(ctx, sqlite.GetAllProductsByCategoryIdParams{
 		CategoryID: int64(categoryId),
+		Search:     search,
 		Limit:      paginationInput.Limit,
 		Offset:     paginationInput.Skip,
 	})
@@ -166,6 +550,7 @@ func (p *ProductSqlite) GetAllByCategoryID(categoryId types.CategoryID, paginati
 			Description:         productOutput.Description.String,
 			Price:               productOutput.Price,
 			Rating:              int8(productOutput.Rating),
+			ReviewCount:         productOutput.ReviewCount,
 			ImageURL:            productOutput.ImageUrl.String,
 			SpecificationValues: []*entity.ProductSpecificationValue{},
 		})
@@ -183,9 +568,62 @@ func (p *ProductSqlite) GetAllByCategoryID(categoryId types.CategoryID, paginati
 		productsList = append(productsList, product)
 	}
 
+	if repoErr := p.attachVariants(ctx, productsList); repoErr != nil {
+		return nil, *paginatorOutput, repoErr
+	}
+
 	return productsList, *paginatorOutput, nil
 }
 
+func (p *ProductSqlite) GetManyAfterID(cursorInput entity.ProductCursorInput) ([]*entity.Product, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	var categoryId interface{}
+
+	if cursorInput.CategoryID > 0 {
+		categoryId = int64(cursorInput.CategoryID)
+	}
+
+	productsOutput, err := p.DB.GetProductsAfterID(ctx, sqlite.GetProductsAfterIDParams{
+		AfterID:    int64(cursorInput.AfterID),
+		CategoryID: categoryId,
+		Limit:      cursorInput.Limit,
+		Offset:     cursorInput.Skip,
+	})
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	productsList := make([]*entity.Product, 0, len(productsOutput))
+
+	for _, productOutput := range productsOutput {
+		product, entityErr := entity.NewProduct(entity.ProductProps{
+			ID:                  types.ProductID(productOutput.ID),
+			PublicID:            types.ProductPublicID(productOutput.PublicID),
+			CategoryID:          types.CategoryID(productOutput.CategoryID),
+			Name:                types.ProductName(productOutput.Name),
+			Description:         productOutput.Description.String,
+			Price:               productOutput.Price,
+			Rating:              int8(productOutput.Rating),
+			ImageURL:            productOutput.ImageUrl.String,
+			SpecificationValues: []*entity.ProductSpecificationValue{},
+		})
+
+		if entityErr != nil {
+			return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		productsList = append(productsList, product)
+	}
+
+	return productsList, nil
+}
+
 func (p *ProductSqlite) GetOneByPublicId(publicId types.ProductPublicID) (*entity.Product, exceptions.RepositoryException) {
 	ctx := context.Background()
 
@@ -201,12 +639,19 @@ func (p *ProductSqlite) GetOneByPublicId(publicId types.ProductPublicID) (*entit
 		ID:                  types.ProductID(productOutput.ID),
 		PublicID:            types.ProductPublicID(productOutput.PublicID),
 		CategoryID:          types.CategoryID(productOutput.CategoryID),
+		CategoryPublicID:    types.CategoryPublicID(productOutput.CategoryPublicID),
 		Name:                types.ProductName(productOutput.Name),
 		Description:         productOutput.Description.String,
 		Price:               productOutput.Price,
 		Rating:              int8(productOutput.Rating),
+		ReviewCount:         productOutput.ReviewCount,
 		ImageURL:            productOutput.ImageUrl.String,
 		SpecificationValues: []*entity.ProductSpecificationValue{},
+		ParentID:            types.ProductID(productOutput.ParentID.Int64),
+		ParentPublicID:      types.ProductPublicID(productOutput.ParentPublicID.String),
+		VariantLabel:        productOutput.VariantLabel.String,
+		Version:             productOutput.Version,
+		PublishedAt:         parseDateTime(productOutput.PublishedAt.String),
 	})
 
 	if entityErr != nil {
@@ -262,6 +707,46 @@ func (p *ProductSqlite) GetOneByPublicId(publicId types.ProductPublicID) (*entit
 	return product, nil
 }
 
+// GetOneDeletedByPublicId returns a product in the trash, without its specification
+// values.
+func (p *ProductSqlite) GetOneDeletedByPublicId(publicId types.ProductPublicID) (*entity.Product, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	productOutput, err := p.DB.GetOneDeletedProductByPublicId(ctx, string(publicId))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	product, entityErr := entity.NewProduct(entity.ProductProps{
+		ID:                  types.ProductID(productOutput.ID),
+		PublicID:            types.ProductPublicID(productOutput.PublicID),
+		CategoryID:          types.CategoryID(productOutput.CategoryID),
+		CategoryPublicID:    types.CategoryPublicID(productOutput.CategoryPublicID),
+		Name:                types.ProductName(productOutput.Name),
+		Description:         productOutput.Description.String,
+		Price:               productOutput.Price,
+		Rating:              int8(productOutput.Rating),
+		ReviewCount:         productOutput.ReviewCount,
+		ImageURL:            productOutput.ImageUrl.String,
+		SpecificationValues: []*entity.ProductSpecificationValue{},
+		ParentID:            types.ProductID(productOutput.ParentID.Int64),
+		ParentPublicID:      types.ProductPublicID(productOutput.ParentPublicID.String),
+		VariantLabel:        productOutput.VariantLabel.String,
+		Version:             productOutput.Version,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return product, nil
+}
+
 func (p *ProductSqlite) GetOneByPublicIdWithSpecificationGroups(publicId types.ProductPublicID) (*aggregate.ProductWithSpecificationsGroups, exceptions.RepositoryException) {
 	ctx := context.Background()
 
@@ -274,7 +759,9 @@ func (p *ProductSqlite) GetOneByPublicIdWithSpecificationGroups(publicId types.P
 	}
 
 	if len(outputs) == 0 {
-		return nil, exceptions.Repo(errors.New("product not found"))
+		return nil, exceptions.Repo(errors.New("product not found"), exceptions.RepositoryOpts{
+			Reason: constants.RepositoryNotFoundError,
+		})
 	}
 
 	product, entityErr := entity.NewProduct(entity.ProductProps{
@@ -285,8 +772,12 @@ func (p *ProductSqlite) GetOneByPublicIdWithSpecificationGroups(publicId types.P
 		Description:         outputs[0].ProductDescription.String,
 		Price:               outputs[0].ProductPrice,
 		Rating:              int8(outputs[0].ProductRating),
+		ReviewCount:         outputs[0].ProductReviewCount,
 		ImageURL:            outputs[0].ProductImageUrl.String,
 		SpecificationValues: []*entity.ProductSpecificationValue{},
+		ParentID:            types.ProductID(outputs[0].ProductParentID.Int64),
+		ParentPublicID:      types.ProductPublicID(outputs[0].ProductParentPublicID.String),
+		VariantLabel:        outputs[0].ProductVariantLabel.String,
 	})
 
 	if entityErr != nil {
@@ -326,6 +817,8 @@ func (p *ProductSqlite) GetOneByPublicIdWithSpecificationGroups(publicId types.P
 			SpecificationID: types.SpecificationID(output.SpecificationID),
 			Type:            types.SpecificationType(output.SpecificationType),
 			Value:           specValue,
+			Inherited:       output.SpecificationInherited == 1,
+			Version:         output.SpecificationVersion,
 		})
 
 		if entityErr != nil {
@@ -394,23 +887,264 @@ func (p *ProductSqlite) GetOneByPublicIdWithSpecificationGroups(publicId types.P
 	return aggregate, nil
 }
 
+// UpdateOne updates the product and, for a family, moves its variants along with
+// the category. It only writes over the version the product was read at, and
+// moves the product to the next one.
 func (p *ProductSqlite) UpdateOne(product *entity.Product) exceptions.RepositoryException {
 	ctx := context.Background()
 
-	err := p.DB.UpdateOneProduct(ctx, sqlite.UpdateOneProductParams{
-		ID:          int64(product.ID),
-		Name:        string(product.Name),
-		Description: sql.NullString{String: product.Description, Valid: product.Description != ""},
-		Price:       product.Price,
-		Rating:      int64(product.Rating),
-		ImageUrl:    sql.NullString{String: product.ImageURL, Valid: product.ImageURL != ""},
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	// the alerts of the watchlists are sent out of the request, from the check left
+	// here when the price changes
+	err = qtx.CreateWatchlistPriceCheck(ctx, sqlite.CreateWatchlistPriceCheckParams{
+		ProductID: int64(product.ID),
+		Price:     product.Price,
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	result, err := qtx.UpdateOneProduct(ctx, sqlite.UpdateOneProductParams{
+		ID:           int64(product.ID),
+		Version:      product.Version,
+		CategoryID:   int64(product.CategoryID),
+		Name:         string(product.Name),
+		Description:  sql.NullString{String: product.Description, Valid: product.Description != ""},
+		Price:        product.Price,
+		Rating:       int64(product.Rating),
+		ImageUrl:     sql.NullString{String: product.ImageURL, Valid: product.ImageURL != ""},
+		VariantLabel: sql.NullString{String: product.VariantLabel, Valid: product.IsVariant()},
+	})
+
+	if repoErr := versionedWriteError(result, err, "product", int64(product.ID)); repoErr != nil {
+		return repoErr
+	}
+
+	if !product.IsVariant() {
+		err = qtx.UpdateProductVariantsCategory(ctx, sqlite.UpdateProductVariantsCategoryParams{
+			CategoryID: int64(product.CategoryID),
+			ParentID:   sql.NullInt64{Int64: int64(product.ID), Valid: true},
+		})
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+	}
+
+	err = createOutboxEvent(ctx, qtx, constants.OutboxEventProductUpdated, constants.OutboxAggregateProduct, string(product.PublicID), toProductEventPayload(product))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	product.Version++
+
+	return nil
+}
+
+// PublishOne publishes the product alone, a variant is published on its own.
+func (p *ProductSqlite) PublishOne(product *entity.Product) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	result, err := qtx.PublishOneProduct(ctx, sqlite.PublishOneProductParams{
+		ID:      int64(product.ID),
+		Version: product.Version,
 	})
 
+	if repoErr := versionedWriteError(result, err, "product", int64(product.ID)); repoErr != nil {
+		return repoErr
+	}
+
+	err = createOutboxEvent(ctx, qtx, constants.OutboxEventProductUpdated, constants.OutboxAggregateProduct, string(product.PublicID), toProductEventPayload(product))
+
 	if err != nil {
 		return exceptions.Repo(err, exceptions.RepositoryOpts{
 			Reason: sqlite.Reason(err),
 		})
 	}
 
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	product.Version++
+	product.PublishedAt = time.Now().UTC().Truncate(time.Second)
+
+	return nil
+}
+
+func (p *ProductSqlite) GetAllVariants(parent *entity.Product) ([]*entity.Product, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	repoErr := p.attachVariants(ctx, []*entity.Product{parent})
+
+	if repoErr != nil {
+		return nil, repoErr
+	}
+
+	return parent.Variants, nil
+}
+
+// GetRevision takes now because an offer stops being current with no write, which
+// offers are current is part of the revision.
+func (p *ProductSqlite) GetRevision(publicId types.ProductPublicID, now time.Time) (*entity.Revision, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	revisionOutput, err := p.DB.GetProductRevisionParts(ctx, sqlite.GetProductRevisionPartsParams{
+		PublicID:     string(publicId),
+		CurrentSince: now.Add(-constants.ProductOfferMaxAge).UTC().Format(time.DateTime),
+	})
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	// the product itself is always a part, none means it does not exist
+	if len(revisionOutput) == 0 {
+		return nil, exceptions.Repo(sql.ErrNoRows, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(sql.ErrNoRows),
+		})
+	}
+
+	parts := make([]entity.RevisionPart, len(revisionOutput))
+
+	for i, row := range revisionOutput {
+		parts[i] = entity.RevisionPart{
+			Kind:      row.Part,
+			ID:        row.ID,
+			Version:   row.Version,
+			State:     row.State,
+			ChangedAt: parseDateTime(row.ChangedAt),
+		}
+	}
+
+	return entity.NewRevision(parts...), nil
+}
+
+// attachVariants loads the variants of every product in a single query and sets
+// them on Variants.
+func (p *ProductSqlite) attachVariants(ctx context.Context, products []*entity.Product) exceptions.RepositoryException {
+	if len(products) == 0 {
+		return nil
+	}
+
+	parentIds := make([]int64, len(products))
+	productsById := make(map[types.ProductID]*entity.Product, len(products))
+
+	for i, product := range products {
+		parentIds[i] = int64(product.ID)
+		productsById[product.ID] = product
+		product.Variants = []*entity.Product{}
+	}
+
+	parentIdsJson, err := json.Marshal(parentIds)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryUnknownError,
+		})
+	}
+
+	variantsOutput, err := p.DB.GetProductVariantsByParentIDs(ctx, string(parentIdsJson))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	for _, variantOutput := range variantsOutput {
+		variant, entityErr := entity.NewProduct(entity.ProductProps{
+			ID:             types.ProductID(variantOutput.ID),
+			PublicID:       types.ProductPublicID(variantOutput.PublicID),
+			CategoryID:     types.CategoryID(variantOutput.CategoryID),
+			Name:           types.ProductName(variantOutput.Name),
+			Description:    variantOutput.Description.String,
+			Price:          variantOutput.Price,
+			Rating:         int8(variantOutput.Rating),
+			ReviewCount:    variantOutput.ReviewCount,
+			ImageURL:       variantOutput.ImageUrl.String,
+			ParentID:       types.ProductID(variantOutput.ParentID.Int64),
+			ParentPublicID: types.ProductPublicID(variantOutput.ParentPublicID),
+			VariantLabel:   variantOutput.VariantLabel.String,
+		})
+
+		if entityErr != nil {
+			return exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		if parent, exists := productsById[variant.ParentID]; exists {
+			parent.Variants = append(parent.Variants, variant)
+		}
+	}
+
+	return nil
+}
+
+// versionedWriteError reports a failed conditional write; no affected rows means the
+// row is no longer at the version it was read at.
+func versionedWriteError(result sql.Result, err error, kind string, id int64) exceptions.RepositoryException {
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	rows, err := result.RowsAffected()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if rows == 0 {
+		return exceptions.Repo(fmt.Errorf("%s %d was changed by someone else", kind, id), exceptions.RepositoryOpts{
+			Reason: constants.RepositoryVersionConflictError,
+		})
+	}
+
 	return nil
 }
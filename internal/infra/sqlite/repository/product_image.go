@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+)
+
+type ProductImageSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewProductImageSqlite(dbConn *sql.DB) repository.ProductImage {
+	return &ProductImageSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (p *ProductImageSqlite) GetAllByProductID(productID types.ProductID) ([]*entity.ProductImage, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	imagesOutput, err := p.DB.GetAllProductImagesByProductID(ctx, int64(productID))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	images := make([]*entity.ProductImage, 0, len(imagesOutput))
+
+	for _, imageOutput := range imagesOutput {
+		image, entityErr := entity.NewProductImage(entity.ProductImageProps{
+			ID:           imageOutput.ID,
+			PublicID:     types.ProductImagePublicID(imageOutput.PublicID),
+			ProductID:    types.ProductID(imageOutput.ProductID),
+			Position:     int(imageOutput.Position),
+			AltText:      imageOutput.AltText,
+			ContentType:  imageOutput.ContentType,
+			Size:         imageOutput.Size,
+			Width:        int(imageOutput.Width),
+			Height:       int(imageOutput.Height),
+			StorageKey:   imageOutput.StorageKey,
+			ThumbnailKey: imageOutput.ThumbnailKey,
+		})
+
+		if entityErr != nil {
+			return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		images = append(images, image)
+	}
+
+	return images, nil
+}
+
+func (p *ProductImageSqlite) CreateOne(image *entity.ProductImage, gallery []*entity.ProductImage) exceptions.RepositoryException {
+	return p.withGallery(gallery, image, func(ctx context.Context, qtx *sqlite.Queries) error {
+		result, err := qtx.CreateOneProductImage(ctx, sqlite.CreateOneProductImageParams{
+			PublicID:     string(image.PublicID),
+			ProductID:    int64(image.ProductID),
+			Position:     int64(image.Position),
+			AltText:      image.AltText,
+			ContentType:  image.ContentType,
+			Size:         image.Size,
+			Width:        int64(image.Width),
+			Height:       int64(image.Height),
+			StorageKey:   image.StorageKey,
+			ThumbnailKey: image.ThumbnailKey,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		id, err := result.LastInsertId()
+
+		if err != nil {
+			return err
+		}
+
+		image.ID = id
+
+		return nil
+	})
+}
+
+func (p *ProductImageSqlite) UpdateOne(image *entity.ProductImage, gallery []*entity.ProductImage) exceptions.RepositoryException {
+	return p.withGallery(gallery, image, func(ctx context.Context, qtx *sqlite.Queries) error {
+		return qtx.UpdateOneProductImage(ctx, sqlite.UpdateOneProductImageParams{
+			Position: int64(image.Position),
+			AltText:  image.AltText,
+			ID:       image.ID,
+		})
+	})
+}
+
+func (p *ProductImageSqlite) DeleteOne(image *entity.ProductImage, gallery []*entity.ProductImage) exceptions.RepositoryException {
+	return p.withGallery(gallery, image, func(ctx context.Context, qtx *sqlite.Queries) error {
+		return qtx.DeleteOneProductImage(ctx, image.ID)
+	})
+}
+
+// withGallery runs write for the given image and then stores the position and alt
+// text of the rest of the gallery, all in a single transaction.
+func (p *ProductImageSqlite) withGallery(gallery []*entity.ProductImage, image *entity.ProductImage, write func(context.Context, *sqlite.Queries) error) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	if err := write(ctx, qtx); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	for _, current := range gallery {
+		if current == image {
+			continue
+		}
+
+		err := qtx.UpdateOneProductImage(ctx, sqlite.UpdateOneProductImageParams{
+			Position: int64(current.Position),
+			AltText:  current.AltText,
+			ID:       current.ID,
+		})
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+	"time"
+)
+
+type ProductOfferSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewProductOfferSqlite(dbConn *sql.DB) repository.ProductOffer {
+	return &ProductOfferSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (p *ProductOfferSqlite) GetAllByProductIDs(productIds []types.ProductID) ([]*entity.ProductOffer, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	if len(productIds) == 0 {
+		return []*entity.ProductOffer{}, nil
+	}
+
+	productIdsJson, err := json.Marshal(productIds)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryUnknownError,
+		})
+	}
+
+	offersOutput, err := p.DB.GetProductOffersByProductIDs(ctx, string(productIdsJson))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	offers := make([]*entity.ProductOffer, 0, len(offersOutput))
+
+	for _, offerOutput := range offersOutput {
+		offer, entityErr := entity.NewProductOffer(entity.ProductOfferProps{
+			ID:               offerOutput.ID,
+			PublicID:         types.ProductOfferPublicID(offerOutput.PublicID),
+			ProductID:        types.ProductID(offerOutput.ProductID),
+			RetailerID:       types.RetailerID(offerOutput.RetailerID),
+			RetailerPublicID: types.RetailerPublicID(offerOutput.RetailerPublicID),
+			RetailerName:     offerOutput.RetailerName,
+			Price:            offerOutput.Price,
+			URL:              offerOutput.Url,
+			Availability:     types.ProductOfferAvailability(offerOutput.Availability),
+			LastSeenAt:       parseDateTime(offerOutput.LastSeenAt),
+		})
+
+		if entityErr != nil {
+			return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		offers = append(offers, offer)
+	}
+
+	return offers, nil
+}
+
+func (p *ProductOfferSqlite) GetOneByRetailerID(productId types.ProductID, retailerId types.RetailerID) (*entity.ProductOffer, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	offerOutput, err := p.DB.GetOneProductOfferByRetailerID(ctx, sqlite.GetOneProductOfferByRetailerIDParams{
+		ProductID:  int64(productId),
+		RetailerID: int64(retailerId),
+	})
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	offer, entityErr := entity.NewProductOffer(entity.ProductOfferProps{
+		ID:               offerOutput.ID,
+		PublicID:         types.ProductOfferPublicID(offerOutput.PublicID),
+		ProductID:        types.ProductID(offerOutput.ProductID),
+		RetailerID:       types.RetailerID(offerOutput.RetailerID),
+		RetailerPublicID: types.RetailerPublicID(offerOutput.RetailerPublicID),
+		RetailerName:     offerOutput.RetailerName,
+		Price:            offerOutput.Price,
+		URL:              offerOutput.Url,
+		Availability:     types.ProductOfferAvailability(offerOutput.Availability),
+		LastSeenAt:       parseDateTime(offerOutput.LastSeenAt),
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return offer, nil
+}
+
+func (p *ProductOfferSqlite) CreateOne(offer *entity.ProductOffer) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	result, err := p.DB.CreateOneProductOffer(ctx, sqlite.CreateOneProductOfferParams{
+		PublicID:     string(offer.PublicID),
+		ProductID:    int64(offer.ProductID),
+		RetailerID:   int64(offer.RetailerID),
+		Price:        offer.Price,
+		Url:          offer.URL,
+		Availability: string(offer.Availability),
+		LastSeenAt:   offer.LastSeenAt.UTC().Format(time.DateTime),
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	offer.ID = id
+
+	return nil
+}
+
+func (p *ProductOfferSqlite) UpdateOne(offer *entity.ProductOffer) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := p.DB.UpdateOneProductOffer(ctx, sqlite.UpdateOneProductOfferParams{
+		Price:        offer.Price,
+		Url:          offer.URL,
+		Availability: string(offer.Availability),
+		LastSeenAt:   offer.LastSeenAt.UTC().Format(time.DateTime),
+		ID:           offer.ID,
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+// DeleteOne moves the Last-Modified of the product, a deleted offer leaves no row
+// behind to tell it changed.
+func (p *ProductOfferSqlite) DeleteOne(offer *entity.ProductOffer) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	err = qtx.DeleteOneProductOffer(ctx, offer.ID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = qtx.TouchOneProduct(ctx, int64(offer.ProductID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+	"time"
+)
+
+type ProductReviewSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewProductReviewSqlite(dbConn *sql.DB) repository.ProductReview {
+	return &ProductReviewSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (p *ProductReviewSqlite) GetOneByPublicID(productID types.ProductID, publicID types.ProductReviewPublicID) (*entity.ProductReview, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	reviewOutput, err := p.DB.GetOneProductReviewByPublicID(ctx, sqlite.GetOneProductReviewByPublicIDParams{
+		ProductID: int64(productID),
+		PublicID:  string(publicID),
+	})
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	review, entityErr := entity.NewProductReview(entity.ProductReviewProps{
+		ID:           reviewOutput.ID,
+		PublicID:     types.ProductReviewPublicID(reviewOutput.PublicID),
+		ProductID:    types.ProductID(reviewOutput.ProductID),
+		Author:       reviewOutput.Author,
+		Stars:        int8(reviewOutput.Stars),
+		Text:         reviewOutput.Text,
+		HelpfulVotes: reviewOutput.HelpfulVotes,
+		Status:       types.ProductReviewStatus(reviewOutput.Status),
+		CreatedAt:    parseDateTime(reviewOutput.CreatedAt),
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return review, nil
+}
+
+func (p *ProductReviewSqlite) GetAllByProductID(productID types.ProductID, status types.ProductReviewStatus, paginationInput entity.PaginatorInput) ([]*entity.ProductReview, entity.PaginatorOutput, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	paginatorOutput := &entity.PaginatorOutput{Total: 0}
+
+	reviewsOutput, err := p.DB.GetAllProductReviewsByProductID(ctx, sqlite.GetAllProductReviewsByProductIDParams{
+		ProductID: int64(productID),
+		Status:    string(status),
+		Limit:     paginationInput.Limit,
+		Offset:    paginationInput.Skip,
+	})
+
+	if err != nil {
+		return nil, *paginatorOutput, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	reviews := make([]*entity.ProductReview, 0, len(reviewsOutput))
+
+	for _, reviewOutput := range reviewsOutput {
+		review, entityErr := entity.NewProductReview(entity.ProductReviewProps{
+			ID:           reviewOutput.ID,
+			PublicID:     types.ProductReviewPublicID(reviewOutput.PublicID),
+			ProductID:    types.ProductID(reviewOutput.ProductID),
+			Author:       reviewOutput.Author,
+			Stars:        int8(reviewOutput.Stars),
+			Text:         reviewOutput.Text,
+			HelpfulVotes: reviewOutput.HelpfulVotes,
+			Status:       types.ProductReviewStatus(reviewOutput.Status),
+			CreatedAt:    parseDateTime(reviewOutput.CreatedAt),
+		})
+
+		if entityErr != nil {
+			return nil, *paginatorOutput, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		if paginatorOutput.Total == 0 {
+			paginatorOutput.Total = reviewOutput.ReviewsQuantity
+		}
+
+		reviews = append(reviews, review)
+	}
+
+	return reviews, *paginatorOutput, nil
+}
+
+func (p *ProductReviewSqlite) GetRatingSummary(productID types.ProductID) (*entity.ProductRatingSummary, exceptions.RepositoryException) {
+	return p.getRatingSummary(context.Background(), p.DB, productID)
+}
+
+func (p *ProductReviewSqlite) CreateOne(review *entity.ProductReview) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	result, err := p.DB.CreateOneProductReview(ctx, sqlite.CreateOneProductReviewParams{
+		PublicID:  string(review.PublicID),
+		ProductID: int64(review.ProductID),
+		Author:    review.Author,
+		Stars:     int64(review.Stars),
+		Text:      review.Text,
+		Status:    string(review.Status),
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	review.ID = id
+
+	if review.CreatedAt.IsZero() {
+		review.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	}
+
+	return nil
+}
+
+func (p *ProductReviewSqlite) ModerateOne(review *entity.ProductReview, product *entity.Product) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	err = qtx.UpdateProductReviewStatus(ctx, sqlite.UpdateProductReviewStatusParams{
+		Status: string(review.Status),
+		ID:     review.ID,
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	summary, repoErr := p.getRatingSummary(ctx, qtx, product.ID)
+
+	if repoErr != nil {
+		return repoErr
+	}
+
+	if entityErr := product.ApplyRatingSummary(summary); entityErr != nil {
+		return exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	err = qtx.UpdateProductRatingSummary(ctx, sqlite.UpdateProductRatingSummaryParams{
+		Rating:      int64(product.Rating),
+		ReviewCount: product.ReviewCount,
+		ID:          int64(product.ID),
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+func (p *ProductReviewSqlite) AddHelpfulVote(review *entity.ProductReview) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	helpfulVotes, err := p.DB.AddProductReviewHelpfulVote(ctx, review.ID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	review.HelpfulVotes = helpfulVotes
+
+	return nil
+}
+
+func (p *ProductReviewSqlite) getRatingSummary(ctx context.Context, queries *sqlite.Queries, productID types.ProductID) (*entity.ProductRatingSummary, exceptions.RepositoryException) {
+	distributionOutput, err := queries.GetProductReviewDistribution(ctx, int64(productID))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	distribution := make(map[int8]int64, len(distributionOutput))
+
+	for _, row := range distributionOutput {
+		distribution[int8(row.Stars)] = row.ReviewsQuantity
+	}
+
+	summary, entityErr := entity.NewProductRatingSummary(productID, distribution)
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return summary, nil
+}
+
+// parseDateTime reads the timestamps written by datetime('now'), which are stored
+// in UTC without a zone.
+func parseDateTime(value string) time.Time {
+	parsed, err := time.ParseInLocation(time.DateTime, value, time.UTC)
+
+	if err != nil {
+		return time.Time{}
+	}
+
+	return parsed
+}
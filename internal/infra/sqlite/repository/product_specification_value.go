@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"project/internal/domain/constants"
 	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
@@ -22,36 +23,31 @@ func NewProductSpecificationValueSqlite(dbConn *sql.DB) repository.ProductSpecif
 	}
 }
 
+// CreateOne writes the value and the product.specifications_updated event of its
+// product in a single transaction, like the other writes of a single value.
 func (p *ProductSpecificationValueSqlite) CreateOne(productSpec *entity.ProductSpecificationValue) exceptions.RepositoryException {
 	ctx := context.Background()
 
-	var stringVal string
-	var intVal int64
-	var boolVal int64
+	tx, err := p.Conn.BeginTx(ctx, nil)
 
-	if productSpec.Value.StringValue != nil {
-		stringVal = *productSpec.Value.StringValue
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
 	}
 
-	if productSpec.Value.IntValue != nil {
-		intVal = *productSpec.Value.IntValue
-	}
+	defer tx.Rollback()
 
-	if productSpec.Value.BoolValue != nil {
-		if *productSpec.Value.BoolValue {
-			boolVal = 1
-		} else {
-			boolVal = 0
-		}
+	qtx := p.DB.WithTx(tx)
 
-	}
+	stringVal, intVal, boolVal := toNullSpecValue(productSpec.Value)
 
-	productSpecOutput, err := p.DB.CreateOneProductSpecificationValue(ctx, sqlite.CreateOneProductSpecificationValueParams{
+	productSpecOutput, err := qtx.CreateOneProductSpecificationValue(ctx, sqlite.CreateOneProductSpecificationValueParams{
 		ProductID:       int64(productSpec.ProductID),
 		SpecificationID: int64(productSpec.SpecificationID),
-		StringValue:     sql.NullString{String: stringVal, Valid: productSpec.Value.StringValue != nil},
-		IntValue:        sql.NullInt64{Int64: intVal, Valid: productSpec.Value.IntValue != nil},
-		BoolValue:       sql.NullInt64{Int64: boolVal, Valid: productSpec.Value.BoolValue != nil},
+		StringValue:     stringVal,
+		IntValue:        intVal,
+		BoolValue:       boolVal,
 	})
 
 	if err != nil {
@@ -70,9 +66,306 @@ func (p *ProductSpecificationValueSqlite) CreateOne(productSpec *entity.ProductS
 
 	productSpec.ID = id
 
+	err = qtx.TouchOneProduct(ctx, int64(productSpec.ProductID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = createProductSpecificationsOutboxEvent(ctx, qtx, productSpec.ProductID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+// UpdateOne only writes over the version the value was read at, and moves the value
+// to the next one.
+func (p *ProductSpecificationValueSqlite) UpdateOne(productSpec *entity.ProductSpecificationValue) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	stringVal, intVal, boolVal := toNullSpecValue(productSpec.Value)
+
+	result, err := qtx.UpdateOneProductSpecificationValue(ctx, sqlite.UpdateOneProductSpecificationValueParams{
+		ID:          productSpec.ID,
+		Version:     productSpec.Version,
+		StringValue: stringVal,
+		IntValue:    intVal,
+		BoolValue:   boolVal,
+	})
+
+	if repoErr := versionedWriteError(result, err, "product specification value", productSpec.ID); repoErr != nil {
+		return repoErr
+	}
+
+	err = qtx.TouchOneProduct(ctx, int64(productSpec.ProductID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = createProductSpecificationsOutboxEvent(ctx, qtx, productSpec.ProductID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	productSpec.Version++
+
 	return nil
 }
 
+func (p *ProductSpecificationValueSqlite) UpsertOne(productSpec *entity.ProductSpecificationValue) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	stringVal, intVal, boolVal := toNullSpecValue(productSpec.Value)
+
+	upsertOutput, err := qtx.UpsertOneProductSpecificationValue(ctx, sqlite.UpsertOneProductSpecificationValueParams{
+		ProductID:       int64(productSpec.ProductID),
+		SpecificationID: int64(productSpec.SpecificationID),
+		StringValue:     stringVal,
+		IntValue:        intVal,
+		BoolValue:       boolVal,
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	productSpec.ID = upsertOutput.ID
+	productSpec.Version = upsertOutput.Version
+
+	err = qtx.TouchOneProduct(ctx, int64(productSpec.ProductID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = createProductSpecificationsOutboxEvent(ctx, qtx, productSpec.ProductID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+func (p *ProductSpecificationValueSqlite) DeleteOne(productSpec *entity.ProductSpecificationValue) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	err = qtx.DeleteOneProductSpecificationValue(ctx, productSpec.ID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = qtx.TouchOneProduct(ctx, int64(productSpec.ProductID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = createProductSpecificationsOutboxEvent(ctx, qtx, productSpec.ProductID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+// ReplaceManyByProductID swaps the whole specification sheet of a product in a single
+// transaction: either every value is written or none is.
+func (p *ProductSpecificationValueSqlite) ReplaceManyByProductID(productID types.ProductID, productSpecs []*entity.ProductSpecificationValue) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := p.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := p.DB.WithTx(tx)
+
+	err = qtx.DeleteAllProductSpecificationValuesByProductID(ctx, int64(productID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	for _, productSpec := range productSpecs {
+		stringVal, intVal, boolVal := toNullSpecValue(productSpec.Value)
+
+		result, err := qtx.CreateOneProductSpecificationValue(ctx, sqlite.CreateOneProductSpecificationValueParams{
+			ProductID:       int64(productID),
+			SpecificationID: int64(productSpec.SpecificationID),
+			StringValue:     stringVal,
+			IntValue:        intVal,
+			BoolValue:       boolVal,
+		})
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		id, err := result.LastInsertId()
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		productSpec.ID = id
+	}
+
+	err = qtx.TouchOneProduct(ctx, int64(productID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = createProductSpecificationsOutboxEvent(ctx, qtx, productID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+func (p *ProductSpecificationValueSqlite) FindOneByProductIDAndSpecificationID(productID types.ProductID, specificationID types.SpecificationID) (*entity.ProductSpecificationValue, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	productSpecOutput, err := p.DB.GetOneProductSpecificationValueByProductIDAndSpecificationID(ctx, sqlite.GetOneProductSpecificationValueByProductIDAndSpecificationIDParams{
+		ProductID:       int64(productID),
+		SpecificationID: int64(specificationID),
+	})
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	productSpec, entityErr := entity.NewProductSpecificationValue(entity.ProductSpecificationValueProps{
+		ID:              productSpecOutput.ID,
+		ProductID:       types.ProductID(productSpecOutput.ProductID),
+		SpecificationID: types.SpecificationID(productSpecOutput.SpecificationID),
+		Type:            types.SpecificationType(productSpecOutput.Type),
+		Value:           toSpecValue(productSpecOutput.StringValue, productSpecOutput.IntValue, productSpecOutput.BoolValue),
+		Version:         productSpecOutput.Version,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return productSpec, nil
+}
+
 func (p *ProductSpecificationValueSqlite) FindManyByProductID(productID types.ProductID) ([]*entity.ProductSpecificationValue, exceptions.RepositoryException) {
 	ctx := context.Background()
 
@@ -87,30 +380,91 @@ func (p *ProductSpecificationValueSqlite) FindManyByProductID(productID types.Pr
 	}
 
 	for _, productSpecOutput := range productSpecsOutput {
-		specValue := &entity.SpecValue{}
-		if productSpecOutput.StringValue.Valid {
-			specValue.StringValue = &productSpecOutput.StringValue.String
-		}
+		productSpecEntity, entityErr := entity.NewProductSpecificationValue(entity.ProductSpecificationValueProps{
+			ID:              productSpecOutput.ID,
+			ProductID:       types.ProductID(productSpecOutput.ProductID),
+			SpecificationID: types.SpecificationID(productSpecOutput.SpecificationID),
+			Type:            types.SpecificationType(productSpecOutput.Type),
+			Value:           toSpecValue(productSpecOutput.StringValue, productSpecOutput.IntValue, productSpecOutput.BoolValue),
+		})
 
-		if productSpecOutput.IntValue.Valid {
-			specValue.IntValue = &productSpecOutput.IntValue.Int64
+		if entityErr != nil {
+			return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
 		}
 
-		if productSpecOutput.BoolValue.Valid {
-			var boolVal bool
-			if productSpecOutput.BoolValue.Int64 == 1 {
-				boolVal = true
-				specValue.BoolValue = &boolVal
-			} else {
-				specValue.BoolValue = &boolVal
-			}
+		productSpecs = append(productSpecs, productSpecEntity)
+	}
+
+	return productSpecs, nil
+}
+
+func (p *ProductSpecificationValueSqlite) FindManyByProductIDs(productIDs []types.ProductID) ([]*entity.ProductSpecificationValue, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	productSpecs := []*entity.ProductSpecificationValue{}
+
+	if len(productIDs) == 0 {
+		return productSpecs, nil
+	}
+
+	ids := make([]int64, len(productIDs))
+
+	for i, productID := range productIDs {
+		ids[i] = int64(productID)
+	}
+
+	productSpecsOutput, err := p.DB.GetAllProductSpecificationValuesByProductIDs(ctx, ids)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	for _, productSpecOutput := range productSpecsOutput {
+		productSpecEntity, entityErr := entity.NewProductSpecificationValue(entity.ProductSpecificationValueProps{
+			ID:              productSpecOutput.ID,
+			ProductID:       types.ProductID(productSpecOutput.ProductID),
+			SpecificationID: types.SpecificationID(productSpecOutput.SpecificationID),
+			Type:            types.SpecificationType(productSpecOutput.Type),
+			Value:           toSpecValue(productSpecOutput.StringValue, productSpecOutput.IntValue, productSpecOutput.BoolValue),
+		})
+
+		if entityErr != nil {
+			return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
 		}
+
+		productSpecs = append(productSpecs, productSpecEntity)
+	}
+
+	return productSpecs, nil
+}
+
+func (p *ProductSpecificationValueSqlite) FindManyBySpecificationID(specificationID types.SpecificationID) ([]*entity.ProductSpecificationValue, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	productSpecs := []*entity.ProductSpecificationValue{}
+
+	productSpecsOutput, err := p.DB.GetAllProductSpecificationValuesBySpecificationID(ctx, int64(specificationID))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	for _, productSpecOutput := range productSpecsOutput {
 		productSpecEntity, entityErr := entity.NewProductSpecificationValue(entity.ProductSpecificationValueProps{
 			ID:              productSpecOutput.ID,
 			ProductID:       types.ProductID(productSpecOutput.ProductID),
 			SpecificationID: types.SpecificationID(productSpecOutput.SpecificationID),
 			Type:            types.SpecificationType(productSpecOutput.Type),
-			Value:           specValue,
+			Value:           toSpecValue(productSpecOutput.StringValue, productSpecOutput.IntValue, productSpecOutput.BoolValue),
+			Version:         productSpecOutput.Version,
 		})
 
 		if entityErr != nil {
@@ -124,3 +478,46 @@ func (p *ProductSpecificationValueSqlite) FindManyByProductID(productID types.Pr
 
 	return productSpecs, nil
 }
+
+func toSpecValue(stringVal sql.NullString, intVal sql.NullInt64, boolVal sql.NullInt64) *entity.SpecValue {
+	specValue := &entity.SpecValue{}
+
+	if stringVal.Valid {
+		specValue.StringValue = &stringVal.String
+	}
+
+	if intVal.Valid {
+		specValue.IntValue = &intVal.Int64
+	}
+
+	if boolVal.Valid {
+		value := boolVal.Int64 == 1
+		specValue.BoolValue = &value
+	}
+
+	return specValue
+}
+
+func toNullSpecValue(specValue *entity.SpecValue) (sql.NullString, sql.NullInt64, sql.NullInt64) {
+	var stringVal sql.NullString
+	var intVal sql.NullInt64
+	var boolVal sql.NullInt64
+
+	if specValue.StringValue != nil {
+		stringVal = sql.NullString{String: *specValue.StringValue, Valid: true}
+	}
+
+	if specValue.IntValue != nil {
+		intVal = sql.NullInt64{Int64: *specValue.IntValue, Valid: true}
+	}
+
+	if specValue.BoolValue != nil {
+		boolVal = sql.NullInt64{Int64: 0, Valid: true}
+
+		if *specValue.BoolValue {
+			boolVal.Int64 = 1
+		}
+	}
+
+	return stringVal, intVal, boolVal
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/infra/sqlite"
+	"time"
+)
+
+type RateLimitSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewRateLimitSqlite(dbConn *sql.DB) repository.RateLimit {
+	return &RateLimitSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (r *RateLimitSqlite) Take(key string, policy *entity.RateLimitPolicy, now time.Time) (*entity.RateLimitBucket, bool, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	bucketOutput, err := r.DB.TakeRateLimitToken(ctx, sqlite.TakeRateLimitTokenParams{
+		Key:        key,
+		Tokens:     float64(policy.Limit - 1),
+		Capacity:   float64(policy.Limit),
+		RefillRate: policy.RefillRate(),
+		UpdatedAt:  toUnixSeconds(now),
+	})
+
+	if err != nil {
+		return nil, false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return &entity.RateLimitBucket{
+		Key:        bucketOutput.Key,
+		Tokens:     bucketOutput.Tokens,
+		Capacity:   bucketOutput.Capacity,
+		RefillRate: bucketOutput.RefillRate,
+		UpdatedAt:  fromUnixSeconds(bucketOutput.UpdatedAt),
+	}, bucketOutput.Taken == 1, nil
+}
+
+func (r *RateLimitSqlite) DeleteFull(now time.Time) (int64, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	result, err := r.DB.DeleteFullRateLimitBuckets(ctx, toUnixSeconds(now))
+
+	if err != nil {
+		return 0, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	deleted, err := result.RowsAffected()
+
+	if err != nil {
+		return 0, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return deleted, nil
+}
+
+// toUnixSeconds keeps the fraction of a second, a bucket refills a little on every
+// request.
+func toUnixSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+func fromUnixSeconds(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+)
+
+type RetailerSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewRetailerSqlite(dbConn *sql.DB) repository.Retailer {
+	return &RetailerSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (r *RetailerSqlite) GetOneByPublicID(publicId types.RetailerPublicID) (*entity.Retailer, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	retailerOutput, err := r.DB.GetOneRetailerByPublicID(ctx, string(publicId))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	retailer, entityErr := entity.NewRetailer(entity.RetailerProps{
+		ID:         types.RetailerID(retailerOutput.ID),
+		PublicID:   types.RetailerPublicID(retailerOutput.PublicID),
+		Name:       retailerOutput.Name,
+		WebsiteURL: retailerOutput.WebsiteUrl,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return retailer, nil
+}
+
+func (r *RetailerSqlite) GetAll() ([]*entity.Retailer, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	retailersOutput, err := r.DB.GetAllRetailers(ctx)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	retailers := make([]*entity.Retailer, 0, len(retailersOutput))
+
+	for _, retailerOutput := range retailersOutput {
+		retailer, entityErr := entity.NewRetailer(entity.RetailerProps{
+			ID:         types.RetailerID(retailerOutput.ID),
+			PublicID:   types.RetailerPublicID(retailerOutput.PublicID),
+			Name:       retailerOutput.Name,
+			WebsiteURL: retailerOutput.WebsiteUrl,
+		})
+
+		if entityErr != nil {
+			return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		retailers = append(retailers, retailer)
+	}
+
+	return retailers, nil
+}
+
+func (r *RetailerSqlite) ExistsByName(name string, publicId types.RetailerPublicID) (bool, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	id, err := r.DB.CheckIfRetailerExists(ctx, sqlite.CheckIfRetailerExistsParams{
+		Name:     name,
+		PublicID: publicId,
+	})
+
+	if err != nil {
+		if sqlite.Reason(err) == constants.RepositoryNotFoundError {
+			return false, nil
+		}
+
+		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return id != 0, nil
+}
+
+func (r *RetailerSqlite) CreateOne(retailer *entity.Retailer) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	result, err := r.DB.CreateOneRetailer(ctx, sqlite.CreateOneRetailerParams{
+		PublicID:   string(retailer.PublicID),
+		Name:       retailer.Name,
+		WebsiteUrl: retailer.WebsiteURL,
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	retailer.ID = types.RetailerID(id)
+
+	return nil
+}
+
+func (r *RetailerSqlite) UpdateOne(retailer *entity.Retailer) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := r.DB.UpdateOneRetailer(ctx, sqlite.UpdateOneRetailerParams{
+		Name:       retailer.Name,
+		WebsiteUrl: retailer.WebsiteURL,
+		ID:         int64(retailer.ID),
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+func (r *RetailerSqlite) DeleteOne(retailer *entity.Retailer) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := r.DB.DeleteOneRetailer(ctx, int64(retailer.ID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
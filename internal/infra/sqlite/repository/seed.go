@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"project/internal/domain/aggregate"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/infra/sqlite"
+)
+
+type SeedSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewSeedSqlite(dbConn *sql.DB) repository.Seed {
+	return &SeedSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+// Reset deletes every catalog row, children first, in a single transaction.
+func (s *SeedSqlite) Reset() exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := s.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := s.DB.WithTx(tx)
+
+	deletes := []func(context.Context) error{
+		qtx.DeleteAllExternalReferences,
+		qtx.DeleteAllProductReviews,
+		qtx.DeleteAllWatchlistDeliveries,
+		qtx.DeleteAllWatchlistPriceChecks,
+		qtx.DeleteAllWatchlists,
+		qtx.DeleteAllProductOffers,
+		qtx.DeleteAllRetailers,
+		qtx.DeleteAllProductImages,
+		qtx.DeleteAllProductSpecificationValues,
+		qtx.DeleteAllCategorySpecifications,
+		qtx.DeleteAllProducts,
+		qtx.DeleteAllCategories,
+		qtx.DeleteAllSpecifications,
+		qtx.DeleteAllSpecificationGroups,
+	}
+
+	for _, deleteAll := range deletes {
+		if err := deleteAll(ctx); err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+// Apply writes the catalog keeping the IDs it carries. Rows that already exist
+// with the same ID and public ID are updated (and restored if deleted); an ID
+// taken by a row with another public ID is a conflict and nothing is written.
+// Category templates and product values are replaced by the ones given.
+func (s *SeedSqlite) Apply(catalog *aggregate.Catalog) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := s.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := s.DB.WithTx(tx)
+
+	for _, group := range catalog.SpecificationGroups {
+		rows, err := qtx.UpsertSeedSpecificationGroup(ctx, sqlite.UpsertSeedSpecificationGroupParams{
+			ID:          int64(group.ID),
+			PublicID:    string(group.PublicID),
+			Name:        group.Name,
+			Description: sql.NullString{String: group.Description, Valid: group.Description != ""},
+		})
+
+		if repoErr := seedUpsertError(err, rows, "specification group", int64(group.ID)); repoErr != nil {
+			return repoErr
+		}
+	}
+
+	for _, specification := range catalog.Specifications {
+		rows, err := qtx.UpsertSeedSpecification(ctx, sqlite.UpsertSeedSpecificationParams{
+			ID:                   int64(specification.ID),
+			PublicID:             string(specification.PublicID),
+			SpecificationGroupID: int64(specification.EspecificationGroupID),
+			Title:                specification.Title,
+			Type:                 string(specification.Type),
+		})
+
+		if repoErr := seedUpsertError(err, rows, "specification", int64(specification.ID)); repoErr != nil {
+			return repoErr
+		}
+	}
+
+	for _, category := range catalog.Categories {
+		rows, err := qtx.UpsertSeedCategory(ctx, sqlite.UpsertSeedCategoryParams{
+			ID:          int64(category.ID),
+			PublicID:    string(category.PublicID),
+			Name:        category.Name,
+			Description: sql.NullString{String: category.Description, Valid: category.Description != ""},
+		})
+
+		if repoErr := seedUpsertError(err, rows, "category", int64(category.ID)); repoErr != nil {
+			return repoErr
+		}
+	}
+
+	for _, template := range catalog.CategoryTemplates {
+		err := qtx.DeleteAllCategorySpecificationsByCategoryID(ctx, int64(template.CategoryID))
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		for _, categorySpecification := range template.Specifications {
+			result, err := qtx.CreateOneCategorySpecification(ctx, sqlite.CreateOneCategorySpecificationParams{
+				CategoryID:      int64(template.CategoryID),
+				SpecificationID: int64(categorySpecification.SpecificationID),
+				Requirement:     string(categorySpecification.Requirement),
+				DisplayOrder:    categorySpecification.DisplayOrder,
+			})
+
+			if err != nil {
+				return exceptions.Repo(err, exceptions.RepositoryOpts{
+					Reason: sqlite.Reason(err),
+				})
+			}
+
+			id, err := result.LastInsertId()
+
+			if err != nil {
+				return exceptions.Repo(err, exceptions.RepositoryOpts{
+					Reason: sqlite.Reason(err),
+				})
+			}
+
+			categorySpecification.ID = id
+		}
+	}
+
+	for _, product := range catalog.Products {
+		rows, err := qtx.UpsertSeedProduct(ctx, sqlite.UpsertSeedProductParams{
+			ID:          int64(product.ID),
+			PublicID:    string(product.PublicID),
+			CategoryID:  int64(product.CategoryID),
+			Name:        string(product.Name),
+			Description: sql.NullString{String: product.Description, Valid: product.Description != ""},
+			Price:       product.Price,
+			Rating:      int64(product.Rating),
+			ImageUrl:    sql.NullString{String: product.ImageURL, Valid: product.ImageURL != ""},
+		})
+
+		if repoErr := seedUpsertError(err, rows, "product", int64(product.ID)); repoErr != nil {
+			return repoErr
+		}
+
+		err = qtx.DeleteAllProductSpecificationValuesByProductID(ctx, int64(product.ID))
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		for _, productSpec := range product.SpecificationValues {
+			stringVal, intVal, boolVal := toNullSpecValue(productSpec.Value)
+
+			result, err := qtx.CreateOneProductSpecificationValue(ctx, sqlite.CreateOneProductSpecificationValueParams{
+				ProductID:       int64(product.ID),
+				SpecificationID: int64(productSpec.SpecificationID),
+				StringValue:     stringVal,
+				IntValue:        intVal,
+				BoolValue:       boolVal,
+			})
+
+			if err != nil {
+				return exceptions.Repo(err, exceptions.RepositoryOpts{
+					Reason: sqlite.Reason(err),
+				})
+			}
+
+			id, err := result.LastInsertId()
+
+			if err != nil {
+				return exceptions.Repo(err, exceptions.RepositoryOpts{
+					Reason: sqlite.Reason(err),
+				})
+			}
+
+			productSpec.ID = id
+			productSpec.ProductID = product.ID
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+// seedUpsertError reports a failed upsert; no affected rows means the ID belongs to
+// a row with another public ID.
+func seedUpsertError(err error, rows int64, kind string, id int64) exceptions.RepositoryException {
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if rows == 0 {
+		return exceptions.Repo(fmt.Errorf("%s %d already exists with another public ID", kind, id), exceptions.RepositoryOpts{
+			Reason: constants.RepositoryUniqueConstraintError,
+		})
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"project/internal/domain/constants"
 	"project/internal/domain/entity"
 	. "project/internal/domain/exception"
 	exceptions "project/internal/domain/exception"
@@ -51,6 +52,34 @@ func (s *Specificationqlite) GetAllByGroupID(specGroupID SpecificationGroupID) (
 	return specifications, nil
 }
 
+func (s *Specificationqlite) GetAll() ([]*entity.Specification, RepositoryException) {
+	ctx := context.Background()
+
+	specifications := []*entity.Specification{}
+
+	specificationsOutput, err := s.DB.ListAllSpecifications(ctx)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	for _, specificationOutput := range specificationsOutput {
+		specificationEntity := &entity.Specification{
+			ID:                    SpecificationID(specificationOutput.ID),
+			PublicID:              SpecificationPublicID(specificationOutput.PublicID),
+			Title:                 specificationOutput.Title,
+			EspecificationGroupID: SpecificationGroupID(specificationOutput.SpecificationGroupID),
+			Type:                  SpecificationType(specificationOutput.Type),
+		}
+
+		specifications = append(specifications, specificationEntity)
+	}
+
+	return specifications, nil
+}
+
 func (s *Specificationqlite) GetOneByPublicID(publicId SpecificationPublicID) (*entity.Specification, RepositoryException) {
 	ctx := context.Background()
 
@@ -63,10 +92,247 @@ func (s *Specificationqlite) GetOneByPublicID(publicId SpecificationPublicID) (*
 	}
 
 	return &entity.Specification{
-		ID:                    SpecificationID(specOutput.ID),
-		PublicID:              SpecificationPublicID(specOutput.PublicID),
-		Title:                 specOutput.Title,
-		EspecificationGroupID: SpecificationGroupID(specOutput.ID_2),
-		Type:                  SpecificationType(specOutput.Type),
+		ID:                         SpecificationID(specOutput.ID),
+		PublicID:                   SpecificationPublicID(specOutput.PublicID),
+		Title:                      specOutput.Title,
+		EspecificationGroupID:      SpecificationGroupID(specOutput.ID_2),
+		SpecificationGroupPublicID: SpecificationGroupPublicID(specOutput.PublicID_2),
+		Type:                       SpecificationType(specOutput.Type),
+	}, nil
+}
+
+func (s *Specificationqlite) GetOneDeletedByPublicID(publicId SpecificationPublicID) (*entity.Specification, RepositoryException) {
+	ctx := context.Background()
+
+	specOutput, err := s.DB.GetOneDeletedSpecificationByPublicID(ctx, string(publicId))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return &entity.Specification{
+		ID:                         SpecificationID(specOutput.ID),
+		PublicID:                   SpecificationPublicID(specOutput.PublicID),
+		Title:                      specOutput.Title,
+		EspecificationGroupID:      SpecificationGroupID(specOutput.ID_2),
+		SpecificationGroupPublicID: SpecificationGroupPublicID(specOutput.PublicID_2),
+		Type:                       SpecificationType(specOutput.Type),
 	}, nil
 }
+
+func (s *Specificationqlite) GetManyByPublicIDs(publicIds []SpecificationPublicID) ([]*entity.Specification, RepositoryException) {
+	ctx := context.Background()
+
+	specifications := []*entity.Specification{}
+
+	if len(publicIds) == 0 {
+		return specifications, nil
+	}
+
+	ids := make([]string, len(publicIds))
+
+	for i, publicId := range publicIds {
+		ids[i] = string(publicId)
+	}
+
+	specsOutput, err := s.DB.GetManySpecificationsByPublicIDs(ctx, ids)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	for _, specOutput := range specsOutput {
+		specification, entityErr := entity.NewSpecification(entity.SpecificationProps{
+			ID:                    SpecificationID(specOutput.ID),
+			PublicID:              SpecificationPublicID(specOutput.PublicID),
+			Title:                 specOutput.Title,
+			EspecificationGroupID: SpecificationGroupID(specOutput.ID_2),
+			Type:                  SpecificationType(specOutput.Type),
+		})
+
+		if entityErr != nil {
+			return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		specifications = append(specifications, specification)
+	}
+
+	return specifications, nil
+}
+
+func (s *Specificationqlite) ExistsByTitle(title string, specGroupID SpecificationGroupID, publicId SpecificationPublicID) (bool, RepositoryException) {
+	ctx := context.Background()
+
+	id, err := s.DB.CheckIfSpecificationExists(ctx, sqlite.CheckIfSpecificationExistsParams{
+		Title:                title,
+		SpecificationGroupID: int64(specGroupID),
+		PublicID:             publicId,
+	})
+
+	if err != nil {
+		if sqlite.Reason(err) == constants.RepositoryNotFoundError {
+			return false, nil
+		}
+
+		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if id == 0 {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (s *Specificationqlite) CountValues(specID SpecificationID) (int64, RepositoryException) {
+	ctx := context.Background()
+
+	total, err := s.DB.CountProductSpecificationValuesBySpecificationId(ctx, int64(specID))
+
+	if err != nil {
+		return 0, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return total, nil
+}
+
+func (s *Specificationqlite) CreateOne(specification *entity.Specification) RepositoryException {
+	ctx := context.Background()
+
+	result, err := s.DB.CreateOneSpecification(ctx, sqlite.CreateOneSpecificationParams{
+		PublicID:             string(specification.PublicID),
+		SpecificationGroupID: int64(specification.EspecificationGroupID),
+		Title:                specification.Title,
+		Type:                 string(specification.Type),
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	specification.ID = SpecificationID(id)
+
+	return nil
+}
+
+func (s *Specificationqlite) UpdateOne(specification *entity.Specification) RepositoryException {
+	ctx := context.Background()
+
+	err := s.DB.UpdateOneSpecification(ctx, sqlite.UpdateOneSpecificationParams{
+		ID:                   int64(specification.ID),
+		SpecificationGroupID: int64(specification.EspecificationGroupID),
+		Title:                specification.Title,
+		Type:                 string(specification.Type),
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+// UpdateOneWithValues persists a specification together with its already converted
+// product values, so a type change never leaves values of the old type behind.
+func (s *Specificationqlite) UpdateOneWithValues(specification *entity.Specification, values []*entity.ProductSpecificationValue) RepositoryException {
+	ctx := context.Background()
+
+	tx, err := s.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := s.DB.WithTx(tx)
+
+	err = qtx.UpdateOneSpecification(ctx, sqlite.UpdateOneSpecificationParams{
+		ID:                   int64(specification.ID),
+		SpecificationGroupID: int64(specification.EspecificationGroupID),
+		Title:                specification.Title,
+		Type:                 string(specification.Type),
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	for _, value := range values {
+		stringVal, intVal, boolVal := toNullSpecValue(value.Value)
+
+		result, err := qtx.UpdateOneProductSpecificationValue(ctx, sqlite.UpdateOneProductSpecificationValueParams{
+			ID:          value.ID,
+			Version:     value.Version,
+			StringValue: stringVal,
+			IntValue:    intVal,
+			BoolValue:   boolVal,
+		})
+
+		if repoErr := versionedWriteError(result, err, "product specification value", value.ID); repoErr != nil {
+			return repoErr
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+func (s *Specificationqlite) DeleteOne(specification *entity.Specification) RepositoryException {
+	ctx := context.Background()
+
+	err := s.DB.DeleteOneSpecification(ctx, int64(specification.ID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+func (s *Specificationqlite) RestoreOne(specification *entity.Specification) RepositoryException {
+	ctx := context.Background()
+
+	err := s.DB.RestoreOneSpecification(ctx, int64(specification.ID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"project/internal/domain/constants"
 	"project/internal/domain/entity"
 	exceptions "project/internal/domain/exception"
 	"project/internal/domain/repository"
@@ -47,6 +48,31 @@ func (s *SpecificationGroupSqlite) GetAll() ([]*entity.SpecificationGroup, excep
 	return specificationGroups, nil
 }
 
+func (s *SpecificationGroupSqlite) GetRevision() (*entity.Revision, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	revisionOutput, err := s.DB.GetSpecificationGroupsRevision(ctx)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	parts := make([]entity.RevisionPart, len(revisionOutput))
+
+	for i, row := range revisionOutput {
+		parts[i] = entity.RevisionPart{
+			Kind:      "specification_group",
+			ID:        row.ID,
+			Version:   row.Version,
+			ChangedAt: parseDateTime(row.ChangedAt),
+		}
+	}
+
+	return entity.NewRevision(parts...), nil
+}
+
 func (s *SpecificationGroupSqlite) GetOneByPublicID(publicId types.SpecificationGroupPublicID) (*entity.SpecificationGroup, exceptions.RepositoryException) {
 	ctx := context.Background()
 
@@ -65,3 +91,191 @@ func (s *SpecificationGroupSqlite) GetOneByPublicID(publicId types.Specification
 		Description: specificationGroupOutput.Description.String,
 	}, nil
 }
+
+func (s *SpecificationGroupSqlite) GetOneDeletedByPublicID(publicId types.SpecificationGroupPublicID) (*entity.SpecificationGroup, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	specificationGroupOutput, err := s.DB.GetOneDeletedSpecificationGroupByPublicID(ctx, string(publicId))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return &entity.SpecificationGroup{
+		ID:          types.SpecificationGroupID(specificationGroupOutput.ID),
+		PublicID:    types.SpecificationGroupPublicID(specificationGroupOutput.PublicID),
+		Name:        specificationGroupOutput.Name,
+		Description: specificationGroupOutput.Description.String,
+	}, nil
+}
+
+func (s *SpecificationGroupSqlite) ExistsByName(name string, publicId types.SpecificationGroupPublicID) (bool, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	id, err := s.DB.CheckIfSpecificationGroupExists(ctx, sqlite.CheckIfSpecificationGroupExistsParams{
+		Name:     name,
+		PublicID: publicId,
+	})
+
+	if err != nil {
+		if sqlite.Reason(err) == constants.RepositoryNotFoundError {
+			return false, nil
+		}
+
+		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if id == 0 {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (s *SpecificationGroupSqlite) CountSpecifications(groupId types.SpecificationGroupID) (int64, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	total, err := s.DB.CountSpecificationsByGroupId(ctx, int64(groupId))
+
+	if err != nil {
+		return 0, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return total, nil
+}
+
+func (s *SpecificationGroupSqlite) CreateOne(group *entity.SpecificationGroup) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	result, err := s.DB.CreateOneSpecificationGroup(ctx, sqlite.CreateOneSpecificationGroupParams{
+		PublicID:    string(group.PublicID),
+		Name:        group.Name,
+		Description: sql.NullString{String: group.Description, Valid: group.Description != ""},
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	group.ID = types.SpecificationGroupID(id)
+
+	return nil
+}
+
+func (s *SpecificationGroupSqlite) UpdateOne(group *entity.SpecificationGroup) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := s.DB.UpdateOneSpecificationGroup(ctx, sqlite.UpdateOneSpecificationGroupParams{
+		ID:          int64(group.ID),
+		Name:        group.Name,
+		Description: sql.NullString{String: group.Description, Valid: group.Description != ""},
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+// DeleteOne deletes the group and its specifications in a single transaction.
+func (s *SpecificationGroupSqlite) DeleteOne(group *entity.SpecificationGroup) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := s.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := s.DB.WithTx(tx)
+
+	err = qtx.DeleteOneSpecificationGroup(ctx, int64(group.ID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = qtx.DeleteSpecificationsByGroupId(ctx, int64(group.ID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+// RestoreOne restores the group and the specifications deleted with it in a single
+// transaction. The specifications go first, they are matched against the deletion
+// time of the group.
+func (s *SpecificationGroupSqlite) RestoreOne(group *entity.SpecificationGroup) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := s.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := s.DB.WithTx(tx)
+
+	err = qtx.RestoreSpecificationsByGroupId(ctx, int64(group.ID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	err = qtx.RestoreOneSpecificationGroup(ctx, int64(group.ID))
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+type TrashSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewTrashSqlite(dbConn *sql.DB) repository.Trash {
+	return &TrashSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (t *TrashSqlite) GetAll(filter entity.TrashFilter, paginationInput entity.PaginatorInput) ([]*entity.TrashItem, entity.PaginatorOutput, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	paginatorOutput := &entity.PaginatorOutput{Total: 0}
+
+	params := sqlite.GetAllTrashItemsParams{
+		Limit:  paginationInput.Limit,
+		Offset: paginationInput.Skip,
+	}
+
+	if filter.EntityType != "" {
+		params.EntityType = string(filter.EntityType)
+	}
+
+	itemsOutput, err := t.DB.GetAllTrashItems(ctx, params)
+
+	if err != nil {
+		return nil, *paginatorOutput, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	items := make([]*entity.TrashItem, 0, len(itemsOutput))
+
+	for _, itemOutput := range itemsOutput {
+		item, entityErr := newTrashItem(types.TrashEntityType(itemOutput.EntityType), itemOutput.PublicID, itemOutput.Name, itemOutput.DeletedAt)
+
+		if entityErr != nil {
+			return nil, *paginatorOutput, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		if paginatorOutput.Total == 0 {
+			paginatorOutput.Total = itemOutput.ItemsQuantity
+		}
+
+		items = append(items, item)
+	}
+
+	return items, *paginatorOutput, nil
+}
+
+// Purge runs in a single transaction, going from the products down to the
+// specification groups so each step sees what the previous one removed.
+func (t *TrashSqlite) Purge(deletedBefore time.Time) (*entity.TrashPurge, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	tx, err := t.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := t.DB.WithTx(tx)
+
+	before := sql.NullString{String: deletedBefore.UTC().Format(time.DateTime), Valid: true}
+
+	purge := &entity.TrashPurge{
+		Items:       []*entity.TrashItem{},
+		StorageKeys: []string{},
+	}
+
+	productsOutput, err := qtx.GetPurgeableProducts(ctx, before)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	productIds := make([]int64, len(productsOutput))
+
+	for i, productOutput := range productsOutput {
+		productIds[i] = productOutput.ID
+
+		item, err := newTrashItem(constants.TrashEntityProduct, productOutput.PublicID, productOutput.Name, productOutput.DeletedAt)
+
+		if err != nil {
+			return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		purge.Items = append(purge.Items, item)
+	}
+
+	if len(productIds) > 0 {
+		productIdsJson, err := json.Marshal(productIds)
+
+		if err != nil {
+			return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: constants.RepositoryUnknownError,
+			})
+		}
+
+		imageKeysOutput, err := qtx.GetProductImageKeysByProductIDs(ctx, string(productIdsJson))
+
+		if err != nil {
+			return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		for _, imageKeyOutput := range imageKeysOutput {
+			purge.StorageKeys = append(purge.StorageKeys, imageKeyOutput.StorageKey, imageKeyOutput.ThumbnailKey)
+		}
+	}
+
+	// the rows attached to the products go before them, the foreign keys are enforced
+	err = purgeByIDs(ctx, productIds,
+		qtx.PurgeProductSpecificationValues,
+		qtx.PurgeProductImages,
+		qtx.PurgeProductReviews,
+		qtx.PurgeProductOffers,
+		qtx.PurgeWatchlistDeliveries,
+		qtx.PurgeWatchlistPriceChecks,
+		qtx.PurgeWatchlists,
+		qtx.PurgeExternalReferences,
+		qtx.PurgeProducts,
+	)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	categoriesOutput, err := qtx.GetPurgeableCategories(ctx, before)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	categoryIds := make([]int64, len(categoriesOutput))
+
+	for i, categoryOutput := range categoriesOutput {
+		categoryIds[i] = categoryOutput.ID
+
+		item, err := newTrashItem(constants.TrashEntityCategory, categoryOutput.PublicID, categoryOutput.Name, categoryOutput.DeletedAt)
+
+		if err != nil {
+			return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		purge.Items = append(purge.Items, item)
+	}
+
+	err = purgeByIDs(ctx, categoryIds, qtx.PurgeCategorySpecificationsByCategoryIDs, qtx.PurgeCategories)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	specsOutput, err := qtx.GetPurgeableSpecifications(ctx, before)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	specIds := make([]int64, len(specsOutput))
+
+	for i, specOutput := range specsOutput {
+		specIds[i] = specOutput.ID
+
+		item, err := newTrashItem(constants.TrashEntitySpecification, specOutput.PublicID, specOutput.Title, specOutput.DeletedAt)
+
+		if err != nil {
+			return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		purge.Items = append(purge.Items, item)
+	}
+
+	err = purgeByIDs(ctx, specIds, qtx.PurgeCategorySpecificationsBySpecificationIDs, qtx.PurgeSpecifications)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	groupsOutput, err := qtx.GetPurgeableSpecificationGroups(ctx, before)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	groupIds := make([]int64, len(groupsOutput))
+
+	for i, groupOutput := range groupsOutput {
+		groupIds[i] = groupOutput.ID
+
+		item, err := newTrashItem(constants.TrashEntitySpecificationGroup, groupOutput.PublicID, groupOutput.Name, groupOutput.DeletedAt)
+
+		if err != nil {
+			return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+
+		purge.Items = append(purge.Items, item)
+	}
+
+	err = purgeByIDs(ctx, groupIds, qtx.PurgeSpecificationGroups)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return purge, nil
+}
+
+func newTrashItem(entityType types.TrashEntityType, publicId string, name string, deletedAt sql.NullString) (*entity.TrashItem, error) {
+	item, entityErr := entity.NewTrashItem(entity.TrashItemProps{
+		EntityType: entityType,
+		PublicID:   publicId,
+		Name:       name,
+		DeletedAt:  parseDateTime(deletedAt.String),
+	})
+
+	if entityErr != nil {
+		return nil, entityErr
+	}
+
+	return item, nil
+}
+
+// purgeByIDs runs the deletes in order, each one taking the ids as a JSON array.
+func purgeByIDs(ctx context.Context, ids []int64, purges ...func(context.Context, interface{}) error) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	idsJson, err := json.Marshal(ids)
+
+	if err != nil {
+		return err
+	}
+
+	for _, purge := range purges {
+		err = purge(ctx, string(idsJson))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+type WatchlistSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewWatchlistSqlite(dbConn *sql.DB) repository.Watchlist {
+	return &WatchlistSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (w *WatchlistSqlite) GetOneByPublicID(publicID types.WatchlistPublicID, subscriberToken string) (*entity.Watchlist, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	watchlistOutput, err := w.DB.GetOneWatchlistByPublicID(ctx, sqlite.GetOneWatchlistByPublicIDParams{
+		PublicID:        string(publicID),
+		SubscriberToken: subscriberToken,
+	})
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return toWatchlistEntity(sqlite.GetAllWatchlistsByProductIDRow(watchlistOutput))
+}
+
+func (w *WatchlistSqlite) GetAllBySubscriberToken(subscriberToken string) ([]*entity.Watchlist, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	watchlistsOutput, err := w.DB.GetAllWatchlistsBySubscriberToken(ctx, subscriberToken)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	watchlists := make([]*entity.Watchlist, 0, len(watchlistsOutput))
+
+	for _, watchlistOutput := range watchlistsOutput {
+		watchlist, repoErr := toWatchlistEntity(sqlite.GetAllWatchlistsByProductIDRow(watchlistOutput))
+
+		if repoErr != nil {
+			return nil, repoErr
+		}
+
+		watchlists = append(watchlists, watchlist)
+	}
+
+	return watchlists, nil
+}
+
+func (w *WatchlistSqlite) GetAllByProductID(productID types.ProductID) ([]*entity.Watchlist, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	watchlistsOutput, err := w.DB.GetAllWatchlistsByProductID(ctx, int64(productID))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	watchlists := make([]*entity.Watchlist, 0, len(watchlistsOutput))
+
+	for _, watchlistOutput := range watchlistsOutput {
+		watchlist, repoErr := toWatchlistEntity(watchlistOutput)
+
+		if repoErr != nil {
+			return nil, repoErr
+		}
+
+		watchlists = append(watchlists, watchlist)
+	}
+
+	return watchlists, nil
+}
+
+func (w *WatchlistSqlite) GetAllWatchedProductPublicIDs() ([]types.ProductPublicID, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	publicIDsOutput, err := w.DB.GetAllWatchedProductPublicIDs(ctx)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	publicIDs := make([]types.ProductPublicID, 0, len(publicIDsOutput))
+
+	for _, publicID := range publicIDsOutput {
+		publicIDs = append(publicIDs, types.ProductPublicID(publicID))
+	}
+
+	return publicIDs, nil
+}
+
+func (w *WatchlistSqlite) TakePriceChecks(limit int64) ([]types.ProductPublicID, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	tx, err := w.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := w.DB.WithTx(tx)
+
+	productIDs, err := qtx.DeleteWatchlistPriceChecks(ctx, limit)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if len(productIDs) == 0 {
+		return []types.ProductPublicID{}, nil
+	}
+
+	productIDsJson, err := json.Marshal(productIDs)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryUnknownError,
+		})
+	}
+
+	publicIDsOutput, err := qtx.GetWatchedProductPublicIDsByIDs(ctx, string(productIDsJson))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	publicIDs := make([]types.ProductPublicID, 0, len(publicIDsOutput))
+
+	for _, publicID := range publicIDsOutput {
+		publicIDs = append(publicIDs, types.ProductPublicID(publicID))
+	}
+
+	return publicIDs, nil
+}
+
+func (w *WatchlistSqlite) ExistsBySubscriberToken(subscriberToken string, productID types.ProductID) (bool, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	id, err := w.DB.CheckIfWatchlistExists(ctx, sqlite.CheckIfWatchlistExistsParams{
+		SubscriberToken: subscriberToken,
+		ProductID:       int64(productID),
+	})
+
+	if err != nil {
+		if sqlite.Reason(err) == constants.RepositoryNotFoundError {
+			return false, nil
+		}
+
+		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return id != 0, nil
+}
+
+func (w *WatchlistSqlite) CreateOne(watchlist *entity.Watchlist) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	result, err := w.DB.CreateOneWatchlist(ctx, sqlite.CreateOneWatchlistParams{
+		PublicID:        string(watchlist.PublicID),
+		ProductID:       int64(watchlist.ProductID),
+		SubscriberToken: watchlist.SubscriberToken,
+		TargetPrice:     watchlist.TargetPrice,
+		DropPercent:     watchlist.DropPercent,
+		BasePrice:       watchlist.BasePrice,
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	watchlist.ID = id
+
+	if watchlist.CreatedAt.IsZero() {
+		watchlist.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	}
+
+	return nil
+}
+
+func (w *WatchlistSqlite) UpdateLastNotifiedPrice(watchlist *entity.Watchlist) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := w.DB.UpdateWatchlistLastNotifiedPrice(ctx, sqlite.UpdateWatchlistLastNotifiedPriceParams{
+		LastNotifiedPrice: watchlist.LastNotifiedPrice,
+		ID:                watchlist.ID,
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+func (w *WatchlistSqlite) DeleteOne(watchlist *entity.Watchlist) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := w.DB.DeleteOneWatchlist(ctx, watchlist.ID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+func (w *WatchlistSqlite) CreateOneDelivery(delivery *entity.WatchlistDelivery) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	result, err := w.DB.CreateOneWatchlistDelivery(ctx, sqlite.CreateOneWatchlistDeliveryParams{
+		WatchlistID:    delivery.WatchlistID,
+		Trigger:        string(delivery.Trigger),
+		Price:          delivery.Price,
+		ThresholdPrice: delivery.ThresholdPrice,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		StatusCode:     delivery.StatusCode,
+		Error:          delivery.Error,
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	delivery.ID = id
+
+	if delivery.CreatedAt.IsZero() {
+		delivery.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	}
+
+	return nil
+}
+
+func (w *WatchlistSqlite) GetAllDeliveries(watchlistID int64, status types.WatchlistDeliveryStatus, paginationInput entity.PaginatorInput) ([]*entity.WatchlistDelivery, entity.PaginatorOutput, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	paginatorOutput := &entity.PaginatorOutput{Total: 0}
+
+	var statusFilter interface{}
+
+	if status != "" {
+		statusFilter = string(status)
+	}
+
+	deliveriesOutput, err := w.DB.GetAllWatchlistDeliveriesByWatchlistID(ctx, sqlite.GetAllWatchlistDeliveriesByWatchlistIDParams{
+		WatchlistID: watchlistID,
+		Status:      statusFilter,
+		Limit:       paginationInput.Limit,
+		Offset:      paginationInput.Skip,
+	})
+
+	if err != nil {
+		return nil, *paginatorOutput, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	deliveries := make([]*entity.WatchlistDelivery, 0, len(deliveriesOutput))
+
+	for _, deliveryOutput := range deliveriesOutput {
+		delivery, entityErr := entity.NewWatchlistDelivery(entity.WatchlistDeliveryProps{
+			ID:             deliveryOutput.ID,
+			WatchlistID:    deliveryOutput.WatchlistID,
+			Trigger:        types.WatchlistTrigger(deliveryOutput.Trigger),
+			Price:          deliveryOutput.Price,
+			ThresholdPrice: deliveryOutput.ThresholdPrice,
+			Status:         types.WatchlistDeliveryStatus(deliveryOutput.Status),
+			Attempts:       deliveryOutput.Attempts,
+			StatusCode:     deliveryOutput.StatusCode,
+			Error:          deliveryOutput.Error,
+			CreatedAt:      parseDateTime(deliveryOutput.CreatedAt),
+		})
+
+		if entityErr != nil {
+			return nil, *paginatorOutput, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(entityErr),
+			})
+		}
+
+		if paginatorOutput.Total == 0 {
+			paginatorOutput.Total = deliveryOutput.DeliveriesQuantity
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, *paginatorOutput, nil
+}
+
+// toWatchlistEntity maps the watchlist rows, the queries select the same columns.
+func toWatchlistEntity(watchlistOutput sqlite.GetAllWatchlistsByProductIDRow) (*entity.Watchlist, exceptions.RepositoryException) {
+	watchlist, entityErr := entity.NewWatchlist(entity.WatchlistProps{
+		ID:                watchlistOutput.ID,
+		PublicID:          types.WatchlistPublicID(watchlistOutput.PublicID),
+		ProductID:         types.ProductID(watchlistOutput.ProductID),
+		ProductPublicID:   types.ProductPublicID(watchlistOutput.ProductPublicID),
+		SubscriberToken:   watchlistOutput.SubscriberToken,
+		TargetPrice:       watchlistOutput.TargetPrice,
+		DropPercent:       watchlistOutput.DropPercent,
+		BasePrice:         watchlistOutput.BasePrice,
+		LastNotifiedPrice: watchlistOutput.LastNotifiedPrice,
+		CreatedAt:         parseDateTime(watchlistOutput.CreatedAt),
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return watchlist, nil
+}
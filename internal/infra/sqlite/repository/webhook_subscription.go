@@ -0,0 +1,413 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+	"strings"
+	"time"
+)
+
+type WebhookSubscriptionSqlite struct {
+	Conn *sql.DB
+	DB   *sqlite.Queries
+}
+
+func NewWebhookSubscriptionSqlite(dbConn *sql.DB) repository.WebhookSubscription {
+	return &WebhookSubscriptionSqlite{
+		Conn: dbConn,
+		DB:   sqlite.New(dbConn),
+	}
+}
+
+func (w *WebhookSubscriptionSqlite) GetOneByPublicID(publicID types.WebhookSubscriptionPublicID) (*entity.WebhookSubscription, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	subscriptionOutput, err := w.DB.GetOneWebhookSubscriptionByPublicID(ctx, string(publicID))
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return toWebhookSubscriptionEntity(sqlite.GetAllWebhookSubscriptionsRow(subscriptionOutput))
+}
+
+func (w *WebhookSubscriptionSqlite) GetAll() ([]*entity.WebhookSubscription, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	subscriptionsOutput, err := w.DB.GetAllWebhookSubscriptions(ctx)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	subscriptions := make([]*entity.WebhookSubscription, 0, len(subscriptionsOutput))
+
+	for _, subscriptionOutput := range subscriptionsOutput {
+		subscription, repoErr := toWebhookSubscriptionEntity(subscriptionOutput)
+
+		if repoErr != nil {
+			return nil, repoErr
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+func (w *WebhookSubscriptionSqlite) ExistsByURL(url string) (bool, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	id, err := w.DB.CheckIfWebhookSubscriptionExists(ctx, url)
+
+	if err != nil {
+		if sqlite.Reason(err) == constants.RepositoryNotFoundError {
+			return false, nil
+		}
+
+		return false, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return id != 0, nil
+}
+
+func (w *WebhookSubscriptionSqlite) CreateOne(subscription *entity.WebhookSubscription) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	eventTypes := make([]string, len(subscription.EventTypes))
+
+	for i, eventType := range subscription.EventTypes {
+		eventTypes[i] = string(eventType)
+	}
+
+	result, err := w.DB.CreateOneWebhookSubscription(ctx, sqlite.CreateOneWebhookSubscriptionParams{
+		PublicID:   string(subscription.PublicID),
+		Url:        subscription.URL,
+		Secret:     subscription.Secret,
+		EventTypes: strings.Join(eventTypes, ","),
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	id, err := result.LastInsertId()
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	subscription.ID = id
+
+	if subscription.CreatedAt.IsZero() {
+		subscription.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	}
+
+	return nil
+}
+
+func (w *WebhookSubscriptionSqlite) DeleteOne(subscription *entity.WebhookSubscription) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := w.DB.DeleteOneWebhookSubscription(ctx, subscription.ID)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+// ClaimDueDeliveries claims and reads the deliveries in a single transaction, the
+// claim writes first so that two dispatchers never read the same deliveries.
+func (w *WebhookSubscriptionSqlite) ClaimDueDeliveries(now time.Time, lockedUntil time.Time, limit int64) ([]*entity.WebhookDelivery, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	tx, err := w.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := w.DB.WithTx(tx)
+
+	ids, err := qtx.ClaimDueWebhookDeliveries(ctx, sqlite.ClaimDueWebhookDeliveriesParams{
+		LockedUntil: sql.NullString{String: lockedUntil.UTC().Format(time.DateTime), Valid: true},
+		Now:         now.UTC().Format(time.DateTime),
+		Limit:       limit,
+	})
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	deliveries := make([]*entity.WebhookDelivery, 0, len(ids))
+
+	if len(ids) == 0 {
+		return deliveries, nil
+	}
+
+	deliveriesOutput, err := qtx.GetManyWebhookDeliveriesByIDs(ctx, ids)
+
+	if err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	for _, deliveryOutput := range deliveriesOutput {
+		delivery, repoErr := toWebhookDeliveryEntity(deliveryOutput)
+
+		if repoErr != nil {
+			return nil, repoErr
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return deliveries, nil
+}
+
+func (w *WebhookSubscriptionSqlite) UpdateOneDelivery(delivery *entity.WebhookDelivery) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	err := w.DB.UpdateOneWebhookDelivery(ctx, sqlite.UpdateOneWebhookDeliveryParams{
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt.UTC().Format(time.DateTime),
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    sql.NullString{String: delivery.DeliveredAt.UTC().Format(time.DateTime), Valid: !delivery.DeliveredAt.IsZero()},
+		ID:             delivery.ID,
+	})
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return nil
+}
+
+func (w *WebhookSubscriptionSqlite) GetAllDeliveries(subscriptionID int64, status types.WebhookDeliveryStatus, paginationInput entity.PaginatorInput) ([]*entity.WebhookDelivery, entity.PaginatorOutput, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	paginatorOutput := &entity.PaginatorOutput{Total: 0}
+
+	var statusFilter interface{}
+
+	if status != "" {
+		statusFilter = string(status)
+	}
+
+	deliveriesOutput, err := w.DB.GetAllWebhookDeliveriesBySubscriptionID(ctx, sqlite.GetAllWebhookDeliveriesBySubscriptionIDParams{
+		SubscriptionID: subscriptionID,
+		Status:         statusFilter,
+		Limit:          paginationInput.Limit,
+		Offset:         paginationInput.Skip,
+	})
+
+	if err != nil {
+		return nil, *paginatorOutput, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	deliveries := make([]*entity.WebhookDelivery, 0, len(deliveriesOutput))
+
+	for _, deliveryOutput := range deliveriesOutput {
+		delivery, repoErr := toWebhookDeliveryEntity(sqlite.GetManyWebhookDeliveriesByIDsRow{
+			ID:                deliveryOutput.ID,
+			EventID:           deliveryOutput.EventID,
+			SubscriptionID:    deliveryOutput.SubscriptionID,
+			Status:            deliveryOutput.Status,
+			Attempts:          deliveryOutput.Attempts,
+			NextAttemptAt:     deliveryOutput.NextAttemptAt,
+			LastStatusCode:    deliveryOutput.LastStatusCode,
+			LastError:         deliveryOutput.LastError,
+			DeliveredAt:       deliveryOutput.DeliveredAt,
+			CreatedAt:         deliveryOutput.CreatedAt,
+			EventType:         deliveryOutput.EventType,
+			AggregateType:     deliveryOutput.AggregateType,
+			AggregatePublicID: deliveryOutput.AggregatePublicID,
+			Payload:           deliveryOutput.Payload,
+			EventCreatedAt:    deliveryOutput.EventCreatedAt,
+		})
+
+		if repoErr != nil {
+			return nil, *paginatorOutput, repoErr
+		}
+
+		if paginatorOutput.Total == 0 {
+			paginatorOutput.Total = deliveryOutput.DeliveriesQuantity
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, *paginatorOutput, nil
+}
+
+// ReplayDeliveries runs in a single transaction, a replay is either fully queued or
+// not queued at all.
+func (w *WebhookSubscriptionSqlite) ReplayDeliveries(subscription *entity.WebhookSubscription, events []*entity.OutboxEvent) exceptions.RepositoryException {
+	ctx := context.Background()
+
+	tx, err := w.Conn.BeginTx(ctx, nil)
+
+	if err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	defer tx.Rollback()
+
+	qtx := w.DB.WithTx(tx)
+
+	for _, event := range events {
+		err = qtx.ReplayOneWebhookDelivery(ctx, sqlite.ReplayOneWebhookDeliveryParams{
+			EventID:        event.ID,
+			SubscriptionID: subscription.ID,
+		})
+
+		if err != nil {
+			return exceptions.Repo(err, exceptions.RepositoryOpts{
+				Reason: sqlite.Reason(err),
+			})
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryTransactionError,
+		})
+	}
+
+	return nil
+}
+
+func (w *WebhookSubscriptionSqlite) ReplayDeadDeliveries(subscription *entity.WebhookSubscription) (int64, exceptions.RepositoryException) {
+	ctx := context.Background()
+
+	result, err := w.DB.ReplayDeadWebhookDeliveries(ctx, subscription.ID)
+
+	if err != nil {
+		return 0, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	replayed, err := result.RowsAffected()
+
+	if err != nil {
+		return 0, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(err),
+		})
+	}
+
+	return replayed, nil
+}
+
+func toWebhookSubscriptionEntity(subscriptionOutput sqlite.GetAllWebhookSubscriptionsRow) (*entity.WebhookSubscription, exceptions.RepositoryException) {
+	eventTypes := []types.OutboxEventType{}
+
+	if subscriptionOutput.EventTypes != "" {
+		for eventType := range strings.SplitSeq(subscriptionOutput.EventTypes, ",") {
+			eventTypes = append(eventTypes, types.OutboxEventType(eventType))
+		}
+	}
+
+	subscription, entityErr := entity.NewWebhookSubscription(entity.WebhookSubscriptionProps{
+		ID:         subscriptionOutput.ID,
+		PublicID:   types.WebhookSubscriptionPublicID(subscriptionOutput.PublicID),
+		URL:        subscriptionOutput.Url,
+		Secret:     subscriptionOutput.Secret,
+		EventTypes: eventTypes,
+		CreatedAt:  parseDateTime(subscriptionOutput.CreatedAt),
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return subscription, nil
+}
+
+// toWebhookDeliveryEntity maps the delivery rows, the queries select the same
+// columns besides the total of the paginated one.
+func toWebhookDeliveryEntity(deliveryOutput sqlite.GetManyWebhookDeliveriesByIDsRow) (*entity.WebhookDelivery, exceptions.RepositoryException) {
+	event, repoErr := toOutboxEventEntity(sqlite.GetAllOutboxEventsSinceRow{
+		ID:                deliveryOutput.EventID,
+		EventType:         deliveryOutput.EventType,
+		AggregateType:     deliveryOutput.AggregateType,
+		AggregatePublicID: deliveryOutput.AggregatePublicID,
+		Payload:           deliveryOutput.Payload,
+		CreatedAt:         deliveryOutput.EventCreatedAt,
+	})
+
+	if repoErr != nil {
+		return nil, repoErr
+	}
+
+	var deliveredAt time.Time
+
+	if deliveryOutput.DeliveredAt.Valid {
+		deliveredAt = parseDateTime(deliveryOutput.DeliveredAt.String)
+	}
+
+	delivery, entityErr := entity.NewWebhookDelivery(entity.WebhookDeliveryProps{
+		ID:             deliveryOutput.ID,
+		EventID:        deliveryOutput.EventID,
+		SubscriptionID: deliveryOutput.SubscriptionID,
+		Status:         types.WebhookDeliveryStatus(deliveryOutput.Status),
+		Attempts:       deliveryOutput.Attempts,
+		NextAttemptAt:  parseDateTime(deliveryOutput.NextAttemptAt),
+		LastStatusCode: deliveryOutput.LastStatusCode,
+		LastError:      deliveryOutput.LastError,
+		DeliveredAt:    deliveredAt,
+		CreatedAt:      parseDateTime(deliveryOutput.CreatedAt),
+		Event:          event,
+	})
+
+	if entityErr != nil {
+		return nil, exceptions.Repo(entityErr, exceptions.RepositoryOpts{
+			Reason: sqlite.Reason(entityErr),
+		})
+	}
+
+	return delivery, nil
+}
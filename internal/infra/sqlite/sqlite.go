@@ -4,15 +4,47 @@ import (
 	"database/sql"
 	"fmt"
 	"project/internal/infra/config/environment"
+	"time"
 
+	"github.com/gofiber/fiber/v3"
 	_ "github.com/mattn/go-sqlite3"
 )
 
 type Sqlite struct {
-	DB *sql.DB
+	DB                 *sql.DB
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
 }
 
+// NewSqliteInstance connects and checks the schema version against the embedded
+// migrations, following config.MigrationPolicy. A prefork child only verifies the
+// version, the parent migrated before forking it.
 func NewSqliteInstance(config *environment.Sqlite) *Sqlite {
+	instance := NewSqliteConnection(config)
+
+	migrator, err := NewMigrator(instance.DB)
+	if err != nil {
+		instance.DB.Close()
+		panic(fmt.Sprintf("Error loading sqlite migrations, err: %v", err))
+	}
+
+	policy := config.MigrationPolicy
+
+	// children boot together, migrating from each of them would race on the same database
+	if fiber.IsChild() && policy == environment.MigrationPolicyAuto {
+		policy = environment.MigrationPolicyFail
+	}
+
+	if err := migrator.Check(policy); err != nil {
+		instance.DB.Close()
+		panic(fmt.Sprintf("Error checking sqlite schema, err: %v", err))
+	}
+
+	return instance
+}
+
+// NewSqliteConnection connects without looking at the schema, for the migrate commands.
+func NewSqliteConnection(config *environment.Sqlite) *Sqlite {
 	dbConn, err := sql.Open("sqlite3", config.Dsn)
 	if err != nil {
 		panic(fmt.Sprintf("Error connecting to sqlite database, err: %v", err))
@@ -23,5 +55,9 @@ func NewSqliteInstance(config *environment.Sqlite) *Sqlite {
 		panic(fmt.Sprintf("Error pinging to sqlite database, err: %v", err))
 	}
 
-	return &Sqlite{DB: dbConn}
+	return &Sqlite{
+		DB:                 dbConn,
+		TrashRetention:     config.TrashRetention,
+		TrashPurgeInterval: config.TrashPurgeInterval,
+	}
 }
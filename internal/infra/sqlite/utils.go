@@ -24,6 +24,7 @@ func Reason(err error) types.RepositoryErrorReason {
 		"foreign key":            constants.RepositoryForeignKeyViolationError,
 		"Duplicate entry":        constants.RepositoryUniqueConstraintError,
 		"unique constraint":      constants.RepositoryUniqueConstraintError,
+		"UNIQUE constraint":      constants.RepositoryUniqueConstraintError,
 		"index":                  constants.RepositoryIndexError,
 		"syntax error":           constants.RepositoryQuerySyntaxError,
 		"timeout":                constants.RepositoryTimeoutError,
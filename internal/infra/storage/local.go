@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+)
+
+// Local keeps the files in a directory of the server, served by the API itself.
+type Local struct {
+	Root      string
+	PublicURL string
+}
+
+func NewLocal(root string, publicURL string) (*Local, error) {
+	absoluteRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(absoluteRoot, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &Local{Root: absoluteRoot, PublicURL: publicURL}, nil
+}
+
+// Save writes to a temporary file and renames it, so a file being served is never
+// half written.
+func (l *Local) Save(key string, content []byte, contentType string) exceptions.RepositoryException {
+	filePath, err := l.path(key)
+	if err != nil {
+		return storageError(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return storageError(err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return storageError(err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return storageError(err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return storageError(err)
+	}
+
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return storageError(err)
+	}
+
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return storageError(err)
+	}
+
+	return nil
+}
+
+// Delete removes the file, a file that is already gone is not an error.
+func (l *Local) Delete(key string) exceptions.RepositoryException {
+	filePath, err := l.path(key)
+	if err != nil {
+		return storageError(err)
+	}
+
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return storageError(err)
+	}
+
+	return nil
+}
+
+func (l *Local) URL(key string) string {
+	return path.Join(l.PublicURL, key)
+}
+
+func (l *Local) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+
+	return filepath.Join(l.Root, filepath.FromSlash(key)), nil
+}
+
+func storageError(err error) exceptions.RepositoryException {
+	return exceptions.Repo(err, exceptions.RepositoryOpts{
+		Reason: constants.RepositoryStorageError,
+	})
+}
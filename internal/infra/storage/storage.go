@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"fmt"
+	"project/internal/domain/repository"
+	"project/internal/infra/config/environment"
+)
+
+type Storage struct {
+	Images repository.ImageStorage
+	// StaticRoot is the directory the API serves under StaticPath, empty when the
+	// backend serves its own files.
+	StaticRoot string
+	StaticPath string
+}
+
+func NewStorageInstance(config *environment.Storage) *Storage {
+	switch config.Driver {
+	case environment.StorageDriverLocal:
+		local, err := NewLocal(config.LocalPath, config.PublicPath)
+		if err != nil {
+			panic(fmt.Sprintf("Error preparing local storage, err: %v", err))
+		}
+
+		return &Storage{
+			Images:     local,
+			StaticRoot: local.Root,
+			StaticPath: config.PublicPath,
+		}
+	}
+
+	panic(fmt.Sprintf("Unknown storage driver %q", config.Driver))
+}
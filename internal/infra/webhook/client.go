@@ -0,0 +1,94 @@
+package webhook
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"time"
+)
+
+// Client posts the payloads to a single webhook URL. Network errors, 429 and 5xx
+// responses are retried up to MaxAttempts, waiting Backoff and then twice as long
+// before each retry.
+type Client struct {
+	URL         string
+	HTTP        *http.Client
+	MaxAttempts int
+	Backoff     time.Duration
+}
+
+func NewClient(url string, timeout time.Duration, maxAttempts int, backoff time.Duration) *Client {
+	return &Client{
+		URL:         url,
+		HTTP:        &http.Client{Timeout: timeout},
+		MaxAttempts: maxAttempts,
+		Backoff:     backoff,
+	}
+}
+
+func (c *Client) Send(payload []byte) (int64, int64, exceptions.RepositoryException) {
+	var lastErr error
+	var statusCode int
+	attempts := 0
+
+	for attempts < c.MaxAttempts {
+		if attempts > 0 {
+			time.Sleep(c.Backoff << (attempts - 1))
+		}
+
+		attempts++
+
+		statusCode, lastErr = c.post(payload)
+
+		if lastErr == nil {
+			return int64(attempts), int64(statusCode), nil
+		}
+
+		if !retryable(statusCode) {
+			break
+		}
+	}
+
+	return int64(attempts), int64(statusCode), exceptions.Repo(lastErr, exceptions.RepositoryOpts{
+		Reason: constants.RepositoryWebhookError,
+	})
+}
+
+func (c *Client) post(payload []byte) (int, error) {
+	request, err := http.NewRequest(http.MethodPost, c.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	return do(c.HTTP, request)
+}
+
+// do sends the request and turns the responses out of the 2xx range into errors.
+func do(httpClient *http.Client, request *http.Request) (int, error) {
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	// drained so the connection can be reused
+	io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("webhook answered with status %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
+
+// retryable is true for errors that may go away: the webhook was not reached, is
+// rate limiting or failed on its side.
+func retryable(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"strconv"
+	"time"
+)
+
+const (
+	// SignatureHeader carries "t=<unix time>,v1=<signature>", the signature is the
+	// hex HMAC-SHA256 of "<unix time>.<body>" with the subscription secret.
+	SignatureHeader = "X-Webhook-Signature"
+	// EventIDHeader lets the receiver drop the events it already processed, an event
+	// may be delivered more than once.
+	EventIDHeader = "X-Webhook-Event-ID"
+)
+
+// SignedClient posts the outbox events to the subscription URLs, one attempt per
+// call, the dispatcher decides when to retry.
+type SignedClient struct {
+	HTTP *http.Client
+}
+
+func NewSignedClient(timeout time.Duration) *SignedClient {
+	return &SignedClient{
+		HTTP: &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *SignedClient) Post(url string, secret string, eventID int64, payload []byte) (int64, exceptions.RepositoryException) {
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryWebhookError,
+		})
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventIDHeader, strconv.FormatInt(eventID, 10))
+	request.Header.Set(SignatureHeader, fmt.Sprintf("t=%s,v1=%s", timestamp, Sign(secret, timestamp, payload)))
+
+	statusCode, err := do(c.HTTP, request)
+
+	if err != nil {
+		return int64(statusCode), exceptions.Repo(err, exceptions.RepositoryOpts{
+			Reason: constants.RepositoryWebhookError,
+		})
+	}
+
+	return int64(statusCode), nil
+}
+
+// Sign returns the signature of the payload sent at timestamp, receivers compute it
+// again to check the payload came from us and was not changed.
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
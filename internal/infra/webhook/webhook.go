@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"project/internal/domain/constants"
+	"project/internal/domain/repository"
+	"project/internal/infra/config/environment"
+	"time"
+)
+
+type Webhook struct {
+	// Sender is nil when no webhook URL is configured, alerts are not sent.
+	Sender        repository.WebhookSender
+	SweepInterval time.Duration
+	CheckInterval time.Duration
+	// Poster sends the outbox events to the URLs of the webhook subscriptions.
+	Poster                 repository.WebhookPoster
+	OutboxDispatchInterval time.Duration
+	OutboxMaxAttempts      int
+	OutboxBackoff          time.Duration
+	// OutboxLock keeps the deliveries a dispatcher took for as long as posting a whole
+	// batch can take, then a delivery without an answer is taken again.
+	OutboxLock time.Duration
+}
+
+func NewWebhookInstance(config *environment.Webhook) *Webhook {
+	webhook := &Webhook{
+		SweepInterval:          config.SweepInterval,
+		CheckInterval:          config.CheckInterval,
+		Poster:                 NewSignedClient(config.Timeout),
+		OutboxDispatchInterval: config.OutboxDispatchInterval,
+		OutboxMaxAttempts:      config.OutboxMaxAttempts,
+		OutboxBackoff:          config.OutboxBackoff,
+		OutboxLock:             config.Timeout * constants.OutboxDispatchBatchSize,
+	}
+
+	if config.URL != "" {
+		webhook.Sender = NewClient(config.URL, config.Timeout, config.MaxAttempts, config.Backoff)
+	}
+
+	return webhook
+}
@@ -0,0 +1,56 @@
+package validator
+
+import (
+	"fmt"
+	"mime/multipart"
+	"slices"
+	"strings"
+)
+
+type FileValidator struct {
+	required bool
+	tests    []Test[*multipart.FileHeader]
+}
+
+func File() *FileValidator {
+	return &FileValidator{
+		required: false,
+	}
+}
+
+func (fv *FileValidator) Validate(value any) (ValidatorValue, ValidatorIssue) {
+	return primitiveValidation(value, fv.required, fv.tests)
+}
+
+func (fv *FileValidator) Required() *FileValidator {
+	fv.required = true
+	return fv
+}
+
+func (fv *FileValidator) MaxSize(bytes int64) *FileValidator {
+	maxSize := func(file *multipart.FileHeader) (ValidatorValue, ValidatorIssue) {
+		if file.Size > bytes {
+			return nil, fmt.Sprintf("The maximum file size is %d bytes", bytes)
+		}
+		return nil, ""
+	}
+
+	fv.tests = append(fv.tests, maxSize)
+	return fv
+}
+
+// ContentType checks the Content-Type sent by the client for the file part, the
+// content itself is not inspected.
+func (fv *FileValidator) ContentType(contentTypes ...string) *FileValidator {
+	contentType := func(file *multipart.FileHeader) (ValidatorValue, ValidatorIssue) {
+		fileContentType, _, _ := strings.Cut(file.Header.Get("Content-Type"), ";")
+
+		if !slices.Contains(contentTypes, strings.TrimSpace(fileContentType)) {
+			return nil, "The file content type must be one of " + strings.Join(contentTypes, ", ")
+		}
+		return nil, ""
+	}
+
+	fv.tests = append(fv.tests, contentType)
+	return fv
+}
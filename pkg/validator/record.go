@@ -0,0 +1,56 @@
+package validator
+
+import "fmt"
+
+// RecordValidator validates objects with arbitrary keys whose values are scalars
+// (string, number or boolean), e.g. {"a1b2c3d4": 65, "e5f6g7h8": true}.
+type RecordValidator struct {
+	required bool
+	max      int
+}
+
+func Record() *RecordValidator {
+	return &RecordValidator{
+		required: false,
+	}
+}
+
+func (rv *RecordValidator) Validate(value any) (ValidatorValue, ValidatorIssue) {
+	if rv.required && value == nil {
+		return nil, "Is required"
+	}
+
+	if !rv.required && value == nil {
+		return nil, ""
+	}
+
+	tValue, ok := value.(map[string]any)
+
+	if !ok {
+		return nil, "Is not a valid object(map)"
+	}
+
+	if rv.max > 0 && len(tValue) > rv.max {
+		return nil, fmt.Sprintf("The maximum length target is %d", rv.max)
+	}
+
+	for key, item := range tValue {
+		switch item.(type) {
+		case string, float64, bool:
+		default:
+			return nil, fmt.Sprintf("Key [%s] Recived type %T, need to be of type string, float or boolean", key, item)
+		}
+	}
+
+	return tValue, ""
+}
+
+func (rv *RecordValidator) Required() *RecordValidator {
+	rv.required = true
+	return rv
+}
+
+func (rv *RecordValidator) Max(n int) *RecordValidator {
+	rv.max = n
+	return rv
+}
@@ -0,0 +1,137 @@
+package usecase_test
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/infra/sqlite/repository"
+	"project/test/testdb"
+	"sync"
+	"testing"
+)
+
+// countingSender records the alerts sent, answering each one at the first attempt.
+type countingSender struct {
+	mutex sync.Mutex
+	sends int
+}
+
+func (s *countingSender) Send(payload []byte) (int64, int64, exceptions.RepositoryException) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.sends++
+
+	return 1, 200, nil
+}
+
+func TestCheckWatchlistPriceUpdates(t *testing.T) {
+	db := testdb.NewSqlite(t)
+
+	category := testdb.CreateCategory(t, db, "Geladeiras")
+	product := testdb.CreateProduct(t, db, category, domain_entity.ProductProps{Name: "Geladeira 400L", Price: 350000})
+
+	products := repository.NewProductSqlite(db.DB)
+	offers := repository.NewProductOfferSqlite(db.DB)
+	watchlists := repository.NewWatchlistSqlite(db.DB)
+	sender := &countingSender{}
+
+	_, usecaseErr := usecase.NewCreateOneWatchlist(products, offers, watchlists).Execute(&dto.CreateOneWatchlistInput{
+		SubscriberToken: "tok-3f9a2c71d04e",
+		ProductPublicID: product.PublicID,
+		TargetPrice:     300000,
+		ActorRole:       constants.RoleViewer,
+	})
+	if usecaseErr != nil {
+		t.Fatalf("Expected no error, got %v", usecaseErr)
+	}
+
+	check := usecase.NewCheckWatchlistPriceUpdates(products, offers, watchlists, sender)
+	version := product.Version
+
+	update := func(price int64) {
+		t.Helper()
+
+		output, usecaseErr := usecase.NewUpdateOneProduct(products, repository.NewCategorySqlite(db.DB), repository.NewAuditLogSqlite(db.DB)).Execute(&dto.UpdateOneProductInput{
+			PublicID:         product.PublicID,
+			Name:             product.Name,
+			Price:            price,
+			CategoryPublicID: category.PublicID,
+			Version:          version,
+			ActorRole:        constants.RoleEditor,
+		})
+		if usecaseErr != nil {
+			t.Fatalf("Expected no error, got %v", usecaseErr)
+		}
+
+		version = output.Version
+	}
+
+	tests := []struct {
+		name          string
+		prices        []int64
+		expectChecked int
+		expectSends   int
+	}{
+		{
+			name:          "Should not check a product whose price did not change",
+			prices:        []int64{350000},
+			expectChecked: 0,
+			expectSends:   0,
+		},
+		{
+			name:          "Should check once a product updated below the target more than once",
+			prices:        []int64{290000, 280000},
+			expectChecked: 1,
+			expectSends:   1,
+		},
+		{
+			name:          "Should leave nothing to check once checked",
+			expectChecked: 0,
+			expectSends:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := sender.sends
+
+			for _, price := range tt.prices {
+				update(price)
+			}
+
+			if sender.sends != sent {
+				t.Fatalf("Expected no alert sent by the update, got %d sent", sender.sends-sent)
+			}
+
+			output, usecaseErr := check.Execute()
+			if usecaseErr != nil {
+				t.Fatalf("Expected no error, got %v", usecaseErr)
+			}
+
+			if output.Products != tt.expectChecked {
+				t.Errorf("Expected %d products checked, got %d", tt.expectChecked, output.Products)
+			}
+
+			if sender.sends != tt.expectSends {
+				t.Errorf("Expected %d alerts sent, got %d", tt.expectSends, sender.sends)
+			}
+		})
+	}
+
+	watched, repoErr := watchlists.GetAllByProductID(product.ID)
+	if repoErr != nil || len(watched) != 1 {
+		t.Fatalf("Expected 1 watchlist, got %d %v", len(watched), repoErr)
+	}
+
+	deliveries, _, repoErr := watchlists.GetAllDeliveries(watched[0].ID, "", domain_entity.PaginatorInput{Limit: 10})
+	if repoErr != nil {
+		t.Fatalf("Expected no error, got %v", repoErr)
+	}
+
+	if len(deliveries) != 1 || deliveries[0].Trigger != constants.WatchlistTriggerPriceUpdate || deliveries[0].Price != 280000 {
+		t.Errorf("Expected 1 price_update delivery at 280000, got %+v", deliveries)
+	}
+}
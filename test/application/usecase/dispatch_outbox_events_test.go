@@ -0,0 +1,248 @@
+package usecase_test
+
+import (
+	"errors"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"project/test/testdb"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingPoster records the events posted, answering 200 or failing them all.
+type countingPoster struct {
+	mutex sync.Mutex
+	posts map[int64]int
+	fail  bool
+}
+
+func (p *countingPoster) Post(url string, secret string, eventID int64, payload []byte) (int64, exceptions.RepositoryException) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.posts[eventID]++
+
+	if p.fail {
+		return 503, exceptions.Repo(errors.New("subscription answered 503"))
+	}
+
+	return 200, nil
+}
+
+func newDispatcher(db *sqlite.Sqlite, poster *countingPoster) *usecase.DispatchOutboxEvents {
+	return usecase.NewDispatchOutboxEvents(
+		repository.NewOutboxSqlite(db.DB),
+		repository.NewWebhookSubscriptionSqlite(db.DB),
+		poster,
+		3,
+		time.Minute,
+		time.Minute,
+	)
+}
+
+// subscribeAndCreate subscribes to the created categories and creates count of them.
+func subscribeAndCreate(t *testing.T, db *sqlite.Sqlite, count int) {
+	t.Helper()
+
+	_, usecaseErr := usecase.NewCreateOneWebhookSubscription(repository.NewWebhookSubscriptionSqlite(db.DB)).Execute(&dto.CreateOneWebhookSubscriptionInput{
+		URL:        "https://example.com/hooks/catalog",
+		EventTypes: []types.OutboxEventType{constants.OutboxEventCategoryCreated},
+		ActorRole:  constants.RoleSystem,
+	})
+	if usecaseErr != nil {
+		t.Fatalf("Expected no error, got %v", usecaseErr)
+	}
+
+	for _, name := range []string{"Geladeiras", "Fogões", "Micro-ondas", "Lava-louças"}[:count] {
+		testdb.CreateCategory(t, db, name)
+	}
+}
+
+func TestDispatchOutboxEvents_Execute(t *testing.T) {
+	t.Run("Should send each event once with dispatchers side by side", func(t *testing.T) {
+		db := testdb.NewSqlite(t)
+		subscribeAndCreate(t, db, 4)
+
+		poster := &countingPoster{posts: map[int64]int{}}
+
+		var wait sync.WaitGroup
+		outputs := make([]*dto.DispatchOutboxEventsOutput, 2)
+		errs := make([]exceptions.UsecaseException, 2)
+
+		for i := range outputs {
+			wait.Add(1)
+
+			go func() {
+				defer wait.Done()
+				outputs[i], errs[i] = newDispatcher(db, poster).Execute()
+			}()
+		}
+
+		wait.Wait()
+
+		events, delivered := 0, 0
+
+		for i := range outputs {
+			if errs[i] != nil {
+				t.Fatalf("Expected no error, got %v", errs[i])
+			}
+
+			events += outputs[i].Events
+			delivered += outputs[i].Delivered
+		}
+
+		if events != 4 || delivered != 4 {
+			t.Errorf("Expected 4 events dispatched and delivered once, got %d and %d", events, delivered)
+		}
+
+		if len(poster.posts) != 4 {
+			t.Errorf("Expected 4 events posted, got %d", len(poster.posts))
+		}
+
+		for eventID, posts := range poster.posts {
+			if posts != 1 {
+				t.Errorf("Expected event %d posted once, got %d", eventID, posts)
+			}
+		}
+
+		output, usecaseErr := newDispatcher(db, poster).Execute()
+		if usecaseErr != nil {
+			t.Fatalf("Expected no error, got %v", usecaseErr)
+		}
+
+		if output.Events != 0 || output.Delivered != 0 || output.Failed != 0 {
+			t.Errorf("Expected nothing left to dispatch, got %+v", output)
+		}
+	})
+
+	t.Run("Should not dispatch an event another dispatcher marked", func(t *testing.T) {
+		db := testdb.NewSqlite(t)
+		subscribeAndCreate(t, db, 1)
+
+		outbox := repository.NewOutboxSqlite(db.DB)
+		subscriptions := repository.NewWebhookSubscriptionSqlite(db.DB)
+
+		events, repoErr := outbox.GetAllUndispatched(constants.OutboxDispatchBatchSize)
+		if repoErr != nil {
+			t.Fatalf("Expected no error, got %v", repoErr)
+		}
+
+		accepting, repoErr := subscriptions.GetAll()
+		if repoErr != nil {
+			t.Fatalf("Expected no error, got %v", repoErr)
+		}
+
+		for i, expected := range []bool{true, false} {
+			dispatched, repoErr := outbox.Dispatch(events[0], accepting)
+			if repoErr != nil {
+				t.Fatalf("Expected no error, got %v", repoErr)
+			}
+
+			if dispatched != expected {
+				t.Errorf("Expected dispatch %d to tell %v, got %v", i+1, expected, dispatched)
+			}
+		}
+
+		now := time.Now()
+
+		deliveries, repoErr := subscriptions.ClaimDueDeliveries(now, now.Add(time.Minute), constants.OutboxDispatchBatchSize)
+		if repoErr != nil {
+			t.Fatalf("Expected no error, got %v", repoErr)
+		}
+
+		if len(deliveries) != 1 {
+			t.Errorf("Expected a single delivery of the event, got %d", len(deliveries))
+		}
+	})
+
+	t.Run("Should claim the deliveries again once their lock is over", func(t *testing.T) {
+		db := testdb.NewSqlite(t)
+		subscribeAndCreate(t, db, 2)
+
+		subscriptions := repository.NewWebhookSubscriptionSqlite(db.DB)
+		outbox := repository.NewOutboxSqlite(db.DB)
+
+		events, repoErr := outbox.GetAllUndispatched(constants.OutboxDispatchBatchSize)
+		if repoErr != nil {
+			t.Fatalf("Expected no error, got %v", repoErr)
+		}
+
+		accepting, repoErr := subscriptions.GetAll()
+		if repoErr != nil {
+			t.Fatalf("Expected no error, got %v", repoErr)
+		}
+
+		for _, event := range events {
+			if _, repoErr := outbox.Dispatch(event, accepting); repoErr != nil {
+				t.Fatalf("Expected no error, got %v", repoErr)
+			}
+		}
+
+		now := time.Now()
+
+		claims := []struct {
+			at           time.Duration
+			expectClaims int
+		}{
+			{at: 0, expectClaims: 2},
+			{at: 30 * time.Second, expectClaims: 0},
+			{at: 2 * time.Minute, expectClaims: 2},
+		}
+
+		for _, claim := range claims {
+			at := now.Add(claim.at)
+
+			deliveries, repoErr := subscriptions.ClaimDueDeliveries(at, at.Add(time.Minute), constants.OutboxDispatchBatchSize)
+			if repoErr != nil {
+				t.Fatalf("Expected no error, got %v", repoErr)
+			}
+
+			if len(deliveries) != claim.expectClaims {
+				t.Errorf("Expected %d deliveries claimed after %s, got %d", claim.expectClaims, claim.at, len(deliveries))
+			}
+
+			for _, delivery := range deliveries {
+				if delivery.Status != constants.WebhookDeliveryStatusSending {
+					t.Errorf("Expected a sending delivery, got %s", delivery.Status)
+				}
+			}
+		}
+	})
+
+	t.Run("Should put a failed delivery back to pending", func(t *testing.T) {
+		db := testdb.NewSqlite(t)
+		subscribeAndCreate(t, db, 1)
+
+		poster := &countingPoster{posts: map[int64]int{}, fail: true}
+
+		output, usecaseErr := newDispatcher(db, poster).Execute()
+		if usecaseErr != nil {
+			t.Fatalf("Expected no error, got %v", usecaseErr)
+		}
+
+		if output.Failed != 1 {
+			t.Errorf("Expected 1 failed delivery, got %+v", output)
+		}
+
+		subscriptions, repoErr := repository.NewWebhookSubscriptionSqlite(db.DB).GetAll()
+		if repoErr != nil {
+			t.Fatalf("Expected no error, got %v", repoErr)
+		}
+
+		deliveries, _, repoErr := repository.NewWebhookSubscriptionSqlite(db.DB).GetAllDeliveries(subscriptions[0].ID, constants.WebhookDeliveryStatusPending, domain_entity.PaginatorInput{Limit: 10})
+		if repoErr != nil {
+			t.Fatalf("Expected no error, got %v", repoErr)
+		}
+
+		if len(deliveries) != 1 || deliveries[0].Attempts != 1 {
+			t.Errorf("Expected a pending delivery after 1 attempt, got %+v", deliveries)
+		}
+	})
+}
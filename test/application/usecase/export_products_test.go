@@ -0,0 +1,153 @@
+package usecase_test
+
+import (
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	domain_entity "project/internal/domain/entity"
+	exceptions "project/internal/domain/exception"
+	domain_repository "project/internal/domain/repository"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite/repository"
+	"project/test/testdb"
+	"testing"
+)
+
+// batchCountingProducts records the batches the export reads.
+type batchCountingProducts struct {
+	domain_repository.Product
+	batches []domain_entity.ProductCursorInput
+}
+
+func (b *batchCountingProducts) GetManyAfterID(input domain_entity.ProductCursorInput) ([]*domain_entity.Product, exceptions.RepositoryException) {
+	b.batches = append(b.batches, input)
+	return b.Product.GetManyAfterID(input)
+}
+
+func TestExportProducts_Execute(t *testing.T) {
+	db := testdb.NewSqlite(t)
+
+	phones := testdb.CreateCategory(t, db, "Smartphones")
+	notebooks := testdb.CreateCategory(t, db, "Notebooks")
+
+	for i := range 450 {
+		testdb.CreateProduct(t, db, phones, domain_entity.ProductProps{
+			Name:  types.ProductName(fmt.Sprintf("Phone %03d", i)),
+			Price: int64(1000 + i),
+		})
+	}
+
+	testdb.CreateProduct(t, db, notebooks, domain_entity.ProductProps{Name: "Notebook", Price: 500000})
+
+	tests := []struct {
+		name            string
+		input           *dto.ExportProductsInput
+		expectProducts  int
+		expectFirstName string
+		expectBatches   int
+	}{
+		{
+			name:            "Should read the whole catalog in batches",
+			input:           &dto.ExportProductsInput{},
+			expectProducts:  451,
+			expectFirstName: "Phone 000",
+			expectBatches:   3,
+		},
+		{
+			name:            "Should skip and limit across batches",
+			input:           &dto.ExportProductsInput{PaginatorInput: &dto.PaginatorInput{Skip: 10, Limit: 250}},
+			expectProducts:  250,
+			expectFirstName: "Phone 010",
+			expectBatches:   2,
+		},
+		{
+			name:            "Should only read the products of the category",
+			input:           &dto.ExportProductsInput{CategoryPublicID: notebooks.PublicID},
+			expectProducts:  1,
+			expectFirstName: "Notebook",
+			expectBatches:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products := &batchCountingProducts{Product: repository.NewProductSqlite(db.DB)}
+
+			exportProducts := usecase.NewExportProducts(
+				products,
+				repository.NewCategorySqlite(db.DB),
+				repository.NewSpecificationqlite(db.DB),
+				repository.NewProductSpecificationValueSqlite(db.DB),
+			)
+
+			output, err := exportProducts.Execute(tt.input)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if len(products.batches) != 0 {
+				t.Fatalf("Expected no product read before the sequence is consumed, got %d batches", len(products.batches))
+			}
+
+			var units []*dto.ExportProductUnit
+
+			for unit, err := range output.Products {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				units = append(units, unit)
+			}
+
+			if len(units) != tt.expectProducts {
+				t.Fatalf("Expected %d products, got %d", tt.expectProducts, len(units))
+			}
+
+			if units[0].Name != types.ProductName(tt.expectFirstName) {
+				t.Errorf("Expected first product %q, got %q", tt.expectFirstName, units[0].Name)
+			}
+
+			seen := make(map[string]bool, len(units))
+
+			for _, unit := range units {
+				if seen[string(unit.PublicID)] {
+					t.Fatalf("Expected each product once, got %s twice", unit.PublicID)
+				}
+				seen[string(unit.PublicID)] = true
+			}
+
+			if len(products.batches) != tt.expectBatches {
+				t.Errorf("Expected %d batches, got %d", tt.expectBatches, len(products.batches))
+			}
+
+			for _, batch := range products.batches {
+				if batch.Limit > 200 {
+					t.Errorf("Expected batches of at most 200 products, got %d", batch.Limit)
+				}
+			}
+		})
+	}
+
+	t.Run("Should stop reading when the consumer stops", func(t *testing.T) {
+		products := &batchCountingProducts{Product: repository.NewProductSqlite(db.DB)}
+
+		exportProducts := usecase.NewExportProducts(
+			products,
+			repository.NewCategorySqlite(db.DB),
+			repository.NewSpecificationqlite(db.DB),
+			repository.NewProductSpecificationValueSqlite(db.DB),
+		)
+
+		output, err := exportProducts.Execute(&dto.ExportProductsInput{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		for range output.Products {
+			break
+		}
+
+		if len(products.batches) != 1 {
+			t.Errorf("Expected a single batch read, got %d", len(products.batches))
+		}
+	})
+}
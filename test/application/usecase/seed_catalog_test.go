@@ -0,0 +1,127 @@
+package usecase_test
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	"project/internal/domain/types"
+	"project/internal/infra/seed"
+	"project/internal/infra/sqlite/repository"
+	"project/test/testdb"
+	"strings"
+	"testing"
+)
+
+func TestSeedCatalog_Execute_Profiles(t *testing.T) {
+	for _, profile := range seed.Profiles {
+		t.Run("Should seed the "+profile+" profile again and again with fixed specification IDs", func(t *testing.T) {
+			db := testdb.NewSqlite(t)
+			seedCatalog := usecase.NewSeedCatalog(repository.NewSeedSqlite(db.DB))
+
+			fixture, err := seed.Load(seed.Fixtures, profile)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			for range 2 {
+				output, usecaseErr := seedCatalog.Execute(&dto.SeedCatalogInput{Fixture: fixture, ActorRole: constants.RoleSystem})
+				if usecaseErr != nil {
+					t.Fatalf("Expected no error, got %v", usecaseErr)
+				}
+
+				if output.Products != len(fixture.Products) || output.Specifications != len(fixture.Specifications) {
+					t.Errorf("Expected %d products and %d specifications, got %d and %d", len(fixture.Products), len(fixture.Specifications), output.Products, output.Specifications)
+				}
+			}
+
+			var products int
+			if err := db.DB.QueryRow(`SELECT COUNT(*) FROM products`).Scan(&products); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if products != len(fixture.Products) {
+				t.Errorf("Expected %d products after seeding twice, got %d", len(fixture.Products), products)
+			}
+
+			for id, expectedType := range constants.BuiltInSpecifications {
+				var specificationType string
+				if err := db.DB.QueryRow(`SELECT type FROM specifications WHERE id = ?`, id).Scan(&specificationType); err != nil {
+					t.Fatalf("Expected built-in specification %d, got %v", id, err)
+				}
+
+				if types.SpecificationType(specificationType) != expectedType {
+					t.Errorf("Expected built-in specification %d to be %s, got %s", id, expectedType, specificationType)
+				}
+			}
+		})
+	}
+}
+
+func TestSeedCatalog_Execute_Errors(t *testing.T) {
+	db := testdb.NewSqlite(t)
+	seedCatalog := usecase.NewSeedCatalog(repository.NewSeedSqlite(db.DB))
+
+	validFixture := func() *dto.SeedFixture {
+		fixture, err := seed.Load(seed.Fixtures, "test")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return fixture
+	}
+
+	tests := []struct {
+		name         string
+		fixture      func() *dto.SeedFixture
+		role         types.Role
+		expectStatus int
+		expectedMsg  string
+	}{
+		{
+			name:         "Should return 403 for a role that cannot seed",
+			fixture:      validFixture,
+			role:         constants.RoleCatalogAdmin,
+			expectStatus: 403,
+		},
+		{
+			name: "Should return 422 when a built-in specification is missing",
+			fixture: func() *dto.SeedFixture {
+				fixture := validFixture()
+				fixture.Specifications = fixture.Specifications[:len(fixture.Specifications)-1]
+				fixture.Categories, fixture.Products = nil, nil
+				return fixture
+			},
+			role:         constants.RoleSystem,
+			expectStatus: 422,
+			expectedMsg:  "built-in specification 16 is missing",
+		},
+		{
+			name: "Should return 422 when a built-in specification changes type",
+			fixture: func() *dto.SeedFixture {
+				fixture := validFixture()
+				fixture.Specifications[0].Type = constants.SpecificationTypeString
+				return fixture
+			},
+			role:         constants.RoleSystem,
+			expectStatus: 422,
+			expectedMsg:  "built-in specification 1 must be of type int",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, usecaseErr := seedCatalog.Execute(&dto.SeedCatalogInput{Fixture: tt.fixture(), ActorRole: tt.role})
+
+			if usecaseErr == nil {
+				t.Fatalf("Expected error with status %d, got nil", tt.expectStatus)
+			}
+
+			if usecaseErr.Instance().StatusCode != tt.expectStatus {
+				t.Errorf("Expected status %d, got %d", tt.expectStatus, usecaseErr.Instance().StatusCode)
+			}
+
+			if !strings.Contains(usecaseErr.Instance().Message, tt.expectedMsg) {
+				t.Errorf("Expected message containing %q, got %q", tt.expectedMsg, usecaseErr.Instance().Message)
+			}
+		})
+	}
+}
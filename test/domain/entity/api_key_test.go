@@ -0,0 +1,171 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	. "project/internal/domain/types"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewApiKey(t *testing.T) {
+	validProps := func() domain_entity.ApiKeyProps {
+		return domain_entity.ApiKeyProps{
+			Name: " catalog-importer ",
+			Role: constants.RoleEditor,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.ApiKeyProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create a key",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should return error when Name is empty",
+			props: func() domain_entity.ApiKeyProps {
+				props := validProps()
+				props.Name = "  "
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Name cannot be empty",
+		},
+		{
+			name: "Should return error when Name is too long",
+			props: func() domain_entity.ApiKeyProps {
+				props := validProps()
+				props.Name = strings.Repeat("a", constants.ApiKeyNameMaxLength+1)
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Name cannot be longer than",
+		},
+		{
+			name: "Should return error when Role is system",
+			props: func() domain_entity.ApiKeyProps {
+				props := validProps()
+				props.Role = constants.RoleSystem
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Role \"system\" is not valid",
+		},
+		{
+			name: "Should return error when Hash is not a SHA-256",
+			props: func() domain_entity.ApiKeyProps {
+				props := validProps()
+				props.Hash = "abc"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Hash must be a hex SHA-256",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKey, err := domain_entity.NewApiKey(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if apiKey.Name != "catalog-importer" {
+				t.Errorf("Expected trimmed name, got %q", apiKey.Name)
+			}
+			if apiKey.Hash != domain_entity.HashApiKey(apiKey.Key) {
+				t.Errorf("Expected the hash of the generated key, got %q", apiKey.Hash)
+			}
+		})
+	}
+}
+
+func TestParseApiKey(t *testing.T) {
+	apiKey, err := domain_entity.NewApiKey(domain_entity.ApiKeyProps{Name: "catalog-importer", Role: constants.RoleViewer})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		key              string
+		expectedOk       bool
+		expectedPublicID ApiKeyPublicID
+	}{
+		{name: "Should read the public ID of a generated key", key: apiKey.Key, expectedOk: true, expectedPublicID: apiKey.PublicID},
+		{name: "Should reject another prefix", key: strings.Replace(apiKey.Key, constants.ApiKeyPrefix, "abc", 1), expectedOk: false},
+		{name: "Should reject a short secret", key: apiKey.Key[:len(apiKey.Key)-2], expectedOk: false},
+		{name: "Should reject a JWT", key: "eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiJhIn0.c2ln", expectedOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publicID, ok := domain_entity.ParseApiKey(tt.key)
+
+			if ok != tt.expectedOk || publicID != tt.expectedPublicID {
+				t.Errorf("Expected (%q, %v), got (%q, %v)", tt.expectedPublicID, tt.expectedOk, publicID, ok)
+			}
+		})
+	}
+}
+
+func TestApiKey_Verify(t *testing.T) {
+	apiKey, err := domain_entity.NewApiKey(domain_entity.ApiKeyProps{Name: "catalog-importer", Role: constants.RoleViewer})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	other, err := domain_entity.NewApiKey(domain_entity.ApiKeyProps{Name: "catalog-importer", Role: constants.RoleViewer})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		revokedAt   time.Time
+		key         string
+		expectError bool
+		expectedMsg string
+	}{
+		{name: "Should accept its key", key: apiKey.Key, expectError: false},
+		{name: "Should reject another key", key: other.Key, expectError: true, expectedMsg: "does not match"},
+		{name: "Should reject its key once revoked", key: apiKey.Key, revokedAt: time.Now(), expectError: true, expectedMsg: "is revoked"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := *apiKey
+			stored.RevokedAt = tt.revokedAt
+
+			err := stored.Verify(tt.key)
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	. "project/internal/domain/types"
+	"strings"
+	"testing"
+)
+
+func TestNewAuditEntry(t *testing.T) {
+	validProps := func() domain_entity.AuditEntryProps {
+		return domain_entity.AuditEntryProps{
+			Actor:          "catalog-team",
+			EntityType:     constants.AuditEntityProduct,
+			EntityPublicID: "prd00001",
+			Operation:      constants.AuditOperationUpdate,
+			Changes: []domain_entity.AuditChange{
+				{Field: "price", Before: int64(1000), After: int64(900)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.AuditEntryProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create an entry",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should return error when Actor is too long",
+			props: func() domain_entity.AuditEntryProps {
+				props := validProps()
+				props.Actor = Actor(strings.Repeat("a", constants.AuditActorMaxLength+1))
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Actor cannot be longer than",
+		},
+		{
+			name: "Should return error when EntityType is unknown",
+			props: func() domain_entity.AuditEntryProps {
+				props := validProps()
+				props.EntityType = "review"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "EntityType \"review\" is not valid",
+		},
+		{
+			name: "Should return error when EntityPublicID is empty",
+			props: func() domain_entity.AuditEntryProps {
+				props := validProps()
+				props.EntityPublicID = ""
+				return props
+			},
+			expectError: true,
+			expectedMsg: "EntityPublicID cannot be empty",
+		},
+		{
+			name: "Should return error when Operation is unknown",
+			props: func() domain_entity.AuditEntryProps {
+				props := validProps()
+				props.Operation = "archive"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Operation \"archive\" is not valid",
+		},
+		{
+			name: "Should return error when Changes is empty",
+			props: func() domain_entity.AuditEntryProps {
+				props := validProps()
+				props.Changes = nil
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Changes cannot be empty",
+		},
+		{
+			name: "Should return error when a change has no field",
+			props: func() domain_entity.AuditEntryProps {
+				props := validProps()
+				props.Changes = append(props.Changes, domain_entity.AuditChange{After: "x"})
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Field of a change cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain_entity.NewAuditEntry(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewAuditEntry_Actor(t *testing.T) {
+	tests := []struct {
+		name     string
+		actor    Actor
+		expected Actor
+	}{
+		{name: "Should keep the actor", actor: "catalog-team", expected: "catalog-team"},
+		{name: "Should trim the actor", actor: "  catalog-team ", expected: "catalog-team"},
+		{name: "Should default to anonymous", actor: "  ", expected: constants.AuditAnonymousActor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := domain_entity.NewAuditEntry(domain_entity.AuditEntryProps{
+				Actor:          tt.actor,
+				EntityType:     constants.AuditEntityCategory,
+				EntityPublicID: "cat00001",
+				Operation:      constants.AuditOperationCreate,
+				Changes:        []domain_entity.AuditChange{{Field: "name", After: "Phones"}},
+			})
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if entry.Actor != tt.expected {
+				t.Errorf("Expected actor %q, got %q", tt.expected, entry.Actor)
+			}
+		})
+	}
+}
+
+func TestDiffAuditFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   map[string]any
+		after    map[string]any
+		expected []domain_entity.AuditChange
+	}{
+		{
+			name:   "Should record every field of a created entity",
+			before: nil,
+			after:  map[string]any{"name": "Phone", "price": int64(1000)},
+			expected: []domain_entity.AuditChange{
+				{Field: "name", Before: nil, After: "Phone"},
+				{Field: "price", Before: nil, After: int64(1000)},
+			},
+		},
+		{
+			name:   "Should record every field of a deleted entity",
+			before: map[string]any{"name": "Phone"},
+			after:  nil,
+			expected: []domain_entity.AuditChange{
+				{Field: "name", Before: "Phone", After: nil},
+			},
+		},
+		{
+			name:   "Should skip the fields that did not change",
+			before: map[string]any{"name": "Phone", "price": int64(1000), "description": "Old"},
+			after:  map[string]any{"name": "Phone", "price": int64(900), "description": "New"},
+			expected: []domain_entity.AuditChange{
+				{Field: "description", Before: "Old", After: "New"},
+				{Field: "price", Before: int64(1000), After: int64(900)},
+			},
+		},
+		{
+			name:   "Should compare nested values",
+			before: map[string]any{"offers.rtl00001": map[string]any{"price": int64(1000)}},
+			after:  map[string]any{"offers.rtl00001": map[string]any{"price": int64(1000)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := domain_entity.DiffAuditFields(tt.before, tt.after)
+
+			if len(changes) != len(tt.expected) {
+				t.Fatalf("Expected %d changes, got %d: %v", len(tt.expected), len(changes), changes)
+			}
+
+			for i, change := range changes {
+				expected := tt.expected[i]
+
+				if change.Field != expected.Field || change.Before != expected.Before || change.After != expected.After {
+					t.Errorf("Expected change %v, got %v", expected, change)
+				}
+			}
+		})
+	}
+}
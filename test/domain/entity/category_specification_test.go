@@ -0,0 +1,253 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	. "project/internal/domain/types"
+	"strings"
+	"testing"
+)
+
+func TestNewCategorySpecification(t *testing.T) {
+	tests := []struct {
+		name        string
+		props       domain_entity.CategorySpecificationProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name: "Should create a required entry",
+			props: domain_entity.CategorySpecificationProps{
+				CategoryID:      1,
+				SpecificationID: constants.PowerInWatts,
+				Requirement:     constants.SpecificationRequirementRequired,
+				DisplayOrder:    1,
+			},
+			expectError: false,
+		},
+		{
+			name: "Should return error when CategoryID is zero",
+			props: domain_entity.CategorySpecificationProps{
+				CategoryID:      0,
+				SpecificationID: constants.PowerInWatts,
+				Requirement:     constants.SpecificationRequirementAllowed,
+			},
+			expectError: true,
+			expectedMsg: "CategoryID field must be greater than 0",
+		},
+		{
+			name: "Should return error when SpecificationID is zero",
+			props: domain_entity.CategorySpecificationProps{
+				CategoryID:      1,
+				SpecificationID: 0,
+				Requirement:     constants.SpecificationRequirementAllowed,
+			},
+			expectError: true,
+			expectedMsg: "SpecificationID field must be greater than 0",
+		},
+		{
+			name: "Should return error when Requirement is unknown",
+			props: domain_entity.CategorySpecificationProps{
+				CategoryID:      1,
+				SpecificationID: constants.PowerInWatts,
+				Requirement:     "optional",
+			},
+			expectError: true,
+			expectedMsg: "Requirement must be one of required, recommended or allowed",
+		},
+		{
+			name: "Should return error when DisplayOrder is negative",
+			props: domain_entity.CategorySpecificationProps{
+				CategoryID:      1,
+				SpecificationID: constants.PowerInWatts,
+				Requirement:     constants.SpecificationRequirementAllowed,
+				DisplayOrder:    -1,
+			},
+			expectError: true,
+			expectedMsg: "DisplayOrder field cannot be less than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categorySpecification, err := domain_entity.NewCategorySpecification(tt.props)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got nil", tt.expectedMsg)
+					return
+				}
+				if !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error message to contain %q, but got %q", tt.expectedMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error, but got: %v", err)
+				}
+				if categorySpecification == nil {
+					t.Error("Expected category specification instance, but got nil")
+				}
+			}
+		})
+	}
+}
+
+func newCategorySpecification(t *testing.T, specificationID SpecificationID, requirement SpecificationRequirement, displayOrder int64) *domain_entity.CategorySpecification {
+	categorySpecification, err := domain_entity.NewCategorySpecification(domain_entity.CategorySpecificationProps{
+		CategoryID:      1,
+		SpecificationID: specificationID,
+		Requirement:     requirement,
+		DisplayOrder:    displayOrder,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error creating category specification, but got: %v", err)
+	}
+
+	return categorySpecification
+}
+
+func TestNewCategoryTemplate(t *testing.T) {
+	t.Run("Should sort entries by display order", func(t *testing.T) {
+		template, err := domain_entity.NewCategoryTemplate(1, []*domain_entity.CategorySpecification{
+			newCategorySpecification(t, constants.TDPWatts, constants.SpecificationRequirementRecommended, 2),
+			newCategorySpecification(t, constants.Threads, constants.SpecificationRequirementRequired, 1),
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if template.Specifications[0].SpecificationID != constants.Threads {
+			t.Errorf("Expected first entry to be %d, got %d", constants.Threads, template.Specifications[0].SpecificationID)
+		}
+	})
+
+	t.Run("Should return error when a specification is listed twice", func(t *testing.T) {
+		_, err := domain_entity.NewCategoryTemplate(1, []*domain_entity.CategorySpecification{
+			newCategorySpecification(t, constants.Threads, constants.SpecificationRequirementRequired, 1),
+			newCategorySpecification(t, constants.Threads, constants.SpecificationRequirementAllowed, 2),
+		})
+
+		if err == nil || !strings.Contains(err.Error(), "is listed more than once") {
+			t.Errorf("Expected duplicate error, got %v", err)
+		}
+	})
+
+	t.Run("Should return error when an entry belongs to another category", func(t *testing.T) {
+		_, err := domain_entity.NewCategoryTemplate(2, []*domain_entity.CategorySpecification{
+			newCategorySpecification(t, constants.Threads, constants.SpecificationRequirementRequired, 1),
+		})
+
+		if err == nil || !strings.Contains(err.Error(), "belongs to category 1, not 2") {
+			t.Errorf("Expected category mismatch error, got %v", err)
+		}
+	})
+}
+
+func TestCategoryTemplate_Allows(t *testing.T) {
+	empty, err := domain_entity.NewCategoryTemplate(1, nil)
+
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if !empty.Allows(constants.Waterproof) {
+		t.Error("Expected empty template to allow any specification")
+	}
+
+	template, err := domain_entity.NewCategoryTemplate(1, []*domain_entity.CategorySpecification{
+		newCategorySpecification(t, constants.Threads, constants.SpecificationRequirementRequired, 1),
+		newCategorySpecification(t, constants.USBC, constants.SpecificationRequirementAllowed, 2),
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if !template.Allows(constants.USBC) {
+		t.Error("Expected template to allow a listed specification")
+	}
+	if template.Allows(constants.CapacityLiters) {
+		t.Error("Expected template to reject a specification it does not list")
+	}
+}
+
+func TestCategoryTemplate_Completeness(t *testing.T) {
+	template, err := domain_entity.NewCategoryTemplate(1, []*domain_entity.CategorySpecification{
+		newCategorySpecification(t, constants.Threads, constants.SpecificationRequirementRequired, 1),
+		newCategorySpecification(t, constants.FrequencyGHz, constants.SpecificationRequirementRequired, 2),
+		newCategorySpecification(t, constants.TDPWatts, constants.SpecificationRequirementRecommended, 3),
+		newCategorySpecification(t, constants.USBC, constants.SpecificationRequirementAllowed, 4),
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	tests := []struct {
+		name               string
+		filled             []SpecificationID
+		expectedMissingReq []SpecificationID
+		expectedMissingRec []SpecificationID
+		expectedComplete   bool
+	}{
+		{
+			name:               "Should report every required and recommended spec when nothing is filled",
+			filled:             nil,
+			expectedMissingReq: []SpecificationID{constants.Threads, constants.FrequencyGHz},
+			expectedMissingRec: []SpecificationID{constants.TDPWatts},
+			expectedComplete:   false,
+		},
+		{
+			name:               "Should be complete with only recommended specs missing",
+			filled:             []SpecificationID{constants.Threads, constants.FrequencyGHz, constants.USBC},
+			expectedMissingReq: nil,
+			expectedMissingRec: []SpecificationID{constants.TDPWatts},
+			expectedComplete:   true,
+		},
+		{
+			name:               "Should be complete with every spec filled",
+			filled:             []SpecificationID{constants.Threads, constants.FrequencyGHz, constants.TDPWatts},
+			expectedMissingReq: nil,
+			expectedMissingRec: nil,
+			expectedComplete:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]*domain_entity.ProductSpecificationValue, len(tt.filled))
+
+			for i, specificationID := range tt.filled {
+				values[i] = &domain_entity.ProductSpecificationValue{
+					ProductID:       10,
+					SpecificationID: specificationID,
+					Value:           &domain_entity.SpecValue{IntValue: intPtr(1)},
+				}
+			}
+
+			completeness := template.Completeness(values)
+
+			if completeness.Complete() != tt.expectedComplete {
+				t.Errorf("Expected complete %t, got %t", tt.expectedComplete, completeness.Complete())
+			}
+			if completeness.RequiredTotal != 2 || completeness.RecommendedTotal != 1 {
+				t.Errorf("Expected 2 required and 1 recommended, got %d and %d", completeness.RequiredTotal, completeness.RecommendedTotal)
+			}
+			if len(completeness.MissingRequired) != len(tt.expectedMissingReq) {
+				t.Fatalf("Expected %d missing required, got %d", len(tt.expectedMissingReq), len(completeness.MissingRequired))
+			}
+			for i, specificationID := range tt.expectedMissingReq {
+				if completeness.MissingRequired[i].SpecificationID != specificationID {
+					t.Errorf("Expected missing required %d, got %d", specificationID, completeness.MissingRequired[i].SpecificationID)
+				}
+			}
+			if len(completeness.MissingRecommended) != len(tt.expectedMissingRec) {
+				t.Fatalf("Expected %d missing recommended, got %d", len(tt.expectedMissingRec), len(completeness.MissingRecommended))
+			}
+			if completeness.RequiredFilled != completeness.RequiredTotal-len(tt.expectedMissingReq) {
+				t.Errorf("Expected %d required filled, got %d", completeness.RequiredTotal-len(tt.expectedMissingReq), completeness.RequiredFilled)
+			}
+		})
+	}
+}
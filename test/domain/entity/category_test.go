@@ -141,3 +141,96 @@ func TestNewCategory(t *testing.T) {
 		})
 	}
 }
+
+func TestCategory_Update(t *testing.T) {
+	longName := strings.Repeat("a", 256)
+	longDescription := strings.Repeat("b", 2001)
+
+	tests := []struct {
+		name        string
+		props       domain_entity.UpdateCategoryProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name: "Should update name and description",
+			props: domain_entity.UpdateCategoryProps{
+				Name:        "Home Appliances",
+				Description: "Refrigerators, stoves and washing machines",
+			},
+			expectError: false,
+		},
+		{
+			name: "Should allow clearing the description",
+			props: domain_entity.UpdateCategoryProps{
+				Name: "Home Appliances",
+			},
+			expectError: false,
+		},
+		{
+			name: "Should return error when Name is empty",
+			props: domain_entity.UpdateCategoryProps{
+				Name: "",
+			},
+			expectError: true,
+			expectedMsg: "Name cannot be empty",
+		},
+		{
+			name: "Should return error when Name is too long",
+			props: domain_entity.UpdateCategoryProps{
+				Name: longName,
+			},
+			expectError: true,
+			expectedMsg: "Name cannot be longer than 255 characters",
+		},
+		{
+			name: "Should return error when Description is too long",
+			props: domain_entity.UpdateCategoryProps{
+				Name:        "Valid Name",
+				Description: longDescription,
+			},
+			expectError: true,
+			expectedMsg: "Description cannot be longer than 2000 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category, err := domain_entity.NewCategory(domain_entity.CategoryProps{
+				ID:          1,
+				PublicID:    "12345678",
+				Name:        "Electronics",
+				Description: "Gadgets",
+			})
+
+			if err != nil {
+				t.Fatalf("Failed to setup category: %v", err)
+			}
+
+			updateErr := category.Update(tt.props)
+
+			if tt.expectError {
+				if updateErr == nil {
+					t.Errorf("Expected error containing %q, but got nil", tt.expectedMsg)
+					return
+				}
+				if !strings.Contains(updateErr.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error message to contain %q, but got %q", tt.expectedMsg, updateErr.Error())
+				}
+			} else {
+				if updateErr != nil {
+					t.Errorf("Expected no error, but got: %v", updateErr)
+				}
+				if category.Name != tt.props.Name {
+					t.Errorf("Expected name %s, got %s", tt.props.Name, category.Name)
+				}
+				if category.Description != tt.props.Description {
+					t.Errorf("Expected description %s, got %s", tt.props.Description, category.Description)
+				}
+				if category.PublicID != "12345678" {
+					t.Errorf("Expected PublicID to be preserved, got %s", category.PublicID)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	"strings"
+	"testing"
+)
+
+func TestNewExternalReference(t *testing.T) {
+	longExternalID := strings.Repeat("1", 256)
+	maxExternalID := strings.Repeat("1", 255)
+
+	tests := []struct {
+		name        string
+		props       domain_entity.ExternalReferenceProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name: "Should create a valid external reference",
+			props: domain_entity.ExternalReferenceProps{
+				ID:              1,
+				Source:          constants.ExternalSourceFakeStore,
+				ExternalID:      "20",
+				ProductID:       3,
+				ProductPublicID: "a1b2c3d4",
+			},
+			expectError: false,
+		},
+		{
+			name: "Should create a reference for a product not created yet",
+			props: domain_entity.ExternalReferenceProps{
+				Source:     constants.ExternalSourceFakeStore,
+				ExternalID: "20",
+			},
+			expectError: false,
+		},
+		{
+			name: "Should allow max length ExternalID",
+			props: domain_entity.ExternalReferenceProps{
+				Source:     constants.ExternalSourceFakeStore,
+				ExternalID: maxExternalID,
+			},
+			expectError: false,
+		},
+		{
+			name: "Should return error when ID is negative",
+			props: domain_entity.ExternalReferenceProps{
+				ID:         -1,
+				Source:     constants.ExternalSourceFakeStore,
+				ExternalID: "20",
+			},
+			expectError: true,
+			expectedMsg: "ID field cannot be less than 0",
+		},
+		{
+			name: "Should return error when Source is empty",
+			props: domain_entity.ExternalReferenceProps{
+				ExternalID: "20",
+			},
+			expectError: true,
+			expectedMsg: "Source cannot be empty",
+		},
+		{
+			name: "Should return error when ExternalID is empty",
+			props: domain_entity.ExternalReferenceProps{
+				Source: constants.ExternalSourceFakeStore,
+			},
+			expectError: true,
+			expectedMsg: "ExternalID cannot be empty",
+		},
+		{
+			name: "Should return error when ExternalID is too long",
+			props: domain_entity.ExternalReferenceProps{
+				Source:     constants.ExternalSourceFakeStore,
+				ExternalID: longExternalID,
+			},
+			expectError: true,
+			expectedMsg: "ExternalID cannot be longer than 255 characters",
+		},
+		{
+			name: "Should return error when ProductID is negative",
+			props: domain_entity.ExternalReferenceProps{
+				Source:     constants.ExternalSourceFakeStore,
+				ExternalID: "20",
+				ProductID:  -1,
+			},
+			expectError: true,
+			expectedMsg: "ProductID field cannot be less than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			externalReference, err := domain_entity.NewExternalReference(tt.props)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got nil", tt.expectedMsg)
+					return
+				}
+				if !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error message to contain %q, but got %q", tt.expectedMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error, but got: %v", err)
+				}
+				if externalReference == nil {
+					t.Error("Expected external reference instance, but got nil")
+					return
+				}
+				if externalReference.ExternalID != tt.props.ExternalID {
+					t.Errorf("Expected ExternalID %s, got %s", tt.props.ExternalID, externalReference.ExternalID)
+				}
+			}
+		})
+	}
+}
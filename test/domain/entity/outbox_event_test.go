@@ -0,0 +1,79 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	"strings"
+	"testing"
+)
+
+func TestNewOutboxEvent(t *testing.T) {
+	validProps := func() domain_entity.OutboxEventProps {
+		return domain_entity.OutboxEventProps{
+			Type:              constants.OutboxEventProductUpdated,
+			AggregateType:     constants.OutboxAggregateProduct,
+			AggregatePublicID: "prd00001",
+			Payload:           []byte(`{"public_id":"prd00001"}`),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.OutboxEventProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create an event",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should return error when Type is unknown",
+			props: func() domain_entity.OutboxEventProps {
+				props := validProps()
+				props.Type = "product.*"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "is not valid",
+		},
+		{
+			name: "Should return error when AggregatePublicID is empty",
+			props: func() domain_entity.OutboxEventProps {
+				props := validProps()
+				props.AggregatePublicID = ""
+				return props
+			},
+			expectError: true,
+			expectedMsg: "AggregatePublicID cannot be empty",
+		},
+		{
+			name: "Should return error when Payload is not JSON",
+			props: func() domain_entity.OutboxEventProps {
+				props := validProps()
+				props.Payload = []byte("prd00001")
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Payload must be valid JSON",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain_entity.NewOutboxEvent(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	"strings"
+	"testing"
+)
+
+func TestNewPrincipal(t *testing.T) {
+	validProps := func() domain_entity.PrincipalProps {
+		return domain_entity.PrincipalProps{
+			Subject: " maria ",
+			Method:  constants.AuthMethodJWT,
+			Role:    constants.RoleEditor,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.PrincipalProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create a principal",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should return error when Subject is empty",
+			props: func() domain_entity.PrincipalProps {
+				props := validProps()
+				props.Subject = ""
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Subject cannot be empty",
+		},
+		{
+			name: "Should return error when Role is unknown",
+			props: func() domain_entity.PrincipalProps {
+				props := validProps()
+				props.Role = "owner"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Role \"owner\" is not valid",
+		},
+		{
+			name: "Should return error when Role is system",
+			props: func() domain_entity.PrincipalProps {
+				props := validProps()
+				props.Role = constants.RoleSystem
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Role \"system\" is not valid",
+		},
+		{
+			name: "Should return error when an api key principal has no key",
+			props: func() domain_entity.PrincipalProps {
+				props := validProps()
+				props.Method = constants.AuthMethodApiKey
+				return props
+			},
+			expectError: true,
+			expectedMsg: "ApiKeyPublicID cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := domain_entity.NewPrincipal(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if principal.Subject != "maria" {
+				t.Errorf("Expected trimmed subject, got %q", principal.Subject)
+			}
+		})
+	}
+}
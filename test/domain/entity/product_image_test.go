@@ -0,0 +1,246 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	. "project/internal/domain/types"
+	"strings"
+	"testing"
+)
+
+func TestNewProductImage(t *testing.T) {
+	validProps := func() domain_entity.ProductImageProps {
+		return domain_entity.ProductImageProps{
+			ProductID:   1,
+			AltText:     "Front view",
+			ContentType: constants.ProductImageContentTypeJPEG,
+			Size:        1024,
+			Width:       800,
+			Height:      600,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.ProductImageProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create a valid image with a generated public ID",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should return error when ProductID is zero",
+			props: func() domain_entity.ProductImageProps {
+				props := validProps()
+				props.ProductID = 0
+				return props
+			},
+			expectError: true,
+			expectedMsg: "ProductID field must be greater than 0",
+		},
+		{
+			name: "Should return error when the content type is not supported",
+			props: func() domain_entity.ProductImageProps {
+				props := validProps()
+				props.ContentType = "image/webp"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "is not supported",
+		},
+		{
+			name: "Should return error when the file is too large",
+			props: func() domain_entity.ProductImageProps {
+				props := validProps()
+				props.Size = constants.ProductImageMaxSize + 1
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Size must be between",
+		},
+		{
+			name: "Should return error when the dimensions are missing",
+			props: func() domain_entity.ProductImageProps {
+				props := validProps()
+				props.Height = 0
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Width and Height must be greater than 0",
+		},
+		{
+			name: "Should return error when AltText is too long",
+			props: func() domain_entity.ProductImageProps {
+				props := validProps()
+				props.AltText = strings.Repeat("a", 256)
+				return props
+			},
+			expectError: true,
+			expectedMsg: "AltText cannot be longer than 255 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			image, err := domain_entity.NewProductImage(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(image.PublicID) != 8 {
+				t.Errorf("Expected a generated public ID, got %q", image.PublicID)
+			}
+		})
+	}
+}
+
+func TestProductImage_SetStorageKeys(t *testing.T) {
+	image, _ := domain_entity.NewProductImage(domain_entity.ProductImageProps{
+		PublicID:    "9f8e7d6c",
+		ProductID:   1,
+		ContentType: constants.ProductImageContentTypeGIF,
+		Size:        10,
+		Width:       10,
+		Height:      10,
+	})
+
+	image.SetStorageKeys("1a2b3c4d", constants.ProductImageContentTypePNG)
+
+	if image.StorageKey != "products/1a2b3c4d/9f8e7d6c.gif" {
+		t.Errorf("Unexpected storage key %q", image.StorageKey)
+	}
+	if image.ThumbnailKey != "products/1a2b3c4d/9f8e7d6c_thumb.png" {
+		t.Errorf("Unexpected thumbnail key %q", image.ThumbnailKey)
+	}
+}
+
+func TestProduct_Gallery(t *testing.T) {
+	newImage := func(publicID ProductImagePublicID) *domain_entity.ProductImage {
+		return &domain_entity.ProductImage{PublicID: publicID, ProductID: 1}
+	}
+
+	positions := func(product *domain_entity.Product) string {
+		ids := make([]string, 0, len(product.Images))
+
+		for i, image := range product.Images {
+			if image.Position != i {
+				t.Fatalf("Expected image %s at position %d, got %d", image.PublicID, i, image.Position)
+			}
+
+			ids = append(ids, string(image.PublicID))
+		}
+
+		return strings.Join(ids, ",")
+	}
+
+	tests := []struct {
+		name        string
+		run         func(product *domain_entity.Product) error
+		expected    string
+		expectedMsg string
+	}{
+		{
+			name: "Should append images in order",
+			run: func(product *domain_entity.Product) error {
+				return product.AddImage(newImage("d"))
+			},
+			expected: "a,b,c,d",
+		},
+		{
+			name: "Should move an image to the front shifting the others",
+			run: func(product *domain_entity.Product) error {
+				_, err := product.MoveImage("c", 0)
+				return err
+			},
+			expected: "c,a,b",
+		},
+		{
+			name: "Should move an image past the end to the end",
+			run: func(product *domain_entity.Product) error {
+				_, err := product.MoveImage("a", 10)
+				return err
+			},
+			expected: "b,c,a",
+		},
+		{
+			name: "Should close the gap of a removed image",
+			run: func(product *domain_entity.Product) error {
+				_, err := product.RemoveImage("b")
+				return err
+			},
+			expected: "a,c",
+		},
+		{
+			name: "Should return error for an unknown image",
+			run: func(product *domain_entity.Product) error {
+				_, err := product.MoveImage("z", 0)
+				return err
+			},
+			expectedMsg: "not found in the product gallery",
+		},
+		{
+			name: "Should return error for a negative position",
+			run: func(product *domain_entity.Product) error {
+				_, err := product.MoveImage("a", -1)
+				return err
+			},
+			expectedMsg: "Position cannot be negative",
+		},
+		{
+			name: "Should return error for an image of another product",
+			run: func(product *domain_entity.Product) error {
+				return product.AddImage(&domain_entity.ProductImage{PublicID: "x", ProductID: 2})
+			},
+			expectedMsg: "does not belong to the product",
+		},
+		{
+			name: "Should return error when the gallery is full",
+			run: func(product *domain_entity.Product) error {
+				for i := len(product.Images); i < constants.ProductImageMaxPerProduct; i++ {
+					product.AddImage(newImage(ProductImagePublicID(rune('e' + i))))
+				}
+
+				return product.AddImage(newImage("z"))
+			},
+			expectedMsg: "at most",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := &domain_entity.Product{ID: 1}
+
+			for _, publicID := range []ProductImagePublicID{"a", "b", "c"} {
+				if err := product.AddImage(newImage(publicID)); err != nil {
+					t.Fatalf("Expected no error adding image, got %v", err)
+				}
+			}
+
+			err := tt.run(product)
+
+			if tt.expectedMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got := positions(product); got != tt.expected {
+				t.Errorf("Expected gallery %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	. "project/internal/domain/types"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewProductOffer(t *testing.T) {
+	validProps := func() domain_entity.ProductOfferProps {
+		return domain_entity.ProductOfferProps{
+			ProductID:  1,
+			RetailerID: 1,
+			Price:      19990,
+			URL:        " https://loja-a.com.br/p/1 ",
+			LastSeenAt: time.Now(),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.ProductOfferProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create an offer in stock by default",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should return error when RetailerID is zero",
+			props: func() domain_entity.ProductOfferProps {
+				props := validProps()
+				props.RetailerID = 0
+				return props
+			},
+			expectError: true,
+			expectedMsg: "RetailerID field must be greater than 0",
+		},
+		{
+			name: "Should return error when Price is zero",
+			props: func() domain_entity.ProductOfferProps {
+				props := validProps()
+				props.Price = 0
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Price must be greater than 0",
+		},
+		{
+			name: "Should return error when URL is not http",
+			props: func() domain_entity.ProductOfferProps {
+				props := validProps()
+				props.URL = "loja-a.com.br/p/1"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "URL must be an http or https URL",
+		},
+		{
+			name: "Should return error when Availability is unknown",
+			props: func() domain_entity.ProductOfferProps {
+				props := validProps()
+				props.Availability = "preorder"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "is not valid",
+		},
+		{
+			name: "Should return error when LastSeenAt is empty",
+			props: func() domain_entity.ProductOfferProps {
+				props := validProps()
+				props.LastSeenAt = time.Time{}
+				return props
+			},
+			expectError: true,
+			expectedMsg: "LastSeenAt cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offer, err := domain_entity.NewProductOffer(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if offer.Availability != constants.ProductOfferAvailabilityInStock {
+				t.Errorf("Expected availability in_stock, got %q", offer.Availability)
+			}
+			if offer.URL != "https://loja-a.com.br/p/1" {
+				t.Errorf("Expected trimmed URL, got %q", offer.URL)
+			}
+		})
+	}
+}
+
+func TestProductOffer_Update(t *testing.T) {
+	seenAt := time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		props       domain_entity.UpdateProductOfferProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name: "Should store a newer sighting",
+			props: domain_entity.UpdateProductOfferProps{
+				Price:      17990,
+				URL:        "https://loja-a.com.br/p/1",
+				LastSeenAt: seenAt.Add(time.Hour),
+			},
+		},
+		{
+			name: "Should accept the same sighting again",
+			props: domain_entity.UpdateProductOfferProps{
+				Price:      17990,
+				URL:        "https://loja-a.com.br/p/1",
+				LastSeenAt: seenAt,
+			},
+		},
+		{
+			name: "Should return error when LastSeenAt goes back in time",
+			props: domain_entity.UpdateProductOfferProps{
+				Price:      17990,
+				URL:        "https://loja-a.com.br/p/1",
+				LastSeenAt: seenAt.Add(-time.Hour),
+			},
+			expectError: true,
+			expectedMsg: "LastSeenAt cannot be before the last sighting of the offer",
+		},
+		{
+			name: "Should return error when Price is negative",
+			props: domain_entity.UpdateProductOfferProps{
+				Price:      -1,
+				URL:        "https://loja-a.com.br/p/1",
+				LastSeenAt: seenAt,
+			},
+			expectError: true,
+			expectedMsg: "Price must be greater than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offer, err := domain_entity.NewProductOffer(domain_entity.ProductOfferProps{
+				ProductID:  1,
+				RetailerID: 1,
+				Price:      19990,
+				URL:        "https://loja-a.com.br/p/1",
+				LastSeenAt: seenAt,
+			})
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			err = offer.Update(tt.props)
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if offer.Price != tt.props.Price || !offer.LastSeenAt.Equal(tt.props.LastSeenAt) {
+				t.Errorf("Expected price %d seen at %v, got %d seen at %v", tt.props.Price, tt.props.LastSeenAt, offer.Price, offer.LastSeenAt)
+			}
+		})
+	}
+}
+
+func TestProductOffer_IsCurrent(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		offer    *domain_entity.ProductOffer
+		expected bool
+	}{
+		{
+			name:     "Should be current when in stock and seen recently",
+			offer:    &domain_entity.ProductOffer{Availability: constants.ProductOfferAvailabilityInStock, LastSeenAt: now.Add(-time.Hour)},
+			expected: true,
+		},
+		{
+			name:     "Should be current when seen exactly at the max age",
+			offer:    &domain_entity.ProductOffer{Availability: constants.ProductOfferAvailabilityInStock, LastSeenAt: now.Add(-constants.ProductOfferMaxAge)},
+			expected: true,
+		},
+		{
+			name:     "Should not be current when stale",
+			offer:    &domain_entity.ProductOffer{Availability: constants.ProductOfferAvailabilityInStock, LastSeenAt: now.Add(-constants.ProductOfferMaxAge - time.Second)},
+			expected: false,
+		},
+		{
+			name:     "Should not be current when out of stock",
+			offer:    &domain_entity.ProductOffer{Availability: constants.ProductOfferAvailabilityOutOfStock, LastSeenAt: now},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.offer.IsCurrent(now); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestProduct_BestOffer(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	offer := func(id int64, price int64, availability ProductOfferAvailability, seenAt time.Time) *domain_entity.ProductOffer {
+		return &domain_entity.ProductOffer{ID: id, Price: price, Availability: availability, LastSeenAt: seenAt}
+	}
+
+	tests := []struct {
+		name           string
+		offers         []*domain_entity.ProductOffer
+		expectedBestID int64
+		expectedSpread int64
+		expectedPrice  int64
+	}{
+		{
+			name:          "Should fall back to the catalog price without offers",
+			offers:        nil,
+			expectedPrice: 10000,
+		},
+		{
+			name: "Should pick the cheapest current offer and the spread between stores",
+			offers: []*domain_entity.ProductOffer{
+				offer(1, 9500, constants.ProductOfferAvailabilityInStock, now),
+				offer(2, 8900, constants.ProductOfferAvailabilityInStock, now.Add(-time.Hour)),
+				offer(3, 9900, constants.ProductOfferAvailabilityInStock, now),
+			},
+			expectedBestID: 2,
+			expectedSpread: 1000,
+			expectedPrice:  8900,
+		},
+		{
+			name: "Should ignore offers out of stock or stale",
+			offers: []*domain_entity.ProductOffer{
+				offer(1, 9500, constants.ProductOfferAvailabilityInStock, now),
+				offer(2, 7000, constants.ProductOfferAvailabilityOutOfStock, now),
+				offer(3, 6000, constants.ProductOfferAvailabilityInStock, now.Add(-8*24*time.Hour)),
+			},
+			expectedBestID: 1,
+			expectedSpread: 0,
+			expectedPrice:  9500,
+		},
+		{
+			name: "Should fall back to the catalog price when no offer is current",
+			offers: []*domain_entity.ProductOffer{
+				offer(1, 7000, constants.ProductOfferAvailabilityOutOfStock, now),
+			},
+			expectedPrice: 10000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := &domain_entity.Product{ID: 1, Price: 10000, Offers: tt.offers}
+
+			best := product.BestOffer(now)
+
+			if tt.expectedBestID == 0 {
+				if best != nil {
+					t.Errorf("Expected no best offer, got %+v", best)
+				}
+			} else if best == nil || best.ID != tt.expectedBestID {
+				t.Errorf("Expected best offer %d, got %+v", tt.expectedBestID, best)
+			}
+
+			if spread := product.OfferSpread(now); spread != tt.expectedSpread {
+				t.Errorf("Expected spread %d, got %d", tt.expectedSpread, spread)
+			}
+
+			if price := product.EffectivePrice(now); price != tt.expectedPrice {
+				t.Errorf("Expected effective price %d, got %d", tt.expectedPrice, price)
+			}
+		})
+	}
+}
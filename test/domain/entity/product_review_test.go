@@ -0,0 +1,251 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	. "project/internal/domain/types"
+	"strings"
+	"testing"
+)
+
+func TestNewProductReview(t *testing.T) {
+	validProps := func() domain_entity.ProductReviewProps {
+		return domain_entity.ProductReviewProps{
+			ProductID: 1,
+			Author:    " Ana ",
+			Stars:     4,
+			Text:      "Quiet and efficient",
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.ProductReviewProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create a pending review with a generated public ID",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should return error when ProductID is zero",
+			props: func() domain_entity.ProductReviewProps {
+				props := validProps()
+				props.ProductID = 0
+				return props
+			},
+			expectError: true,
+			expectedMsg: "ProductID field must be greater than 0",
+		},
+		{
+			name: "Should return error when Author is blank",
+			props: func() domain_entity.ProductReviewProps {
+				props := validProps()
+				props.Author = "   "
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Author cannot be empty",
+		},
+		{
+			name: "Should return error when Stars is out of range",
+			props: func() domain_entity.ProductReviewProps {
+				props := validProps()
+				props.Stars = 6
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Stars must be between 1 and 5",
+		},
+		{
+			name: "Should return error when Text is too long",
+			props: func() domain_entity.ProductReviewProps {
+				props := validProps()
+				props.Text = strings.Repeat("a", 5001)
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Text cannot be longer than 5000 characters",
+		},
+		{
+			name: "Should return error when Status is unknown",
+			props: func() domain_entity.ProductReviewProps {
+				props := validProps()
+				props.Status = "hidden"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "is not valid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review, err := domain_entity.NewProductReview(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(review.PublicID) != 8 {
+				t.Errorf("Expected a generated public ID, got %q", review.PublicID)
+			}
+			if review.Author != "Ana" {
+				t.Errorf("Expected trimmed author, got %q", review.Author)
+			}
+			if review.Status != constants.ProductReviewStatusPending {
+				t.Errorf("Expected pending status, got %q", review.Status)
+			}
+		})
+	}
+}
+
+func TestProductReview_Moderate(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		expectError bool
+	}{
+		{name: "Should approve a review", status: "approved"},
+		{name: "Should reject a review", status: "rejected"},
+		{name: "Should not move a review back to pending", status: "pending", expectError: true},
+		{name: "Should not accept an unknown status", status: "hidden", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review, _ := domain_entity.NewProductReview(domain_entity.ProductReviewProps{
+				ProductID: 1,
+				Author:    "Ana",
+				Stars:     5,
+			})
+
+			err := review.Moderate(ProductReviewStatus(tt.status))
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error, got nil")
+				}
+				if review.Status != constants.ProductReviewStatusPending {
+					t.Errorf("Expected status to stay pending, got %q", review.Status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if string(review.Status) != tt.status {
+				t.Errorf("Expected status %q, got %q", tt.status, review.Status)
+			}
+		})
+	}
+}
+
+func TestProductReview_VoteHelpful(t *testing.T) {
+	review, _ := domain_entity.NewProductReview(domain_entity.ProductReviewProps{
+		ProductID: 1,
+		Author:    "Ana",
+		Stars:     5,
+	})
+
+	if err := review.VoteHelpful(); err == nil {
+		t.Error("Expected error when voting a pending review, got nil")
+	}
+
+	review.Moderate(constants.ProductReviewStatusApproved)
+
+	if err := review.VoteHelpful(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if review.HelpfulVotes != 1 {
+		t.Errorf("Expected 1 helpful vote, got %d", review.HelpfulVotes)
+	}
+}
+
+func TestNewProductRatingSummary(t *testing.T) {
+	tests := []struct {
+		name           string
+		distribution   map[int8]int64
+		expectError    bool
+		expectedRating int8
+		expectedCount  int64
+	}{
+		{
+			name:           "Should have rating 0 without reviews",
+			distribution:   map[int8]int64{},
+			expectedRating: 0,
+			expectedCount:  0,
+		},
+		{
+			name:           "Should average the stars on the product scale",
+			distribution:   map[int8]int64{5: 1, 4: 1},
+			expectedRating: 45,
+			expectedCount:  2,
+		},
+		{
+			name:           "Should round the average half up",
+			distribution:   map[int8]int64{5: 2, 4: 1, 1: 1},
+			expectedRating: 38, // 3.75 stars
+			expectedCount:  4,
+		},
+		{
+			name:         "Should return error for stars out of range",
+			distribution: map[int8]int64{6: 1},
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary, err := domain_entity.NewProductRatingSummary(1, tt.distribution)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if summary.Rating != tt.expectedRating {
+				t.Errorf("Expected rating %d, got %d", tt.expectedRating, summary.Rating)
+			}
+			if summary.Count != tt.expectedCount {
+				t.Errorf("Expected count %d, got %d", tt.expectedCount, summary.Count)
+			}
+			if len(summary.Distribution) != 5 {
+				t.Errorf("Expected the 5 stars in the distribution, got %d", len(summary.Distribution))
+			}
+		})
+	}
+}
+
+func TestProduct_ApplyRatingSummary(t *testing.T) {
+	product := &domain_entity.Product{ID: 1, PublicID: "12345678", CategoryID: 1, Name: "Name", Price: 100, Rating: 10}
+
+	summary, _ := domain_entity.NewProductRatingSummary(1, map[int8]int64{5: 3})
+
+	if err := product.ApplyRatingSummary(summary); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if product.Rating != 50 || product.ReviewCount != 3 {
+		t.Errorf("Expected rating 50 from 3 reviews, got %d from %d", product.Rating, product.ReviewCount)
+	}
+
+	other, _ := domain_entity.NewProductRatingSummary(2, map[int8]int64{1: 1})
+
+	if err := product.ApplyRatingSummary(other); err == nil {
+		t.Error("Expected error when applying the summary of another product, got nil")
+	}
+}
@@ -376,3 +376,376 @@ func TestProductSpecificationValue_Compare_TypeMismatch(t *testing.T) {
 		t.Errorf("Expected error %q, got %q", expected, err.Error())
 	}
 }
+
+func TestProductSpecificationValue_ConvertTo(t *testing.T) {
+	tests := []struct {
+		name        string
+		sourceType  SpecificationType
+		value       *domain_entity.SpecValue
+		target      SpecificationType
+		expected    *domain_entity.SpecValue
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:       "Should convert numeric string to int",
+			sourceType: "string",
+			value:      &domain_entity.SpecValue{StringValue: strPtr(" 120 ")},
+			target:     "int",
+			expected:   &domain_entity.SpecValue{IntValue: intPtr(120)},
+		},
+		{
+			name:       "Should convert string to bool",
+			sourceType: "string",
+			value:      &domain_entity.SpecValue{StringValue: strPtr("true")},
+			target:     "bool",
+			expected:   &domain_entity.SpecValue{BoolValue: boolPtr(true)},
+		},
+		{
+			name:       "Should convert int to string",
+			sourceType: "int",
+			value:      &domain_entity.SpecValue{IntValue: intPtr(65)},
+			target:     "string",
+			expected:   &domain_entity.SpecValue{StringValue: strPtr("65")},
+		},
+		{
+			name:       "Should convert zero int to false",
+			sourceType: "int",
+			value:      &domain_entity.SpecValue{IntValue: intPtr(0)},
+			target:     "bool",
+			expected:   &domain_entity.SpecValue{BoolValue: boolPtr(false)},
+		},
+		{
+			name:       "Should convert bool to int",
+			sourceType: "bool",
+			value:      &domain_entity.SpecValue{BoolValue: boolPtr(true)},
+			target:     "int",
+			expected:   &domain_entity.SpecValue{IntValue: intPtr(1)},
+		},
+		{
+			name:        "Should return error when string is not numeric",
+			sourceType:  "string",
+			value:       &domain_entity.SpecValue{StringValue: strPtr("abc")},
+			target:      "int",
+			expectError: true,
+			expectedMsg: `cannot convert string value "abc" to int`,
+		},
+		{
+			name:        "Should return error when target type is unknown",
+			sourceType:  "int",
+			value:       &domain_entity.SpecValue{IntValue: intPtr(1)},
+			target:      "float",
+			expectError: true,
+			expectedMsg: "invalid target type float",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := domain_entity.NewProductSpecificationValue(domain_entity.ProductSpecificationValueProps{
+				ID:              1,
+				ProductID:       10,
+				SpecificationID: constants.NoiseDb,
+				Type:            tt.sourceType,
+				Value:           tt.value,
+			})
+
+			if err != nil {
+				t.Fatalf("Expected no error creating value, but got: %v", err)
+			}
+
+			err = spec.ConvertTo(tt.target)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got nil", tt.expectedMsg)
+					return
+				}
+				if !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error message to contain %q, but got %q", tt.expectedMsg, err.Error())
+				}
+				if spec.Type != tt.sourceType {
+					t.Errorf("Expected Type to stay %s, got %s", tt.sourceType, spec.Type)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if spec.Type != tt.target {
+				t.Errorf("Expected Type %s, got %s", tt.target, spec.Type)
+			}
+			if tt.expected.StringValue != nil && (spec.Value.StringValue == nil || *spec.Value.StringValue != *tt.expected.StringValue) {
+				t.Errorf("Expected string value %q, got %v", *tt.expected.StringValue, spec.Value.StringValue)
+			}
+			if tt.expected.IntValue != nil && (spec.Value.IntValue == nil || *spec.Value.IntValue != *tt.expected.IntValue) {
+				t.Errorf("Expected int value %d, got %v", *tt.expected.IntValue, spec.Value.IntValue)
+			}
+			if tt.expected.BoolValue != nil && (spec.Value.BoolValue == nil || *spec.Value.BoolValue != *tt.expected.BoolValue) {
+				t.Errorf("Expected bool value %t, got %v", *tt.expected.BoolValue, spec.Value.BoolValue)
+			}
+		})
+	}
+}
+
+func TestNewSpecValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		specType    SpecificationType
+		expectError bool
+		expectedMsg string
+	}{
+		{name: "Should pick the string value", specType: "string"},
+		{name: "Should pick the int value", specType: "int"},
+		{name: "Should pick the bool value", specType: "bool"},
+		{name: "Should return error when type is unknown", specType: "float", expectError: true, expectedMsg: "invalid specification type float"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := domain_entity.NewSpecValue(tt.specType, "inox", 42, true)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got nil", tt.expectedMsg)
+					return
+				}
+				if !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error message to contain %q, but got %q", tt.expectedMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+
+			switch tt.specType {
+			case "string":
+				if value.StringValue == nil || *value.StringValue != "inox" || value.IntValue != nil || value.BoolValue != nil {
+					t.Errorf("Expected only string value, got %+v", value)
+				}
+			case "int":
+				if value.IntValue == nil || *value.IntValue != 42 || value.StringValue != nil || value.BoolValue != nil {
+					t.Errorf("Expected only int value, got %+v", value)
+				}
+			case "bool":
+				if value.BoolValue == nil || !*value.BoolValue || value.StringValue != nil || value.IntValue != nil {
+					t.Errorf("Expected only bool value, got %+v", value)
+				}
+			}
+		})
+	}
+}
+
+func TestProductSpecificationValue_UpdateValue(t *testing.T) {
+	newSpec := func() *domain_entity.ProductSpecificationValue {
+		spec, err := domain_entity.NewProductSpecificationValue(domain_entity.ProductSpecificationValueProps{
+			ID:              1,
+			ProductID:       10,
+			SpecificationID: constants.PowerInWatts,
+			Type:            "int",
+			Value:           &domain_entity.SpecValue{IntValue: intPtr(100)},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error creating value, but got: %v", err)
+		}
+
+		return spec
+	}
+
+	t.Run("Should replace the value", func(t *testing.T) {
+		spec := newSpec()
+
+		err := spec.UpdateValue(&domain_entity.SpecValue{IntValue: intPtr(250)})
+
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if *spec.Value.IntValue != 250 {
+			t.Errorf("Expected int value 250, got %d", *spec.Value.IntValue)
+		}
+	})
+
+	t.Run("Should keep previous value when new value is empty", func(t *testing.T) {
+		spec := newSpec()
+
+		err := spec.UpdateValue(&domain_entity.SpecValue{})
+
+		if err == nil {
+			t.Fatal("Expected error, but got nil")
+		}
+		if !strings.Contains(err.Error(), "at least one value") {
+			t.Errorf("Expected error message to contain %q, but got %q", "at least one value", err.Error())
+		}
+		if spec.Value.IntValue == nil || *spec.Value.IntValue != 100 {
+			t.Errorf("Expected previous int value 100 to be kept, got %+v", spec.Value)
+		}
+	})
+
+	t.Run("Should return error when value is nil", func(t *testing.T) {
+		spec := newSpec()
+
+		err := spec.UpdateValue(nil)
+
+		if err == nil {
+			t.Fatal("Expected error, but got nil")
+		}
+		if !strings.Contains(err.Error(), "Value cannot be nil") {
+			t.Errorf("Expected error message to contain %q, but got %q", "Value cannot be nil", err.Error())
+		}
+	})
+}
+
+func TestParseSpecValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		specType    SpecificationType
+		raw         any
+		expectError bool
+		expectedMsg string
+	}{
+		{name: "Should parse string value", specType: "string", raw: "Inox"},
+		{name: "Should parse integral float as int", specType: "int", raw: float64(65)},
+		{name: "Should parse bool value", specType: "bool", raw: false},
+		{name: "Should reject number for string spec", specType: "string", raw: float64(65), expectError: true, expectedMsg: "expected a string value, got float64"},
+		{name: "Should reject fractional number for int spec", specType: "int", raw: 6.5, expectError: true, expectedMsg: "expected an integer value, got 6.5"},
+		{name: "Should reject string for int spec", specType: "int", raw: "65", expectError: true, expectedMsg: "expected an integer value, got string"},
+		{name: "Should reject string for bool spec", specType: "bool", raw: "true", expectError: true, expectedMsg: "expected a boolean value, got string"},
+		{name: "Should reject unknown type", specType: "float", raw: 1.5, expectError: true, expectedMsg: "invalid specification type float"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := domain_entity.ParseSpecValue(tt.specType, tt.raw)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got nil", tt.expectedMsg)
+					return
+				}
+				if !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error message to contain %q, but got %q", tt.expectedMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+
+			switch tt.specType {
+			case "string":
+				if value.StringValue == nil || *value.StringValue != tt.raw.(string) {
+					t.Errorf("Expected string value %v, got %+v", tt.raw, value)
+				}
+			case "int":
+				if value.IntValue == nil || *value.IntValue != int64(tt.raw.(float64)) {
+					t.Errorf("Expected int value %v, got %+v", tt.raw, value)
+				}
+			case "bool":
+				if value.BoolValue == nil || *value.BoolValue != tt.raw.(bool) {
+					t.Errorf("Expected bool value %v, got %+v", tt.raw, value)
+				}
+			}
+		})
+	}
+}
+
+func TestParseSpecValueText(t *testing.T) {
+	tests := []struct {
+		name        string
+		specType    SpecificationType
+		raw         string
+		expected    any
+		expectError bool
+		expectedMsg string
+	}{
+		{name: "Should keep text for string spec", specType: "string", raw: " Inox ", expected: " Inox "},
+		{name: "Should parse int from text", specType: "int", raw: " 65 ", expected: int64(65)},
+		{name: "Should parse bool from text", specType: "bool", raw: "true", expected: true},
+		{name: "Should reject fractional text for int spec", specType: "int", raw: "6.5", expectError: true, expectedMsg: `expected an integer value, got "6.5"`},
+		{name: "Should reject unknown text for bool spec", specType: "bool", raw: "maybe", expectError: true, expectedMsg: `expected a boolean value, got "maybe"`},
+		{name: "Should reject unknown type", specType: "float", raw: "1.5", expectError: true, expectedMsg: "invalid specification type float"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := domain_entity.ParseSpecValueText(tt.specType, tt.raw)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got nil", tt.expectedMsg)
+					return
+				}
+				if !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error message to contain %q, but got %q", tt.expectedMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+
+			switch tt.specType {
+			case "string":
+				if value.StringValue == nil || *value.StringValue != tt.expected.(string) {
+					t.Errorf("Expected string value %v, got %+v", tt.expected, value)
+				}
+			case "int":
+				if value.IntValue == nil || *value.IntValue != tt.expected.(int64) {
+					t.Errorf("Expected int value %v, got %+v", tt.expected, value)
+				}
+			case "bool":
+				if value.BoolValue == nil || *value.BoolValue != tt.expected.(bool) {
+					t.Errorf("Expected bool value %v, got %+v", tt.expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestProductSpecificationValue_SharesValueWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		left     domain_entity.ProductSpecificationValue
+		right    domain_entity.ProductSpecificationValue
+		expected bool
+	}{
+		{
+			name:     "Should share when one side inherits the other's value",
+			left:     domain_entity.ProductSpecificationValue{ID: 10, ProductID: 2, Inherited: true},
+			right:    domain_entity.ProductSpecificationValue{ID: 10, ProductID: 3, Inherited: true},
+			expected: true,
+		},
+		{
+			name:     "Should not share values with different IDs",
+			left:     domain_entity.ProductSpecificationValue{ID: 10, ProductID: 2, Inherited: true},
+			right:    domain_entity.ProductSpecificationValue{ID: 11, ProductID: 3},
+			expected: false,
+		},
+		{
+			name:     "Should not share when no side is inherited",
+			left:     domain_entity.ProductSpecificationValue{ID: 10, ProductID: 2},
+			right:    domain_entity.ProductSpecificationValue{ID: 10, ProductID: 3},
+			expected: false,
+		},
+		{
+			name:     "Should not share unsaved values",
+			left:     domain_entity.ProductSpecificationValue{ProductID: 2, Inherited: true},
+			right:    domain_entity.ProductSpecificationValue{ProductID: 3, Inherited: true},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.left.SharesValueWith(&tt.right); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	. "project/internal/domain/types"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewProduct(t *testing.T) {
@@ -137,6 +138,68 @@ func TestNewProduct(t *testing.T) {
 			expectError: true,
 			expectedMsg: "Rating must be between 0 and 50",
 		},
+		{
+			name: "Should return error when Version is negative",
+			props: domain_entity.ProductProps{
+				ID:         1,
+				PublicID:   "12345678",
+				CategoryID: 1,
+				Name:       "Versioned Product",
+				Version:    -1,
+			},
+			expectError: true,
+			expectedMsg: "Version cannot be negative",
+		},
+		{
+			name: "Should return error when a variant has no label",
+			props: domain_entity.ProductProps{
+				ID:         2,
+				PublicID:   "12345678",
+				CategoryID: 1,
+				Name:       "Variant",
+				ParentID:   1,
+			},
+			expectError: true,
+			expectedMsg: "VariantLabel cannot be empty for a variant",
+		},
+		{
+			name: "Should return error when a product is a variant of itself",
+			props: domain_entity.ProductProps{
+				ID:           1,
+				PublicID:     "12345678",
+				CategoryID:   1,
+				Name:         "Variant",
+				ParentID:     1,
+				VariantLabel: "128GB",
+			},
+			expectError: true,
+			expectedMsg: "A product cannot be a variant of itself",
+		},
+		{
+			name: "Should return error when a product that is not a variant has a label",
+			props: domain_entity.ProductProps{
+				ID:           1,
+				PublicID:     "12345678",
+				CategoryID:   1,
+				Name:         "Family",
+				VariantLabel: "128GB",
+			},
+			expectError: true,
+			expectedMsg: "VariantLabel is only allowed on variants",
+		},
+		{
+			name: "Should return error when VariantLabel is too long",
+			props: domain_entity.ProductProps{
+				ID:           2,
+				PublicID:     "12345678",
+				CategoryID:   1,
+				Name:         "Variant",
+				ParentID:     1,
+				VariantLabel: strings.Repeat("c", 101),
+			},
+			expectError: true,
+			expectedMsg: "VariantLabel cannot be longer than 100 characters",
+		},
 	}
 
 	for _, tt := range tests {
@@ -222,6 +285,236 @@ func TestProduct_Update(t *testing.T) {
 	}
 }
 
+func TestProduct_UpdateWithReviews(t *testing.T) {
+	product, _ := domain_entity.NewProduct(domain_entity.ProductProps{
+		ID:          1,
+		PublicID:    "12345678",
+		CategoryID:  10,
+		Name:        "Name",
+		Price:       100,
+		Rating:      45,
+		ReviewCount: 2,
+	})
+
+	err := product.Update(domain_entity.UpdateProductProps{
+		CategoryID: 10,
+		Name:       "Name",
+		Price:      100,
+		Rating:     10,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error on update, got %v", err)
+	}
+	if product.Rating != 45 {
+		t.Errorf("Expected the rating from reviews to be kept, got %d", product.Rating)
+	}
+}
+
+func TestProduct_CheckVersion(t *testing.T) {
+	product, _ := domain_entity.NewProduct(domain_entity.ProductProps{
+		ID:         1,
+		PublicID:   "12345678",
+		CategoryID: 10,
+		Name:       "Name",
+		Version:    3,
+	})
+
+	if err := product.CheckVersion(3); err != nil {
+		t.Errorf("Expected no error for the current version, got %v", err)
+	}
+
+	err := product.CheckVersion(2)
+	if err == nil || !strings.Contains(err.Error(), "product is at version 3, not 2") {
+		t.Errorf("Expected error containing %q, got %v", "product is at version 3, not 2", err)
+	}
+}
+
+func TestProduct_NewVariant(t *testing.T) {
+	newFamily := func() *domain_entity.Product {
+		family, _ := domain_entity.NewProduct(domain_entity.ProductProps{
+			ID:          1,
+			PublicID:    "11111111",
+			CategoryID:  10,
+			Name:        "Smartphone X",
+			Description: "Family description",
+			Price:       300000,
+		})
+
+		return family
+	}
+
+	tests := []struct {
+		name        string
+		family      func() *domain_entity.Product
+		props       domain_entity.ProductVariantProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:   "Should create a variant with the family name, description and category",
+			family: newFamily,
+			props: domain_entity.ProductVariantProps{
+				PublicID:     "22222222",
+				VariantLabel: "256GB",
+				Price:        350000,
+			},
+			expectError: false,
+		},
+		{
+			name: "Should return error when the family was not persisted",
+			family: func() *domain_entity.Product {
+				family := newFamily()
+				family.ID = 0
+
+				return family
+			},
+			props:       domain_entity.ProductVariantProps{PublicID: "22222222", VariantLabel: "256GB"},
+			expectError: true,
+			expectedMsg: "not persisted",
+		},
+		{
+			name: "Should return error when the family is a variant",
+			family: func() *domain_entity.Product {
+				family := newFamily()
+				family.ParentID = 5
+				family.VariantLabel = "128GB"
+
+				return family
+			},
+			props:       domain_entity.ProductVariantProps{PublicID: "22222222", VariantLabel: "256GB"},
+			expectError: true,
+			expectedMsg: "variant of a variant",
+		},
+		{
+			name: "Should return error when the label is already used, ignoring case",
+			family: func() *domain_entity.Product {
+				family := newFamily()
+				family.Variants = []*domain_entity.Product{{ID: 2, ParentID: 1, VariantLabel: "256GB"}}
+
+				return family
+			},
+			props:       domain_entity.ProductVariantProps{PublicID: "22222222", VariantLabel: "256gb"},
+			expectError: true,
+			expectedMsg: "already exists",
+		},
+		{
+			name:        "Should return error when the label is empty",
+			family:      newFamily,
+			props:       domain_entity.ProductVariantProps{PublicID: "22222222"},
+			expectError: true,
+			expectedMsg: "VariantLabel cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			family := tt.family()
+
+			variant, err := family.NewVariant(tt.props)
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !variant.IsVariant() || variant.ParentID != family.ID || variant.ParentPublicID != family.PublicID {
+				t.Errorf("Expected a variant of the family, got %+v", variant)
+			}
+			if variant.CategoryID != family.CategoryID || variant.Name != family.Name || variant.Description != family.Description {
+				t.Errorf("Expected category, name and description of the family, got %+v", variant)
+			}
+			if !family.HasVariants() || family.Variants[len(family.Variants)-1] != variant {
+				t.Error("Expected the variant to be added to the family")
+			}
+		})
+	}
+}
+
+func TestProduct_InheritSpecificationValues(t *testing.T) {
+	familyValue := int64(8)
+	overrideValue := int64(16)
+
+	family := &domain_entity.Product{
+		ID: 1,
+		SpecificationValues: []*domain_entity.ProductSpecificationValue{
+			{ID: 10, ProductID: 1, SpecificationID: constants.Threads, Type: "int", Value: &domain_entity.SpecValue{IntValue: &familyValue}},
+			{ID: 11, ProductID: 1, SpecificationID: constants.PowerInWatts, Type: "int", Value: &domain_entity.SpecValue{IntValue: &familyValue}},
+		},
+	}
+
+	variant := &domain_entity.Product{
+		ID:           2,
+		ParentID:     1,
+		VariantLabel: "Pro",
+		SpecificationValues: []*domain_entity.ProductSpecificationValue{
+			{ID: 20, ProductID: 2, SpecificationID: constants.Threads, Type: "int", Value: &domain_entity.SpecValue{IntValue: &overrideValue}},
+		},
+	}
+
+	if err := variant.InheritSpecificationValues(family); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(variant.SpecificationValues) != 2 {
+		t.Fatalf("Expected 2 values, got %d", len(variant.SpecificationValues))
+	}
+
+	override, inherited := variant.SpecificationValues[0], variant.SpecificationValues[1]
+
+	if override.Inherited || *override.Value.IntValue != overrideValue {
+		t.Errorf("Expected the variant value to override the family one, got %+v", override)
+	}
+	if !inherited.Inherited || inherited.ID != 11 || inherited.ProductID != variant.ID {
+		t.Errorf("Expected the family value to be inherited, got %+v", inherited)
+	}
+
+	other := &domain_entity.Product{ID: 3, ParentID: 9, VariantLabel: "Max"}
+
+	if err := other.InheritSpecificationValues(family); err == nil {
+		t.Error("Expected error when inheriting from another family, got nil")
+	}
+}
+
+func TestProduct_AddSpecificationValue(t *testing.T) {
+	product, _ := domain_entity.NewProduct(domain_entity.ProductProps{
+		PublicID:   "12345678",
+		CategoryID: 10,
+		Name:       "Unsaved product",
+	})
+
+	threads := &domain_entity.Specification{ID: constants.Threads, Type: "int"}
+
+	err := product.AddSpecificationValue(threads, &domain_entity.SpecValue{IntValue: intPtr(16)})
+
+	if err != nil {
+		t.Fatalf("Expected no error adding a value to an unsaved product, got %v", err)
+	}
+	if len(product.SpecificationValues) != 1 || product.SpecificationValues[0].Type != "int" {
+		t.Fatalf("Expected one int value attached, got %+v", product.SpecificationValues)
+	}
+
+	err = product.AddSpecificationValue(threads, &domain_entity.SpecValue{IntValue: intPtr(32)})
+
+	if err == nil || !strings.Contains(err.Error(), "already has a value") {
+		t.Errorf("Expected duplicate value error, got %v", err)
+	}
+
+	err = product.AddSpecificationValue(&domain_entity.Specification{ID: constants.USBC, Type: "bool"}, &domain_entity.SpecValue{})
+
+	if err == nil || !strings.Contains(err.Error(), "at least one value") {
+		t.Errorf("Expected empty value error, got %v", err)
+	}
+	if len(product.SpecificationValues) != 1 {
+		t.Errorf("Expected invalid values not to be attached, got %d values", len(product.SpecificationValues))
+	}
+}
+
 func TestProduct_Compare(t *testing.T) {
 	valL := int64(100)
 	valR := int64(50)
@@ -335,6 +628,94 @@ func TestProduct_Compare(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Should flag a higher rating based on few reviews as neutral",
+			p1: func() *domain_entity.Product {
+				p := baseProduct(1, 100, 50, 1)
+				p.ReviewCount = 2
+				return p
+			}(),
+			p2: baseProduct(2, 100, 40, 1),
+			validateRes: func(t *testing.T, res *domain_entity.ComparisonProductsResult) {
+				if res.RatingComparisonResult.LeftReviewCount != 2 {
+					t.Errorf("Expected LeftReviewCount 2, got %d", res.RatingComparisonResult.LeftReviewCount)
+				}
+				if len(res.RatingComparisonResult.Insights) != 1 {
+					t.Fatalf("Expected 1 rating insight, got %d", len(res.RatingComparisonResult.Insights))
+				}
+				insight := res.RatingComparisonResult.Insights[0]
+				if insight.Favorable || !insight.Neutral || !strings.Contains(insight.Message, "based on only 2 reviews") {
+					t.Errorf("Expected neutral insight about few reviews, got %+v", insight)
+				}
+			},
+		},
+		{
+			name: "Should keep the lower rating neutral when the other one has few reviews",
+			p1:   baseProduct(1, 100, 30, 1),
+			p2: func() *domain_entity.Product {
+				p := baseProduct(2, 100, 50, 1)
+				p.ReviewCount = 1
+				return p
+			}(),
+			validateRes: func(t *testing.T, res *domain_entity.ComparisonProductsResult) {
+				insight := res.RatingComparisonResult.Insights[0]
+				if insight.Favorable || !insight.Neutral || !strings.Contains(insight.Message, "only 1 review") {
+					t.Errorf("Expected neutral insight about few reviews, got %+v", insight)
+				}
+			},
+		},
+		{
+			name: "Should compare ratings with enough reviews as usual",
+			p1: func() *domain_entity.Product {
+				p := baseProduct(1, 100, 50, 1)
+				p.ReviewCount = constants.ProductReviewMinConfidentCount
+				return p
+			}(),
+			p2: baseProduct(2, 100, 40, 1),
+			validateRes: func(t *testing.T, res *domain_entity.ComparisonProductsResult) {
+				insight := res.RatingComparisonResult.Insights[0]
+				if !insight.Favorable || insight.Message != "has higher rating" {
+					t.Errorf("Expected favorable higher rating insight, got %+v", insight)
+				}
+			},
+		},
+		{
+			name: "Should compare the best current offers instead of the catalog prices",
+			p1: func() *domain_entity.Product {
+				p := baseProduct(1, 5000, 30, 1)
+				p.Offers = []*domain_entity.ProductOffer{
+					{ID: 1, ProductID: 1, RetailerID: 1, Price: 9000, Availability: constants.ProductOfferAvailabilityInStock, LastSeenAt: time.Now()},
+					{ID: 2, ProductID: 1, RetailerID: 2, Price: 4000, Availability: constants.ProductOfferAvailabilityOutOfStock, LastSeenAt: time.Now()},
+				}
+				return p
+			}(),
+			p2: func() *domain_entity.Product {
+				p := baseProduct(2, 10000, 30, 1)
+				p.Offers = []*domain_entity.ProductOffer{
+					{ID: 3, ProductID: 2, RetailerID: 1, Price: 8000, Availability: constants.ProductOfferAvailabilityInStock, LastSeenAt: time.Now()},
+				}
+				return p
+			}(),
+			validateRes: func(t *testing.T, res *domain_entity.ComparisonProductsResult) {
+				if res.PriceComparisonResult.Left != 9000 || res.PriceComparisonResult.Right != 8000 {
+					t.Errorf("Expected prices 9000 and 8000, got %d and %d", res.PriceComparisonResult.Left, res.PriceComparisonResult.Right)
+				}
+
+				if res.PriceComparisonResult.LeftOffer == nil || res.PriceComparisonResult.LeftOffer.ID != 1 {
+					t.Errorf("Expected left offer 1, got %+v", res.PriceComparisonResult.LeftOffer)
+				}
+
+				foundExpensive := false
+				for _, i := range res.PriceComparisonResult.Insights {
+					if strings.Contains(i.Message, "more expensive") {
+						foundExpensive = true
+					}
+				}
+				if !foundExpensive {
+					t.Error("Expected insight about being more expensive")
+				}
+			},
+		},
 		{
 			name:        "Should return error if different categories",
 			p1:          baseProduct(1, 100, 10, 1),
@@ -0,0 +1,140 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewRateLimitPolicy(t *testing.T) {
+	validProps := func() domain_entity.RateLimitPolicyProps {
+		return domain_entity.RateLimitPolicyProps{
+			Name:   constants.RateLimitPolicyCompare,
+			Limit:  20,
+			Window: time.Minute,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.RateLimitPolicyProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create a policy",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should return error when Name is not a policy",
+			props: func() domain_entity.RateLimitPolicyProps {
+				props := validProps()
+				props.Name = "search"
+				return props
+			},
+			expectError: true,
+			expectedMsg: `Name "search" is not valid`,
+		},
+		{
+			name: "Should return error when Limit is 0",
+			props: func() domain_entity.RateLimitPolicyProps {
+				props := validProps()
+				props.Limit = 0
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Limit must be at least 1",
+		},
+		{
+			name: "Should return error when Window is shorter than a second",
+			props: func() domain_entity.RateLimitPolicyProps {
+				props := validProps()
+				props.Window = 500 * time.Millisecond
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Window cannot be shorter than 1s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := domain_entity.NewRateLimitPolicy(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if rate := policy.RefillRate(); rate != 20.0/60 {
+				t.Errorf("Expected refill rate %v, got %v", 20.0/60, rate)
+			}
+		})
+	}
+}
+
+func TestRateLimitBucket_Take(t *testing.T) {
+	policy, err := domain_entity.NewRateLimitPolicy(domain_entity.RateLimitPolicyProps{
+		Name:   constants.RateLimitPolicyWrite,
+		Limit:  2,
+		Window: 10 * time.Second,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	start := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	bucket := domain_entity.NewRateLimitBucket("write:ip:127.0.0.1", policy, start)
+
+	tests := []struct {
+		name            string
+		at              time.Duration
+		expectTaken     bool
+		expectRemaining int64
+		expectRetryIn   time.Duration
+	}{
+		{name: "Should take from a full bucket", at: 0, expectTaken: true, expectRemaining: 1},
+		{name: "Should take the last token", at: 0, expectTaken: true, expectRemaining: 0, expectRetryIn: 5 * time.Second},
+		{name: "Should refuse an empty bucket", at: 0, expectTaken: false, expectRemaining: 0, expectRetryIn: 5 * time.Second},
+		{name: "Should refuse before a whole token is back", at: 3 * time.Second, expectTaken: false, expectRemaining: 0, expectRetryIn: 2 * time.Second},
+		{name: "Should take once a token is back", at: 5 * time.Second, expectTaken: true, expectRemaining: 0, expectRetryIn: 5 * time.Second},
+		{name: "Should not refill past the limit", at: time.Hour, expectTaken: true, expectRemaining: 1},
+		{name: "Should not refill when the clock goes back", at: time.Minute, expectTaken: true, expectRemaining: 0, expectRetryIn: 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taken := bucket.Take(policy, start.Add(tt.at))
+
+			if taken != tt.expectTaken {
+				t.Errorf("Expected taken %v, got %v", tt.expectTaken, taken)
+			}
+
+			if remaining := bucket.Remaining(); remaining != tt.expectRemaining {
+				t.Errorf("Expected remaining %d, got %d", tt.expectRemaining, remaining)
+			}
+
+			if retryIn := bucket.RetryIn(); retryIn != tt.expectRetryIn {
+				t.Errorf("Expected retry in %s, got %s", tt.expectRetryIn, retryIn)
+			}
+		})
+	}
+
+	if bucket.IsFull(start.Add(time.Hour)) {
+		t.Errorf("Expected the bucket not to be full an hour after it was last taken from")
+	}
+
+	if !bucket.IsFull(start.Add(time.Hour + 10*time.Second)) {
+		t.Errorf("Expected the bucket to be full once refilled")
+	}
+}
@@ -0,0 +1,70 @@
+package entity_test
+
+import (
+	domain_entity "project/internal/domain/entity"
+	"strings"
+	"testing"
+)
+
+func TestNewRetailer(t *testing.T) {
+	tests := []struct {
+		name        string
+		props       domain_entity.RetailerProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create a retailer without website",
+			props:       domain_entity.RetailerProps{Name: " Loja A "},
+			expectError: false,
+		},
+		{
+			name:        "Should create a retailer with website",
+			props:       domain_entity.RetailerProps{Name: "Loja A", WebsiteURL: "https://loja-a.com.br"},
+			expectError: false,
+		},
+		{
+			name:        "Should return error when Name is blank",
+			props:       domain_entity.RetailerProps{Name: "  "},
+			expectError: true,
+			expectedMsg: "Name cannot be empty",
+		},
+		{
+			name:        "Should return error when Name is too long",
+			props:       domain_entity.RetailerProps{Name: strings.Repeat("a", 256)},
+			expectError: true,
+			expectedMsg: "Name cannot be longer than 255 characters",
+		},
+		{
+			name:        "Should return error when WebsiteURL is not http",
+			props:       domain_entity.RetailerProps{Name: "Loja A", WebsiteURL: "ftp://loja-a.com.br"},
+			expectError: true,
+			expectedMsg: "WebsiteURL must be an http or https URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retailer, err := domain_entity.NewRetailer(tt.props)
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if retailer.Name != strings.TrimSpace(tt.props.Name) {
+				t.Errorf("Expected trimmed name, got %q", retailer.Name)
+			}
+
+			if len(retailer.PublicID) != 8 {
+				t.Errorf("Expected generated public ID, got %q", retailer.PublicID)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package entity_test
+
+import (
+	domain_entity "project/internal/domain/entity"
+	"testing"
+	"time"
+)
+
+func TestNewRevision(t *testing.T) {
+	changedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	validParts := func() []domain_entity.RevisionPart {
+		return []domain_entity.RevisionPart{
+			{Kind: "product", ID: 1, Version: 3, ChangedAt: changedAt},
+			{Kind: "product_offer", ID: 7, State: "1999|in_stock|1", ChangedAt: changedAt.Add(-time.Hour)},
+		}
+	}
+
+	base := domain_entity.NewRevision(validParts()...)
+
+	tests := []struct {
+		name          string
+		parts         func() []domain_entity.RevisionPart
+		expectSameTag bool
+	}{
+		{
+			name:          "Should give the same tag for the same parts",
+			parts:         validParts,
+			expectSameTag: true,
+		},
+		{
+			name: "Should change the tag when a version goes up",
+			parts: func() []domain_entity.RevisionPart {
+				parts := validParts()
+				parts[0].Version++
+				return parts
+			},
+			expectSameTag: false,
+		},
+		{
+			name: "Should change the tag when a state changes",
+			parts: func() []domain_entity.RevisionPart {
+				parts := validParts()
+				parts[1].State = "1999|in_stock|0"
+				return parts
+			},
+			expectSameTag: false,
+		},
+		{
+			name: "Should change the tag when a part is gone",
+			parts: func() []domain_entity.RevisionPart {
+				return validParts()[:1]
+			},
+			expectSameTag: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revision := domain_entity.NewRevision(tt.parts()...)
+
+			if sameTag := revision.Tag == base.Tag; sameTag != tt.expectSameTag {
+				t.Errorf("Expected same tag %v, got tags %q and %q", tt.expectSameTag, base.Tag, revision.Tag)
+			}
+		})
+	}
+
+	if !base.ModifiedAt.Equal(changedAt) {
+		t.Errorf("Expected modified at %s, got %s", changedAt, base.ModifiedAt)
+	}
+}
+
+func TestRevision_Combine(t *testing.T) {
+	left := domain_entity.NewRevision(domain_entity.RevisionPart{Kind: "product", ID: 1, Version: 1, ChangedAt: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)})
+	right := domain_entity.NewRevision(domain_entity.RevisionPart{Kind: "product", ID: 2, Version: 1, ChangedAt: time.Date(2026, 10, 2, 12, 0, 0, 0, time.UTC)})
+
+	combined := left.Combine(right)
+
+	tests := []struct {
+		name          string
+		revision      *domain_entity.Revision
+		expectSameTag bool
+	}{
+		{name: "Should give the same tag for the same sides", revision: left.Combine(right), expectSameTag: true},
+		{name: "Should change the tag when the sides are swapped", revision: right.Combine(left), expectSameTag: false},
+		{name: "Should differ from a single side", revision: left, expectSameTag: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if sameTag := tt.revision.Tag == combined.Tag; sameTag != tt.expectSameTag {
+				t.Errorf("Expected same tag %v, got tags %q and %q", tt.expectSameTag, combined.Tag, tt.revision.Tag)
+			}
+		})
+	}
+
+	if !combined.ModifiedAt.Equal(right.ModifiedAt) {
+		t.Errorf("Expected modified at %s, got %s", right.ModifiedAt, combined.ModifiedAt)
+	}
+}
@@ -182,3 +182,85 @@ func TestSpecificationGroup_HasSpecifications(t *testing.T) {
 		})
 	}
 }
+
+func TestSpecificationGroup_Update(t *testing.T) {
+	longName := strings.Repeat("a", 256)
+	longDescription := strings.Repeat("b", 2001)
+
+	tests := []struct {
+		name        string
+		props       domain_entity.UpdateSpecificationGroupProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name: "Should update name and description",
+			props: domain_entity.UpdateSpecificationGroupProps{
+				Name:        "Connectivity",
+				Description: "Wireless and wired connections",
+			},
+			expectError: false,
+		},
+		{
+			name: "Should return error when Name is empty",
+			props: domain_entity.UpdateSpecificationGroupProps{
+				Name: "",
+			},
+			expectError: true,
+			expectedMsg: "Name cannot be empty",
+		},
+		{
+			name: "Should return error when Name is too long",
+			props: domain_entity.UpdateSpecificationGroupProps{
+				Name: longName,
+			},
+			expectError: true,
+			expectedMsg: "Name cannot be longer than 255 characters",
+		},
+		{
+			name: "Should return error when Description is too long",
+			props: domain_entity.UpdateSpecificationGroupProps{
+				Name:        "Valid Name",
+				Description: longDescription,
+			},
+			expectError: true,
+			expectedMsg: "Description cannot be longer than 2000 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group, err := domain_entity.NewSpecificationGroup(domain_entity.SpecificationGroupProps{
+				ID:       1,
+				PublicID: "12345678",
+				Name:     "Hardware",
+			})
+
+			if err != nil {
+				t.Fatalf("Expected no error creating specification group, but got: %v", err)
+			}
+
+			err = group.Update(tt.props)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got nil", tt.expectedMsg)
+					return
+				}
+				if !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error message to contain %q, but got %q", tt.expectedMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error, but got: %v", err)
+				}
+				if group.Name != tt.props.Name {
+					t.Errorf("Expected name %s, got %s", tt.props.Name, group.Name)
+				}
+				if group.Description != tt.props.Description {
+					t.Errorf("Expected description %s, got %s", tt.props.Description, group.Description)
+				}
+			}
+		})
+	}
+}
@@ -98,6 +98,18 @@ func TestNewSpecification(t *testing.T) {
 			expectError: true,
 			expectedMsg: "Type cannot be empty",
 		},
+		{
+			name: "Should return error when Type is unknown",
+			props: domain_entity.SpecificationProps{
+				ID:                    1,
+				PublicID:              "12345678",
+				Title:                 "Valid Title",
+				EspecificationGroupID: 10,
+				Type:                  "float",
+			},
+			expectError: true,
+			expectedMsg: "Type must be one of string, int or bool",
+		},
 	}
 
 	for _, tt := range tests {
@@ -133,3 +145,93 @@ func TestNewSpecification(t *testing.T) {
 		})
 	}
 }
+
+func TestSpecification_Update(t *testing.T) {
+	tests := []struct {
+		name        string
+		props       domain_entity.UpdateSpecificationProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name: "Should update title, group and type",
+			props: domain_entity.UpdateSpecificationProps{
+				Title:                 "Refresh Rate",
+				EspecificationGroupID: 20,
+				Type:                  "int",
+			},
+			expectError: false,
+		},
+		{
+			name: "Should return error when Title is empty",
+			props: domain_entity.UpdateSpecificationProps{
+				Title:                 "",
+				EspecificationGroupID: 10,
+				Type:                  "string",
+			},
+			expectError: true,
+			expectedMsg: "Title cannot be empty",
+		},
+		{
+			name: "Should return error when EspecificationGroupID is zero",
+			props: domain_entity.UpdateSpecificationProps{
+				Title:                 "Valid Title",
+				EspecificationGroupID: 0,
+				Type:                  "string",
+			},
+			expectError: true,
+			expectedMsg: "EspecificationGroupID field must be greater than 0",
+		},
+		{
+			name: "Should return error when Type is unknown",
+			props: domain_entity.UpdateSpecificationProps{
+				Title:                 "Valid Title",
+				EspecificationGroupID: 10,
+				Type:                  "float",
+			},
+			expectError: true,
+			expectedMsg: "Type must be one of string, int or bool",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := domain_entity.NewSpecification(domain_entity.SpecificationProps{
+				ID:                    1,
+				PublicID:              "12345678",
+				Title:                 "Screen Size",
+				EspecificationGroupID: 10,
+				Type:                  "string",
+			})
+
+			if err != nil {
+				t.Fatalf("Expected no error creating specification, but got: %v", err)
+			}
+
+			err = spec.Update(tt.props)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error containing %q, but got nil", tt.expectedMsg)
+					return
+				}
+				if !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error message to contain %q, but got %q", tt.expectedMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error, but got: %v", err)
+				}
+				if spec.Title != tt.props.Title {
+					t.Errorf("Expected title %s, got %s", tt.props.Title, spec.Title)
+				}
+				if spec.EspecificationGroupID != tt.props.EspecificationGroupID {
+					t.Errorf("Expected GroupID %d, got %d", tt.props.EspecificationGroupID, spec.EspecificationGroupID)
+				}
+				if spec.Type != tt.props.Type {
+					t.Errorf("Expected Type %s, got %s", tt.props.Type, spec.Type)
+				}
+			}
+		})
+	}
+}
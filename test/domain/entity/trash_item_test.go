@@ -0,0 +1,121 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewTrashItem(t *testing.T) {
+	validProps := func() domain_entity.TrashItemProps {
+		return domain_entity.TrashItemProps{
+			EntityType: constants.TrashEntityProduct,
+			PublicID:   "prd00001",
+			Name:       "Phone",
+			DeletedAt:  time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.TrashItemProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create an item",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should create an item of a specification group",
+			props: func() domain_entity.TrashItemProps {
+				props := validProps()
+				props.EntityType = constants.TrashEntitySpecificationGroup
+				return props
+			},
+			expectError: false,
+		},
+		{
+			name: "Should return error when EntityType is unknown",
+			props: func() domain_entity.TrashItemProps {
+				props := validProps()
+				props.EntityType = "retailer"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "EntityType \"retailer\" is not valid",
+		},
+		{
+			name: "Should return error when PublicID is empty",
+			props: func() domain_entity.TrashItemProps {
+				props := validProps()
+				props.PublicID = ""
+				return props
+			},
+			expectError: true,
+			expectedMsg: "PublicID cannot be empty",
+		},
+		{
+			name: "Should return error when DeletedAt is empty",
+			props: func() domain_entity.TrashItemProps {
+				props := validProps()
+				props.DeletedAt = time.Time{}
+				return props
+			},
+			expectError: true,
+			expectedMsg: "DeletedAt cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain_entity.NewTrashItem(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTrashItem_PurgeAt(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	item, err := domain_entity.NewTrashItem(domain_entity.TrashItemProps{
+		EntityType: constants.TrashEntityCategory,
+		PublicID:   "cat00001",
+		Name:       "Phones",
+		DeletedAt:  deletedAt,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		retention time.Duration
+		expected  time.Time
+	}{
+		{name: "Should add the retention", retention: constants.TrashDefaultRetention, expected: time.Date(2026, 10, 31, 12, 0, 0, 0, time.UTC)},
+		{name: "Should be the deletion without retention", retention: 0, expected: deletedAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if purgeAt := item.PurgeAt(tt.retention); !purgeAt.Equal(tt.expected) {
+				t.Errorf("Expected purge at %s, got %s", tt.expected, purgeAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	"strings"
+	"testing"
+)
+
+const subscriberToken = "abcdefghijklmnop"
+
+func TestNewWatchlist(t *testing.T) {
+	validProps := func() domain_entity.WatchlistProps {
+		return domain_entity.WatchlistProps{
+			ProductID:       1,
+			SubscriberToken: " " + subscriberToken + " ",
+			TargetPrice:     9000,
+			BasePrice:       10000,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.WatchlistProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create a watchlist with a target price",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should create a watchlist with a percentage drop",
+			props: func() domain_entity.WatchlistProps {
+				props := validProps()
+				props.TargetPrice = 0
+				props.DropPercent = 15
+				return props
+			},
+			expectError: false,
+		},
+		{
+			name: "Should return error when SubscriberToken is too short",
+			props: func() domain_entity.WatchlistProps {
+				props := validProps()
+				props.SubscriberToken = "short"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "SubscriberToken must be at least 16 characters long",
+		},
+		{
+			name: "Should return error when neither TargetPrice nor DropPercent is set",
+			props: func() domain_entity.WatchlistProps {
+				props := validProps()
+				props.TargetPrice = 0
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Either TargetPrice or DropPercent must be set",
+		},
+		{
+			name: "Should return error when both TargetPrice and DropPercent are set",
+			props: func() domain_entity.WatchlistProps {
+				props := validProps()
+				props.DropPercent = 10
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Either TargetPrice or DropPercent must be set",
+		},
+		{
+			name: "Should return error when DropPercent is 100 or more",
+			props: func() domain_entity.WatchlistProps {
+				props := validProps()
+				props.TargetPrice = 0
+				props.DropPercent = 100
+				return props
+			},
+			expectError: true,
+			expectedMsg: "DropPercent must be between 1 and 99",
+		},
+		{
+			name: "Should return error when BasePrice is zero",
+			props: func() domain_entity.WatchlistProps {
+				props := validProps()
+				props.BasePrice = 0
+				return props
+			},
+			expectError: true,
+			expectedMsg: "BasePrice must be greater than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watchlist, err := domain_entity.NewWatchlist(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if watchlist.SubscriberToken != subscriberToken {
+				t.Errorf("Expected trimmed subscriber token, got %q", watchlist.SubscriberToken)
+			}
+			if len(watchlist.PublicID) != 8 {
+				t.Errorf("Expected a generated public ID, got %q", watchlist.PublicID)
+			}
+		})
+	}
+}
+
+func TestWatchlist_ThresholdPrice(t *testing.T) {
+	tests := []struct {
+		name      string
+		watchlist *domain_entity.Watchlist
+		expected  int64
+	}{
+		{
+			name:      "Should use the target price",
+			watchlist: &domain_entity.Watchlist{TargetPrice: 9000, BasePrice: 10000},
+			expected:  9000,
+		},
+		{
+			name:      "Should apply the percentage drop to the base price",
+			watchlist: &domain_entity.Watchlist{DropPercent: 15, BasePrice: 10000},
+			expected:  8500,
+		},
+		{
+			name:      "Should round the percentage drop down to the cent",
+			watchlist: &domain_entity.Watchlist{DropPercent: 10, BasePrice: 9999},
+			expected:  8999,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.watchlist.ThresholdPrice(); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWatchlist_ShouldNotify(t *testing.T) {
+	tests := []struct {
+		name              string
+		lastNotifiedPrice int64
+		price             int64
+		expected          bool
+	}{
+		{
+			name:     "Should notify when the price reaches the threshold",
+			price:    9000,
+			expected: true,
+		},
+		{
+			name:     "Should not notify above the threshold",
+			price:    9001,
+			expected: false,
+		},
+		{
+			name:     "Should not notify without a price",
+			price:    0,
+			expected: false,
+		},
+		{
+			name:              "Should not notify the same price twice",
+			lastNotifiedPrice: 8500,
+			price:             8500,
+			expected:          false,
+		},
+		{
+			name:              "Should not notify when the price goes up below the threshold",
+			lastNotifiedPrice: 8500,
+			price:             8800,
+			expected:          false,
+		},
+		{
+			name:              "Should notify a further drop",
+			lastNotifiedPrice: 8500,
+			price:             8000,
+			expected:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watchlist := &domain_entity.Watchlist{TargetPrice: 9000, BasePrice: 10000, LastNotifiedPrice: tt.lastNotifiedPrice}
+
+			if got := watchlist.ShouldNotify(tt.price); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWatchlist_Rearm(t *testing.T) {
+	tests := []struct {
+		name              string
+		lastNotifiedPrice int64
+		price             int64
+		expectedChanged   bool
+		expectedLastPrice int64
+	}{
+		{
+			name:              "Should rearm when the price goes back above the threshold",
+			lastNotifiedPrice: 8500,
+			price:             9500,
+			expectedChanged:   true,
+			expectedLastPrice: 0,
+		},
+		{
+			name:              "Should keep the last alert while the price stays below the threshold",
+			lastNotifiedPrice: 8500,
+			price:             8900,
+			expectedChanged:   false,
+			expectedLastPrice: 8500,
+		},
+		{
+			name:              "Should not change a watchlist that was never notified",
+			lastNotifiedPrice: 0,
+			price:             9500,
+			expectedChanged:   false,
+			expectedLastPrice: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watchlist := &domain_entity.Watchlist{TargetPrice: 9000, BasePrice: 10000, LastNotifiedPrice: tt.lastNotifiedPrice}
+
+			if changed := watchlist.Rearm(tt.price); changed != tt.expectedChanged {
+				t.Errorf("Expected changed %v, got %v", tt.expectedChanged, changed)
+			}
+			if watchlist.LastNotifiedPrice != tt.expectedLastPrice {
+				t.Errorf("Expected last notified price %d, got %d", tt.expectedLastPrice, watchlist.LastNotifiedPrice)
+			}
+		})
+	}
+}
+
+func TestNewWatchlistDelivery(t *testing.T) {
+	validProps := func() domain_entity.WatchlistDeliveryProps {
+		return domain_entity.WatchlistDeliveryProps{
+			WatchlistID:    1,
+			Trigger:        constants.WatchlistTriggerPriceUpdate,
+			Price:          8500,
+			ThresholdPrice: 9000,
+			Status:         constants.WatchlistDeliveryStatusDelivered,
+			Attempts:       1,
+			StatusCode:     204,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.WatchlistDeliveryProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create a delivery",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should return error when Trigger is unknown",
+			props: func() domain_entity.WatchlistDeliveryProps {
+				props := validProps()
+				props.Trigger = "manual"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "is not valid",
+		},
+		{
+			name: "Should return error when Status is unknown",
+			props: func() domain_entity.WatchlistDeliveryProps {
+				props := validProps()
+				props.Status = "pending"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "is not valid",
+		},
+		{
+			name: "Should return error without attempts",
+			props: func() domain_entity.WatchlistDeliveryProps {
+				props := validProps()
+				props.Attempts = 0
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Attempts must be greater than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain_entity.NewWatchlistDelivery(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package entity_test
+
+import (
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	. "project/internal/domain/types"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewWebhookSubscription(t *testing.T) {
+	validProps := func() domain_entity.WebhookSubscriptionProps {
+		return domain_entity.WebhookSubscriptionProps{
+			URL:        " https://indexer.example.com/events ",
+			Secret:     "0123456789abcdef",
+			EventTypes: []OutboxEventType{"product.*", constants.OutboxEventCategoryUpdated},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		props       func() domain_entity.WebhookSubscriptionProps
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should create a subscription",
+			props:       validProps,
+			expectError: false,
+		},
+		{
+			name: "Should create a subscription to every event",
+			props: func() domain_entity.WebhookSubscriptionProps {
+				props := validProps()
+				props.EventTypes = nil
+				return props
+			},
+			expectError: false,
+		},
+		{
+			name: "Should return error when URL is not http",
+			props: func() domain_entity.WebhookSubscriptionProps {
+				props := validProps()
+				props.URL = "ftp://indexer.example.com/events"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "URL must be an http or https URL",
+		},
+		{
+			name: "Should return error when Secret is too short",
+			props: func() domain_entity.WebhookSubscriptionProps {
+				props := validProps()
+				props.Secret = "short"
+				return props
+			},
+			expectError: true,
+			expectedMsg: "Secret must be at least 16 characters long",
+		},
+		{
+			name: "Should return error when an event type is unknown",
+			props: func() domain_entity.WebhookSubscriptionProps {
+				props := validProps()
+				props.EventTypes = []OutboxEventType{"product.renamed"}
+				return props
+			},
+			expectError: true,
+			expectedMsg: "is not valid",
+		},
+		{
+			name: "Should return error when the wildcard aggregate is unknown",
+			props: func() domain_entity.WebhookSubscriptionProps {
+				props := validProps()
+				props.EventTypes = []OutboxEventType{"retailer.*"}
+				return props
+			},
+			expectError: true,
+			expectedMsg: "is not valid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription, err := domain_entity.NewWebhookSubscription(tt.props())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if subscription.URL != "https://indexer.example.com/events" {
+				t.Errorf("Expected trimmed URL, got %q", subscription.URL)
+			}
+			if len(subscription.PublicID) != 8 {
+				t.Errorf("Expected a generated public ID, got %q", subscription.PublicID)
+			}
+		})
+	}
+}
+
+func TestNewWebhookSubscription_GeneratesSecret(t *testing.T) {
+	subscription, err := domain_entity.NewWebhookSubscription(domain_entity.WebhookSubscriptionProps{
+		URL: "https://indexer.example.com/events",
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(subscription.Secret) != 48 {
+		t.Errorf("Expected a generated secret of 48 characters, got %q", subscription.Secret)
+	}
+}
+
+func TestWebhookSubscription_Accepts(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventTypes []OutboxEventType
+		eventType  OutboxEventType
+		expected   bool
+	}{
+		{
+			name:       "Should accept every event without event types",
+			eventTypes: nil,
+			eventType:  constants.OutboxEventCategoryDeleted,
+			expected:   true,
+		},
+		{
+			name:       "Should accept a listed event type",
+			eventTypes: []OutboxEventType{constants.OutboxEventProductUpdated},
+			eventType:  constants.OutboxEventProductUpdated,
+			expected:   true,
+		},
+		{
+			name:       "Should not accept an event type that is not listed",
+			eventTypes: []OutboxEventType{constants.OutboxEventProductUpdated},
+			eventType:  constants.OutboxEventProductDeleted,
+			expected:   false,
+		},
+		{
+			name:       "Should accept the events of a wildcard aggregate",
+			eventTypes: []OutboxEventType{"product.*"},
+			eventType:  constants.OutboxEventProductSpecificationsUpdated,
+			expected:   true,
+		},
+		{
+			name:       "Should not accept the events of another aggregate",
+			eventTypes: []OutboxEventType{"product.*"},
+			eventType:  constants.OutboxEventCategoryCreated,
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := &domain_entity.WebhookSubscription{EventTypes: tt.eventTypes}
+
+			if got := subscription.Accepts(tt.eventType); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWebhookDelivery_MarkFailed(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                  string
+		attempts              int64
+		maxAttempts           int64
+		backoff               time.Duration
+		expectedStatus        WebhookDeliveryStatus
+		expectedNextAttemptAt time.Time
+	}{
+		{
+			name:                  "Should wait the backoff after the first attempt",
+			attempts:              0,
+			maxAttempts:           5,
+			backoff:               30 * time.Second,
+			expectedStatus:        constants.WebhookDeliveryStatusPending,
+			expectedNextAttemptAt: now.Add(30 * time.Second),
+		},
+		{
+			name:                  "Should double the backoff on each attempt",
+			attempts:              2,
+			maxAttempts:           5,
+			backoff:               30 * time.Second,
+			expectedStatus:        constants.WebhookDeliveryStatusPending,
+			expectedNextAttemptAt: now.Add(2 * time.Minute),
+		},
+		{
+			name:                  "Should cap the backoff",
+			attempts:              20,
+			maxAttempts:           50,
+			backoff:               30 * time.Second,
+			expectedStatus:        constants.WebhookDeliveryStatusPending,
+			expectedNextAttemptAt: now.Add(constants.WebhookDeliveryMaxBackoff),
+		},
+		{
+			name:           "Should dead-letter after the last attempt",
+			attempts:       4,
+			maxAttempts:    5,
+			backoff:        30 * time.Second,
+			expectedStatus: constants.WebhookDeliveryStatusDead,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery, err := domain_entity.NewWebhookDelivery(domain_entity.WebhookDeliveryProps{
+				EventID:        1,
+				SubscriptionID: 1,
+				Status:         constants.WebhookDeliveryStatusSending,
+				Attempts:       tt.attempts,
+			})
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			err = delivery.MarkFailed(503, "webhook answered with status 503", now, tt.maxAttempts, tt.backoff)
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if delivery.Status != tt.expectedStatus {
+				t.Errorf("Expected status %s, got %s", tt.expectedStatus, delivery.Status)
+			}
+			if delivery.Attempts != tt.attempts+1 {
+				t.Errorf("Expected %d attempts, got %d", tt.attempts+1, delivery.Attempts)
+			}
+			if tt.expectedStatus == constants.WebhookDeliveryStatusPending && !delivery.NextAttemptAt.Equal(tt.expectedNextAttemptAt) {
+				t.Errorf("Expected next attempt at %v, got %v", tt.expectedNextAttemptAt, delivery.NextAttemptAt)
+			}
+		})
+	}
+}
+
+func TestWebhookDelivery_MarkDelivered(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      WebhookDeliveryStatus
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:        "Should deliver a sending delivery",
+			status:      constants.WebhookDeliveryStatusSending,
+			expectError: false,
+		},
+		{
+			name:        "Should return error for a delivery no dispatcher claimed",
+			status:      constants.WebhookDeliveryStatusPending,
+			expectError: true,
+			expectedMsg: "a pending delivery cannot be delivered",
+		},
+		{
+			name:        "Should return error for a dead delivery",
+			status:      constants.WebhookDeliveryStatusDead,
+			expectError: true,
+			expectedMsg: "a dead delivery cannot be delivered",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := &domain_entity.WebhookDelivery{EventID: 1, SubscriptionID: 1, Status: tt.status, LastError: "timeout"}
+
+			err := delivery.MarkDelivered(204, time.Now())
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if delivery.Status != constants.WebhookDeliveryStatusDelivered || delivery.LastError != "" || delivery.DeliveredAt.IsZero() {
+				t.Errorf("Expected a delivered delivery, got %+v", delivery)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package exception_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"project/internal/domain/constants"
+	exceptions "project/internal/domain/exception"
+	"project/internal/domain/types"
+	"strings"
+	"testing"
+)
+
+func TestUsecase_SetRequestID(t *testing.T) {
+	var requestID types.RequestID = "trace-0001"
+
+	t.Run("Should pass the request ID down to the wrapped exceptions", func(t *testing.T) {
+		entityErr := exceptions.Entity(errors.New("Name cannot be empty"), exceptions.EntityOpts{Reason: constants.EntityValidationError})
+		repoErr := exceptions.Repo(entityErr, exceptions.RepositoryOpts{Reason: constants.RepositoryUnknownError})
+		usecaseErr := exceptions.Usecase(repoErr, exceptions.UsecaseOpts{Code: "CreateOneCategory", StatusCode: 422, Message: "Invalid category"})
+
+		usecaseErr.SetRequestID(requestID)
+
+		ids := map[string]types.RequestID{
+			"usecase":    usecaseErr.Instance().RequestID,
+			"repository": repoErr.Instance().RequestID,
+			"entity":     entityErr.Instance().RequestID,
+		}
+
+		for kind, id := range ids {
+			if id != requestID {
+				t.Errorf("Expected the %s exception to have request ID %q, got %q", kind, requestID, id)
+			}
+		}
+
+		for _, err := range []error{usecaseErr, repoErr, entityErr} {
+			if !strings.Contains(err.Error(), "RequestID: "+string(requestID)) {
+				t.Errorf("Expected error containing the request ID, got %v", err)
+			}
+		}
+	})
+
+	t.Run("Should log the request ID of each exception", func(t *testing.T) {
+		repoErr := exceptions.Repo(errors.New("sql: no rows in result set"), exceptions.RepositoryOpts{Reason: constants.RepositoryNotFoundError})
+		usecaseErr := exceptions.Usecase(repoErr, exceptions.UsecaseOpts{Code: "GetOneProductByPublicId", StatusCode: 404, Message: "Error getting product"})
+
+		usecaseErr.SetRequestID(requestID)
+
+		var buffer bytes.Buffer
+
+		slog.New(slog.NewJSONHandler(&buffer, nil)).Warn(usecaseErr.Instance().Message, slog.Any("exception", usecaseErr))
+
+		var line struct {
+			Exception struct {
+				RequestID types.RequestID `json:"request_id"`
+				Cause     struct {
+					RequestID types.RequestID `json:"request_id"`
+					Error     string          `json:"error"`
+				} `json:"cause"`
+			} `json:"exception"`
+		}
+
+		if err := json.Unmarshal(buffer.Bytes(), &line); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if line.Exception.RequestID != requestID || line.Exception.Cause.RequestID != requestID {
+			t.Errorf("Expected request ID %q on the exception and its cause, got %s", requestID, buffer.String())
+		}
+
+		if line.Exception.Cause.Error != "sql: no rows in result set" {
+			t.Errorf("Expected the error of the cause, got %q", line.Exception.Cause.Error)
+		}
+	})
+
+	t.Run("Should keep a plain error cause as it is", func(t *testing.T) {
+		usecaseErr := exceptions.Usecase(errors.New("boom"), exceptions.UsecaseOpts{Code: "ExportProducts", StatusCode: 500, Message: "Error exporting products"})
+
+		usecaseErr.SetRequestID(requestID)
+
+		if usecaseErr.Instance().RequestID != requestID {
+			t.Errorf("Expected request ID %q, got %q", requestID, usecaseErr.Instance().RequestID)
+		}
+	})
+
+	t.Run("Should have no request ID line outside of a request", func(t *testing.T) {
+		usecaseErr := exceptions.Usecase(errors.New("boom"), exceptions.UsecaseOpts{Code: "TrashPurge", StatusCode: 500, Message: "Error purging the trash"})
+
+		if strings.Contains(usecaseErr.Error(), "- RequestID:") {
+			t.Errorf("Expected no request ID line, got %v", usecaseErr)
+		}
+	})
+}
@@ -0,0 +1,150 @@
+package catalog_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"project/internal/application/dto"
+	"project/internal/infra/catalog"
+	"strings"
+	"testing"
+)
+
+func exportFixture() ([]*dto.ExportSpecificationColumn, []*dto.ExportProductUnit) {
+	columns := []*dto.ExportSpecificationColumn{
+		{Key: "Processador", PublicID: "spec0001", Title: "Processador", Type: "string"},
+		{Key: "RAM (GB)", PublicID: "spec0002", Title: "RAM (GB)", Type: "int"},
+		{Key: "5G", PublicID: "spec0003", Title: "5G", Type: "bool"},
+	}
+
+	products := []*dto.ExportProductUnit{
+		{
+			PublicID:         "prod0001",
+			Name:             `Galaxy "S24", 256GB`,
+			Description:      "Tela de 6,2\"\nduas linhas",
+			Price:            499900,
+			Rating:           45,
+			Category:         "Smartphones",
+			CategoryPublicID: "cate0001",
+			Specifications:   map[string]any{"Processador": "Snapdragon 8, Gen 3", "RAM (GB)": int64(8), "5G": true},
+		},
+		{
+			PublicID:         "prod0002",
+			Name:             "Moto G",
+			Price:            129900,
+			Category:         "Smartphones",
+			CategoryPublicID: "cate0001",
+			Specifications:   map[string]any{},
+		},
+	}
+
+	return columns, products
+}
+
+func encode(t *testing.T, format catalog.Format) string {
+	t.Helper()
+
+	columns, products := exportFixture()
+
+	var buffer bytes.Buffer
+
+	encoder, err := catalog.NewEncoder(format, &buffer, columns)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, product := range products {
+		if err := encoder.Encode(product); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	if err := encoder.Close(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	return buffer.String()
+}
+
+func TestEncoder_CSV(t *testing.T) {
+	records, err := csv.NewReader(strings.NewReader(encode(t, catalog.FormatCSV))).ReadAll()
+	if err != nil {
+		t.Fatalf("Expected a valid CSV, got %v", err)
+	}
+
+	expected := [][]string{
+		{"public_id", "name", "description", "price", "rating", "image_url", "category", "category_public_id", "Processador", "RAM (GB)", "5G"},
+		{"prod0001", `Galaxy "S24", 256GB`, "Tela de 6,2\"\nduas linhas", "499900", "45", "", "Smartphones", "cate0001", "Snapdragon 8, Gen 3", "8", "true"},
+		{"prod0002", "Moto G", "", "129900", "0", "", "Smartphones", "cate0001", "", "", ""},
+	}
+
+	if len(records) != len(expected) {
+		t.Fatalf("Expected %d records, got %d", len(expected), len(records))
+	}
+
+	for i := range expected {
+		if strings.Join(records[i], "|") != strings.Join(expected[i], "|") {
+			t.Errorf("Expected record %d to be %q, got %q", i, expected[i], records[i])
+		}
+	}
+}
+
+func TestEncoder_JSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		format catalog.Format
+		decode func(string) ([]dto.ExportProductUnit, error)
+	}{
+		{
+			name:   "Should write a JSON array",
+			format: catalog.FormatJSON,
+			decode: func(content string) ([]dto.ExportProductUnit, error) {
+				var units []dto.ExportProductUnit
+				err := json.Unmarshal([]byte(content), &units)
+				return units, err
+			},
+		},
+		{
+			name:   "Should write a product per NDJSON line",
+			format: catalog.FormatNDJSON,
+			decode: func(content string) ([]dto.ExportProductUnit, error) {
+				var units []dto.ExportProductUnit
+
+				for _, line := range strings.Split(strings.TrimSuffix(content, "\n"), "\n") {
+					var unit dto.ExportProductUnit
+					if err := json.Unmarshal([]byte(line), &unit); err != nil {
+						return nil, err
+					}
+					units = append(units, unit)
+				}
+
+				return units, nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			units, err := tt.decode(encode(t, tt.format))
+			if err != nil {
+				t.Fatalf("Expected a valid document, got %v", err)
+			}
+
+			if len(units) != 2 {
+				t.Fatalf("Expected 2 products, got %d", len(units))
+			}
+
+			if units[0].Name != `Galaxy "S24", 256GB` || units[0].Description != "Tela de 6,2\"\nduas linhas" {
+				t.Errorf("Expected the name and description as they are, got %q and %q", units[0].Name, units[0].Description)
+			}
+
+			if units[0].Specifications["Processador"] != "Snapdragon 8, Gen 3" || units[0].Specifications["5G"] != true {
+				t.Errorf("Expected the filled specifications, got %v", units[0].Specifications)
+			}
+
+			if len(units[1].Specifications) != 0 {
+				t.Errorf("Expected no specifications, got %v", units[1].Specifications)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"project/internal/domain/constants"
+	domain_entity "project/internal/domain/entity"
+	"project/internal/infra/sqlite/repository"
+	"project/test/testdb"
+	"project/test/testserver"
+	"strings"
+	"testing"
+)
+
+func TestUpdateOneProductHandler_IfMatch(t *testing.T) {
+	server := testserver.New(t, testserver.Options{})
+
+	category := testdb.CreateCategory(t, server.Sqlite, "Geladeiras")
+	product := testdb.CreateProduct(t, server.Sqlite, category, domain_entity.ProductProps{Name: "Geladeira 400L", Price: 350000})
+	key := testdb.CreateApiKey(t, server.Sqlite, constants.RoleEditor)
+
+	response, _ := server.Do(t, http.MethodGet, "/products/"+string(product.PublicID), nil, nil)
+	read := response.Header.Get("ETag")
+
+	if read != `"1"` {
+		t.Fatalf("Expected the ETag of version 1, got %q", read)
+	}
+
+	tests := []struct {
+		name         string
+		ifMatch      string
+		body         map[string]any
+		expectStatus int
+		expectETag   string
+		expectedMsg  string
+	}{
+		{
+			name:         "Should update the version that was read",
+			ifMatch:      read,
+			body:         map[string]any{"price": 330000},
+			expectStatus: http.StatusOK,
+			expectETag:   `"2"`,
+		},
+		{
+			name:         "Should refuse a stale If-Match",
+			ifMatch:      read,
+			body:         map[string]any{"price": 320000},
+			expectStatus: http.StatusPreconditionFailed,
+			expectedMsg:  "Product was changed by someone else, read it again",
+		},
+		{
+			name:         "Should refuse a stale version field",
+			body:         map[string]any{"price": 320000, "version": 1},
+			expectStatus: http.StatusPreconditionFailed,
+			expectedMsg:  "Product was changed by someone else, read it again",
+		},
+		{
+			name:         "Should prefer If-Match over the version field",
+			ifMatch:      `W/"2"`,
+			body:         map[string]any{"price": 310000, "version": 1},
+			expectStatus: http.StatusOK,
+			expectETag:   `"3"`,
+		},
+		{
+			name:         "Should refuse an If-Match without a version",
+			ifMatch:      "*",
+			body:         map[string]any{"price": 300000},
+			expectStatus: http.StatusPreconditionFailed,
+			expectedMsg:  "If-Match does not hold a product version",
+		},
+		{
+			name:         "Should require a version",
+			body:         map[string]any{"price": 300000},
+			expectStatus: http.StatusPreconditionRequired,
+			expectedMsg:  "Product version is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{"Authorization": {"Bearer " + key}}
+
+			if tt.ifMatch != "" {
+				header.Set("If-Match", tt.ifMatch)
+			}
+
+			tt.body["name"] = product.Name
+			tt.body["category_public_id"] = category.PublicID
+
+			response, body := server.Do(t, http.MethodPut, "/products/"+string(product.PublicID), header, tt.body)
+
+			if response.StatusCode != tt.expectStatus {
+				t.Fatalf("Expected status %d, got %d %s", tt.expectStatus, response.StatusCode, body)
+			}
+
+			if etag := response.Header.Get("ETag"); etag != tt.expectETag {
+				t.Errorf("Expected ETag %q, got %q", tt.expectETag, etag)
+			}
+
+			if !strings.Contains(string(body), tt.expectedMsg) {
+				t.Errorf("Expected body containing %q, got %s", tt.expectedMsg, body)
+			}
+		})
+	}
+
+	updated, repoErr := repository.NewProductSqlite(server.Sqlite.DB).GetOneByPublicId(product.PublicID)
+	if repoErr != nil {
+		t.Fatalf("Expected no error, got %v", repoErr)
+	}
+
+	if updated.Price != 310000 || updated.Version != 3 {
+		t.Errorf("Expected price 310000 at version 3, got %d at version %d", updated.Price, updated.Version)
+	}
+}
+
+func TestUpdateOneProductHandler_Category(t *testing.T) {
+	server := testserver.New(t, testserver.Options{})
+
+	fridges := testdb.CreateCategory(t, server.Sqlite, "Geladeiras")
+	freezers := testdb.CreateCategory(t, server.Sqlite, "Freezers")
+	family := testdb.CreateProduct(t, server.Sqlite, fridges, domain_entity.ProductProps{Name: "Geladeira 400L", Price: 350000})
+	key := testdb.CreateApiKey(t, server.Sqlite, constants.RoleEditor)
+	header := http.Header{"Authorization": {"Bearer " + key}}
+
+	response, body := server.Do(t, http.MethodPost, "/products/"+string(family.PublicID)+"/variants", header, map[string]any{
+		"variant_label": "Inox",
+		"price":         370000,
+	})
+	if response.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d %s", http.StatusCreated, response.StatusCode, body)
+	}
+
+	products := repository.NewProductSqlite(server.Sqlite.DB)
+
+	variants, repoErr := products.GetAllVariants(family)
+	if repoErr != nil || len(variants) != 1 {
+		t.Fatalf("Expected 1 variant, got %d %v", len(variants), repoErr)
+	}
+
+	tests := []struct {
+		name         string
+		product      *domain_entity.Product
+		category     *domain_entity.Category
+		expectStatus int
+		expectedMsg  string
+	}{
+		{
+			name:         "Should refuse to move a variant away from its family",
+			product:      variants[0],
+			category:     freezers,
+			expectStatus: http.StatusUnprocessableEntity,
+			expectedMsg:  "A variant always has the category of its family",
+		},
+		{
+			name:         "Should move the family with its variants",
+			product:      family,
+			category:     freezers,
+			expectStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, repoErr := products.GetOneByPublicId(tt.product.PublicID)
+			if repoErr != nil {
+				t.Fatalf("Expected no error, got %v", repoErr)
+			}
+
+			response, body := server.Do(t, http.MethodPut, "/products/"+string(current.PublicID), header, map[string]any{
+				"name":               current.Name,
+				"price":              current.Price,
+				"variant_label":      current.VariantLabel,
+				"category_public_id": tt.category.PublicID,
+				"version":            current.Version,
+			})
+
+			if response.StatusCode != tt.expectStatus {
+				t.Fatalf("Expected status %d, got %d %s", tt.expectStatus, response.StatusCode, body)
+			}
+
+			if !strings.Contains(string(body), tt.expectedMsg) {
+				t.Errorf("Expected body containing %q, got %s", tt.expectedMsg, body)
+			}
+		})
+	}
+
+	for _, product := range []*domain_entity.Product{family, variants[0]} {
+		moved, repoErr := products.GetOneByPublicId(product.PublicID)
+		if repoErr != nil {
+			t.Fatalf("Expected no error, got %v", repoErr)
+		}
+
+		if moved.CategoryID != freezers.ID {
+			t.Errorf("Expected %s in category %d, got %d", product.PublicID, freezers.ID, moved.CategoryID)
+		}
+	}
+}
+
+func TestPublishOneProductHandler(t *testing.T) {
+	server := testserver.New(t, testserver.Options{})
+
+	category := testdb.CreateCategory(t, server.Sqlite, "Geladeiras")
+	product := testdb.CreateProduct(t, server.Sqlite, category, domain_entity.ProductProps{Name: "Geladeira 400L", Price: 350000})
+	admin := http.Header{"Authorization": {"Bearer " + testdb.CreateApiKey(t, server.Sqlite, constants.RoleCatalogAdmin)}}
+	editor := http.Header{"Authorization": {"Bearer " + testdb.CreateApiKey(t, server.Sqlite, constants.RoleEditor)}}
+
+	create := func(path string, body map[string]any) string {
+		t.Helper()
+
+		response, responseBody := server.Do(t, http.MethodPost, path, admin, body)
+		if response.StatusCode != http.StatusCreated {
+			t.Fatalf("Expected status %d, got %d %s", http.StatusCreated, response.StatusCode, responseBody)
+		}
+
+		var created struct {
+			Data struct {
+				PublicID string `json:"public_id"`
+			} `json:"data"`
+		}
+
+		if err := json.Unmarshal(responseBody, &created); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		return created.Data.PublicID
+	}
+
+	group := create("/specifications/groups", map[string]any{"name": "Dimensões"})
+	capacity := create("/specifications", map[string]any{"title": "Capacidade", "type": "string", "specification_group_public_id": group})
+
+	response, body := server.Do(t, http.MethodPut, "/categories/"+string(category.PublicID)+"/specifications", admin, map[string]any{
+		"specifications": []map[string]any{{"specification_public_id": capacity, "requirement": "required"}},
+	})
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d %s", http.StatusOK, response.StatusCode, body)
+	}
+
+	tests := []struct {
+		name         string
+		values       map[string]any
+		expectStatus int
+		expectedMsg  string
+	}{
+		{
+			name:         "Should refuse a product missing a required specification",
+			expectStatus: http.StatusUnprocessableEntity,
+			expectedMsg:  capacity,
+		},
+		{
+			name:         "Should publish a product with every required specification",
+			values:       map[string]any{capacity: "400L"},
+			expectStatus: http.StatusOK,
+			expectedMsg:  "Product published successfully",
+		},
+		{
+			name:         "Should refuse a product already published",
+			expectStatus: http.StatusConflict,
+			expectedMsg:  "Product is already published",
+		},
+	}
+
+	products := repository.NewProductSqlite(server.Sqlite.DB)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.values != nil {
+				response, body := server.Do(t, http.MethodPut, "/products/"+string(product.PublicID)+"/specifications", editor, map[string]any{
+					"specifications": tt.values,
+				})
+				if response.StatusCode != http.StatusOK {
+					t.Fatalf("Expected status %d, got %d %s", http.StatusOK, response.StatusCode, body)
+				}
+			}
+
+			current, repoErr := products.GetOneByPublicId(product.PublicID)
+			if repoErr != nil {
+				t.Fatalf("Expected no error, got %v", repoErr)
+			}
+
+			path := fmt.Sprintf("/products/%s/publish?version=%d", product.PublicID, current.Version)
+			response, body := server.Do(t, http.MethodPost, path, editor, nil)
+
+			if response.StatusCode != tt.expectStatus {
+				t.Fatalf("Expected status %d, got %d %s", tt.expectStatus, response.StatusCode, body)
+			}
+
+			if !strings.Contains(string(body), tt.expectedMsg) {
+				t.Errorf("Expected body containing %q, got %s", tt.expectedMsg, body)
+			}
+		})
+	}
+
+	published, repoErr := products.GetOneByPublicId(product.PublicID)
+	if repoErr != nil {
+		t.Fatalf("Expected no error, got %v", repoErr)
+	}
+
+	if !published.IsPublished() {
+		t.Errorf("Expected %s to be published", product.PublicID)
+	}
+}
@@ -0,0 +1,237 @@
+package middleware_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"project/internal/domain/constants"
+	"project/test/testdb"
+	"project/test/testserver"
+	"strings"
+	"testing"
+	"time"
+)
+
+const jwtSecret = "a-secret-of-at-least-32-bytes-for-hs256"
+
+// signJWT signs the claims with HS256, alg overrides the alg of the header.
+func signJWT(t *testing.T, secret string, alg string, claims map[string]any) string {
+	t.Helper()
+
+	encode := func(value any) string {
+		content, err := json.Marshal(value)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(content)
+	}
+
+	signed := encode(map[string]string{"alg": alg, "typ": "JWT"}) + "." + encode(claims)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestAuthenticate_JWT(t *testing.T) {
+	server := testserver.New(t, testserver.Options{JWTSecret: jwtSecret})
+
+	valid := func() map[string]any {
+		return map[string]any{
+			"sub":  "jane@example.com",
+			"role": "catalog-admin",
+			"exp":  time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	tampered := func() string {
+		parts := strings.Split(signJWT(t, jwtSecret, "HS256", valid()), ".")
+		claims := valid()
+		claims["sub"] = "john@example.com"
+		content, _ := json.Marshal(claims)
+		return parts[0] + "." + base64.RawURLEncoding.EncodeToString(content) + "." + parts[2]
+	}
+
+	unsigned := func() string {
+		parts := strings.Split(signJWT(t, jwtSecret, "none", valid()), ".")
+		return parts[0] + "." + parts[1] + "."
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		token        string
+		expectStatus int
+	}{
+		{
+			name:         "Should accept a token signed with the secret",
+			method:       http.MethodPost,
+			token:        signJWT(t, jwtSecret, "HS256", valid()),
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:         "Should refuse a token signed with another secret",
+			method:       http.MethodPost,
+			token:        signJWT(t, "another-secret-of-at-least-32-bytes!!", "HS256", valid()),
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "Should refuse a token whose claims changed after signing",
+			method:       http.MethodPost,
+			token:        tampered(),
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "Should refuse a token with alg none",
+			method:       http.MethodPost,
+			token:        unsigned(),
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "Should refuse an expired token",
+			method: http.MethodPost,
+			token: signJWT(t, jwtSecret, "HS256", map[string]any{
+				"sub":  "jane@example.com",
+				"role": "catalog-admin",
+				"exp":  time.Now().Add(-time.Hour).Unix(),
+			}),
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "Should refuse a bad token on a read too",
+			method:       http.MethodGet,
+			token:        signJWT(t, "another-secret-of-at-least-32-bytes!!", "HS256", valid()),
+			expectStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{"Authorization": {"Bearer " + tt.token}}
+
+			var body any
+
+			if tt.method == http.MethodPost {
+				body = map[string]any{"name": "Categoria " + string(rune('A'+i))}
+			}
+
+			response, content := server.Do(t, tt.method, "/categories", header, body)
+
+			if response.StatusCode != tt.expectStatus {
+				t.Fatalf("Expected status %d, got %d %s", tt.expectStatus, response.StatusCode, content)
+			}
+
+			if tt.expectStatus != http.StatusUnauthorized {
+				return
+			}
+
+			if challenge := response.Header.Get("WWW-Authenticate"); challenge != "Bearer" {
+				t.Errorf("Expected the Bearer challenge, got %q", challenge)
+			}
+
+			if !strings.Contains(string(content), "Invalid or expired credential") {
+				t.Errorf("Expected body containing %q, got %s", "Invalid or expired credential", content)
+			}
+		})
+	}
+}
+
+func TestRequirePrincipal(t *testing.T) {
+	server := testserver.New(t, testserver.Options{})
+
+	viewerKey := testdb.CreateApiKey(t, server.Sqlite, constants.RoleViewer)
+	editorKey := testdb.CreateApiKey(t, server.Sqlite, constants.RoleEditor)
+	adminKey := testdb.CreateApiKey(t, server.Sqlite, constants.RoleCatalogAdmin)
+
+	tests := []struct {
+		name         string
+		path         string
+		key          string
+		expectStatus int
+	}{
+		{
+			name:         "Should keep the catalog readable without a credential",
+			path:         "/categories",
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "Should refuse the audit log without a credential",
+			path:         "/audit-log?limit=20&skip=0",
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "Should refuse the webhook subscriptions without a credential",
+			path:         "/webhook-subscriptions",
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "Should refuse the webhook deliveries without a credential",
+			path:         "/webhook-subscriptions/01J00000000000000000000000/deliveries",
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "Should refuse the trash without a credential",
+			path:         "/trash?limit=20&skip=0",
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "Should refuse the audit log to a viewer",
+			path:         "/audit-log?limit=20&skip=0",
+			key:          viewerKey,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:         "Should show the audit log to an editor",
+			path:         "/audit-log?limit=20&skip=0",
+			key:          editorKey,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "Should refuse the webhook subscriptions to an editor",
+			path:         "/webhook-subscriptions",
+			key:          editorKey,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:         "Should refuse the trash to an editor",
+			path:         "/trash?limit=20&skip=0",
+			key:          editorKey,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:         "Should show the webhook subscriptions to a catalog admin",
+			path:         "/webhook-subscriptions",
+			key:          adminKey,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "Should show the trash to a catalog admin",
+			path:         "/trash?limit=20&skip=0",
+			key:          adminKey,
+			expectStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+
+			if tt.key != "" {
+				header.Set("Authorization", "Bearer "+tt.key)
+			}
+
+			response, content := server.Do(t, http.MethodGet, tt.path, header, nil)
+
+			if response.StatusCode != tt.expectStatus {
+				t.Fatalf("Expected status %d, got %d %s", tt.expectStatus, response.StatusCode, content)
+			}
+
+			if tt.expectStatus == http.StatusUnauthorized && response.Header.Get("WWW-Authenticate") != "Bearer" {
+				t.Errorf("Expected the Bearer challenge, got %q", response.Header.Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package middleware_test
+
+import (
+	"net/http"
+	"project/internal/infra/config/environment"
+	"project/test/testserver"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimit_Limit(t *testing.T) {
+	options := testserver.Options{
+		RateLimit: environment.RateLimit{
+			Read: environment.RateLimitPolicy{Limit: 2, Window: time.Minute},
+		},
+	}
+
+	server := testserver.New(t, options)
+
+	// another process on the same database, its memory has none of the buckets
+	options.Sqlite = server.Sqlite
+	other := testserver.New(t, options)
+
+	tests := []struct {
+		name            string
+		server          *testserver.Server
+		expectStatus    int
+		expectRemaining string
+		expectRetry     bool
+	}{
+		{name: "Should take from a full bucket", server: server, expectStatus: http.StatusOK, expectRemaining: "1"},
+		{name: "Should take the last token", server: server, expectStatus: http.StatusOK, expectRemaining: "0"},
+		{name: "Should refuse an empty bucket", server: server, expectStatus: http.StatusTooManyRequests, expectRemaining: "0", expectRetry: true},
+		{name: "Should refuse an empty bucket from another process", server: other, expectStatus: http.StatusTooManyRequests, expectRemaining: "0", expectRetry: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, body := tt.server.Do(t, http.MethodGet, "/categories", nil, nil)
+
+			if response.StatusCode != tt.expectStatus {
+				t.Fatalf("Expected status %d, got %d %s", tt.expectStatus, response.StatusCode, body)
+			}
+
+			expectedHeaders := map[string]string{
+				"RateLimit-Policy":    "2;w=60",
+				"RateLimit-Limit":     "2",
+				"RateLimit-Remaining": tt.expectRemaining,
+			}
+
+			for header, expected := range expectedHeaders {
+				if value := response.Header.Get(header); value != expected {
+					t.Errorf("Expected %s %q, got %q", header, expected, value)
+				}
+			}
+
+			reset, err := strconv.Atoi(response.Header.Get("RateLimit-Reset"))
+			if err != nil || reset < 1 || reset > 60 {
+				t.Errorf("Expected RateLimit-Reset within the window, got %q", response.Header.Get("RateLimit-Reset"))
+			}
+
+			retryAfter := response.Header.Get("Retry-After")
+
+			if !tt.expectRetry {
+				if retryAfter != "" {
+					t.Errorf("Expected no Retry-After, got %q", retryAfter)
+				}
+				return
+			}
+
+			if seconds, err := strconv.Atoi(retryAfter); err != nil || seconds < 1 || seconds > 30 {
+				t.Errorf("Expected Retry-After within the time a token takes, got %q", retryAfter)
+			}
+
+			if !strings.Contains(string(body), "rate limit of the read requests exceeded") {
+				t.Errorf("Expected body containing %q, got %s", "rate limit of the read requests exceeded", body)
+			}
+		})
+	}
+
+	t.Run("Should not limit a route whose policy is off", func(t *testing.T) {
+		// compare has a policy of its own, the empty read bucket does not apply
+		response, body := server.Do(t, http.MethodPost, "/products/compare", nil, map[string]any{
+			"left_public_id":  "unknown0",
+			"right_public_id": "unknown1",
+		})
+
+		if response.StatusCode == http.StatusTooManyRequests {
+			t.Fatalf("Expected the request not to be limited, got %d %s", response.StatusCode, body)
+		}
+
+		if policy := response.Header.Get("RateLimit-Policy"); policy != "" {
+			t.Errorf("Expected no RateLimit-Policy, got %q", policy)
+		}
+	})
+}
+
+func TestRateLimit_LimitCredentials(t *testing.T) {
+	server := testserver.New(t, testserver.Options{
+		RateLimit: environment.RateLimit{
+			Auth: environment.RateLimitPolicy{Limit: 2, Window: time.Minute},
+		},
+	})
+
+	tests := []struct {
+		name         string
+		credential   string
+		expectStatus int
+	}{
+		{name: "Should not limit a request without a credential", expectStatus: http.StatusOK},
+		{name: "Should check the first bad credential", credential: "pck_unknown_secret", expectStatus: http.StatusUnauthorized},
+		{name: "Should check the second bad credential", credential: "pck_unknown_secret", expectStatus: http.StatusUnauthorized},
+		{name: "Should refuse a bad credential once the bucket of the IP is empty", credential: "pck_unknown_secret", expectStatus: http.StatusTooManyRequests},
+		{name: "Should still serve a request without a credential", expectStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+
+			if tt.credential != "" {
+				header.Set("Authorization", "Bearer "+tt.credential)
+			}
+
+			response, body := server.Do(t, http.MethodGet, "/categories", header, nil)
+
+			if response.StatusCode != tt.expectStatus {
+				t.Fatalf("Expected status %d, got %d %s", tt.expectStatus, response.StatusCode, body)
+			}
+
+			if tt.expectStatus == http.StatusTooManyRequests && !strings.Contains(string(body), "rate limit of the auth requests exceeded") {
+				t.Errorf("Expected body containing %q, got %s", "rate limit of the auth requests exceeded", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"project/internal/infra/logger"
+	"project/test/testserver"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// lockedBuffer keeps the log lines the server writes from its own goroutines.
+type lockedBuffer struct {
+	mutex  sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.buffer.Write(p)
+}
+
+func (b *lockedBuffer) Lines() []string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return strings.Split(strings.TrimSpace(b.buffer.String()), "\n")
+}
+
+// captureLogs makes the default logger write JSON to the returned buffer until the
+// test is done.
+func captureLogs(t *testing.T) *lockedBuffer {
+	t.Helper()
+
+	buffer := &lockedBuffer{}
+	previous := slog.Default()
+
+	slog.SetDefault(slog.New(&logger.ContextHandler{Handler: slog.NewJSONHandler(buffer, nil)}))
+
+	t.Cleanup(func() {
+		slog.SetDefault(previous)
+	})
+
+	return buffer
+}
+
+func TestRequestID(t *testing.T) {
+	logs := captureLogs(t)
+	server := testserver.New(t, testserver.Options{})
+
+	generated := regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+	tests := []struct {
+		name            string
+		requestID       string
+		path            string
+		expectRequestID string
+		expectStatus    int
+	}{
+		{
+			name:            "Should keep the request ID of the client",
+			requestID:       "trace-0001",
+			path:            "/categories",
+			expectRequestID: "trace-0001",
+			expectStatus:    http.StatusOK,
+		},
+		{
+			name:         "Should generate a request ID when there is none",
+			path:         "/categories",
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "Should replace a request ID that is not valid",
+			requestID:    "trace 0001\t<script>",
+			path:         "/categories",
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "Should replace a request ID that is too long",
+			requestID:    strings.Repeat("a", 200),
+			path:         "/categories",
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:            "Should send the request ID in the error body",
+			requestID:       "trace-0404",
+			path:            "/products/unknown0",
+			expectRequestID: "trace-0404",
+			expectStatus:    http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+
+			if tt.requestID != "" {
+				header.Set("X-Request-ID", tt.requestID)
+			}
+
+			response, body := server.Do(t, http.MethodGet, tt.path, header, nil)
+
+			if response.StatusCode != tt.expectStatus {
+				t.Fatalf("Expected status %d, got %d %s", tt.expectStatus, response.StatusCode, body)
+			}
+
+			requestID := response.Header.Get("X-Request-ID")
+
+			if tt.expectRequestID != "" && requestID != tt.expectRequestID {
+				t.Errorf("Expected request ID %q, got %q", tt.expectRequestID, requestID)
+			}
+
+			if tt.expectRequestID == "" && !generated.MatchString(requestID) {
+				t.Errorf("Expected a generated request ID, got %q", requestID)
+			}
+
+			if response.StatusCode < http.StatusBadRequest {
+				return
+			}
+
+			var errorBody struct {
+				RequestID string `json:"request_id"`
+			}
+
+			if err := json.Unmarshal(body, &errorBody); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if errorBody.RequestID != requestID {
+				t.Errorf("Expected request ID %q in the body, got %q", requestID, errorBody.RequestID)
+			}
+		})
+	}
+
+	t.Run("Should log the request ID with the exception and its cause", func(t *testing.T) {
+		var line struct {
+			RequestID string `json:"request_id"`
+			Exception struct {
+				RequestID string `json:"request_id"`
+				Cause     struct {
+					RequestID string `json:"request_id"`
+				} `json:"cause"`
+			} `json:"exception"`
+		}
+
+		found := false
+
+		for _, raw := range logs.Lines() {
+			if !strings.Contains(raw, `"exception"`) || !strings.Contains(raw, "trace-0404") {
+				continue
+			}
+
+			if err := json.Unmarshal([]byte(raw), &line); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			found = true
+		}
+
+		if !found {
+			t.Fatalf("Expected a logged exception of request trace-0404")
+		}
+
+		if line.RequestID != "trace-0404" || line.Exception.RequestID != "trace-0404" || line.Exception.Cause.RequestID != "trace-0404" {
+			t.Errorf("Expected request ID trace-0404 on the line, the exception and its cause, got %+v", line)
+		}
+	})
+}
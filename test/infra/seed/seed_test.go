@@ -0,0 +1,114 @@
+package seed_test
+
+import (
+	"project/internal/domain/constants"
+	"project/internal/infra/seed"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad_Profiles(t *testing.T) {
+	for _, profile := range seed.Profiles {
+		t.Run("Should load the "+profile+" profile with the built-in specifications", func(t *testing.T) {
+			fixture, err := seed.Load(seed.Fixtures, profile)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			found := 0
+
+			for _, specification := range fixture.Specifications {
+				expectedType, isBuiltIn := constants.BuiltInSpecifications[specification.ID]
+
+				if !isBuiltIn {
+					continue
+				}
+
+				found++
+
+				if specification.Type != expectedType {
+					t.Errorf("Expected built-in specification %d to be %s, got %s", specification.ID, expectedType, specification.Type)
+				}
+			}
+
+			if found != len(constants.BuiltInSpecifications) {
+				t.Errorf("Expected %d built-in specifications, got %d", len(constants.BuiltInSpecifications), found)
+			}
+
+			if len(fixture.Categories) == 0 || len(fixture.Products) == 0 {
+				t.Errorf("Expected categories and products, got %d and %d", len(fixture.Categories), len(fixture.Products))
+			}
+		})
+	}
+}
+
+func TestLoad_Includes(t *testing.T) {
+	fsys := fstest.MapFS{
+		"base.yaml":    {Data: []byte("specification_groups:\n  - { id: 1, public_id: sgrp0001, name: Base }\n")},
+		"extra.yml":    {Data: []byte("include: [base]\nspecification_groups:\n  - { id: 2, public_id: sgrp0002, name: Extra }\n")},
+		"staging.json": {Data: []byte(`{"include": ["base", "extra"], "specification_groups": [{"id": 3, "public_id": "sgrp0003", "name": "Staging"}]}`)},
+		"a.yaml":       {Data: []byte("include: [b]\n")},
+		"b.yaml":       {Data: []byte("include: [a]\n")},
+		"typo.yaml":    {Data: []byte("categorys: []\n")},
+	}
+
+	tests := []struct {
+		name        string
+		profile     string
+		expectIds   []int64
+		expectError bool
+		expectedMsg string
+	}{
+		{
+			name:      "Should put the rows of the included profiles first, each profile once",
+			profile:   "staging",
+			expectIds: []int64{1, 2, 3},
+		},
+		{
+			name:        "Should return error on an include cycle",
+			profile:     "a",
+			expectError: true,
+			expectedMsg: "profile include cycle: a -> b -> a",
+		},
+		{
+			name:        "Should return error on an unknown key",
+			profile:     "typo",
+			expectError: true,
+			expectedMsg: "invalid fixture typo.yaml",
+		},
+		{
+			name:        "Should return error when the profile does not exist",
+			profile:     "prod",
+			expectError: true,
+			expectedMsg: `profile "prod" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture, err := seed.Load(fsys, tt.profile)
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if len(fixture.SpecificationGroups) != len(tt.expectIds) {
+				t.Fatalf("Expected %d specification groups, got %d", len(tt.expectIds), len(fixture.SpecificationGroups))
+			}
+
+			for i, group := range fixture.SpecificationGroups {
+				if int64(group.ID) != tt.expectIds[i] {
+					t.Errorf("Expected specification group %d at %d, got %d", tt.expectIds[i], i, group.ID)
+				}
+			}
+		})
+	}
+}
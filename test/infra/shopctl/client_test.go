@@ -0,0 +1,177 @@
+package shopctl_test
+
+import (
+	"errors"
+	"fmt"
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	"project/internal/infra/seed"
+	"project/internal/infra/shopctl"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"project/test/testdb"
+	"project/test/testserver"
+	"strings"
+	"testing"
+)
+
+func seedCatalog(t *testing.T, db *sqlite.Sqlite) {
+	t.Helper()
+
+	fixture, err := seed.Load(seed.Fixtures, "test")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	_, usecaseErr := usecase.NewSeedCatalog(repository.NewSeedSqlite(db.DB)).Execute(&dto.SeedCatalogInput{
+		Fixture:   fixture,
+		ActorRole: constants.RoleSystem,
+	})
+	if usecaseErr != nil {
+		t.Fatalf("Expected no error, got %v", usecaseErr)
+	}
+}
+
+func expectClientError(t *testing.T, err error, statusCode int, message string) {
+	t.Helper()
+
+	var clientErr *shopctl.Error
+
+	if !errors.As(err, &clientErr) {
+		t.Fatalf("Expected a client error with status %d, got %v", statusCode, err)
+	}
+
+	if clientErr.StatusCode != statusCode || !strings.Contains(clientErr.Message, message) {
+		t.Errorf("Expected status %d and message containing %q, got %d %q", statusCode, message, clientErr.StatusCode, clientErr.Message)
+	}
+}
+
+// TestClients runs the same calls through the local and the HTTP client, both must
+// give the same outputs and errors.
+func TestClients(t *testing.T) {
+	server := testserver.New(t, testserver.Options{})
+	seedCatalog(t, server.Sqlite)
+
+	editorKey := testdb.CreateApiKey(t, server.Sqlite, constants.RoleCatalogAdmin)
+	viewerKey := testdb.CreateApiKey(t, server.Sqlite, constants.RoleViewer)
+
+	newLocal := func(apiKey string) shopctl.Client {
+		client, err := shopctl.NewLocal(server.Sqlite.DB, apiKey)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return client
+	}
+
+	clients := []struct {
+		name   string
+		client shopctl.Client
+		viewer shopctl.Client
+	}{
+		{name: "local", client: newLocal(""), viewer: newLocal(viewerKey)},
+		{name: "http", client: shopctl.NewHTTP(server.URL+"/", editorKey), viewer: shopctl.NewHTTP(server.URL, viewerKey)},
+	}
+
+	for _, c := range clients {
+		t.Run(c.name, func(t *testing.T) {
+			comparison, err := c.client.CompareProducts(&dto.CompareProductsInput{LeftPublicID: "prd00001", RightPublicID: "prd00002"})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if comparison.Left.Name != "Geladeira Teste 400L" || comparison.Right.Name != "Geladeira Teste 300L" {
+				t.Errorf("Expected the compared products, got %q and %q", comparison.Left.Name, comparison.Right.Name)
+			}
+
+			if len(comparison.Specifications) == 0 || comparison.Specifications[0].Title == "" {
+				t.Fatalf("Expected the compared specifications with their titles, got %+v", comparison.Specifications)
+			}
+
+			var rendered strings.Builder
+
+			if err := shopctl.NewRenderer(shopctl.FormatJSON, &rendered).Compare(comparison); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if title := fmt.Sprintf("%q: %q", "title", comparison.Specifications[0].Title); !strings.Contains(rendered.String(), title) {
+				t.Errorf("Expected the rendered comparison containing %s, got %s", title, rendered.String())
+			}
+
+			product, err := c.client.GetProductSpecifications("prd00003")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if product.Name != "Processador Teste 8 Threads" || len(product.SpecificationsGroups) == 0 {
+				t.Errorf("Expected the product with its specifications, got %+v", product)
+			}
+
+			_, err = c.client.GetProductSpecifications("unknown0")
+			expectClientError(t, err, 404, "")
+
+			created, err := c.client.CreateCategory(&dto.CreateOneCategoryInput{Name: "Fogões " + c.name, Description: "Fogões e cooktops"})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			_, err = c.client.UpdateCategory(&dto.UpdateOneCategoryInput{PublicID: created.PublicID, Name: "Fogões e cooktops " + c.name})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			categories, err := c.client.ListCategories()
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			found := false
+
+			for _, category := range categories.Categories {
+				if category.PublicID == created.PublicID {
+					found = category.Name == "Fogões e cooktops "+c.name
+				}
+			}
+
+			if !found {
+				t.Errorf("Expected the updated category %s in the list", created.PublicID)
+			}
+
+			_, err = c.client.CreateCategory(&dto.CreateOneCategoryInput{Name: "Fogões e cooktops " + c.name})
+			expectClientError(t, err, 409, "")
+
+			if _, err := c.client.DeleteCategory(created.PublicID); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if _, err := c.client.RestoreCategory(created.PublicID); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			_, err = c.viewer.CreateCategory(&dto.CreateOneCategoryInput{Name: "Micro-ondas " + c.name})
+			expectClientError(t, err, 403, `role "viewer" does not have the category:write permission`)
+		})
+	}
+
+	t.Run("Should manage the api keys only on the database", func(t *testing.T) {
+		_, err := shopctl.NewHTTP(server.URL, editorKey).ListApiKeys()
+		expectClientError(t, err, 0, "api keys are managed on the database")
+
+		keys, err := newLocal("").ListApiKeys()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(keys.ApiKeys) != 2 {
+			t.Errorf("Expected 2 api keys, got %d", len(keys.ApiKeys))
+		}
+
+		_, err = newLocal(editorKey).ListApiKeys()
+		expectClientError(t, err, 403, `role "catalog-admin" does not have the api_key:manage permission`)
+	})
+
+	t.Run("Should refuse an unknown api key", func(t *testing.T) {
+		_, err := shopctl.NewLocal(server.Sqlite.DB, "pck_00000000_unknown")
+		expectClientError(t, err, 401, "")
+	})
+}
@@ -0,0 +1,171 @@
+package sqlite_test
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"project/internal/infra/config/environment"
+	"project/internal/infra/sqlite"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sqliteConfig(t *testing.T, policy string) *environment.Sqlite {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	return &environment.Sqlite{
+		Path:            path,
+		BusyTimeout:     5000,
+		Dsn:             fmt.Sprintf("file:%s?_busy_timeout=%d&_fk=1", path, 5000),
+		MigrationPolicy: policy,
+		TrashRetention:  24 * time.Hour,
+	}
+}
+
+func newMigrator(t *testing.T, db *sql.DB) *sqlite.Migrator {
+	t.Helper()
+
+	migrator, err := sqlite.NewMigrator(db)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	return migrator
+}
+
+func TestMigrator_Check(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        string
+		empty         bool
+		down          int
+		expectError   bool
+		expectedMsg   string
+		expectPending bool
+	}{
+		{
+			name:   "Should migrate an empty database",
+			policy: environment.MigrationPolicyAuto,
+			empty:  true,
+		},
+		{
+			name:   "Should migrate a database behind the embedded migrations",
+			policy: environment.MigrationPolicyAuto,
+			down:   2,
+		},
+		{
+			name:          "Should only warn about a database behind the embedded migrations",
+			policy:        environment.MigrationPolicyWarn,
+			down:          1,
+			expectPending: true,
+		},
+		{
+			name:        "Should refuse an empty database",
+			policy:      environment.MigrationPolicyFail,
+			empty:       true,
+			expectError: true,
+			expectedMsg: "database schema is at version 0 but this binary expects",
+		},
+		{
+			name:        "Should refuse a database behind the embedded migrations",
+			policy:      environment.MigrationPolicyFail,
+			down:        1,
+			expectError: true,
+			expectedMsg: "run `migrate up` first",
+		},
+		{
+			name:   "Should accept an up to date database",
+			policy: environment.MigrationPolicyFail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := sqlite.NewSqliteConnection(sqliteConfig(t, tt.policy))
+			defer instance.DB.Close()
+
+			migrator := newMigrator(t, instance.DB)
+
+			if !tt.empty {
+				if _, err := migrator.Up(); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+			}
+
+			for range tt.down {
+				if _, err := migrator.Down(); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+			}
+
+			before, target, err := migrator.Versions()
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			err = migrator.Check(tt.policy)
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedMsg) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedMsg, err)
+				}
+				if err != nil && !strings.Contains(err.Error(), fmt.Sprintf("version %d but this binary expects %d", before, target)) {
+					t.Errorf("Expected the versions %d and %d in the error, got %v", before, target, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			current, _, err := migrator.Versions()
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if pending := current != target; pending != tt.expectPending {
+				t.Errorf("Expected pending migrations %v, got version %d of %d", tt.expectPending, current, target)
+			}
+		})
+	}
+}
+
+func TestNewSqliteInstance_PreforkChild(t *testing.T) {
+	t.Run("Should not migrate from a prefork child", func(t *testing.T) {
+		t.Setenv("FIBER_PREFORK_CHILD", "1")
+
+		defer func() {
+			recovered := recover()
+			if recovered == nil || !strings.Contains(fmt.Sprint(recovered), "database schema is at version 0") {
+				t.Errorf("Expected a panic about the schema version, got %v", recovered)
+			}
+		}()
+
+		sqlite.NewSqliteInstance(sqliteConfig(t, environment.MigrationPolicyAuto))
+	})
+
+	t.Run("Should open a database the parent migrated", func(t *testing.T) {
+		config := sqliteConfig(t, environment.MigrationPolicyAuto)
+
+		parent := sqlite.NewSqliteInstance(config)
+		defer parent.DB.Close()
+
+		t.Setenv("FIBER_PREFORK_CHILD", "1")
+
+		child := sqlite.NewSqliteInstance(config)
+		defer child.DB.Close()
+
+		current, target, err := newMigrator(t, child.DB).Versions()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if current != target {
+			t.Errorf("Expected version %d, got %d", target, current)
+		}
+	})
+}
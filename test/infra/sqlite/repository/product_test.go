@@ -0,0 +1,93 @@
+package repository_test
+
+import (
+	domain_entity "project/internal/domain/entity"
+	"project/internal/infra/sqlite/repository"
+	"project/test/testdb"
+	"testing"
+)
+
+func TestProductSqlite_UpdateOne(t *testing.T) {
+	db := testdb.NewSqlite(t)
+	categories := repository.NewCategorySqlite(db.DB)
+	products := repository.NewProductSqlite(db.DB)
+
+	createCategory := func(name string) *domain_entity.Category {
+		category, err := domain_entity.NewCategory(domain_entity.CategoryProps{Name: name})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if err := categories.CreateOne(category); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		created, repoErr := categories.GetOneByPublicID(category.PublicID)
+		if repoErr != nil {
+			t.Fatalf("Expected no error, got %v", repoErr)
+		}
+
+		return created
+	}
+
+	phones := createCategory("Smartphones")
+	notebooks := createCategory("Notebooks")
+
+	product, entityErr := domain_entity.NewProduct(domain_entity.ProductProps{
+		CategoryID: phones.ID,
+		Name:       "Galaxy Book",
+		Price:      350000,
+	})
+	if entityErr != nil {
+		t.Fatalf("Expected no error, got %v", entityErr)
+	}
+
+	if err := products.CreateOne(product); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// an old updated_at, so the update is told apart from the insert
+	if _, err := db.DB.Exec(`UPDATE products SET updated_at = '2020-01-01 00:00:00' WHERE public_id = ?`, product.PublicID); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	product, repoErr := products.GetOneByPublicId(product.PublicID)
+	if repoErr != nil {
+		t.Fatalf("Expected no error, got %v", repoErr)
+	}
+
+	entityErr = product.Update(domain_entity.UpdateProductProps{
+		CategoryID: notebooks.ID,
+		Name:       product.Name,
+		Price:      330000,
+	})
+	if entityErr != nil {
+		t.Fatalf("Expected no error, got %v", entityErr)
+	}
+
+	if err := products.UpdateOne(product); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	updated, repoErr := products.GetOneByPublicId(product.PublicID)
+	if repoErr != nil {
+		t.Fatalf("Expected no error, got %v", repoErr)
+	}
+
+	if updated.CategoryID != notebooks.ID {
+		t.Errorf("Expected category %d, got %d", notebooks.ID, updated.CategoryID)
+	}
+
+	if updated.Price != 330000 {
+		t.Errorf("Expected price %d, got %d", 330000, updated.Price)
+	}
+
+	var updatedAt string
+	if err := db.DB.QueryRow(`SELECT updated_at FROM products WHERE public_id = ?`, product.PublicID).Scan(&updatedAt); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if updatedAt == "2020-01-01 00:00:00" {
+		t.Errorf("Expected updated_at to move on update, got %s", updatedAt)
+	}
+}
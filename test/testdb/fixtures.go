@@ -0,0 +1,77 @@
+package testdb
+
+import (
+	"project/internal/application/dto"
+	"project/internal/application/usecase"
+	"project/internal/domain/constants"
+	"project/internal/domain/entity"
+	"project/internal/domain/types"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/sqlite/repository"
+	"testing"
+)
+
+// CreateCategory creates a category and reads it back, with its ID.
+func CreateCategory(t testing.TB, db *sqlite.Sqlite, name string) *entity.Category {
+	t.Helper()
+
+	category, entityErr := entity.NewCategory(entity.CategoryProps{Name: name})
+	if entityErr != nil {
+		t.Fatalf("Expected no error, got %v", entityErr)
+	}
+
+	categories := repository.NewCategorySqlite(db.DB)
+
+	if repoErr := categories.CreateOne(category); repoErr != nil {
+		t.Fatalf("Expected no error, got %v", repoErr)
+	}
+
+	created, repoErr := categories.GetOneByPublicID(category.PublicID)
+	if repoErr != nil {
+		t.Fatalf("Expected no error, got %v", repoErr)
+	}
+
+	return created
+}
+
+// CreateProduct creates a product of the category and reads it back, with its ID
+// and version.
+func CreateProduct(t testing.TB, db *sqlite.Sqlite, category *entity.Category, props entity.ProductProps) *entity.Product {
+	t.Helper()
+
+	props.CategoryID = category.ID
+
+	product, entityErr := entity.NewProduct(props)
+	if entityErr != nil {
+		t.Fatalf("Expected no error, got %v", entityErr)
+	}
+
+	products := repository.NewProductSqlite(db.DB)
+
+	if repoErr := products.CreateOne(product); repoErr != nil {
+		t.Fatalf("Expected no error, got %v", repoErr)
+	}
+
+	created, repoErr := products.GetOneByPublicId(product.PublicID)
+	if repoErr != nil {
+		t.Fatalf("Expected no error, got %v", repoErr)
+	}
+
+	return created
+}
+
+// CreateApiKey creates an API key of the role and returns the key to send.
+func CreateApiKey(t testing.TB, db *sqlite.Sqlite, role types.Role) string {
+	t.Helper()
+
+	output, usecaseErr := usecase.NewCreateOneApiKey(repository.NewApiKeySqlite(db.DB)).Execute(&dto.CreateOneApiKeyInput{
+		Name:      "test-" + string(role),
+		Role:      role,
+		ActorRole: constants.RoleSystem,
+	})
+	if usecaseErr != nil {
+		t.Fatalf("Expected no error, got %v", usecaseErr)
+	}
+
+	return output.Key
+}
@@ -0,0 +1,33 @@
+package testdb
+
+import (
+	"fmt"
+	"path/filepath"
+	"project/internal/infra/config/environment"
+	"project/internal/infra/sqlite"
+	"testing"
+	"time"
+)
+
+// NewSqlite opens a database of its own for the test, migrated up to the embedded
+// migrations, and closes it once the test is done.
+func NewSqlite(t testing.TB) *sqlite.Sqlite {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	instance := sqlite.NewSqliteInstance(&environment.Sqlite{
+		Path:               path,
+		BusyTimeout:        5000,
+		Dsn:                fmt.Sprintf("file:%s?_busy_timeout=%d&_fk=1", path, 5000),
+		MigrationPolicy:    environment.MigrationPolicyAuto,
+		TrashRetention:     24 * time.Hour,
+		TrashPurgeInterval: 0,
+	})
+
+	t.Cleanup(func() {
+		instance.DB.Close()
+	})
+
+	return instance
+}
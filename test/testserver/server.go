@@ -0,0 +1,132 @@
+package testserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"project/internal/infra/auth"
+	"project/internal/infra/config/environment"
+	internal_fiber "project/internal/infra/fiber"
+	"project/internal/infra/ratelimit"
+	"project/internal/infra/sqlite"
+	"project/internal/infra/storage"
+	"project/test/testdb"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Options change the configuration of the server, the zero value has no JWT key and
+// no rate limit.
+type Options struct {
+	JWTSecret string
+	RateLimit environment.RateLimit
+	// Sqlite is shared with another server when set, a new database is opened when nil.
+	Sqlite *sqlite.Sqlite
+}
+
+// Server is the API on a database of its own, listening on a free local port.
+type Server struct {
+	URL    string
+	Fiber  *internal_fiber.Fiber
+	Sqlite *sqlite.Sqlite
+}
+
+// New starts the API for the test and shuts it down once the test is done.
+func New(t testing.TB, options Options) *Server {
+	t.Helper()
+
+	// the router reads the swagger credentials from the environment
+	t.Setenv("SWAGGER_ROUTE_ACCESS_USER", "admin")
+	t.Setenv("SWAGGER_ROUTE_ACCESS_PASSWORD", "5o0HlCNQzFqWDuMWXYLhIeLYiHWyolBwsWVap/rgDfo=")
+
+	db := options.Sqlite
+
+	if db == nil {
+		db = testdb.NewSqlite(t)
+	}
+
+	fiberInstance := internal_fiber.NewFiberInstance(
+		&environment.Fiber{Host: "127.0.0.1"},
+		&environment.CacheControl{
+			Catalog: "public, max-age=60",
+			Product: "public, max-age=30",
+			Compare: "private, no-cache",
+		},
+		db,
+		storage.NewStorageInstance(&environment.Storage{
+			Driver:     environment.StorageDriverLocal,
+			LocalPath:  t.TempDir(),
+			PublicPath: "/uploads",
+		}),
+		auth.NewAuthInstance(&environment.Auth{
+			JWTSecret: []byte(options.JWTSecret),
+			JWTLeeway: time.Second,
+		}),
+		ratelimit.NewRateLimitInstance(&options.RateLimit),
+	)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	go fiberInstance.App.Listener(listener, fiber.ListenConfig{DisableStartupMessage: true})
+
+	t.Cleanup(func() {
+		fiberInstance.App.Shutdown()
+	})
+
+	return &Server{
+		URL:    "http://" + listener.Addr().String(),
+		Fiber:  fiberInstance,
+		Sqlite: db,
+	}
+}
+
+// Do sends the request with the headers, body is sent as JSON when it is not nil, and
+// returns the response with its whole body read.
+func (s *Server) Do(t testing.TB, method string, path string, header http.Header, body any) (*http.Response, []byte) {
+	t.Helper()
+
+	var reader io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	request, err := http.NewRequest(method, s.URL+path, reader)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for key, values := range header {
+		request.Header[key] = values
+	}
+
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	return response, content
+}